@@ -0,0 +1,86 @@
+package acctest
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// resourceConfig returns the configuration raw of resource r as Terraform passes it to the
+// provider, so that the raw configuration that diff suppression functions read with
+// GetRawConfig is set as it is outside of tests
+func resourceConfig(r *schema.Resource, raw map[string]interface{}) (*terraform.ResourceConfig, error) {
+	val, err := ctyValue(raw)
+	if err != nil {
+		return nil, err
+	}
+	block := r.CoreConfigSchema()
+	val, err = block.CoerceValue(val)
+	if err != nil {
+		return nil, err
+	}
+	config := terraform.NewResourceConfigShimmed(val, block)
+	config.CtyValue = val
+	return config, nil
+}
+
+// ctyValue converts a configuration value given as Go maps, lists and primitives to a cty value,
+// leaving the conversion to the types of the schema to CoerceValue
+func ctyValue(v interface{}) (cty.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	case string:
+		return cty.StringVal(v), nil
+	case bool:
+		return cty.BoolVal(v), nil
+	case int:
+		return cty.NumberIntVal(int64(v)), nil
+	case int64:
+		return cty.NumberIntVal(v), nil
+	case float64:
+		return cty.NumberFloatVal(v), nil
+	case []string:
+		elems := make([]interface{}, len(v))
+		for i, s := range v {
+			elems[i] = s
+		}
+		return ctyValue(elems)
+	case []interface{}:
+		if len(v) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		elems := make([]cty.Value, len(v))
+		for i, elem := range v {
+			val, err := ctyValue(elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			elems[i] = val
+		}
+		return cty.TupleVal(elems), nil
+	case []map[string]interface{}:
+		elems := make([]interface{}, len(v))
+		for i, m := range v {
+			elems[i] = m
+		}
+		return ctyValue(elems)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal, nil
+		}
+		attrs := make(map[string]cty.Value, len(v))
+		for k, elem := range v {
+			val, err := ctyValue(elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			attrs[k] = val
+		}
+		return cty.ObjectVal(attrs), nil
+	default:
+		return cty.NilVal, fmt.Errorf("unsupported configuration value %#v", v)
+	}
+}
@@ -0,0 +1,40 @@
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// CheckJSON checks that the request body got, as expanded from a configuration, encodes to the
+// JSON want and that decoding want yields got again, so that no configured value is lost on the
+// way to the API
+func CheckJSON(t *testing.T, got interface{}, want string) {
+	t.Helper()
+	body, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("encoding %T: %s", got, err)
+	}
+	var gotValue, wantValue interface{}
+	if err := json.Unmarshal(body, &gotValue); err != nil {
+		t.Fatalf("decoding %s: %s", body, err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("decoding want: %s", err)
+	}
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		var compact bytes.Buffer
+		json.Compact(&compact, []byte(want))
+		t.Errorf("%T encoded as\n got %s\nwant %s", got, body, compact.String())
+		return
+	}
+
+	decoded := reflect.New(reflect.TypeOf(got))
+	if err := json.Unmarshal([]byte(want), decoded.Interface()); err != nil {
+		t.Fatalf("decoding want into %T: %s", got, err)
+	}
+	if !reflect.DeepEqual(decoded.Elem().Interface(), got) {
+		t.Errorf("%T does not survive a JSON round trip:\n got %+v\nwant %+v", got, decoded.Elem().Interface(), got)
+	}
+}
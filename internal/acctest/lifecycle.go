@@ -0,0 +1,296 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Step is one apply of a resource configuration
+type Step struct {
+	// Config is the resource configuration, with nested blocks given as lists of maps
+	Config map[string]interface{}
+
+	// Check verifies the state after the step was applied and refreshed
+	Check func(t *testing.T, state *terraform.InstanceState)
+}
+
+// Lifecycle describes a test of a resource's create, read, update, import and delete functions
+type Lifecycle struct {
+	// Provider is the configured provider serving the resource
+	Provider *schema.Provider
+
+	// Resource is the resource type, e.g. "veeambackup_vbr_repository"
+	Resource string
+
+	// Steps are applied in turn; the first one creates the resource and the others update it
+	Steps []Step
+
+	// ImportStateVerifyIgnore lists attributes, or prefixes of nested attributes, that are not
+	// restored by import because the API does not return them
+	ImportStateVerifyIgnore []string
+}
+
+// ConfigureProvider configures p with the given provider configuration
+func ConfigureProvider(t *testing.T, p *schema.Provider, config map[string]interface{}) {
+	t.Helper()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("configuring provider: %s", formatDiags(diags))
+	}
+}
+
+// VBRProviderConfig returns a provider configuration connecting to the mock VBR server s
+func VBRProviderConfig(s *Server) map[string]interface{} {
+	return map[string]interface{}{
+		"max_retries": 0,
+		"vbr": []interface{}{map[string]interface{}{
+			"hostname":             s.Hostname(),
+			"port":                 s.Port(),
+			"username":             "acctest",
+			"password":             "acctest",
+			"insecure_skip_verify": true,
+		}},
+	}
+}
+
+// AzureProviderConfig returns a provider configuration connecting to the mock VB for Azure server s
+func AzureProviderConfig(s *Server) map[string]interface{} {
+	return map[string]interface{}{
+		"max_retries": 0,
+		"azure": []interface{}{map[string]interface{}{
+			"hostname":             s.URL,
+			"username":             "acctest",
+			"password":             "acctest",
+			"insecure_skip_verify": true,
+		}},
+	}
+}
+
+// AWSProviderConfig returns a provider configuration connecting to the mock VB for AWS server s
+func AWSProviderConfig(s *Server) map[string]interface{} {
+	return map[string]interface{}{
+		"max_retries": 0,
+		"aws": []interface{}{map[string]interface{}{
+			"hostname":             s.Hostname(),
+			"port":                 s.Port(),
+			"username":             "acctest",
+			"password":             "acctest",
+			"insecure_skip_verify": true,
+		}},
+	}
+}
+
+// GCPProviderConfig returns a provider configuration connecting to the mock VB for Google Cloud server s
+func GCPProviderConfig(s *Server) map[string]interface{} {
+	return map[string]interface{}{
+		"max_retries": 0,
+		"gcp": []interface{}{map[string]interface{}{
+			"hostname":             s.Hostname(),
+			"port":                 s.Port(),
+			"username":             "acctest",
+			"password":             "acctest",
+			"insecure_skip_verify": true,
+		}},
+	}
+}
+
+// VB365ProviderConfig returns a provider configuration connecting to the mock VB365 server s
+func VB365ProviderConfig(s *Server) map[string]interface{} {
+	return map[string]interface{}{
+		"max_retries": 0,
+		"vb365": []interface{}{map[string]interface{}{
+			"hostname":             s.Hostname(),
+			"port":                 s.Port(),
+			"username":             "acctest",
+			"password":             "acctest",
+			"insecure_skip_verify": true,
+		}},
+	}
+}
+
+// EMProviderConfig returns a provider configuration connecting to the mock Enterprise Manager server s
+func EMProviderConfig(s *Server) map[string]interface{} {
+	return map[string]interface{}{
+		"max_retries": 0,
+		"enterprise_manager": []interface{}{map[string]interface{}{
+			"hostname":             s.Hostname(),
+			"port":                 s.Port(),
+			"username":             "acctest",
+			"password":             "acctest",
+			"insecure_skip_verify": true,
+		}},
+	}
+}
+
+// Run applies every step, checking after each one that a refresh leaves no changes to plan. It
+// then imports the resource, when it supports import, and compares the imported state with the
+// applied one. Finally it destroys the resource and checks that a refresh removes it from state.
+func (l Lifecycle) Run(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+	meta := l.Provider.Meta()
+	r, ok := l.Provider.ResourcesMap[l.Resource]
+	if !ok {
+		t.Fatalf("provider has no resource %s", l.Resource)
+	}
+
+	var state *terraform.InstanceState
+	for i, step := range l.Steps {
+		config, err := resourceConfig(r, step.Config)
+		if err != nil {
+			t.Fatalf("step %d: invalid configuration: %s", i+1, err)
+		}
+		if diags := r.Validate(config); diags.HasError() {
+			t.Fatalf("step %d: invalid configuration: %s", i+1, formatDiags(diags))
+		}
+
+		plan, err := r.Diff(ctx, state, config, meta)
+		if err != nil {
+			t.Fatalf("step %d: plan: %s", i+1, err)
+		}
+		if plan.Empty() {
+			t.Fatalf("step %d: configuration plans no changes", i+1)
+		}
+
+		var diags diag.Diagnostics
+		state, diags = r.Apply(ctx, state, plan, meta)
+		if diags.HasError() {
+			t.Fatalf("step %d: apply: %s", i+1, formatDiags(diags))
+		}
+
+		state, diags = r.RefreshWithoutUpgrade(ctx, state, meta)
+		if diags.HasError() {
+			t.Fatalf("step %d: refresh: %s", i+1, formatDiags(diags))
+		}
+		if state == nil {
+			t.Fatalf("step %d: refresh removed the resource from state", i+1)
+		}
+
+		plan, err = r.Diff(ctx, state, config, meta)
+		if err != nil {
+			t.Fatalf("step %d: plan after refresh: %s", i+1, err)
+		}
+		if !plan.Empty() {
+			t.Errorf("step %d: plan after refresh is not empty:\n%s", i+1, formatPlan(plan))
+		}
+
+		if step.Check != nil {
+			step.Check(t, state)
+		}
+	}
+
+	if r.Importer != nil {
+		l.verifyImport(t, r, meta, state)
+	}
+
+	if _, diags := r.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, meta); diags.HasError() {
+		t.Fatalf("destroy: %s", formatDiags(diags))
+	}
+	refreshed, diags := r.RefreshWithoutUpgrade(ctx, state, meta)
+	if diags.HasError() {
+		t.Fatalf("refresh after destroy: %s", formatDiags(diags))
+	}
+	if refreshed != nil {
+		t.Errorf("resource %s still exists after destroy", state.ID)
+	}
+}
+
+func (l Lifecycle) verifyImport(t *testing.T, r *schema.Resource, meta interface{}, state *terraform.InstanceState) {
+	t.Helper()
+	ctx := context.Background()
+
+	imported, err := r.Importer.StateContext(ctx, r.Data(&terraform.InstanceState{ID: state.ID}), meta)
+	if err != nil {
+		t.Fatalf("import: %s", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("import returned %d resources, want 1", len(imported))
+	}
+
+	importedState, diags := r.RefreshWithoutUpgrade(ctx, imported[0].State(), meta)
+	if diags.HasError() {
+		t.Fatalf("refresh after import: %s", formatDiags(diags))
+	}
+	if importedState == nil {
+		t.Fatalf("import of %s returned no state", state.ID)
+	}
+
+	for _, k := range attributeKeys(state.Attributes, importedState.Attributes) {
+		if l.ignoredOnImport(k) {
+			continue
+		}
+		if want, got := state.Attributes[k], importedState.Attributes[k]; want != got {
+			t.Errorf("import: %s = %q, want %q", k, got, want)
+		}
+	}
+}
+
+func (l Lifecycle) ignoredOnImport(k string) bool {
+	if strings.HasPrefix(k, "timeouts.") {
+		return true
+	}
+	for _, ignored := range l.ImportStateVerifyIgnore {
+		if k == ignored || strings.HasPrefix(k, ignored+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// attributeKeys returns the sorted union of the keys of flatmap states, without the counts of
+// empty collections, which are left out of state when a collection is never set
+func attributeKeys(states ...map[string]string) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, attrs := range states {
+		for k, v := range attrs {
+			if (strings.HasSuffix(k, ".#") || strings.HasSuffix(k, ".%")) && v == "0" {
+				continue
+			}
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatPlan(plan *terraform.InstanceDiff) string {
+	keys := make([]string, 0, len(plan.Attributes))
+	for k := range plan.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		attr := plan.Attributes[k]
+		switch {
+		case attr.NewRemoved:
+			fmt.Fprintf(&b, "  %s: %q => (removed)\n", k, attr.Old)
+		case attr.NewComputed:
+			fmt.Fprintf(&b, "  %s: %q => (known after apply)\n", k, attr.Old)
+		default:
+			fmt.Fprintf(&b, "  %s: %q => %q\n", k, attr.Old, attr.New)
+		}
+	}
+	return b.String()
+}
+
+func formatDiags(diags diag.Diagnostics) string {
+	var msgs []string
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			msgs = append(msgs, strings.TrimSpace(d.Summary+": "+d.Detail))
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,438 @@
+// Package acctest provides an in-memory stand-in for the Veeam REST APIs and a harness that runs
+// the create, read, update, import and delete lifecycle of provider resources against it, so that
+// resources can be tested without a live appliance.
+package acctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Token is the access token issued by the mock token endpoints
+const Token = "acctest-token"
+
+// AzureAPIPrefix is the path prefix of the VB for Azure API version the provider uses by default
+const AzureAPIPrefix = "/api/v8.1"
+
+// VB365APIPrefix is the path prefix of the VB365 API version the provider uses by default
+const VB365APIPrefix = "/v8"
+
+// EMAPIPrefix is the path prefix of the Enterprise Manager API
+const EMAPIPrefix = "/api"
+
+// Object is a JSON object stored by the mock server
+type Object = map[string]interface{}
+
+// Collection describes a REST collection served by Server. Objects are created by POSTing to the
+// collection and read, updated and deleted at the collection path followed by their ID.
+type Collection struct {
+	// Path of the collection below the API prefix, e.g. "/api/v1/jobs"
+	Path string
+
+	// CreatePath overrides the path objects are created at, e.g. "/accounts/azure/service/saveByApp"
+	CreatePath string
+
+	// UpdatePath overrides the path objects are updated at; the object ID is appended to it
+	UpdatePath string
+
+	// IDField is the field holding the object ID; defaults to "id"
+	IDField string
+
+	// CreateStatus is the status code of a successful create; defaults to 201
+	CreateStatus int
+
+	// UpdateStatus is the status code of a successful update; defaults to 200
+	UpdateStatus int
+
+	// Store converts a create or update request body into the object returned by GET, for APIs
+	// whose request and response models differ. The object has IDField set when it is called.
+	Store func(s *Server, obj Object)
+
+	// Respond builds the body of create and update responses from the stored object, e.g. a VBR
+	// session; the object itself is returned when nil
+	Respond func(s *Server, obj Object) interface{}
+
+	// Validate rejects a create or update request body with 400 Bad Request when it returns an error
+	Validate func(obj Object) error
+}
+
+// Server is an in-memory Veeam REST API. It issues tokens, stores the objects of the registered
+// collections and serves sessions and operations that complete immediately.
+type Server struct {
+	*httptest.Server
+
+	prefix    string
+	errorBody func(status int, message string) Object
+
+	mu          sync.Mutex
+	collections []*Collection
+	objects     map[string]Object // Keyed by path below the API prefix
+	created     map[string]int    // Creation order of objects, for lists
+	nextID      int
+	requests    []string
+}
+
+// NewVBRServer starts a mock Veeam Backup & Replication REST API, reporting a 12.3 build
+func NewVBRServer(t *testing.T) *Server {
+	s := newServer(t, "", func(status int, message string) Object {
+		return Object{"errorCode": http.StatusText(status), "message": message}
+	})
+	s.Put("/api/v1/serverInfo", Object{"vbrId": "acctest", "name": "vbr", "buildVersion": "12.3.0.310", "platform": "Windows"})
+	return s
+}
+
+// NewAzureServer starts a mock Veeam Backup for Microsoft Azure REST API
+func NewAzureServer(t *testing.T) *Server {
+	return newServer(t, AzureAPIPrefix, func(status int, message string) Object {
+		return Object{"title": http.StatusText(status), "detail": message, "status": status}
+	})
+}
+
+// NewAWSServer starts a mock Veeam Backup for AWS REST API
+func NewAWSServer(t *testing.T) *Server {
+	return newServer(t, "/api/v1", func(status int, message string) Object {
+		return Object{"errorCode": http.StatusText(status), "message": message}
+	})
+}
+
+// NewGCPServer starts a mock Veeam Backup for Google Cloud REST API
+func NewGCPServer(t *testing.T) *Server {
+	return newServer(t, "/api/v1", func(status int, message string) Object {
+		return Object{"errorCode": http.StatusText(status), "message": message}
+	})
+}
+
+// NewVB365Server starts a mock Veeam Backup for Microsoft 365 REST API
+func NewVB365Server(t *testing.T) *Server {
+	return newServer(t, VB365APIPrefix, func(status int, message string) Object {
+		return Object{"message": message}
+	})
+}
+
+// NewEMServer starts a mock Veeam Backup Enterprise Manager REST API, which issues session IDs
+// rather than access tokens
+func NewEMServer(t *testing.T) *Server {
+	return newServer(t, EMAPIPrefix, func(status int, message string) Object {
+		return Object{"StatusCode": status, "Message": message}
+	})
+}
+
+func newServer(t *testing.T, prefix string, errorBody func(int, string) Object) *Server {
+	s := &Server{
+		prefix:    prefix,
+		errorBody: errorBody,
+		objects:   map[string]Object{},
+		created:   map[string]int{},
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Hostname returns the host the server listens on, without scheme and port
+func (s *Server) Hostname() string {
+	host, _, _ := net.SplitHostPort(s.Listener.Addr().String())
+	return host
+}
+
+// Port returns the port the server listens on
+func (s *Server) Port() string {
+	_, port, _ := net.SplitHostPort(s.Listener.Addr().String())
+	return port
+}
+
+// Collection registers a collection of objects
+func (s *Server) Collection(c Collection) {
+	if c.IDField == "" {
+		c.IDField = "id"
+	}
+	if c.CreateStatus == 0 {
+		c.CreateStatus = http.StatusCreated
+	}
+	if c.UpdateStatus == 0 {
+		c.UpdateStatus = http.StatusOK
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collections = append(s.collections, &c)
+}
+
+// Put stores obj at path, e.g. to seed objects a resource refers to
+func (s *Server) Put(path string, obj Object) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(path, obj)
+}
+
+func (s *Server) put(path string, obj Object) {
+	if _, ok := s.created[path]; !ok {
+		s.nextID++
+		s.created[path] = s.nextID
+	}
+	s.objects[path] = obj
+}
+
+// Get returns the object stored at path
+func (s *Server) Get(path string) (Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.objects[path]
+	return obj, ok
+}
+
+// Delete removes the object stored at path, e.g. to simulate a deletion outside of Terraform
+func (s *Server) Delete(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects, path)
+}
+
+// Requests returns the method and path of every API request received, e.g. "POST /api/v1/jobs"
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// Session stores a successfully completed VBR session for resourceID and returns it. It must only
+// be called from Collection hooks, which run while the server is locked.
+func (s *Server) Session(sessionType, resourceID string) Object {
+	id := s.newID()
+	session := Object{
+		"id":           id,
+		"name":         sessionType,
+		"jobId":        s.newID(),
+		"sessionType":  sessionType,
+		"state":        "Stopped",
+		"creationTime": time.Now().UTC().Format(time.RFC3339),
+		"endTime":      time.Now().UTC().Format(time.RFC3339),
+		"usn":          1,
+		"resourceId":   resourceID,
+		"result":       Object{"result": "Success", "message": "", "isCanceled": false},
+	}
+	s.put("/api/v1/sessions/"+id, session)
+	return session
+}
+
+// Operation stores a successfully completed VB for Azure operation with the given result and
+// returns the body of the 202 response that started it. It must only be called from Collection
+// hooks.
+func (s *Server) Operation(result interface{}) Object {
+	id := s.newID()
+	s.put("/operations/"+id, Object{
+		"id":        id,
+		"status":    "Success",
+		"startTime": time.Now().UTC().Format(time.RFC3339),
+		"endTime":   time.Now().UTC().Format(time.RFC3339),
+		"result":    result,
+	})
+	return Object{"id": id}
+}
+
+// newID returns a new object ID; the caller must hold mu
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextID)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+
+	switch r.URL.Path {
+	case "/api/oauth2/token", "/api/v1/token", VB365APIPrefix + "/Token":
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(w, http.StatusOK, Object{
+			"access_token":  Token,
+			"refresh_token": "acctest-refresh-token",
+			"token_type":    "bearer",
+			"expires_in":    3600,
+		})
+		return
+	case EMAPIPrefix + "/sessionMngr/":
+		w.Header().Set("X-RestSvcSessionId", Token)
+		writeJSON(w, http.StatusCreated, Object{"SessionId": Token})
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+Token && r.Header.Get("X-RestSvcSessionId") != Token {
+		s.writeError(w, http.StatusUnauthorized, "invalid access token")
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, s.prefix+"/") {
+		s.writeError(w, http.StatusNotFound, "unknown API version")
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, s.prefix)
+
+	switch r.Method {
+	case http.MethodGet:
+		s.serveGet(w, path, r.URL.Query())
+	case http.MethodPost:
+		s.serveCreate(w, r, path)
+	case http.MethodPut:
+		s.serveUpdate(w, r, path)
+	case http.MethodDelete:
+		if _, ok := s.objects[path]; !ok {
+			s.writeError(w, http.StatusNotFound, fmt.Sprintf("%s was not found", path))
+			return
+		}
+		delete(s.objects, path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported")
+	}
+}
+
+func (s *Server) serveGet(w http.ResponseWriter, path string, query url.Values) {
+	if obj, ok := s.objects[path]; ok {
+		writeJSON(w, http.StatusOK, obj)
+		return
+	}
+
+	c := s.collectionAt(path)
+	if c == nil {
+		s.writeError(w, http.StatusNotFound, fmt.Sprintf("%s was not found", path))
+		return
+	}
+
+	// Lists are returned newest first, filtered by name like the VBR nameFilter parameter
+	var paths []string
+	for p := range s.objects {
+		if strings.HasPrefix(p, c.Path+"/") && !strings.Contains(strings.TrimPrefix(p, c.Path+"/"), "/") {
+			paths = append(paths, p)
+		}
+	}
+	sort.Slice(paths, func(i, j int) bool { return s.created[paths[i]] > s.created[paths[j]] })
+
+	data := []Object{}
+	nameFilter := strings.Trim(query.Get("nameFilter"), "*")
+	for _, p := range paths {
+		obj := s.objects[p]
+		if name, ok := obj["name"].(string); ok && nameFilter != "" && !strings.Contains(name, nameFilter) {
+			continue
+		}
+		data = append(data, obj)
+	}
+	writeJSON(w, http.StatusOK, Object{
+		"data":       data,
+		"totalCount": len(data),
+		"pagination": Object{"total": len(data), "count": len(data), "skip": 0, "limit": len(data)},
+	})
+}
+
+func (s *Server) serveCreate(w http.ResponseWriter, r *http.Request, path string) {
+	var c *Collection
+	for _, candidate := range s.collections {
+		if path == candidate.CreatePath || (candidate.CreatePath == "" && path == candidate.Path) {
+			c = candidate
+		}
+	}
+	if c == nil {
+		s.writeError(w, http.StatusNotFound, fmt.Sprintf("%s was not found", path))
+		return
+	}
+
+	obj, ok := s.readBody(w, r)
+	if !ok || !s.validate(w, c, obj) {
+		return
+	}
+	id := s.newID()
+	obj[c.IDField] = id
+	if c.Store != nil {
+		c.Store(s, obj)
+	}
+	s.put(c.Path+"/"+id, obj)
+	writeJSON(w, c.CreateStatus, s.respond(c, obj))
+}
+
+func (s *Server) serveUpdate(w http.ResponseWriter, r *http.Request, path string) {
+	var c *Collection
+	var id string
+	for _, candidate := range s.collections {
+		updatePath := candidate.UpdatePath
+		if updatePath == "" {
+			updatePath = candidate.Path
+		}
+		if rest, ok := strings.CutPrefix(path, updatePath+"/"); ok && !strings.Contains(rest, "/") {
+			c, id = candidate, rest
+		}
+	}
+	if c == nil || s.objects[c.Path+"/"+id] == nil {
+		s.writeError(w, http.StatusNotFound, fmt.Sprintf("%s was not found", path))
+		return
+	}
+
+	obj, ok := s.readBody(w, r)
+	if !ok || !s.validate(w, c, obj) {
+		return
+	}
+	obj[c.IDField] = id
+	if c.Store != nil {
+		c.Store(s, obj)
+	}
+	s.put(c.Path+"/"+id, obj)
+	writeJSON(w, c.UpdateStatus, s.respond(c, obj))
+}
+
+// collectionAt returns the collection listed at path
+func (s *Server) collectionAt(path string) *Collection {
+	for _, c := range s.collections {
+		if c.Path == path {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *Server) respond(c *Collection, obj Object) interface{} {
+	if c.Respond != nil {
+		return c.Respond(s, obj)
+	}
+	return obj
+}
+
+func (s *Server) readBody(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	obj := Object{}
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+		return nil, false
+	}
+	return obj, true
+}
+
+// validate writes a 400 Bad Request response and returns false when the collection rejects obj
+func (s *Server) validate(w http.ResponseWriter, c *Collection, obj Object) bool {
+	if c.Validate == nil {
+		return true
+	}
+	if err := c.Validate(obj); err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, s.errorBody(status, message))
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -67,7 +67,7 @@ func DataSourceAwsIAMRoles() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     -1,
-				Description: "Specifies the maximum number of items of a resource collection to return in a response.",
+				Description: "Specifies the maximum number of items of a resource collection to return in a response. Use -1 for all items.",
 			},
 			"sort": {
 				Type:        schema.TypeSet,
@@ -180,16 +180,6 @@ func DataSourceAwsIAMRolesRead(ctx context.Context, d *schema.ResourceData, meta
 
 	params := url.Values{}
 
-	offset := d.Get("offset").(int)
-	if offset > 0 {
-		params.Set("Offset", strconv.Itoa(offset))
-	}
-
-	limit := d.Get("limit").(int)
-	if limit != -1 {
-		params.Set("Limit", strconv.Itoa(limit))
-	}
-
 	if v, ok := d.GetOk("search_pattern"); ok {
 		params.Set("SearchPattern", v.(string))
 	}
@@ -199,34 +189,42 @@ func DataSourceAwsIAMRolesRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/accounts/amazon?%s", params.Encode()))
+	roles, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[WSIAMRolesDataSourceResponseResults], error) {
+		params.Set("Offset", strconv.Itoa(offset))
+		params.Set("Limit", strconv.Itoa(limit))
+		apiURL := client.BuildAPIURL(vc.WithQuery("/accounts/amazon", params))
 
-	resp, err := client.MakeAuthenticatedRequestAWS("GET", apiURL, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to retrieve IAM roles: %w", err))
-	}
-	defer resp.Body.Close()
+		resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "GET", apiURL, nil)
+		if err != nil {
+			return vc.Page[WSIAMRolesDataSourceResponseResults]{}, fmt.Errorf("failed to retrieve IAM roles: %w", err)
+		}
+		defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
-	}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[WSIAMRolesDataSourceResponseResults]{}, fmt.Errorf("failed to read response body: %w", err)
+		}
 
-	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
+		if resp.StatusCode != 200 {
+			return vc.Page[WSIAMRolesDataSourceResponseResults]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
 
-	var rolesResponse AWSIAMRolesDataSourceResponse
-	if err := json.Unmarshal(body, &rolesResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse IAM roles response: %w", err))
+		var rolesResponse AWSIAMRolesDataSourceResponse
+		if err := json.Unmarshal(body, &rolesResponse); err != nil {
+			return vc.Page[WSIAMRolesDataSourceResponseResults]{}, fmt.Errorf("failed to parse IAM roles response: %w", err)
+		}
+		return vc.Page[WSIAMRolesDataSourceResponseResults]{Items: rolesResponse.Results, Total: rolesResponse.TotalCount}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := d.Set("total_count", rolesResponse.TotalCount); err != nil {
+	if err := d.Set("total_count", roles.Total); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set total_count: %w", err))
 	}
 
-	results := make([]interface{}, 0, len(rolesResponse.Results))
-	for _, role := range rolesResponse.Results {
+	results := make([]interface{}, 0, len(roles.Items))
+	for _, role := range roles.Items {
 		results = append(results, map[string]interface{}{
 			"veeam_id":            role.ID,
 			"name":                role.Name,
@@ -255,6 +253,6 @@ func DataSourceAwsIAMRolesRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("failed to set results: %w", err))
 	}
 
-	d.SetId(fmt.Sprintf("aws-iam-roles-%d", rolesResponse.TotalCount))
+	d.SetId(fmt.Sprintf("aws-iam-roles-%d", roles.Total))
 	return nil
 }
\ No newline at end of file
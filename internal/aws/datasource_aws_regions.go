@@ -50,7 +50,7 @@ func DataSourceAwsRegions() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     -1,
-				Description: "Specifies the maximum number of items of a resource collection to return in a response.",
+				Description: "Specifies the maximum number of items of a resource collection to return in a response. Use -1 for all items.",
 			},
 			"sort": {
 				Type:        schema.TypeSet,
@@ -99,16 +99,6 @@ func DataSourceAwsRegionsRead(ctx context.Context, d *schema.ResourceData, meta
 
 	params := url.Values{}
 
-	offset := d.Get("offset").(int)
-	if offset > 0 {
-		params.Set("Offset", strconv.Itoa(offset))
-	}
-
-	limit := d.Get("limit").(int)
-	if limit != -1 {
-		params.Set("Limit", strconv.Itoa(limit))
-	}
-
 	if v, ok := d.GetOk("search_pattern"); ok {
 		params.Set("SearchPattern", v.(string))
 	}
@@ -119,34 +109,42 @@ func DataSourceAwsRegionsRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/cloudInfrastructure/regions?%s", params.Encode()))
+	regions, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[AWSregionsDataSourceResponseResults], error) {
+		params.Set("Offset", strconv.Itoa(offset))
+		params.Set("Limit", strconv.Itoa(limit))
+		apiURL := client.BuildAPIURL(vc.WithQuery("/cloudInfrastructure/regions", params))
 
-	resp, err := client.MakeAuthenticatedRequestAWS("GET", apiURL, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to retrieve AWS regions: %w", err))
-	}
-	defer resp.Body.Close()
+		resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "GET", apiURL, nil)
+		if err != nil {
+			return vc.Page[AWSregionsDataSourceResponseResults]{}, fmt.Errorf("failed to retrieve AWS regions: %w", err)
+		}
+		defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
-	}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AWSregionsDataSourceResponseResults]{}, fmt.Errorf("failed to read response body: %w", err)
+		}
 
-	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
+		if resp.StatusCode != 200 {
+			return vc.Page[AWSregionsDataSourceResponseResults]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
 
-	var regionsResponse AWSregionsDataSourceResponse
-	if err := json.Unmarshal(body, &regionsResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse AWS regions response: %w", err))
+		var regionsResponse AWSregionsDataSourceResponse
+		if err := json.Unmarshal(body, &regionsResponse); err != nil {
+			return vc.Page[AWSregionsDataSourceResponseResults]{}, fmt.Errorf("failed to parse AWS regions response: %w", err)
+		}
+		return vc.Page[AWSregionsDataSourceResponseResults]{Items: regionsResponse.Results, Total: regionsResponse.TotalCount}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := d.Set("total_count", regionsResponse.TotalCount); err != nil {
+	if err := d.Set("total_count", regions.Total); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set total_count: %w", err))
 	}
 
-	results := make([]interface{}, 0, len(regionsResponse.Results))
-	for _, region := range regionsResponse.Results {
+	results := make([]interface{}, 0, len(regions.Items))
+	for _, region := range regions.Items {
 		results = append(results, map[string]interface{}{
 			"veeam_id":      region.ID,
 			"name":          region.Name,
@@ -158,6 +156,6 @@ func DataSourceAwsRegionsRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("failed to set results: %w", err))
 	}
 
-	d.SetId(fmt.Sprintf("aws-regions-%d", regionsResponse.TotalCount))
+	d.SetId(fmt.Sprintf("aws-regions-%d", regions.Total))
 	return nil
 }
\ No newline at end of file
@@ -79,7 +79,7 @@ func DataSourceAwsRepositories() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     -1,
-				Description: "Specifies the maximum number of items of a resource collection to return in a response.",
+				Description: "Specifies the maximum number of items of a resource collection to return in a response. Use -1 for all items.",
 			},
 			"sort": {
 				Type:        schema.TypeSet,
@@ -278,16 +278,6 @@ func DataSourceAwsRepositoriesRead(ctx context.Context, d *schema.ResourceData,
 
 	params := url.Values{}
 
-	offset := d.Get("offset").(int)
-	if offset > 0 {
-		params.Set("Offset", strconv.Itoa(offset))
-	}
-
-	limit := d.Get("limit").(int)
-	if limit != -1 {
-		params.Set("Limit", strconv.Itoa(limit))
-	}
-
 	if v, ok := d.GetOk("search_pattern"); ok {
 		params.Set("SearchPattern", v.(string))
 	}
@@ -297,34 +287,42 @@ func DataSourceAwsRepositoriesRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/repositories?%s", params.Encode()))
+	repositories, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[AWSrepositoriesDataSourceResponseResults], error) {
+		params.Set("Offset", strconv.Itoa(offset))
+		params.Set("Limit", strconv.Itoa(limit))
+		apiURL := client.BuildAPIURL(vc.WithQuery("/repositories", params))
 
-	resp, err := client.MakeAuthenticatedRequestAWS("GET", apiURL, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to retrieve repositories: %w", err))
-	}
-	defer resp.Body.Close()
+		resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "GET", apiURL, nil)
+		if err != nil {
+			return vc.Page[AWSrepositoriesDataSourceResponseResults]{}, fmt.Errorf("failed to retrieve repositories: %w", err)
+		}
+		defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
-	}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AWSrepositoriesDataSourceResponseResults]{}, fmt.Errorf("failed to read response body: %w", err)
+		}
 
-	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
+		if resp.StatusCode != 200 {
+			return vc.Page[AWSrepositoriesDataSourceResponseResults]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
 
-	var repositoriesResponse AWSrepositoriesDataSourceResponse
-	if err := json.Unmarshal(body, &repositoriesResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse repositories response: %w", err))
+		var repositoriesResponse AWSrepositoriesDataSourceResponse
+		if err := json.Unmarshal(body, &repositoriesResponse); err != nil {
+			return vc.Page[AWSrepositoriesDataSourceResponseResults]{}, fmt.Errorf("failed to parse repositories response: %w", err)
+		}
+		return vc.Page[AWSrepositoriesDataSourceResponseResults]{Items: repositoriesResponse.Results, Total: repositoriesResponse.TotalCount}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := d.Set("total_count", repositoriesResponse.TotalCount); err != nil {
+	if err := d.Set("total_count", repositories.Total); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set total_count: %w", err))
 	}
 
-	results := make([]interface{}, 0, len(repositoriesResponse.Results))
-	for _, repository := range repositoriesResponse.Results {
+	results := make([]interface{}, 0, len(repositories.Items))
+	for _, repository := range repositories.Items {
 		results = append(results, map[string]interface{}{
 			"veeam_id":              repository.ID,
 			"name":                  repository.Name,
@@ -377,7 +375,7 @@ func DataSourceAwsRepositoriesRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("failed to set results: %w", err))
 	}
 
-	d.SetId(fmt.Sprintf("aws-repositories-%d", repositoriesResponse.TotalCount))
+	d.SetId(fmt.Sprintf("aws-repositories-%d", repositories.Total))
 	return nil
 }
 
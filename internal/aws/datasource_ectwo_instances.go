@@ -99,7 +99,7 @@ func DataSourceAwsEC2Instances() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     -1,
-				Description: "Specifies the maximum number of items of a resource collection to return in a response.",
+				Description: "Specifies the maximum number of items of a resource collection to return in a response. Use -1 for all items.",
 			},
 			"sort": {
 				Type:		schema.TypeSet,
@@ -266,16 +266,6 @@ func DataSourceAwsEC2InstancesRead(ctx context.Context, d *schema.ResourceData,
 	// Build query parameters
 	params := url.Values{}
 
-	offset := d.Get("offset").(int)
-	if offset > 0 {
-		params.Set("Offset", strconv.Itoa(offset))
-	}
-
-	limit := d.Get("limit").(int)
-	if limit != -1 {
-		params.Set("Limit", strconv.Itoa(limit))
-	}
-
 	if v, ok := d.GetOk("search_pattern"); ok {
 		params.Set("SearchPattern", v.(string))
 	}
@@ -305,34 +295,42 @@ func DataSourceAwsEC2InstancesRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/virtualMachines?%s", params.Encode()))
+	instances, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[AWSec2InstancesDataSourceResponseResults], error) {
+		params.Set("Offset", strconv.Itoa(offset))
+		params.Set("Limit", strconv.Itoa(limit))
+		apiURL := client.BuildAPIURL(vc.WithQuery("/virtualMachines", params))
 
-	resp, err := client.MakeAuthenticatedRequestAWS("GET", apiURL, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to retrieve EC2 instances: %w", err))
-	}
-	defer resp.Body.Close()
+		resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "GET", apiURL, nil)
+		if err != nil {
+			return vc.Page[AWSec2InstancesDataSourceResponseResults]{}, fmt.Errorf("failed to retrieve EC2 instances: %w", err)
+		}
+		defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
-	}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AWSec2InstancesDataSourceResponseResults]{}, fmt.Errorf("failed to read response body: %w", err)
+		}
 
-	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
+		if resp.StatusCode != 200 {
+			return vc.Page[AWSec2InstancesDataSourceResponseResults]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
 
-	var ec2Response AWSec2InstancesDataSourceResponse
-	if err := json.Unmarshal(body, &ec2Response); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse EC2 instances response: %w", err))
+		var ec2Response AWSec2InstancesDataSourceResponse
+		if err := json.Unmarshal(body, &ec2Response); err != nil {
+			return vc.Page[AWSec2InstancesDataSourceResponseResults]{}, fmt.Errorf("failed to parse EC2 instances response: %w", err)
+		}
+		return vc.Page[AWSec2InstancesDataSourceResponseResults]{Items: ec2Response.Results, Total: ec2Response.TotalCount}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := d.Set("total_count", ec2Response.TotalCount); err != nil {
+	if err := d.Set("total_count", instances.Total); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set total_count: %w", err))
 	}
 
-	results := make([]interface{}, 0, len(ec2Response.Results))
-	for _, instance := range ec2Response.Results {
+	results := make([]interface{}, 0, len(instances.Items))
+	for _, instance := range instances.Items {
 		results = append(results, map[string]interface{}{
 			"veeam_id":                instance.ID,
 			"name":                   instance.Name,
@@ -370,6 +368,6 @@ func DataSourceAwsEC2InstancesRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("failed to set results: %w", err))
 	}
 
-	d.SetId(fmt.Sprintf("aws-ec2-instances-%d", ec2Response.TotalCount))
+	d.SetId(fmt.Sprintf("aws-ec2-instances-%d", instances.Total))
 	return nil
 }
\ No newline at end of file
@@ -98,7 +98,7 @@ func DataSourceAwsRDSInstances() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     -1,
-				Description: "Specifies the maximum number of items of a resource collection to return in a response.",
+				Description: "Specifies the maximum number of items of a resource collection to return in a response. Use -1 for all items.",
 			},
 			"sort": {
 				Type:        schema.TypeSet,
@@ -261,16 +261,6 @@ func DataSourceAwsRDSInstancesRead(ctx context.Context, d *schema.ResourceData,
 
 	params := url.Values{}
 
-	offset := d.Get("offset").(int)
-	if offset > 0 {
-		params.Set("Offset", strconv.Itoa(offset))
-	}
-
-	limit := d.Get("limit").(int)
-	if limit != -1 {
-		params.Set("Limit", strconv.Itoa(limit))
-	}
-
 	if v, ok := d.GetOk("search_pattern"); ok {
 		params.Set("SearchPattern", v.(string))
 	}
@@ -294,34 +284,42 @@ func DataSourceAwsRDSInstancesRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/rds?%s", params.Encode()))
+	instances, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[AWSrdsInstancesDataSourceResponseResult], error) {
+		params.Set("Offset", strconv.Itoa(offset))
+		params.Set("Limit", strconv.Itoa(limit))
+		apiURL := client.BuildAPIURL(vc.WithQuery("/rds", params))
 
-	resp, err := client.MakeAuthenticatedRequestAWS("GET", apiURL, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to retrieve RDS instances: %w", err))
-	}
-	defer resp.Body.Close()
+		resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "GET", apiURL, nil)
+		if err != nil {
+			return vc.Page[AWSrdsInstancesDataSourceResponseResult]{}, fmt.Errorf("failed to retrieve RDS instances: %w", err)
+		}
+		defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
-	}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AWSrdsInstancesDataSourceResponseResult]{}, fmt.Errorf("failed to read response body: %w", err)
+		}
 
-	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
+		if resp.StatusCode != 200 {
+			return vc.Page[AWSrdsInstancesDataSourceResponseResult]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
 
-	var rdsResponse AWSrdsInstancesDataSourceResponse
-	if err := json.Unmarshal(body, &rdsResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse RDS instances response: %w", err))
+		var rdsResponse AWSrdsInstancesDataSourceResponse
+		if err := json.Unmarshal(body, &rdsResponse); err != nil {
+			return vc.Page[AWSrdsInstancesDataSourceResponseResult]{}, fmt.Errorf("failed to parse RDS instances response: %w", err)
+		}
+		return vc.Page[AWSrdsInstancesDataSourceResponseResult]{Items: rdsResponse.Results, Total: rdsResponse.TotalCount}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := d.Set("total_count", rdsResponse.TotalCount); err != nil {
+	if err := d.Set("total_count", instances.Total); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set total_count: %w", err))
 	}
 
-	results := make([]interface{}, 0, len(rdsResponse.Results))
-	for _, instance := range rdsResponse.Results {
+	results := make([]interface{}, 0, len(instances.Items))
+	for _, instance := range instances.Items {
 		results = append(results, map[string]interface{}{
 			"veeam_id":                instance.ID,
 			"name":                    instance.Name,
@@ -361,6 +359,6 @@ func DataSourceAwsRDSInstancesRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("failed to set results: %w", err))
 	}
 
-	d.SetId(fmt.Sprintf("aws-rds-instances-%d", rdsResponse.TotalCount))
+	d.SetId(fmt.Sprintf("aws-rds-instances-%d", instances.Total))
 	return nil
 }
\ No newline at end of file
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"time"
 	vc "terraform-provider-veeambackup/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -186,6 +187,12 @@ func ResourceAwsIAMRole() *schema.Resource {
 				},
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
@@ -223,7 +230,7 @@ func resourceAwsIAMRoleCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	apiURL := client.BuildAPIURL("/accounts/amazon/create")
-	resp, err := client.MakeAuthenticatedRequestAWS("POST", apiURL, bytes.NewBuffer(bodyBytes))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "POST", apiURL, bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create IAM role: %w", err))
 	}
@@ -235,7 +242,7 @@ func resourceAwsIAMRoleCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		return diag.FromErr(vc.NewVeeamAPIError(resp.StatusCode, respBody))
 	}
 
 	var roleResp AWSCreateIamRoleResponse
@@ -253,25 +260,24 @@ func resourceAwsIAMRoleRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/accounts/amazon/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequestAWS("GET", apiURL, nil)
+	apiURL := client.BuildAPIURL(vc.Endpoint("/accounts/amazon/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read IAM role: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == 404 {
-		d.SetId("")
-		return nil
-	}
-
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
 	}
 
 	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, respBody)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(apiErr)
 	}
 
 	var roleResp AWSCreateIamRoleResponse
@@ -349,8 +355,8 @@ func resourceAwsIAMRoleUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("failed to marshal IAM role update request: %w", err))
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/accounts/amazon/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequestAWS("PUT", apiURL, bytes.NewBuffer(bodyBytes))
+	apiURL := client.BuildAPIURL(vc.Endpoint("/accounts/amazon/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "PUT", apiURL, bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update IAM role: %w", err))
 	}
@@ -362,7 +368,7 @@ func resourceAwsIAMRoleUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		return diag.FromErr(vc.NewVeeamAPIError(resp.StatusCode, respBody))
 	}
 
 	return resourceAwsIAMRoleRead(ctx, d, meta)
@@ -374,8 +380,8 @@ func resourceAwsIAMRoleDelete(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/accounts/amazon/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequestAWS("DELETE", apiURL, nil)
+	apiURL := client.BuildAPIURL(vc.Endpoint("/accounts/amazon/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "DELETE", apiURL, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete IAM role: %w", err))
 	}
@@ -383,7 +389,9 @@ func resourceAwsIAMRoleDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
 		body, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
+		if apiErr := vc.NewVeeamAPIError(resp.StatusCode, body); !vc.IsGone(apiErr) {
+			return diag.FromErr(apiErr)
+		}
 	}
 
 	d.SetId("")
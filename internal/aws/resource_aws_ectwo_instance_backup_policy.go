@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"time"
 	vc "terraform-provider-veeambackup/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -419,22 +420,23 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 				Description: "Name of the backup policy.",
 			},
 			"region_ids": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Required:    true,
 				Description: "List of AWS region IDs to which the policy applies.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"backup_type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Type of backup operations performed by the policy.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Type of backup operations performed by the policy.",
+				DiffSuppressFunc: vc.SuppressCaseDifference,
 				ValidateFunc: validation.StringInSlice([]string{
 					"Snapshot",
 					"Backup",
 					"SnapshotAndBackup",
 					"BackupWithArchive",
 					"SnapshotAndBackupWithArchive",
-				}, false),
+				}, true),
 			},
 			// ── Optional ────────────────────────────────────────────
 			"description": {
@@ -450,13 +452,13 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"virtual_machine_ids": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "IDs of EC2 instances to include.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"tag_ids": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Tag IDs whose matching instances are included.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
@@ -472,13 +474,13 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"virtual_machine_ids": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "IDs of EC2 instances to exclude.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"tag_ids": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Tag IDs whose matching instances are excluded.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
@@ -665,9 +667,10 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_repository_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "ID of the target backup repository.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "ID of the target backup repository.",
 						},
 						"use_production_workers": {
 							Type:        schema.TypeBool,
@@ -690,9 +693,10 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_repository_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "ID of the target archive repository.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "ID of the target archive repository.",
 						},
 					},
 				},
@@ -749,7 +753,7 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 													Description: "Number of snapshots to retain.",
 												},
 												"schedule_hours": {
-													Type:        schema.TypeList,
+													Type:        schema.TypeSet,
 													Required:    true,
 													Description: "Hours of the day to run (0-23).",
 													Elem:        &schema.Schema{Type: schema.TypeInt},
@@ -768,9 +772,10 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"time_local": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Time of day to run (HH:mm).",
+										Type:             schema.TypeString,
+										Required:         true,
+										Description:      "Time of day to run (HH:mm).",
+										DiffSuppressFunc: vc.SuppressEquivalentTime,
 									},
 									"snapshot_options": {
 										Type:     schema.TypeList,
@@ -780,7 +785,7 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 											Schema: map[string]*schema.Schema{
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_days": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -796,7 +801,7 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 												"retention_type":  {Type: schema.TypeString, Required: true},
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_days": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -811,7 +816,7 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 											Schema: map[string]*schema.Schema{
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"retention_days": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -844,7 +849,7 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 											Schema: map[string]*schema.Schema{
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_months": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -860,7 +865,7 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 												"retention_type":  {Type: schema.TypeString, Required: true},
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_months": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -875,7 +880,7 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 											Schema: map[string]*schema.Schema{
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_months": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -914,14 +919,14 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"months": {
-										Type:     schema.TypeList,
+										Type:     schema.TypeSet,
 										Optional: true,
 										Elem:     &schema.Schema{Type: schema.TypeString},
 									},
 									"day_number_in_month": {Type: schema.TypeString, Optional: true},
 									"day_of_month":        {Type: schema.TypeInt, Optional: true},
 									"day_of_week": {
-										Type:     schema.TypeList,
+										Type:     schema.TypeSet,
 										Optional: true,
 										Elem:     &schema.Schema{Type: schema.TypeString},
 									},
@@ -981,7 +986,7 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 							Description: "ID of the organizational unit to limit policy scope.",
 						},
 						"excluded_members": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Member account IDs excluded from the policy scope.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
@@ -1001,6 +1006,12 @@ func ResourceAwsEC2InstanceBackupPolicy() *schema.Resource {
 				Description: "Warning message from the last policy session.",
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
@@ -1010,7 +1021,7 @@ func resourceAwsEC2InstanceBackupPolicyCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	req := buildEC2BackupPolicyRequest(d)
+	req := buildEC2BackupPolicyRequest(d, client)
 
 	bodyBytes, err := json.Marshal(req)
 	if err != nil {
@@ -1018,7 +1029,7 @@ func resourceAwsEC2InstanceBackupPolicyCreate(ctx context.Context, d *schema.Res
 	}
 
 	apiURL := client.BuildAPIURL("/virtualMachines/policies")
-	resp, err := client.MakeAuthenticatedRequestAWS("POST", apiURL, bytes.NewBuffer(bodyBytes))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "POST", apiURL, bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create EC2 backup policy: %w", err))
 	}
@@ -1030,7 +1041,7 @@ func resourceAwsEC2InstanceBackupPolicyCreate(ctx context.Context, d *schema.Res
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		return diag.FromErr(vc.NewVeeamAPIError(resp.StatusCode, respBody))
 	}
 
 	var policyResp AWSectwoInstanceBackupPolicyResponse
@@ -1048,25 +1059,24 @@ func resourceAwsEC2InstanceBackupPolicyRead(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/virtualMachines/policies/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequestAWS("GET", apiURL, nil)
+	apiURL := client.BuildAPIURL(vc.Endpoint("/virtualMachines/policies/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read EC2 backup policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == 404 {
-		d.SetId("")
-		return nil
-	}
-
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
 	}
 
 	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, respBody)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(apiErr)
 	}
 
 	var policyResp AWSectwoInstanceBackupPolicyResponse
@@ -1077,7 +1087,7 @@ func resourceAwsEC2InstanceBackupPolicyRead(ctx context.Context, d *schema.Resou
 	if err := d.Set("name", policyResp.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set name: %w", err))
 	}
-	if err := d.Set("description", policyResp.Description); err != nil {
+	if err := d.Set("description", client.TrimDescriptionSuffix(policyResp.Description)); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set description: %w", err))
 	}
 	if err := d.Set("region_ids", policyResp.RegionIDs); err != nil {
@@ -1106,15 +1116,15 @@ func resourceAwsEC2InstanceBackupPolicyUpdate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	req := buildEC2BackupPolicyRequest(d)
+	req := buildEC2BackupPolicyRequest(d, client)
 
 	bodyBytes, err := json.Marshal(req)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to marshal EC2 backup policy request: %w", err))
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/virtualMachines/policies/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequestAWS("PUT", apiURL, bytes.NewBuffer(bodyBytes))
+	apiURL := client.BuildAPIURL(vc.Endpoint("/virtualMachines/policies/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "PUT", apiURL, bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update EC2 backup policy: %w", err))
 	}
@@ -1126,7 +1136,7 @@ func resourceAwsEC2InstanceBackupPolicyUpdate(ctx context.Context, d *schema.Res
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		return diag.FromErr(vc.NewVeeamAPIError(resp.StatusCode, respBody))
 	}
 
 	return resourceAwsEC2InstanceBackupPolicyRead(ctx, d, meta)
@@ -1138,8 +1148,8 @@ func resourceAwsEC2InstanceBackupPolicyDelete(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/virtualMachines/policies/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequestAWS("DELETE", apiURL, nil)
+	apiURL := client.BuildAPIURL(vc.Endpoint("/virtualMachines/policies/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "DELETE", apiURL, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete EC2 backup policy: %w", err))
 	}
@@ -1147,25 +1157,26 @@ func resourceAwsEC2InstanceBackupPolicyDelete(ctx context.Context, d *schema.Res
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		if apiErr := vc.NewVeeamAPIError(resp.StatusCode, respBody); !vc.IsGone(apiErr) {
+			return diag.FromErr(apiErr)
+		}
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func buildEC2BackupPolicyRequest(d *schema.ResourceData) AWSectwoInstanceBackupPolicyRequest {
+func buildEC2BackupPolicyRequest(d *schema.ResourceData, client *vc.AWSBackupClient) AWSectwoInstanceBackupPolicyRequest {
 	req := AWSectwoInstanceBackupPolicyRequest{
 		Name:       d.Get("name").(string),
 		BackupType: d.Get("backup_type").(string),
 	}
 
-	for _, id := range d.Get("region_ids").([]interface{}) {
+	for _, id := range d.Get("region_ids").(*schema.Set).List() {
 		req.RegionIds = append(req.RegionIds, id.(string))
 	}
 
-	if v, ok := d.GetOk("description"); ok {
-		desc := v.(string)
+	if desc := client.AppendDescriptionSuffix(d.Get("description").(string)); desc != "" {
 		req.Description = &desc
 	}
 
@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBuildEC2BackupPolicyRequest(t *testing.T) {
+	for name, tc := range map[string]struct {
+		raw  map[string]interface{}
+		want string
+	}{
+		"without description": {
+			raw: map[string]interface{}{
+				"name":        "production-ec2",
+				"region_ids":  []interface{}{"eu-west-1"},
+				"backup_type": "Snapshot",
+			},
+			want: `{"name": "production-ec2", "regionIds": ["eu-west-1"], "backupType": "Snapshot"}`,
+		},
+		"with description": {
+			raw: map[string]interface{}{
+				"name":        "production-ec2",
+				"description": "Production instances",
+				"region_ids":  []interface{}{"eu-west-1"},
+				"backup_type": "SnapshotAndBackup",
+			},
+			want: `{"name": "production-ec2", "description": "Production instances", "regionIds": ["eu-west-1"], "backupType": "SnapshotAndBackup"}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceAwsEC2InstanceBackupPolicy().Schema, tc.raw)
+			acctest.CheckJSON(t, buildEC2BackupPolicyRequest(d, &vc.AWSBackupClient{}), tc.want)
+		})
+	}
+}
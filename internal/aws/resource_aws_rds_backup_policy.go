@@ -6,7 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/url"
+	"time"
 	vc "terraform-provider-veeambackup/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -408,7 +408,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 				Description: "Name of the RDS backup policy.",
 			},
 			"region_ids": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Required:    true,
 				Description: "List of AWS region IDs to which the policy applies.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -419,16 +419,17 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 				Description: "ID of the AWS account identity (cloud credential) used by this policy.",
 			},
 			"backup_type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Type of backup operations performed by the policy.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Type of backup operations performed by the policy.",
+				DiffSuppressFunc: vc.SuppressCaseDifference,
 				ValidateFunc: validation.StringInSlice([]string{
 					"Snapshot",
 					"Backup",
 					"SnapshotAndBackup",
 					"BackupWithArchive",
 					"SnapshotAndBackupWithArchive",
-				}, false),
+				}, true),
 			},
 			// ── Optional ────────────────────────────────────────────
 			"description": {
@@ -444,13 +445,13 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rds_ids": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "IDs of RDS instances to include.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"tag_ids": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Tag IDs whose matching RDS instances are included.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
@@ -466,13 +467,13 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rds_ids": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "IDs of RDS instances to exclude.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"tag_ids": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Tag IDs whose matching RDS instances are excluded.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
@@ -569,9 +570,10 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_repository_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "ID of the target backup repository.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "ID of the target backup repository.",
 						},
 						"worker_role_id": {
 							Type:        schema.TypeString,
@@ -640,9 +642,10 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_repository_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "ID of the target archive repository.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "ID of the target archive repository.",
 						},
 					},
 				},
@@ -688,7 +691,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 										Description: "Number of times to run per hour.",
 									},
 									"days": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Days of the week to run the daily schedule.",
 										Elem:        &schema.Schema{Type: schema.TypeString},
@@ -705,7 +708,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 													Description: "Number of snapshots to retain.",
 												},
 												"schedule_hours": {
-													Type:        schema.TypeList,
+													Type:        schema.TypeSet,
 													Required:    true,
 													Description: "Hours of the day to run (0-23).",
 													Elem:        &schema.Schema{Type: schema.TypeInt},
@@ -721,7 +724,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 											Schema: map[string]*schema.Schema{
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_hours": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeInt},
 												},
@@ -737,7 +740,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 												"retention_type":  {Type: schema.TypeString, Required: true},
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_hours": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeInt},
 												},
@@ -755,12 +758,13 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"time_local": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Time of day to run (HH:mm).",
+										Type:             schema.TypeString,
+										Required:         true,
+										Description:      "Time of day to run (HH:mm).",
+										DiffSuppressFunc: vc.SuppressEquivalentTime,
 									},
 									"days": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Days of the week to run.",
 										Elem:        &schema.Schema{Type: schema.TypeString},
@@ -773,7 +777,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 											Schema: map[string]*schema.Schema{
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_days": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -788,7 +792,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 											Schema: map[string]*schema.Schema{
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_days": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -804,7 +808,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 												"retention_type":  {Type: schema.TypeString, Required: true},
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_days": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -834,7 +838,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 											Schema: map[string]*schema.Schema{
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_months": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -849,7 +853,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 											Schema: map[string]*schema.Schema{
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_months": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -865,7 +869,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 												"retention_type":  {Type: schema.TypeString, Required: true},
 												"retention_count": {Type: schema.TypeInt, Required: true},
 												"schedule_months": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Required: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -898,14 +902,14 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"months": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Optional: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
 												"day_number_in_month": {Type: schema.TypeString, Optional: true},
 												"day_of_month":        {Type: schema.TypeInt, Optional: true},
 												"day_of_week": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Optional: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -964,7 +968,7 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 							Description: "ID of the organizational unit to limit policy scope.",
 						},
 						"exclude_members": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Member account IDs excluded from the policy scope.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
@@ -979,6 +983,12 @@ func ResourceAwsRDSBackupPolicy() *schema.Resource {
 				Description: "Status of the last policy session.",
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
@@ -988,7 +998,7 @@ func resourceAwsRDSBackupPolicyCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	req := buildRDSBackupPolicyRequest(d)
+	req := buildRDSBackupPolicyRequest(d, client)
 
 	bodyBytes, err := json.Marshal(req)
 	if err != nil {
@@ -996,7 +1006,7 @@ func resourceAwsRDSBackupPolicyCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	apiURL := client.BuildAPIURL("/rds/policies")
-	resp, err := client.MakeAuthenticatedRequestAWS("POST", apiURL, bytes.NewBuffer(bodyBytes))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "POST", apiURL, bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create RDS backup policy: %w", err))
 	}
@@ -1008,7 +1018,7 @@ func resourceAwsRDSBackupPolicyCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		return diag.FromErr(vc.NewVeeamAPIError(resp.StatusCode, respBody))
 	}
 
 	var policyResp AWSRDSBackupPolicyResponse
@@ -1026,25 +1036,24 @@ func resourceAwsRDSBackupPolicyRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/rds/policies/%s", url.PathEscape(d.Id())))
-	resp, err := client.MakeAuthenticatedRequestAWS("GET", apiURL, nil)
+	apiURL := client.BuildAPIURL(vc.Endpoint("/rds/policies/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read RDS backup policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == 404 {
-		d.SetId("")
-		return nil
-	}
-
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
 	}
 
 	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, respBody)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(apiErr)
 	}
 
 	var policyResp AWSRDSBackupPolicyResponse
@@ -1065,7 +1074,7 @@ func resourceAwsRDSBackupPolicyRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("failed to set backup_type: %w", err))
 	}
 	if policyResp.Description != nil {
-		if err := d.Set("description", *policyResp.Description); err != nil {
+		if err := d.Set("description", client.TrimDescriptionSuffix(*policyResp.Description)); err != nil {
 			return diag.FromErr(fmt.Errorf("failed to set description: %w", err))
 		}
 	}
@@ -1082,15 +1091,15 @@ func resourceAwsRDSBackupPolicyUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	req := buildRDSBackupPolicyRequest(d)
+	req := buildRDSBackupPolicyRequest(d, client)
 
 	bodyBytes, err := json.Marshal(req)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to marshal RDS backup policy request: %w", err))
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/rds/policies/%s", url.PathEscape(d.Id())))
-	resp, err := client.MakeAuthenticatedRequestAWS("PUT", apiURL, bytes.NewBuffer(bodyBytes))
+	apiURL := client.BuildAPIURL(vc.Endpoint("/rds/policies/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "PUT", apiURL, bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update RDS backup policy: %w", err))
 	}
@@ -1102,7 +1111,7 @@ func resourceAwsRDSBackupPolicyUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		return diag.FromErr(vc.NewVeeamAPIError(resp.StatusCode, respBody))
 	}
 
 	return resourceAwsRDSBackupPolicyRead(ctx, d, meta)
@@ -1114,8 +1123,8 @@ func resourceAwsRDSBackupPolicyDelete(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/rds/policies/%s", url.PathEscape(d.Id())))
-	resp, err := client.MakeAuthenticatedRequestAWS("DELETE", apiURL, nil)
+	apiURL := client.BuildAPIURL(vc.Endpoint("/rds/policies/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "DELETE", apiURL, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete RDS backup policy: %w", err))
 	}
@@ -1123,26 +1132,27 @@ func resourceAwsRDSBackupPolicyDelete(ctx context.Context, d *schema.ResourceDat
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
 		respBody, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody)))
+		if apiErr := vc.NewVeeamAPIError(resp.StatusCode, respBody); !vc.IsGone(apiErr) {
+			return diag.FromErr(apiErr)
+		}
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyRequest {
+func buildRDSBackupPolicyRequest(d *schema.ResourceData, client *vc.AWSBackupClient) AWSRDSBackupPolicyRequest {
 	req := AWSRDSBackupPolicyRequest{
 		Name:       d.Get("name").(string),
 		IdentityId: d.Get("identity_id").(string),
 		BackupType: d.Get("backup_type").(string),
 	}
 
-	for _, id := range d.Get("region_ids").([]interface{}) {
+	for _, id := range d.Get("region_ids").(*schema.Set).List() {
 		req.RegionIDs = append(req.RegionIDs, id.(string))
 	}
 
-	if v, ok := d.GetOk("description"); ok {
-		desc := v.(string)
+	if desc := client.AppendDescriptionSuffix(d.Get("description").(string)); desc != "" {
 		req.Description = &desc
 	}
 
@@ -1154,10 +1164,10 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 				TagIDs []string `json:"tagIds,omitempty"`
 				RdsIDs []string `json:"rdsIds,omitempty"`
 			}{}
-			for _, id := range m["tag_ids"].([]interface{}) {
+			for _, id := range m["tag_ids"].(*schema.Set).List() {
 				sel.TagIDs = append(sel.TagIDs, id.(string))
 			}
-			for _, id := range m["rds_ids"].([]interface{}) {
+			for _, id := range m["rds_ids"].(*schema.Set).List() {
 				sel.RdsIDs = append(sel.RdsIDs, id.(string))
 			}
 			req.SelectedItems = sel
@@ -1172,10 +1182,10 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 				TagIDs []string `json:"tagIds,omitempty"`
 				RdsIDs []string `json:"rdsIds,omitempty"`
 			}{}
-			for _, id := range m["tag_ids"].([]interface{}) {
+			for _, id := range m["tag_ids"].(*schema.Set).List() {
 				excl.TagIDs = append(excl.TagIDs, id.(string))
 			}
-			for _, id := range m["rds_ids"].([]interface{}) {
+			for _, id := range m["rds_ids"].(*schema.Set).List() {
 				excl.RdsIDs = append(excl.RdsIDs, id.(string))
 			}
 			req.ExcludeItems = excl
@@ -1440,13 +1450,13 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 					Kind:        dm["kind"].(string),
 					RunsPerHour: dm["runs_per_hour"].(int),
 				}
-				for _, d := range dm["days"].([]interface{}) {
+				for _, d := range dm["days"].(*schema.Set).List() {
 					daily.Days = append(daily.Days, d.(string))
 				}
 				if so := dm["snapshot_options"].([]interface{}); len(so) > 0 && so[0] != nil {
 					som := so[0].(map[string]interface{})
 					hours := []int{}
-					for _, h := range som["schedule_hours"].([]interface{}) {
+					for _, h := range som["schedule_hours"].(*schema.Set).List() {
 						hours = append(hours, h.(int))
 					}
 					daily.SnapshotOptions = &struct {
@@ -1460,7 +1470,7 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 				if ro := dm["replica_options"].([]interface{}); len(ro) > 0 && ro[0] != nil {
 					rom := ro[0].(map[string]interface{})
 					hours := []int{}
-					for _, h := range rom["schedule_hours"].([]interface{}) {
+					for _, h := range rom["schedule_hours"].(*schema.Set).List() {
 						hours = append(hours, h.(int))
 					}
 					daily.ReplicaOptions = &struct {
@@ -1474,7 +1484,7 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 				if bo := dm["backup_options"].([]interface{}); len(bo) > 0 && bo[0] != nil {
 					bom := bo[0].(map[string]interface{})
 					hours := []int{}
-					for _, h := range bom["schedule_hours"].([]interface{}) {
+					for _, h := range bom["schedule_hours"].(*schema.Set).List() {
 						hours = append(hours, h.(int))
 					}
 					daily.BackupOptions = &struct {
@@ -1517,13 +1527,13 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 				}{
 					TimeLocal: wm["time_local"].(string),
 				}
-				for _, d := range wm["days"].([]interface{}) {
+				for _, d := range wm["days"].(*schema.Set).List() {
 					weekly.Days = append(weekly.Days, d.(string))
 				}
 				if so := wm["snapshot_options"].([]interface{}); len(so) > 0 && so[0] != nil {
 					som := so[0].(map[string]interface{})
 					days := []string{}
-					for _, d := range som["schedule_days"].([]interface{}) {
+					for _, d := range som["schedule_days"].(*schema.Set).List() {
 						days = append(days, d.(string))
 					}
 					weekly.SnapshotOptions = &struct {
@@ -1537,7 +1547,7 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 				if ro := wm["replica_options"].([]interface{}); len(ro) > 0 && ro[0] != nil {
 					rom := ro[0].(map[string]interface{})
 					days := []string{}
-					for _, d := range rom["schedule_days"].([]interface{}) {
+					for _, d := range rom["schedule_days"].(*schema.Set).List() {
 						days = append(days, d.(string))
 					}
 					weekly.ReplicaOptions = &struct {
@@ -1551,7 +1561,7 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 				if bo := wm["backup_options"].([]interface{}); len(bo) > 0 && bo[0] != nil {
 					bom := bo[0].(map[string]interface{})
 					days := []string{}
-					for _, d := range bom["schedule_days"].([]interface{}) {
+					for _, d := range bom["schedule_days"].(*schema.Set).List() {
 						days = append(days, d.(string))
 					}
 					weekly.BackupOptions = &struct {
@@ -1604,7 +1614,7 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 				if so := mm["snapshot_options"].([]interface{}); len(so) > 0 && so[0] != nil {
 					som := so[0].(map[string]interface{})
 					months := []string{}
-					for _, mn := range som["schedule_months"].([]interface{}) {
+					for _, mn := range som["schedule_months"].(*schema.Set).List() {
 						months = append(months, mn.(string))
 					}
 					monthly.SnapshotOptions = &struct {
@@ -1618,7 +1628,7 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 				if ro := mm["replica_options"].([]interface{}); len(ro) > 0 && ro[0] != nil {
 					rom := ro[0].(map[string]interface{})
 					months := []string{}
-					for _, mn := range rom["schedule_months"].([]interface{}) {
+					for _, mn := range rom["schedule_months"].(*schema.Set).List() {
 						months = append(months, mn.(string))
 					}
 					monthly.ReplicaOptions = &struct {
@@ -1632,7 +1642,7 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 				if bo := mm["backup_options"].([]interface{}); len(bo) > 0 && bo[0] != nil {
 					bom := bo[0].(map[string]interface{})
 					months := []string{}
-					for _, mn := range bom["schedule_months"].([]interface{}) {
+					for _, mn := range bom["schedule_months"].(*schema.Set).List() {
 						months = append(months, mn.(string))
 					}
 					monthly.BackupOptions = &struct {
@@ -1695,10 +1705,10 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 					}{
 						DayNumberInMonth: hm["day_number_in_month"].(string),
 					}
-					for _, mn := range hm["months"].([]interface{}) {
+					for _, mn := range hm["months"].(*schema.Set).List() {
 						hcs.Months = append(hcs.Months, mn.(string))
 					}
-					if dow := hm["day_of_week"].([]interface{}); len(dow) > 0 {
+					if dow := hm["day_of_week"].(*schema.Set).List(); len(dow) > 0 {
 						days := []string{}
 						for _, d := range dow {
 							days = append(days, d.(string))
@@ -1758,7 +1768,7 @@ func buildRDSBackupPolicyRequest(d *schema.ResourceData) AWSRDSBackupPolicyReque
 			if ls, ok := m["limited_scope_id"].(string); ok && ls != "" {
 				org.LimitedScopeID = &ls
 			}
-			for _, em := range m["exclude_members"].([]interface{}) {
+			for _, em := range m["exclude_members"].(*schema.Set).List() {
 				org.ExcludeMembers = append(org.ExcludeMembers, em.(string))
 			}
 			req.OrganizationSettings = org
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestBuildRDSBackupPolicyRequest checks the request body of the RDS backup policy for each of
+// its settings blocks. The policy is read back without them, so there are no flatten functions to
+// round trip through.
+func TestBuildRDSBackupPolicyRequest(t *testing.T) {
+	base := func(settings map[string]interface{}) map[string]interface{} {
+		raw := map[string]interface{}{
+			"name":        "production-rds",
+			"region_ids":  []interface{}{"eu-west-1"},
+			"identity_id": "identity-1",
+			"backup_type": "SnapshotAndBackup",
+		}
+		for key, value := range settings {
+			raw[key] = value
+		}
+		return raw
+	}
+	const request = `"name": "production-rds", "regionIds": ["eu-west-1"], "identityId": "identity-1", "backupType": "SnapshotAndBackup"`
+
+	for name, tc := range map[string]struct {
+		raw  map[string]interface{}
+		want string
+	}{
+		"items": {
+			raw: base(map[string]interface{}{
+				"description":    "Production databases",
+				"selected_items": []interface{}{map[string]interface{}{"rds_ids": []interface{}{"db-1"}, "tag_ids": []interface{}{"tag-1"}}},
+				"exclude_items":  []interface{}{map[string]interface{}{"rds_ids": []interface{}{"db-2"}}},
+			}),
+			want: `{` + request + `, "description": "Production databases",
+				"selectedItems": {"tagIds": ["tag-1"], "rdsIds": ["db-1"]}, "excludeItems": {"rdsIds": ["db-2"]}}`,
+		},
+		"snapshots and replicas": {
+			raw: base(map[string]interface{}{
+				"snapshot_settings": []interface{}{map[string]interface{}{
+					"additional_tags": []interface{}{map[string]interface{}{"key": "owner", "value": "dba"}},
+				}},
+				"replica_settings": []interface{}{map[string]interface{}{
+					"mapping": []interface{}{map[string]interface{}{
+						"source_region_id":               "eu-west-1",
+						"target_region_id":               "eu-central-1",
+						"target_iam_role_id":             "role-1",
+						"encryption_key":                 "key-1",
+						"encrypt_only_encrypted_volumes": true,
+					}},
+					"copy_tags_from_volume_enabled": true,
+				}},
+			}),
+			want: `{` + request + `, "snapshotSettings": {"additionalTags": [{"key": "owner", "value": "dba"}]},
+				"replicaSettings": {"mapping": [{"sourceRegionId": "eu-west-1", "targetRegionId": "eu-central-1", "targetIamRoleId": "role-1",
+					"encryptionKey": "key-1", "encryptOnlyEncryptedVolumes": true}], "copyTagsFromVolumeEnabled": true}}`,
+		},
+		"backups and archive": {
+			raw: base(map[string]interface{}{
+				"rds_backup_settings": []interface{}{map[string]interface{}{
+					"target_repository_id": "repository-1",
+					"worker_role_id":       "role-2",
+					"default_credentials": []interface{}{map[string]interface{}{
+						"database_credentials_id": "credentials-1",
+						"username":                "backup",
+						"password":                "secret",
+					}},
+					"credentials": []interface{}{map[string]interface{}{
+						"database_credentials_id":       "credentials-2",
+						"rds_id":                        "db-1",
+						"database_credentials_username": "admin",
+					}},
+				}},
+				"rds_archive_settings": []interface{}{map[string]interface{}{"target_repository_id": "repository-2"}},
+			}),
+			want: `{` + request + `, "rdsBackupSettings": {"targetRepositoryId": "repository-1", "workerRoleId": "role-2",
+				"defaultCredentials": {"databaseCredentialsId": "credentials-1", "username": "backup", "password": "secret"},
+				"credentials": {"databaseCredentialsId": "credentials-2", "rdsId": "db-1", "databaseCredentialsUserName": "admin"}},
+				"rdsArchiveSettings": {"targetRepositoryId": "repository-2"}}`,
+		},
+		"daily and weekly schedules": {
+			raw: base(map[string]interface{}{
+				"schedule_settings": []interface{}{map[string]interface{}{
+					"daily_schedule_enabled":   true,
+					"weekly_schedule_enabled":  true,
+					"monthly_schedule_enabled": false,
+					"yearly_schedule_enabled":  false,
+					"daily_schedule": []interface{}{map[string]interface{}{
+						"kind":             "SelectedDays",
+						"runs_per_hour":    1,
+						"days":             []interface{}{"Monday"},
+						"snapshot_options": []interface{}{map[string]interface{}{"retention_count": 7, "schedule_hours": []interface{}{2}}},
+						"backup_options":   []interface{}{map[string]interface{}{"retention_type": "Days", "retention_count": 30, "schedule_hours": []interface{}{3}}},
+					}},
+					"weekly_schedule": []interface{}{map[string]interface{}{
+						"time_local":      "04:00",
+						"replica_options": []interface{}{map[string]interface{}{"retention_count": 4, "schedule_days": []interface{}{"Sunday"}}},
+					}},
+				}},
+			}),
+			want: `{` + request + `, "scheduleSettings": {"dailyScheduleEnabled": true, "weeklyScheduleEnabled": true,
+				"monthlyScheduleEnabled": false, "yearlyScheduleEnabled": false,
+				"dailySchedule": {"kind": "SelectedDays", "runsPerHour": 1, "days": ["Monday"],
+					"snapshotOptions": {"retention": {"count": 7}, "schedule": {"hours": [2]}},
+					"backupOptions": {"retention": {"type": "Days", "count": 30}, "schedule": {"hours": [3]}}},
+				"weeklySchedule": {"timeLocal": "04:00", "replicaOptions": {"retention": {"count": 4}, "schedule": {"days": ["Sunday"]}}}}}`,
+		},
+		"monthly and yearly schedules": {
+			raw: base(map[string]interface{}{
+				"schedule_settings": []interface{}{map[string]interface{}{
+					"daily_schedule_enabled":   false,
+					"weekly_schedule_enabled":  false,
+					"monthly_schedule_enabled": true,
+					"yearly_schedule_enabled":  true,
+					"monthly_schedule": []interface{}{map[string]interface{}{
+						"time_local":              "05:00",
+						"day_of_week":             "Saturday",
+						"send_backups_to_archive": true,
+						"backup_options":          []interface{}{map[string]interface{}{"retention_type": "Months", "retention_count": 12, "schedule_months": []interface{}{"January"}}},
+					}},
+					"yearly_schedule": []interface{}{map[string]interface{}{
+						"time_local":                    "06:00",
+						"day_number_in_month":           "Last",
+						"month":                         "December",
+						"day_of_week":                   "Friday",
+						"retention_type":                "Years",
+						"retention_count":               7,
+						"health_check_schedule_enabled": true,
+						"health_check_schedule": []interface{}{map[string]interface{}{
+							"months":              []interface{}{"June"},
+							"day_number_in_month": "First",
+							"day_of_week":         []interface{}{"Sunday"},
+						}},
+					}},
+				}},
+			}),
+			want: `{` + request + `, "scheduleSettings": {"dailyScheduleEnabled": false, "weeklyScheduleEnabled": false,
+				"monthlyScheduleEnabled": true, "yearlyScheduleEnabled": true,
+				"monthlySchedule": {"timeLocal": "05:00", "dayNumberInMonth": "", "dayOfWeek": "Saturday", "dayOfMonth": 0, "sendBackupsToArchive": true,
+					"backupOptions": {"retention": {"type": "Months", "count": 12}, "schedule": {"months": ["January"]}}},
+				"yearlySchedule": {"timeLocal": "06:00", "dayNumberInMonth": "Last", "month": "December", "dayOfWeek": "Friday", "dayOfMonth": 0,
+					"sendBackupsToArchive": false, "healthCheckScheduleEnabled": true, "retention": {"type": "Years", "count": 7},
+					"healthCheckSchedule": {"months": ["June"], "dayNumberInMonth": "First", "dayOfWeek": ["Sunday"]}}}}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceAwsRDSBackupPolicy().Schema, tc.raw)
+			acctest.CheckJSON(t, buildRDSBackupPolicyRequest(d, &vc.AWSBackupClient{}), tc.want)
+		})
+	}
+}
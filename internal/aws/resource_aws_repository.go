@@ -0,0 +1,307 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type AWSRepositoryRequest struct {
+	Name                string  `json:"name"`
+	Description         *string `json:"description,omitempty"`
+	AmazonAccountID     string  `json:"amazonAccountId"`
+	RegionID            string  `json:"regionId"`
+	AmazonBucketID      string  `json:"amazonBucketId"`
+	AmazonStorageFolder string  `json:"amazonStorageFolder"`
+	EnableEncryption    bool    `json:"enableEncryption"`
+	Password            *string `json:"password,omitempty"`
+	Hint                *string `json:"hint,omitempty"`
+}
+
+// AWSRepositoryResponse is a repository as returned by GET /repositories/{id}; it extends the
+// list item with the region the bucket resides in
+type AWSRepositoryResponse struct {
+	AWSrepositoriesDataSourceResponseResults
+	RegionID string `json:"regionId"`
+}
+
+func ResourceAwsRepository() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsRepositoryCreate,
+		ReadContext:   resourceAwsRepositoryRead,
+		UpdateContext: resourceAwsRepositoryUpdate,
+		DeleteContext: resourceAwsRepositoryDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the repository in Veeam Backup for AWS.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the repository.",
+			},
+			"amazon_account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "System ID of the IAM role used to access the bucket, e.g. the ID of a veeambackup_aws_iam_role resource.",
+			},
+			"region_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "System ID of the AWS region where the bucket resides.",
+			},
+			"amazon_bucket_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "System ID of the Amazon S3 bucket that stores the backups.",
+			},
+			"amazon_storage_folder": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the folder in the bucket that stores the backups.",
+			},
+			"enable_encryption": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Whether backups stored in the repository are encrypted with a password.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password used to encrypt the backups; required when enable_encryption is true. The API never returns it, so changes made outside of Terraform are not detected.",
+			},
+			"hint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Hint for the encryption password.",
+			},
+			// Computed
+			"amazon_account": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the IAM role used to access the bucket.",
+			},
+			"bucket": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the Amazon S3 bucket.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the AWS region where the bucket resides.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func buildRepositoryRequest(d *schema.ResourceData) AWSRepositoryRequest {
+	req := AWSRepositoryRequest{
+		Name:                d.Get("name").(string),
+		AmazonAccountID:     d.Get("amazon_account_id").(string),
+		RegionID:            d.Get("region_id").(string),
+		AmazonBucketID:      d.Get("amazon_bucket_id").(string),
+		AmazonStorageFolder: d.Get("amazon_storage_folder").(string),
+		EnableEncryption:    d.Get("enable_encryption").(bool),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		desc := v.(string)
+		req.Description = &desc
+	}
+	if v, ok := d.GetOk("password"); ok {
+		password := v.(string)
+		req.Password = &password
+	}
+	if v, ok := d.GetOk("hint"); ok {
+		hint := v.(string)
+		req.Hint = &hint
+	}
+
+	return req
+}
+
+func resourceAwsRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAWSClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := buildRepositoryRequest(d)
+	if req.EnableEncryption && req.Password == nil {
+		return diag.Errorf("password is required when enable_encryption is true")
+	}
+
+	bodyBytes, err := json.Marshal(req)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal repository request: %w", err))
+	}
+
+	apiURL := client.BuildAPIURL("/repositories")
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "POST", apiURL, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create repository: %w", err))
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
+	}
+
+	if resp.StatusCode != 200 && resp.StatusCode != 201 && resp.StatusCode != 202 {
+		return diag.FromErr(vc.NewVeeamAPIError(resp.StatusCode, respBody))
+	}
+
+	var repositoryResp AWSRepositoryResponse
+	if err := json.Unmarshal(respBody, &repositoryResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse repository response: %w", err))
+	}
+
+	d.SetId(repositoryResp.ID)
+	return resourceAwsRepositoryRead(ctx, d, meta)
+}
+
+func resourceAwsRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAWSClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	apiURL := client.BuildAPIURL(vc.Endpoint("/repositories/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read repository: %w", err))
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
+	}
+
+	if resp.StatusCode != 200 {
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, respBody)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(apiErr)
+	}
+
+	var repositoryResp AWSRepositoryResponse
+	if err := json.Unmarshal(respBody, &repositoryResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse repository response: %w", err))
+	}
+
+	if err := d.Set("name", repositoryResp.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set name: %w", err))
+	}
+	if err := d.Set("description", repositoryResp.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set description: %w", err))
+	}
+	if err := d.Set("amazon_account_id", repositoryResp.Identity.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set amazon_account_id: %w", err))
+	}
+	if err := d.Set("region_id", repositoryResp.RegionID); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set region_id: %w", err))
+	}
+	if err := d.Set("amazon_bucket_id", repositoryResp.AmazonBucketID); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set amazon_bucket_id: %w", err))
+	}
+	if err := d.Set("amazon_storage_folder", repositoryResp.AmazonStorageFolder); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set amazon_storage_folder: %w", err))
+	}
+	if err := d.Set("enable_encryption", repositoryResp.EnableEncryption); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set enable_encryption: %w", err))
+	}
+	if err := d.Set("hint", repositoryResp.Hint); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set hint: %w", err))
+	}
+	if err := d.Set("amazon_account", repositoryResp.Embedded.AmazonAccount); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set amazon_account: %w", err))
+	}
+	if err := d.Set("bucket", repositoryResp.Embedded.Bucket); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set bucket: %w", err))
+	}
+	if err := d.Set("region", repositoryResp.Embedded.Region); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set region: %w", err))
+	}
+
+	return nil
+}
+
+func resourceAwsRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAWSClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(buildRepositoryRequest(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal repository update request: %w", err))
+	}
+
+	apiURL := client.BuildAPIURL(vc.Endpoint("/repositories/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "PUT", apiURL, bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update repository: %w", err))
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
+	}
+
+	if resp.StatusCode != 200 && resp.StatusCode != 202 {
+		return diag.FromErr(vc.NewVeeamAPIError(resp.StatusCode, respBody))
+	}
+
+	return resourceAwsRepositoryRead(ctx, d, meta)
+}
+
+func resourceAwsRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAWSClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	apiURL := client.BuildAPIURL(vc.Endpoint("/repositories/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestAWSWithContext(ctx, "DELETE", apiURL, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete repository: %w", err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 && resp.StatusCode != 202 && resp.StatusCode != 204 {
+		body, _ := io.ReadAll(resp.Body)
+		if apiErr := vc.NewVeeamAPIError(resp.StatusCode, body); !vc.IsGone(apiErr) {
+			return diag.FromErr(apiErr)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
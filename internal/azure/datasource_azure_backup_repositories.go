@@ -392,14 +392,6 @@ func DataSourceAzureBackupRepositoriesRead(ctx context.Context, d *schema.Resour
 		params.Set("IsEncrypted", strconv.FormatBool(v.(bool)))
 	}
 
-	if v, ok := d.GetOk("offset"); ok {
-		params.Set("Offset", strconv.Itoa(v.(int)))
-	}
-
-	if v, ok := d.GetOk("limit"); ok {
-		params.Set("Limit", strconv.Itoa(v.(int)))
-	}
-
 	if v, ok := d.GetOk("tenant_id"); ok {
 		params.Set("TenantId", v.(string))
 	}
@@ -412,39 +404,46 @@ func DataSourceAzureBackupRepositoriesRead(ctx context.Context, d *schema.Resour
 		params.Set("ImmutabilityEnabled", strconv.FormatBool(v.(bool)))
 	}
 
-	// Construct the API URL
-	apiURL := client.BuildAPIURL("/repositories")
-	if len(params) > 0 {
-		apiURL += "?" + params.Encode()
-	}
+	// Make the API requests, following pagination unless a limit is set
+	repositoriesPage, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[BackupRepositoryDetail], error) {
+		params.Set("Offset", strconv.Itoa(offset))
+		params.Set("Limit", strconv.Itoa(limit))
 
-	// Make the API request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiURL, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to retrieve backup repositories: %w", err))
-	}
-	defer resp.Body.Close()
+		// Construct the API URL
+		apiURL := client.BuildAPIURL(vc.WithQuery("/repositories", params))
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
-	}
+		// Make the API request
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
+		if err != nil {
+			return vc.Page[BackupRepositoryDetail]{}, fmt.Errorf("failed to retrieve backup repositories: %w", err)
+		}
+		defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[BackupRepositoryDetail]{}, fmt.Errorf("failed to read response body: %w", err)
+		}
 
-	// Parse the response
-	var repositoriesResp BackupRepositoriesResponse
-	if err := json.Unmarshal(body, &repositoriesResp); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse response: %w", err))
+		if resp.StatusCode != 200 {
+			return vc.Page[BackupRepositoryDetail]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
+
+		// Parse the response
+		var repositoriesResp BackupRepositoriesResponse
+		if err := json.Unmarshal(body, &repositoriesResp); err != nil {
+			return vc.Page[BackupRepositoryDetail]{}, fmt.Errorf("failed to parse response: %w", err)
+		}
+		return vc.Page[BackupRepositoryDetail]{Items: repositoriesResp.Results, Total: repositoriesResp.TotalCount}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Convert repositories to Terraform format
 	repositories := make(map[string]string)
-	repositoryDetails := make([]interface{}, len(repositoriesResp.Results))
+	repositoryDetails := make([]interface{}, len(repositoriesPage.Items))
 
-	for i, repo := range repositoriesResp.Results {
+	for i, repo := range repositoriesPage.Items {
 		repositoryDetails[i] = map[string]interface{}{
 			"veeam_id":                 repo.VeeamID,
 			"name":                     repo.Name,
@@ -504,7 +503,7 @@ func DataSourceAzureBackupRepositoriesRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("failed to set repository_details: %w", err))
 	}
 
-	if err := d.Set("total_count", repositoriesResp.TotalCount); err != nil {
+	if err := d.Set("total_count", repositoriesPage.Total); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set total_count: %w", err))
 	}
 
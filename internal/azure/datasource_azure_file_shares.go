@@ -218,12 +218,6 @@ func DataSourceAzureFileSharesRead(ctx context.Context, d *schema.ResourceData,
 	apiUrl := client.BuildAPIURL("/fileShares")
 
 	// Add query parameter building
-	if request.Offset != 0 {
-		params.Set("offset", strconv.Itoa(request.Offset))
-	}
-	if request.Limit != -1 {
-		params.Set("limit", strconv.Itoa(request.Limit))
-	}
 	if request.SearchPattern != "" {
 		params.Set("searchPattern", request.SearchPattern)
 	}
@@ -246,39 +240,44 @@ func DataSourceAzureFileSharesRead(ctx context.Context, d *schema.ResourceData,
 		params.Add("backupDestination", dest)
 	}
 
-	// Add parameters to URL if any exist
-	if len(params) > 0 {
-		apiUrl += "?" + params.Encode()
-	}
+	// Make API requests, following pagination unless a limit is set
+	fileShares, err := vc.FetchPages(ctx, request.Offset, request.Limit, func(ctx context.Context, offset, limit int) (vc.Page[AzureFileSharesDetail], error) {
+		params.Set("offset", strconv.Itoa(offset))
+		params.Set("limit", strconv.Itoa(limit))
 
-	// Make API request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiUrl, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to fetch Azure file shares: %w", err))
-	}
-	defer resp.Body.Close()
+		// Make API request
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", vc.WithQuery(apiUrl, params), nil)
+		if err != nil {
+			return vc.Page[AzureFileSharesDetail]{}, fmt.Errorf("failed to fetch Azure file shares: %w", err)
+		}
+		defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body)))
-	}
+		if resp.StatusCode != 200 {
+			body, _ := io.ReadAll(resp.Body)
+			return vc.Page[AzureFileSharesDetail]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
 
-	// Read and parse response
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
-	}
+		// Read and parse response
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AzureFileSharesDetail]{}, fmt.Errorf("failed to read response body: %w", err)
+		}
 
-	var fileSharesResp AzureFileSharesResponse
-	if err := json.Unmarshal(body, &fileSharesResp); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse response: %w", err))
+		var fileSharesResp AzureFileSharesResponse
+		if err := json.Unmarshal(body, &fileSharesResp); err != nil {
+			return vc.Page[AzureFileSharesDetail]{}, fmt.Errorf("failed to parse response: %w", err)
+		}
+		return vc.Page[AzureFileSharesDetail]{Items: fileSharesResp.Results, Total: fileSharesResp.TotalCount}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Create maps for file shares
 	fileSharesMap := make(map[string]string)
 	var fileShareDetails []interface{}
 
-	for _, share := range fileSharesResp.Results {
+	for _, share := range fileShares.Items {
 		// Marshal share details to JSON string for map
 		shareJSON, err := json.Marshal(share)
 		if err != nil {
@@ -312,7 +311,7 @@ func DataSourceAzureFileSharesRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("failed to set file_share_details: %w", err))
 	}
 
-	if err := d.Set("total_count", fileSharesResp.TotalCount); err != nil {
+	if err := d.Set("total_count", fileShares.Total); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set total_count: %w", err))
 	}
 
@@ -0,0 +1,140 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// AzurePolicyCostEstimation is the estimated monthly cost of the Azure resources a backup policy
+// consumes, in Currency
+type AzurePolicyCostEstimation struct {
+	Currency        string  `json:"currency"`
+	TotalCost       float64 `json:"totalCost"`
+	SnapshotCost    float64 `json:"snapshotCost"`
+	BackupCost      float64 `json:"backupCost"`
+	ArchiveCost     float64 `json:"archiveCost"`
+	TransactionCost float64 `json:"transactionCost"`
+	TrafficCost     float64 `json:"trafficCost"`
+}
+
+func DataSourceAzurePolicyCostEstimation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the monthly cost that Veeam Backup for Microsoft Azure estimates for the Azure resources a backup policy consumes. " +
+			"Set monthly_budget and check within_budget in a check block or postcondition to gate applies on the budget.",
+		ReadContext: DataSourceAzurePolicyCostEstimationRead,
+		Schema: map[string]*schema.Schema{
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(azureItemTypes(policyPaths), false),
+				Description:  "Type of items the policy protects: VirtualMachine, SqlDatabase, FileShare or CosmosDbAccount.",
+			},
+			"policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "System ID of the backup policy, e.g. the id of a veeambackup_azure_vm_backup_policy resource.",
+			},
+			"monthly_budget": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Maximum monthly cost of the policy, in the currency of the estimation.",
+			},
+			"currency": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Currency of the estimated costs, e.g. USD.",
+			},
+			"total_monthly_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Estimated total monthly cost of the policy.",
+			},
+			"snapshot_monthly_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Estimated monthly cost of the snapshots created by the policy.",
+			},
+			"backup_monthly_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Estimated monthly cost of storing the backups created by the policy in repositories.",
+			},
+			"archive_monthly_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Estimated monthly cost of storing the archived backups created by the policy.",
+			},
+			"transaction_monthly_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Estimated monthly cost of the storage transactions of the policy.",
+			},
+			"traffic_monthly_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Estimated monthly cost of the network traffic of the policy.",
+			},
+			"within_budget": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether total_monthly_cost does not exceed monthly_budget. Always true when monthly_budget is not set or 0.",
+			},
+		},
+	}
+}
+
+func DataSourceAzurePolicyCostEstimationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyType := d.Get("policy_type").(string)
+	policyID := d.Get("policy_id").(string)
+
+	apiURL := client.BuildAPIURL(policyItemPath(policyType, policyID) + "/costEstimation")
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to retrieve Azure policy cost estimation: %w", err))
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
+	}
+	if resp.StatusCode != 200 {
+		return diag.FromErr(vc.NewVeeamAPIError(resp.StatusCode, body))
+	}
+
+	var estimation AzurePolicyCostEstimation
+	if err := json.Unmarshal(body, &estimation); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse response: %w", err))
+	}
+
+	d.Set("currency", estimation.Currency)
+	d.Set("total_monthly_cost", estimation.TotalCost)
+	d.Set("snapshot_monthly_cost", estimation.SnapshotCost)
+	d.Set("backup_monthly_cost", estimation.BackupCost)
+	d.Set("archive_monthly_cost", estimation.ArchiveCost)
+	d.Set("transaction_monthly_cost", estimation.TransactionCost)
+	d.Set("traffic_monthly_cost", estimation.TrafficCost)
+
+	withinBudget := true
+	if v, ok := d.GetOk("monthly_budget"); ok {
+		withinBudget = estimation.TotalCost <= v.(float64)
+	}
+	d.Set("within_budget", withinBudget)
+
+	d.SetId(fmt.Sprintf("%s/%s", policyType, policyID))
+	return nil
+}
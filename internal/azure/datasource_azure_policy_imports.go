@@ -0,0 +1,83 @@
+package azure
+
+import (
+	"context"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// policyResource is the resource that manages the backup policies of one type
+type policyResource struct {
+	resourceType string
+	importable   bool
+}
+
+// policyResources are the resources that manage backup policies, by the type of item the policies
+// protect
+var policyResources = map[string]policyResource{
+	policyTypeVirtualMachine:  {resourceType: "veeambackup_azure_vm_backup_policy", importable: true},
+	policyTypeSQLDatabase:     {resourceType: "veeambackup_azure_sql_backup_policy"},
+	policyTypeFileShare:       {resourceType: "veeambackup_azure_file_shares_backup_policy", importable: true},
+	policyTypeCosmosDBAccount: {resourceType: "veeambackup_azure_cosmos_backup_policy"},
+}
+
+func DataSourceAzurePolicyImports() *schema.Resource {
+	s := vc.ImportCandidatesSchema("policy")
+	s["policy_types"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(azureItemTypes(policyPaths), false),
+		},
+		Description: "Types of items whose policies are listed: VirtualMachine, SqlDatabase, FileShare or CosmosDbAccount. Defaults to all.",
+	}
+
+	return &schema.Resource{
+		Description: "Lists the backup policies of Veeam Backup for Microsoft Azure with the resource addresses to import them to, and renders Terraform import blocks for the policies whose resource supports import, for mass migrations of existing policies.",
+		ReadContext: DataSourceAzurePolicyImportsRead,
+		Schema:      s,
+	}
+}
+
+func DataSourceAzurePolicyImportsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyTypes := azureItemTypes(policyPaths)
+	if v, ok := d.GetOk("policy_types"); ok {
+		policyTypes = nil
+		for _, policyType := range v.([]interface{}) {
+			policyTypes = append(policyTypes, policyType.(string))
+		}
+	}
+
+	var candidates []vc.ImportCandidate
+	for _, policyType := range policyTypes {
+		policies, err := listPolicies(ctx, client, policyType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resource := policyResources[policyType]
+		for _, policy := range policies {
+			candidates = append(candidates, vc.ImportCandidate{
+				ID:           policy.ID,
+				Name:         policy.Name,
+				Type:         policyType,
+				ResourceType: resource.resourceType,
+				Importable:   resource.importable,
+			})
+		}
+	}
+	if err := vc.SetImportCandidates(d, candidates); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("azure_policy_imports")
+	return nil
+}
@@ -0,0 +1,300 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Protection statuses of protected items
+const (
+	ProtectedItemStatusProtected       = "Protected"
+	ProtectedItemStatusOutdated        = "Outdated"
+	ProtectedItemStatusNoRestorePoints = "NoRestorePoints"
+)
+
+// protectedItemPaths are the protected item collections of the API by item type
+var protectedItemPaths = map[string]string{
+	"VirtualMachine":  "/protectedItem/virtualMachines",
+	"SqlDatabase":     "/protectedItem/sql",
+	"FileShare":       "/protectedItem/fileShares",
+	"CosmosDbAccount": "/protectedItem/cosmosDb",
+}
+
+type AzureProtectedItemsResponse struct {
+	Results    []AzureProtectedItem `json:"results"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+type AzureProtectedItem struct {
+	ID                     string `json:"id"`
+	AzureID                string `json:"azureId"`
+	Name                   string `json:"name"`
+	SubscriptionID         string `json:"subscriptionId"`
+	ResourceGroupName      string `json:"resourceGroupName"`
+	RegionName             string `json:"regionName"`
+	PolicyName             string `json:"policyName"`
+	RestorePointsCount     int    `json:"restorePointsCount"`
+	LatestRestorePointDate string `json:"latestRestorePointDate"`
+}
+
+// azureItemTypes returns the item types of the API collections in paths, sorted
+func azureItemTypes(paths map[string]string) []string {
+	itemTypes := make([]string, 0, len(paths))
+	for itemType := range paths {
+		itemTypes = append(itemTypes, itemType)
+	}
+	sort.Strings(itemTypes)
+	return itemTypes
+}
+
+func DataSourceAzureProtectedItems() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the Azure resources protected by Veeam Backup for Microsoft Azure, with their latest restore point. " +
+			"Use required_azure_ids to check that every resource that should be backed up, e.g. every VM tagged backup=true, is protected.",
+		ReadContext: DataSourceAzureProtectedItemsRead,
+		Schema: map[string]*schema.Schema{
+			"item_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Types of protected items to return: VirtualMachine, SqlDatabase, FileShare or CosmosDbAccount. All types are returned when not set.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(azureItemTypes(protectedItemPaths), false),
+				},
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only protected items that belong to the Azure subscription with the specified ID.",
+			},
+			"search_pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only protected items with a name matching the pattern.",
+			},
+			"max_restore_point_age": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: vc.ValidateDuration,
+				Description:  "Maximum age of the latest restore point of a protected item, as a duration such as 24h. Items with an older restore point have the Outdated status.",
+			},
+			"required_azure_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Azure resource IDs that must be protected, e.g. the IDs of the VMs tagged backup=true. IDs are compared case-insensitively, as Azure resource IDs are.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Protected items.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "System ID assigned to the protected item in Veeam Backup for Microsoft Azure.",
+						},
+						"azure_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Azure resource ID of the protected item.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the protected item.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the protected item.",
+						},
+						"subscription_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Azure subscription of the protected item.",
+						},
+						"resource_group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the resource group of the protected item.",
+						},
+						"region_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Azure region of the protected item.",
+						},
+						"policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the backup policy that last protected the item.",
+						},
+						"restore_points_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of restore points of the protected item.",
+						},
+						"latest_restore_point_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time the latest restore point was created.",
+						},
+						"protection_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Protected, Outdated when the latest restore point is older than max_restore_point_age, or NoRestorePoints.",
+						},
+					},
+				},
+			},
+			"unprotected_azure_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Required Azure resource IDs that are not protected, or whose protected item does not have the Protected status.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"all_protected": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every required Azure resource ID is protected, or without required_azure_ids, whether every returned item has the Protected status. false when no item is returned.",
+			},
+		},
+	}
+}
+
+func DataSourceAzureProtectedItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	itemTypes := azureItemTypes(protectedItemPaths)
+	if v, ok := d.GetOk("item_types"); ok {
+		itemTypes = convertSetToStringSlice(v.(*schema.Set))
+		sort.Strings(itemTypes)
+	}
+
+	var maxAge time.Duration
+	if v, ok := d.GetOk("max_restore_point_age"); ok {
+		maxAge, _ = time.ParseDuration(v.(string))
+	}
+
+	params := url.Values{}
+	if v, ok := d.GetOk("subscription_id"); ok {
+		params.Set("SubscriptionId", v.(string))
+	}
+	if v, ok := d.GetOk("search_pattern"); ok {
+		params.Set("SearchPattern", v.(string))
+	}
+
+	now := time.Now()
+	items := []interface{}{}
+	statuses := map[string]string{}
+	for _, itemType := range itemTypes {
+		protected, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, offset, limit int) (vc.Page[AzureProtectedItem], error) {
+			params.Set("Offset", strconv.Itoa(offset))
+			params.Set("Limit", strconv.Itoa(limit))
+			apiURL := client.BuildAPIURL(vc.WithQuery(protectedItemPaths[itemType], params))
+			resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
+			if err != nil {
+				return vc.Page[AzureProtectedItem]{}, fmt.Errorf("failed to retrieve Azure protected items: %w", err)
+			}
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return vc.Page[AzureProtectedItem]{}, fmt.Errorf("failed to read response body: %w", err)
+			}
+			if resp.StatusCode != 200 {
+				return vc.Page[AzureProtectedItem]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+			}
+
+			var itemsResponse AzureProtectedItemsResponse
+			if err := json.Unmarshal(body, &itemsResponse); err != nil {
+				return vc.Page[AzureProtectedItem]{}, fmt.Errorf("failed to parse response: %w", err)
+			}
+			return vc.Page[AzureProtectedItem]{Items: itemsResponse.Results, Total: itemsResponse.TotalCount}, nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, item := range protected.Items {
+			status := protectedItemStatus(item, maxAge, now)
+			statuses[strings.ToLower(item.AzureID)] = status
+			items = append(items, map[string]interface{}{
+				"id":                        item.ID,
+				"azure_id":                  item.AzureID,
+				"type":                      itemType,
+				"name":                      item.Name,
+				"subscription_id":           item.SubscriptionID,
+				"resource_group_name":       item.ResourceGroupName,
+				"region_name":               item.RegionName,
+				"policy_name":               item.PolicyName,
+				"restore_points_count":      item.RestorePointsCount,
+				"latest_restore_point_time": item.LatestRestorePointDate,
+				"protection_status":         status,
+			})
+		}
+	}
+
+	if err := d.Set("items", items); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set items: %w", err))
+	}
+
+	unprotected := []string{}
+	allProtected := true
+	if v, ok := d.GetOk("required_azure_ids"); ok {
+		required := convertSetToStringSlice(v.(*schema.Set))
+		sort.Strings(required)
+		for _, azureID := range required {
+			if statuses[strings.ToLower(azureID)] != ProtectedItemStatusProtected {
+				unprotected = append(unprotected, azureID)
+			}
+		}
+		allProtected = len(unprotected) == 0
+	} else {
+		allProtected = len(statuses) > 0
+		for _, status := range statuses {
+			if status != ProtectedItemStatusProtected {
+				allProtected = false
+			}
+		}
+	}
+	if err := d.Set("unprotected_azure_ids", unprotected); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set unprotected_azure_ids: %w", err))
+	}
+	d.Set("all_protected", allProtected)
+
+	d.SetId("azure_protected_items")
+	return nil
+}
+
+// protectedItemStatus returns whether item has a restore point, created within maxAge of now
+// when maxAge is set
+func protectedItemStatus(item AzureProtectedItem, maxAge time.Duration, now time.Time) string {
+	if item.RestorePointsCount == 0 || item.LatestRestorePointDate == "" {
+		return ProtectedItemStatusNoRestorePoints
+	}
+	if maxAge <= 0 {
+		return ProtectedItemStatusProtected
+	}
+	created, err := time.Parse(time.RFC3339, item.LatestRestorePointDate)
+	if err != nil || now.Sub(created) > maxAge {
+		return ProtectedItemStatusOutdated
+	}
+	return ProtectedItemStatusProtected
+}
@@ -0,0 +1,106 @@
+package azure
+
+import (
+	"context"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceAzureResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Looks up an Azure resource group by name within a subscription, returning the system ID that the selected_items blocks of backup policies " +
+			"and the restore resources take, and its Azure resource ID.",
+		ReadContext: DataSourceAzureResourceGroupRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The name of the resource group. Names are compared case-insensitively, as Azure compares them.",
+			},
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The ID of the Azure subscription the resource group belongs to.",
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The system ID of the service account to look the resource group up with.",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Azure resource ID of the resource group.",
+			},
+			"azure_environment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Azure environment of the resource group.",
+			},
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the Azure tenant the resource group belongs to.",
+			},
+			"region_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the resource group.",
+			},
+		},
+	}
+}
+
+func DataSourceAzureResourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	subscriptionID := d.Get("subscription_id").(string)
+	request := AzureResourceGroupsDataModel{SubscriptionID: &subscriptionID, SearchPattern: &name}
+	if v, ok := d.GetOk("service_account_id"); ok {
+		serviceAccountID := v.(string)
+		request.ServiceAccountID = &serviceAccountID
+	}
+
+	resourceGroups, err := fetchAzureResourceGroups(ctx, client, request, 0, 0)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The search pattern matches substrings, so resource groups with longer names are skipped
+	var matches []AzureResourceGroupsResults
+	for _, resourceGroup := range resourceGroups {
+		if resourceGroup.Name != nil && strings.EqualFold(*resourceGroup.Name, name) &&
+			(resourceGroup.SubscriptionID == nil || strings.EqualFold(*resourceGroup.SubscriptionID, subscriptionID)) {
+			matches = append(matches, resourceGroup)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return diag.Errorf("no resource group is named %q in Azure subscription %s", name, subscriptionID)
+	case 1:
+	default:
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = match.ID
+		}
+		return diag.Errorf("%d resource groups are named %q in Azure subscription %s (IDs %s)", len(matches), name, subscriptionID, strings.Join(ids, ", "))
+	}
+
+	resourceGroup := matches[0]
+	d.SetId(resourceGroup.ID)
+	d.Set("resource_id", resourceGroup.ResourceID)
+	d.Set("azure_environment", resourceGroup.AzureEnvironment)
+	d.Set("tenant_id", resourceGroup.TenantID)
+	d.Set("region_id", resourceGroup.RegionID)
+	return nil
+}
@@ -80,7 +80,7 @@ func DataSourceAzureResourceGroups() *schema.Resource {
 			"limit": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "The maximum number of resource groups to return.",
+				Description: "The maximum number of resource groups to return. When not set, all resource groups are returned.",
 			}, // compute only fields
 			"id": {
 				Type:     schema.TypeString,
@@ -150,14 +150,6 @@ func DataSourceAzureResourceGroupsRead(ctx context.Context, d *schema.ResourceDa
 		searchPattern := v.(string)
 		request.SearchPattern = &searchPattern
 	}
-	if v, ok := d.GetOk("offset"); ok {
-		offset := v.(int)
-		request.Offset = &offset
-	}
-	if v, ok := d.GetOk("limit"); ok {
-		limit := v.(int)
-		request.Limit = &limit
-	}
 	if v, ok := d.GetOk("region_ids"); ok {
 		regionIDsInterface := v.([]interface{})
 		regionIDs := make([]string, len(regionIDsInterface))
@@ -167,42 +159,25 @@ func DataSourceAzureResourceGroupsRead(ctx context.Context, d *schema.ResourceDa
 		request.RegionIDs = &regionIDs
 	}
 
-	// Build query parameters
-	params := buildAzureResourceGroupsQueryParams(request)
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/cloudInfrastructure/resourceGroups?%s", params))
-	// Make API request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiUrl, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error retrieving Azure Resource Groups: %s", err))
-	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
+	// Make the API requests, following pagination unless a limit is set
+	resourceGroups, err := fetchAzureResourceGroups(ctx, client, request, d.Get("offset").(int), d.Get("limit").(int))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error reading response body: %s", err))
-	}
-
-	// Parse response
-	var responseModel AzureResourceGroupsResponseModel
-	if err := json.Unmarshal(body, &responseModel); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing response JSON: %s", err))
+		return diag.FromErr(err)
 	}
 
 	// Set results in schema
-	results := make([]map[string]interface{}, 0)
-	if responseModel.Results != nil {
-		results = make([]map[string]interface{}, len(*responseModel.Results))
-		for i, result := range *responseModel.Results {
-			resultMap := map[string]interface{}{
-				"id":                result.ID,
-				"resource_id":       result.ResourceID,
-				"name":              result.Name,
-				"azure_environment": result.AzureEnvironment,
-				"subscription_id":   result.SubscriptionID,
-				"tenant_id":         result.TenantID,
-				"region_id":         result.RegionID,
-			}
-			results[i] = resultMap
+	results := make([]map[string]interface{}, len(resourceGroups))
+	for i, result := range resourceGroups {
+		resultMap := map[string]interface{}{
+			"id":                result.ID,
+			"resource_id":       result.ResourceID,
+			"name":              result.Name,
+			"azure_environment": result.AzureEnvironment,
+			"subscription_id":   result.SubscriptionID,
+			"tenant_id":         result.TenantID,
+			"region_id":         result.RegionID,
 		}
+		results[i] = resultMap
 	}
 	if err := d.Set("results", results); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting results: %s", err))
@@ -214,7 +189,48 @@ func DataSourceAzureResourceGroupsRead(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func buildAzureResourceGroupsQueryParams(request AzureResourceGroupsDataModel) string {
+// fetchAzureResourceGroups returns the resource groups matching the filters of request, following
+// pagination from offset unless limit is set
+func fetchAzureResourceGroups(ctx context.Context, client *vc.AzureBackupClient, request AzureResourceGroupsDataModel, offset, limit int) ([]AzureResourceGroupsResults, error) {
+	resourceGroups, err := vc.FetchPages(ctx, offset, limit, func(ctx context.Context, offset, limit int) (vc.Page[AzureResourceGroupsResults], error) {
+		request.Offset = &offset
+		request.Limit = &limit
+
+		// Build query parameters
+		params := buildAzureResourceGroupsQueryParams(request)
+		apiUrl := client.BuildAPIURL(vc.WithQuery("/cloudInfrastructure/resourceGroups", params))
+		// Make API request
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiUrl, nil)
+		if err != nil {
+			return vc.Page[AzureResourceGroupsResults]{}, fmt.Errorf("error retrieving Azure Resource Groups: %s", err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AzureResourceGroupsResults]{}, fmt.Errorf("error reading response body: %s", err)
+		}
+
+		// Parse response
+		var responseModel AzureResourceGroupsResponseModel
+		if err := json.Unmarshal(body, &responseModel); err != nil {
+			return vc.Page[AzureResourceGroupsResults]{}, fmt.Errorf("error parsing response JSON: %s", err)
+		}
+		page := vc.Page[AzureResourceGroupsResults]{Total: -1}
+		if responseModel.Results != nil {
+			page.Items = *responseModel.Results
+		}
+		if responseModel.TotalCount != nil {
+			page.Total = *responseModel.TotalCount
+		}
+		return page, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resourceGroups.Items, nil
+}
+
+func buildAzureResourceGroupsQueryParams(request AzureResourceGroupsDataModel) url.Values {
 	params := url.Values{}
 
 	if request.SubscriptionID != nil {
@@ -241,5 +257,5 @@ func buildAzureResourceGroupsQueryParams(request AzureResourceGroupsDataModel) s
 		}
 	}
 
-	return params.Encode()
+	return params
 }
\ No newline at end of file
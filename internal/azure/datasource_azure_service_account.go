@@ -182,10 +182,10 @@ func DataSourceAzureServiceAccountRead(ctx context.Context, d *schema.ResourceDa
 	accountID := d.Get("account_id").(string)
 
 	// Construct the API URL
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/accounts/azure/service/%s", accountID))
+	apiURL := client.BuildAPIURL(vc.Endpoint("/accounts/azure/service/%s", accountID))
 
 	// Make the API request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiURL, nil)
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to retrieve Azure service account: %w", err))
 	}
@@ -196,12 +196,12 @@ func DataSourceAzureServiceAccountRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
 	}
 
-	if resp.StatusCode == 404 {
-		return diag.FromErr(fmt.Errorf("Azure service account with ID %s not found", accountID))
-	}
-
 	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, body)
+		if vc.IsGone(apiErr) {
+			return diag.FromErr(fmt.Errorf("Azure service account with ID %s not found: %w", accountID, apiErr))
+		}
+		return diag.FromErr(apiErr)
 	}
 
 	// Parse the response
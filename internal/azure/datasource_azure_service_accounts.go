@@ -253,52 +253,22 @@ func DataSourceAzureServiceAccountsRead(ctx context.Context, d *schema.ResourceD
 		params.Set("filter", v.(string))
 	}
 
-	if v, ok := d.GetOk("offset"); ok {
-		params.Set("offset", strconv.Itoa(v.(int)))
-	}
-
-	if v, ok := d.GetOk("limit"); ok {
-		params.Set("limit", strconv.Itoa(v.(int)))
-	}
-
 	if v, ok := d.GetOk("purpose"); ok {
 		params.Set("purpose", v.(string))
 	}
 
-	// Construct the API URL
-	apiURL := client.BuildAPIURL("/accounts/azure/service")
-	if len(params) > 0 {
-		apiURL += "?" + params.Encode()
-	}
-
-	// Make the API request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiURL, nil)
+	// Make the API requests, following pagination unless a limit is set
+	accounts, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), fetchAzureServiceAccounts(client, params))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to retrieve Azure service accounts: %w", err))
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
-	}
-
-	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
-
-	// Parse the response
-	var accountsResp AzureServiceAccountsResponse
-	if err := json.Unmarshal(body, &accountsResp); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse response: %w", err))
+		return diag.FromErr(err)
 	}
 
 	// Convert service accounts to Terraform format
-	serviceAccounts := make([]interface{}, len(accountsResp.Results))
+	serviceAccounts := make([]interface{}, len(accounts.Items))
 	serviceAccountsByID := make(map[string]interface{})
 	serviceAccountsByName := make(map[string]interface{})
 
-	for i, account := range accountsResp.Results {
+	for i, account := range accounts.Items {
 		serviceAccounts[i] = map[string]interface{}{
 			"account_id":                              account.AccountID,
 			"application_id":                          account.ApplicationID,
@@ -342,7 +312,7 @@ func DataSourceAzureServiceAccountsRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("failed to set service_accounts_by_name: %w", err))
 	}
 
-	if err := d.Set("total", accountsResp.TotalCount); err != nil {
+	if err := d.Set("total", accounts.Total); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to set total: %w", err))
 	}
 
@@ -351,3 +321,38 @@ func DataSourceAzureServiceAccountsRead(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+// fetchAzureServiceAccounts returns a page fetcher of the service accounts matching the filters of
+// params
+func fetchAzureServiceAccounts(client *vc.AzureBackupClient, params url.Values) vc.PageFetcher[AzureServiceAccount] {
+	return func(ctx context.Context, offset, limit int) (vc.Page[AzureServiceAccount], error) {
+		params.Set("offset", strconv.Itoa(offset))
+		params.Set("limit", strconv.Itoa(limit))
+
+		// Construct the API URL
+		apiURL := client.BuildAPIURL(vc.WithQuery("/accounts/azure/service", params))
+
+		// Make the API request
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
+		if err != nil {
+			return vc.Page[AzureServiceAccount]{}, fmt.Errorf("failed to retrieve Azure service accounts: %w", err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AzureServiceAccount]{}, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		if resp.StatusCode != 200 {
+			return vc.Page[AzureServiceAccount]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
+
+		// Parse the response
+		var accountsResp AzureServiceAccountsResponse
+		if err := json.Unmarshal(body, &accountsResp); err != nil {
+			return vc.Page[AzureServiceAccount]{}, fmt.Errorf("failed to parse response: %w", err)
+		}
+		return vc.Page[AzureServiceAccount]{Items: accountsResp.Results, Total: accountsResp.TotalCount}, nil
+	}
+}
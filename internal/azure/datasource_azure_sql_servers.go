@@ -57,7 +57,7 @@ func DataSourceAzureSqlServers() *schema.Resource {
 			"limit": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "The numbers of items to return.",
+				Description: "The numbers of items to return. When not set, all items are returned.",
 			},
 			"tenant_id": {
 				Type:        schema.TypeString,
@@ -157,14 +157,6 @@ func DataSourceAzureSqlServersRead(ctx context.Context, d *schema.ResourceData,
 	request := AzureSQLServersDataSourceModel{}
 	
 	// Handle optional values - only set if provided
-	if v, ok := d.GetOk("offset"); ok {
-		val := v.(int)
-		request.Offset = &val
-	}
-	if v, ok := d.GetOk("limit"); ok {
-		val := v.(int)
-		request.Limit = &val
-	}
 	if v, ok := d.GetOk("tenant_id"); ok {
 		val := v.(string)
 		request.TenantID = &val
@@ -200,35 +192,49 @@ func DataSourceAzureSqlServersRead(ctx context.Context, d *schema.ResourceData,
 		}
 		request.RegionIDs = &regionIDs
 	}
-	// Build query parameters
-	params := buildSQLServerQueryParams(request)
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/cloudInfrastructure/sqlServers?%s", params))
-	// Make API request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiUrl, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to retrieve Azure SQL Servers: %w", err))
-	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to read response body: %w", err))
-	}
+	// Make API requests, following pagination unless a limit is set
+	servers, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[AzureSQLServer], error) {
+		request.Offset = &offset
+		request.Limit = &limit
 
-	if resp.StatusCode != 200 && resp.StatusCode != 202 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
+		// Build query parameters
+		params := buildSQLServerQueryParams(request)
+		apiUrl := client.BuildAPIURL(vc.WithQuery("/cloudInfrastructure/sqlServers", params))
+		// Make API request
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiUrl, nil)
+		if err != nil {
+			return vc.Page[AzureSQLServer]{}, fmt.Errorf("Failed to retrieve Azure SQL Servers: %w", err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AzureSQLServer]{}, fmt.Errorf("Failed to read response body: %w", err)
+		}
+
+		if resp.StatusCode != 200 && resp.StatusCode != 202 {
+			return vc.Page[AzureSQLServer]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
 
-	// Parse response
-	var sqlServerResponse AzureSQLServersDataSourceResponse
-	if err := json.Unmarshal(body, &sqlServerResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to parse response JSON: %w", err))
+		// Parse response
+		var sqlServerResponse AzureSQLServersDataSourceResponse
+		if err := json.Unmarshal(body, &sqlServerResponse); err != nil {
+			return vc.Page[AzureSQLServer]{}, fmt.Errorf("Failed to parse response JSON: %w", err)
+		}
+		page := vc.Page[AzureSQLServer]{Items: sqlServerResponse.Results, Total: -1}
+		if sqlServerResponse.Total != nil {
+			page.Total = *sqlServerResponse.Total
+		}
+		return page, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Create both a list and a map of SQL servers
-	sqlServersMap := make(map[string]interface{}, len(sqlServerResponse.Results))
-	sqlServersList := make([]interface{}, 0, len(sqlServerResponse.Results))
+	sqlServersMap := make(map[string]interface{}, len(servers.Items))
+	sqlServersList := make([]interface{}, 0, len(servers.Items))
 
-	for _, sqlServers := range sqlServerResponse.Results {
+	for _, sqlServers := range servers.Items {
 		// Create detailed SQLServers object
 		sqlServerDetails := map[string]interface{}{
 			"veeam_id":        sqlServers.VeeamID,
@@ -263,7 +269,7 @@ func DataSourceAzureSqlServersRead(ctx context.Context, d *schema.ResourceData,
 }
 
 // Helper function to build query parameters from the request model
-func buildSQLServerQueryParams(req AzureSQLServersDataSourceModel) string {
+func buildSQLServerQueryParams(req AzureSQLServersDataSourceModel) url.Values {
 	params := url.Values{}
 	if req.Offset != nil {
 		params.Set("offset", strconv.Itoa(*req.Offset))
@@ -296,5 +302,5 @@ func buildSQLServerQueryParams(req AzureSQLServersDataSourceModel) string {
 	if req.ServerTypes != nil {
 		params.Set("serverTypes", *req.ServerTypes)
 	}
-	return params.Encode()
+	return params
 } 
\ No newline at end of file
@@ -260,45 +260,43 @@ if request.VhdCompatible {
 if request.ServiceAccountID != "" {
     params.Set("serviceAccountId", request.ServiceAccountID)
 }
-if request.Offset > 0 {
-    params.Set("offset", strconv.Itoa(request.Offset))
-}
-if request.Limit != -1 {
-    params.Set("limit", strconv.Itoa(request.Limit))
-}
+// Make API requests, following pagination unless a limit is set
+storageAccounts, err := vc.FetchPages(ctx, request.Offset, request.Limit, func(ctx context.Context, offset, limit int) (vc.Page[AzureStorageAccountDetail], error) {
+	params.Set("offset", strconv.Itoa(offset))
+	params.Set("limit", strconv.Itoa(limit))
 
-// Add parameters to URL if any exist
-if len(params) > 0 {
-    apiUrl += "?" + params.Encode()
-}
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", vc.WithQuery(apiUrl, params), nil)
+	if err != nil {
+		return vc.Page[AzureStorageAccountDetail]{}, fmt.Errorf("failed to fetch Azure storage accounts: %w", err)
+	}
+	defer resp.Body.Close()
 
-resp, err := client.MakeAuthenticatedRequest("GET", apiUrl, nil)
-if err != nil {
-    return diag.FromErr(fmt.Errorf("failed to fetch Azure storage accounts: %w", err))
-}
-defer resp.Body.Close()
+	if resp.StatusCode != 200 && resp.StatusCode != 202 {
+		body, _ := io.ReadAll(resp.Body)
+		return vc.Page[AzureStorageAccountDetail]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+	}
 
-if resp.StatusCode != 200 && resp.StatusCode != 202 {
-    body, _ := io.ReadAll(resp.Body)
-    return diag.FromErr(fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body)))
-}
+	// Read and parse response
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return vc.Page[AzureStorageAccountDetail]{}, fmt.Errorf("failed to read response body: %w", err)
+	}
 
-// Read and parse response
-body, err := io.ReadAll(resp.Body)
+	var storageAccountsResp AzureStorageAccountsResponse
+	if err := json.Unmarshal(body, &storageAccountsResp); err != nil {
+		return vc.Page[AzureStorageAccountDetail]{}, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return vc.Page[AzureStorageAccountDetail]{Items: storageAccountsResp.Results, Total: storageAccountsResp.TotalCount}, nil
+})
 if err != nil {
-    return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
-}
-
-var storageAccountsResp AzureStorageAccountsResponse
-if err := json.Unmarshal(body, &storageAccountsResp); err != nil {
-    return diag.FromErr(fmt.Errorf("failed to parse response: %w", err))
+    return diag.FromErr(err)
 }
 
 // Create maps for storage accounts
 storageAccountsMap := make(map[string]string)
 var storageAccountDetails []interface{}
 
-for _, account := range storageAccountsResp.Results {
+for _, account := range storageAccounts.Items {
     // Marshal account details to JSON string for map
     accountJSON, err := json.Marshal(account)
     if err != nil {
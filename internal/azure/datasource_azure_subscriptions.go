@@ -75,7 +75,7 @@ func DataSourceAzureSubscriptions() *schema.Resource {
 			"limit": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "The maximum number of subscriptions to return.",
+				Description: "The maximum number of subscriptions to return. When not set, all subscriptions are returned.",
 			}, // compute only fields
 			"id": {
 				Type:     schema.TypeString,
@@ -123,14 +123,6 @@ func DataSourceAzureSubscriptionsRead(ctx context.Context, d *schema.ResourceDat
 	// Build query parameters
 	params := url.Values{}
 
-	if v, ok := d.GetOk("offset"); ok {
-		params.Set("offset", strconv.Itoa(v.(int)))
-	}
-
-	if v, ok := d.GetOk("limit"); ok {
-		params.Set("limit", strconv.Itoa(v.(int)))
-	}
-
 	if v, ok := d.GetOk("account_id"); ok {
 		params.Set("accountId", v.(string))
 	}
@@ -152,35 +144,49 @@ func DataSourceAzureSubscriptionsRead(ctx context.Context, d *schema.ResourceDat
 		}
 		params.Set("onlyIds", string(onlyIDsJson))
 	}
-	// Make API request
-	apiURL := client.BuildAPIURL("/cloudInfrastructure/subscriptions")
-	if len(params) > 0 {
-		apiURL += "?" + params.Encode()
-	}
+	// Make API requests, following pagination unless a limit is set
+	subscriptions, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[AzureSubscriptionsResults], error) {
+		params.Set("offset", strconv.Itoa(offset))
+		params.Set("limit", strconv.Itoa(limit))
 
-	resp, err := client.MakeAuthenticatedRequest("GET", apiURL, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to retrieve Azure subscriptions: %w", err))
-	}
-	defer resp.Body.Close()
+		// Make API request
+		apiURL := client.BuildAPIURL(vc.WithQuery("/cloudInfrastructure/subscriptions", params))
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
+		if err != nil {
+			return vc.Page[AzureSubscriptionsResults]{}, fmt.Errorf("Failed to retrieve Azure subscriptions: %w", err)
+		}
+		defer resp.Body.Close()
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return diag.FromErr(fmt.Errorf("failed to retrieve Azure subscriptions: status %d: %s", resp.StatusCode, string(body)))
-	}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AzureSubscriptionsResults]{}, err
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return vc.Page[AzureSubscriptionsResults]{}, fmt.Errorf("failed to retrieve Azure subscriptions: %w", vc.NewVeeamAPIError(resp.StatusCode, body))
+		}
 
-	// Parse the response
-	var subscriptionsResponse AzureSubscriptionsResponseModel
-	err = json.Unmarshal(body, &subscriptionsResponse)
+		// Parse the response
+		var subscriptionsResponse AzureSubscriptionsResponseModel
+		err = json.Unmarshal(body, &subscriptionsResponse)
+		if err != nil {
+			return vc.Page[AzureSubscriptionsResults]{}, fmt.Errorf("failed to parse response: %w", err)
+		}
+		page := vc.Page[AzureSubscriptionsResults]{Total: -1}
+		if subscriptionsResponse.Results != nil {
+			page.Items = *subscriptionsResponse.Results
+		}
+		if subscriptionsResponse.TotalCount != nil {
+			page.Total = *subscriptionsResponse.TotalCount
+		}
+		return page, nil
+	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse response: %w", err))
+		return diag.FromErr(err)
 	}
 
-	if subscriptionsResponse.Results == nil || len(*subscriptionsResponse.Results) == 0 {
+	if len(subscriptions.Items) == 0 {
 		if err := d.Set("subscriptions", map[string]interface{}{}); err != nil {
 			return diag.FromErr(err)
 		}
@@ -189,9 +195,9 @@ func DataSourceAzureSubscriptionsRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	// Create subscriptions map
-	subscriptionsMap := make(map[string]interface{}, len(*subscriptionsResponse.Results))
+	subscriptionsMap := make(map[string]interface{}, len(subscriptions.Items))
 
-	for _, subscription := range *subscriptionsResponse.Results {
+	for _, subscription := range subscriptions.Items {
 		subscriptionJson, err := json.Marshal(subscription)
 		if err != nil {
 			return diag.FromErr(err)
@@ -199,7 +205,7 @@ func DataSourceAzureSubscriptionsRead(ctx context.Context, d *schema.ResourceDat
 		subscriptionsMap[subscription.ID] = string(subscriptionJson)
 	}
 
-	first := (*subscriptionsResponse.Results)[0]
+	first := subscriptions.Items[0]
 	if first.Environment != nil {
 		_ = d.Set("environment", *first.Environment)
 	}
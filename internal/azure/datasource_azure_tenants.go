@@ -0,0 +1,145 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceAzureTenants() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the Microsoft Entra tenants known to Veeam Backup for Microsoft Azure, which are the tenants of its service accounts. " +
+			"Use tenants_by_name to resolve the tenant_id arguments of other resources from the display name of a tenant.",
+		ReadContext: DataSourceAzureTenantsRead,
+		Schema: map[string]*schema.Schema{
+			"tenants": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The tenants, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the tenant.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the tenant.",
+						},
+						"service_account_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The system IDs of the service accounts of the tenant.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tenants_by_id": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The names of the tenants, keyed by tenant ID.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tenants_by_name": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The IDs of the tenants, keyed by tenant name. A name shared by several tenants is left out, so that it does not resolve to one of them at random.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// azureTenant is a tenant of the service accounts of the appliance
+type azureTenant struct {
+	id                string
+	name              string
+	serviceAccountIDs []string
+}
+
+func DataSourceAzureTenantsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accounts, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, fetchAzureServiceAccounts(client, url.Values{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Tenant IDs are GUIDs, which the API may return in either case
+	byID := map[string]*azureTenant{}
+	for _, account := range accounts.Items {
+		if account.TenantID == "" {
+			continue
+		}
+		key := strings.ToLower(account.TenantID)
+		tenant, ok := byID[key]
+		if !ok {
+			tenant = &azureTenant{id: account.TenantID}
+			byID[key] = tenant
+		}
+		if tenant.name == "" {
+			tenant.name = account.TenantName
+		}
+		tenant.serviceAccountIDs = append(tenant.serviceAccountIDs, account.AccountID)
+	}
+
+	sorted := make([]*azureTenant, 0, len(byID))
+	for _, tenant := range byID {
+		sort.Strings(tenant.serviceAccountIDs)
+		sorted = append(sorted, tenant)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].name != sorted[j].name {
+			return sorted[i].name < sorted[j].name
+		}
+		return sorted[i].id < sorted[j].id
+	})
+
+	tenants := make([]interface{}, len(sorted))
+	tenantsByID := map[string]interface{}{}
+	tenantsByName := map[string]interface{}{}
+	sharedNames := map[string]bool{}
+	for i, tenant := range sorted {
+		tenants[i] = map[string]interface{}{
+			"id":                  tenant.id,
+			"name":                tenant.name,
+			"service_account_ids": tenant.serviceAccountIDs,
+		}
+		tenantsByID[tenant.id] = tenant.name
+		if tenant.name == "" {
+			continue
+		}
+		if _, ok := tenantsByName[tenant.name]; ok {
+			sharedNames[tenant.name] = true
+		}
+		tenantsByName[tenant.name] = tenant.id
+	}
+	for name := range sharedNames {
+		delete(tenantsByName, name)
+	}
+
+	if err := d.Set("tenants", tenants); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set tenants: %w", err))
+	}
+	if err := d.Set("tenants_by_id", tenantsByID); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set tenants_by_id: %w", err))
+	}
+	if err := d.Set("tenants_by_name", tenantsByName); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set tenants_by_name: %w", err))
+	}
+
+	d.SetId(fmt.Sprintf("azure-tenants-%s", client.Hostname()))
+	return nil
+}
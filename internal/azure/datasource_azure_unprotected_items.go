@@ -0,0 +1,325 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// unprotectedItemPaths are the inventory collections of the API by item type
+var unprotectedItemPaths = map[string]string{
+	"VirtualMachine":  "/virtualMachines",
+	"SqlDatabase":     "/databases",
+	"FileShare":       "/fileShares",
+	"CosmosDbAccount": "/cosmosDb",
+}
+
+type AzureInventoryItemsResponse struct {
+	Results    []AzureInventoryItem `json:"results"`
+	TotalCount int                  `json:"totalCount"`
+	// Total is returned instead of TotalCount by the databases collection
+	Total int `json:"total"`
+}
+
+// AzureInventoryItem holds the fields common to the discovered resources of every inventory
+// collection
+type AzureInventoryItem struct {
+	ID      string `json:"id"`
+	AzureID string `json:"azureId"`
+	// ResourceID is returned instead of AzureID by the databases collection
+	ResourceID        string `json:"resourceId"`
+	Name              string `json:"name"`
+	SubscriptionID    string `json:"subscriptionId"`
+	ResourceGroupName string `json:"resourceGroupName"`
+	RegionID          string `json:"regionId"`
+	RegionName        string `json:"regionName"`
+}
+
+func DataSourceAzureUnprotectedItems() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the Azure resources discovered by Veeam Backup for Microsoft Azure that no backup policy protects, grouped by subscription and region. " +
+			"Use the groups to generate backup policies that close the protection gaps.",
+		ReadContext: DataSourceAzureUnprotectedItemsRead,
+		Schema: map[string]*schema.Schema{
+			"item_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Types of unprotected items to return: VirtualMachine, SqlDatabase, FileShare or CosmosDbAccount. All types are returned when not set.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(azureItemTypes(unprotectedItemPaths), false),
+				},
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only unprotected items that belong to the Azure subscription with the specified ID.",
+			},
+			"region_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Returns only unprotected items that reside in the Azure regions with the specified IDs.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"search_pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only unprotected items with a name matching the pattern.",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Unprotected items.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "System ID assigned to the item in Veeam Backup for Microsoft Azure.",
+						},
+						"azure_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Azure resource ID of the item.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the item.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the item.",
+						},
+						"subscription_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Azure subscription of the item.",
+						},
+						"resource_group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the resource group of the item.",
+						},
+						"region_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Azure region of the item.",
+						},
+						"region_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the Azure region of the item.",
+						},
+					},
+				},
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Unprotected items grouped by subscription, region and type, sorted in that order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subscription_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Azure subscription of the items.",
+						},
+						"region_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the Azure region of the items.",
+						},
+						"region_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the Azure region of the items.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the items.",
+						},
+						"count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of unprotected items in the group.",
+						},
+						"ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "System IDs of the unprotected items in the group, in the order of azure_ids. Backup policies select items by these IDs.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"azure_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Azure resource IDs of the unprotected items in the group, sorted.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of unprotected items.",
+			},
+		},
+	}
+}
+
+func DataSourceAzureUnprotectedItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	itemTypes := azureItemTypes(unprotectedItemPaths)
+	if v, ok := d.GetOk("item_types"); ok {
+		itemTypes = convertSetToStringSlice(v.(*schema.Set))
+		sort.Strings(itemTypes)
+	}
+
+	params := url.Values{}
+	params.Set("ProtectionStatus", "Unprotected")
+	if v, ok := d.GetOk("subscription_id"); ok {
+		params.Set("SubscriptionId", v.(string))
+	}
+	if v, ok := d.GetOk("region_ids"); ok {
+		for _, regionID := range convertSetToStringSlice(v.(*schema.Set)) {
+			params.Add("RegionIds", regionID)
+		}
+	}
+	if v, ok := d.GetOk("search_pattern"); ok {
+		params.Set("SearchPattern", v.(string))
+	}
+
+	items := []interface{}{}
+	groups := map[unprotectedItemGroupKey][]AzureInventoryItem{}
+	regionNames := map[string]string{}
+	for _, itemType := range itemTypes {
+		unprotected, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, offset, limit int) (vc.Page[AzureInventoryItem], error) {
+			params.Set("Offset", strconv.Itoa(offset))
+			params.Set("Limit", strconv.Itoa(limit))
+			apiURL := client.BuildAPIURL(vc.WithQuery(unprotectedItemPaths[itemType], params))
+			resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
+			if err != nil {
+				return vc.Page[AzureInventoryItem]{}, fmt.Errorf("failed to retrieve Azure unprotected items: %w", err)
+			}
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return vc.Page[AzureInventoryItem]{}, fmt.Errorf("failed to read response body: %w", err)
+			}
+			if resp.StatusCode != 200 {
+				return vc.Page[AzureInventoryItem]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+			}
+
+			var itemsResponse AzureInventoryItemsResponse
+			if err := json.Unmarshal(body, &itemsResponse); err != nil {
+				return vc.Page[AzureInventoryItem]{}, fmt.Errorf("failed to parse response: %w", err)
+			}
+			total := itemsResponse.TotalCount
+			if total == 0 {
+				total = itemsResponse.Total
+			}
+			return vc.Page[AzureInventoryItem]{Items: itemsResponse.Results, Total: total}, nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, item := range unprotected.Items {
+			azureID := item.AzureID
+			if azureID == "" {
+				azureID = item.ResourceID
+			}
+			items = append(items, map[string]interface{}{
+				"id":                  item.ID,
+				"azure_id":            azureID,
+				"type":                itemType,
+				"name":                item.Name,
+				"subscription_id":     item.SubscriptionID,
+				"resource_group_name": item.ResourceGroupName,
+				"region_id":           item.RegionID,
+				"region_name":         item.RegionName,
+			})
+
+			key := unprotectedItemGroupKey{subscriptionID: item.SubscriptionID, regionID: item.RegionID, itemType: itemType}
+			item.AzureID = azureID
+			groups[key] = append(groups[key], item)
+			if item.RegionName != "" {
+				regionNames[item.RegionID] = item.RegionName
+			}
+		}
+	}
+
+	if err := d.Set("items", items); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set items: %w", err))
+	}
+	if err := d.Set("groups", flattenUnprotectedItemGroups(groups, regionNames)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set groups: %w", err))
+	}
+	d.Set("total_count", len(items))
+
+	d.SetId("azure_unprotected_items")
+	return nil
+}
+
+type unprotectedItemGroupKey struct {
+	subscriptionID string
+	regionID       string
+	itemType       string
+}
+
+// flattenUnprotectedItemGroups converts the unprotected items by group into the groups attribute,
+// sorted by subscription, region and type
+func flattenUnprotectedItemGroups(groups map[unprotectedItemGroupKey][]AzureInventoryItem, regionNames map[string]string) []interface{} {
+	keys := make([]unprotectedItemGroupKey, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].subscriptionID != keys[j].subscriptionID {
+			return keys[i].subscriptionID < keys[j].subscriptionID
+		}
+		if keys[i].regionID != keys[j].regionID {
+			return keys[i].regionID < keys[j].regionID
+		}
+		return keys[i].itemType < keys[j].itemType
+	})
+
+	result := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		items := groups[key]
+		sort.Slice(items, func(i, j int) bool { return items[i].AzureID < items[j].AzureID })
+		ids := make([]string, 0, len(items))
+		azureIDs := make([]string, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.ID)
+			azureIDs = append(azureIDs, item.AzureID)
+		}
+		result = append(result, map[string]interface{}{
+			"subscription_id": key.subscriptionID,
+			"region_id":       key.regionID,
+			"region_name":     regionNames[key.regionID],
+			"type":            key.itemType,
+			"count":           len(items),
+			"ids":             ids,
+			"azure_ids":       azureIDs,
+		})
+	}
+	return result
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Response uses the AzureVMRestorePointsResults struct in shared_azure_restores
@@ -23,6 +24,7 @@ func DataSourceAzureVMRestorePoint() *schema.Resource {
 			"restore_point_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ValidateFunc: validation.IsUUID,
 				Description: "Specifies the system ID assigned to a restore point in the Veeam Backup for Microsoft Azure REST API.",
 			}, // computed fields
 			"id": {
@@ -140,10 +142,10 @@ func DataSourceAzureVMRestorePointRead(ctx context.Context, d *schema.ResourceDa
 	restorePointID := d.Get("restore_point_id").(string)
 
 	// Construct the API URL
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/restorePoints/virtualMachines/%s", restorePointID))
+	apiUrl := client.BuildAPIURL(vc.Endpoint("/restorePoints/virtualMachines/%s", restorePointID))
 
 	// Make the API request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiUrl, nil)
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiUrl, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to retrieve Azure VM restore point: %w", err))
 	}
@@ -154,12 +156,12 @@ func DataSourceAzureVMRestorePointRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
 	}
 
-	if resp.StatusCode == 404 {
-		return diag.FromErr(fmt.Errorf("Azure VM restore point with ID %s not found", restorePointID))
-	}
-
 	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, body)
+		if vc.IsGone(apiErr) {
+			return diag.FromErr(fmt.Errorf("Azure VM restore point with ID %s not found: %w", restorePointID, apiErr))
+		}
+		return diag.FromErr(apiErr)
 	}
 
 	// Parse the response
@@ -244,14 +244,6 @@ func DataSourceAzureVMRestorePointsRead(ctx context.Context, d *schema.ResourceD
 		}
 		request.DataRetrievalStatus = &dataRetrievalStatus
 	}
-	if v, ok := d.GetOk("offset"); ok {
-		val := v.(int)
-		request.Offset = &val
-	}
-	if v, ok := d.GetOk("limit"); ok {
-		val := v.(int)
-		request.Limit = &val
-	}
 	if v, ok := d.GetOk("storage_access_tier"); ok {
 		storageAccessTier := []string{}
 		for _, id := range v.([]interface{}) {
@@ -263,36 +255,50 @@ func DataSourceAzureVMRestorePointsRead(ctx context.Context, d *schema.ResourceD
 		val := v.(bool)
 		request.ImmutabilityEnabled = &val
 	}
-	// Build query parameters
-	params := buildAzureVMRestorePointsQueryParams(request)
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/restorePoints/virtualMachines?%s", params))
-	// Make Request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiUrl, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to retrieve Azure VM restore points: %w", err))
-	}
-	defer resp.Body.Close()
+	// Make API requests, following pagination unless a limit is set
+	restorePoints, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[AzureVMRestorePointsResults], error) {
+		request.Offset = &offset
+		request.Limit = &limit
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to read response body: %w", err))
-	}
+		// Build query parameters
+		params := buildAzureVMRestorePointsQueryParams(request)
+		apiUrl := client.BuildAPIURL(vc.WithQuery("/restorePoints/virtualMachines", params))
+		// Make Request
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiUrl, nil)
+		if err != nil {
+			return vc.Page[AzureVMRestorePointsResults]{}, fmt.Errorf("Failed to retrieve Azure VM restore points: %w", err)
+		}
+		defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AzureVMRestorePointsResults]{}, fmt.Errorf("Failed to read response body: %w", err)
+		}
+
+		if resp.StatusCode != 200 {
+			return vc.Page[AzureVMRestorePointsResults]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
 
-	// Parse response
-	var vmRestorePointsResponse AzureVMRestorePointsResponse
-	if err := json.Unmarshal(body, &vmRestorePointsResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to parse response JSON: %w", err))
+		// Parse response
+		var vmRestorePointsResponse AzureVMRestorePointsResponse
+		if err := json.Unmarshal(body, &vmRestorePointsResponse); err != nil {
+			return vc.Page[AzureVMRestorePointsResults]{}, fmt.Errorf("Failed to parse response JSON: %w", err)
+		}
+		page := vc.Page[AzureVMRestorePointsResults]{Items: vmRestorePointsResponse.Results, Total: -1}
+		if vmRestorePointsResponse.TotalCount != nil {
+			page.Total = *vmRestorePointsResponse.TotalCount
+		}
+		return page, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Create list and map from response
-	azureVMRestorePointsMap := make(map[string]interface{}, len(vmRestorePointsResponse.Results))
-	azureVMRestorePointsList := make([]interface{}, 0, len(vmRestorePointsResponse.Results))
+	azureVMRestorePointsMap := make(map[string]interface{}, len(restorePoints.Items))
+	azureVMRestorePointsList := make([]interface{}, 0, len(restorePoints.Items))
 
-	for _, vmRestorePoints := range vmRestorePointsResponse.Results {
+	for _, vmRestorePoints := range restorePoints.Items {
 		vmRestorePointsDetails := map[string]interface{}{
 			"id":                    vmRestorePoints.ID,
 			"backup_destination":    vmRestorePoints.BackupDestination,
@@ -330,7 +336,7 @@ func DataSourceAzureVMRestorePointsRead(ctx context.Context, d *schema.ResourceD
 }
 
 // Helper function to build query parameters from the request model
-func buildAzureVMRestorePointsQueryParams(req AzureVMRestorePointsDataSourceModel) string {
+func buildAzureVMRestorePointsQueryParams(req AzureVMRestorePointsDataSourceModel) url.Values {
 	params := url.Values{}
 	if req.Offset != nil {
 		params.Set("offset", strconv.Itoa(*req.Offset))
@@ -361,5 +367,5 @@ func buildAzureVMRestorePointsQueryParams(req AzureVMRestorePointsDataSourceMode
 		StorageAccessTierJson, _ := json.Marshal(*req.StorageAccessTier)
 		params.Set("storage_access_tier", string(StorageAccessTierJson))
 	}
-	return params.Encode()
+	return params
 }
@@ -292,36 +292,45 @@ func DataSourceAzureVMRead(ctx context.Context, d *schema.ResourceData, meta int
         request.BackupDestination = convertSetToStringSlice(set)
     }
 
-	// Build query parameters
-	params := buildQueryParams(request)
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/virtualMachines?%s", params))
-    // Make API request
-    resp, err := client.MakeAuthenticatedRequest("GET", apiURL, nil)
-    if err != nil {
-        return diag.FromErr(fmt.Errorf("failed to retrieve Azure VMs: %w", err))
-    }
-    defer resp.Body.Close()
+	// Make API requests, following pagination unless a limit is set
+	vms, err := vc.FetchPages(ctx, request.Offset, request.Limit, func(ctx context.Context, offset, limit int) (vc.Page[AzureVMDetail], error) {
+		request.Offset = offset
+		request.Limit = limit
 
-    body, err := io.ReadAll(resp.Body)
-    if err != nil {
-        return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
-    }
+		// Build query parameters
+		params := buildQueryParams(request)
+		apiURL := client.BuildAPIURL(vc.WithQuery("/virtualMachines", params))
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
+		if err != nil {
+			return vc.Page[AzureVMDetail]{}, fmt.Errorf("failed to retrieve Azure VMs: %w", err)
+		}
+		defer resp.Body.Close()
 
-    if resp.StatusCode != 200 {
-        return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-    }
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AzureVMDetail]{}, fmt.Errorf("failed to read response body: %w", err)
+		}
 
-    // Parse response
-    var vmResponse AzureVMResponse
-    if err := json.Unmarshal(body, &vmResponse); err != nil {
-        return diag.FromErr(fmt.Errorf("failed to parse response: %w", err))
-    }
+		if resp.StatusCode != 200 {
+			return vc.Page[AzureVMDetail]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
+
+		// Parse response
+		var vmResponse AzureVMResponse
+		if err := json.Unmarshal(body, &vmResponse); err != nil {
+			return vc.Page[AzureVMDetail]{}, fmt.Errorf("failed to parse response: %w", err)
+		}
+		return vc.Page[AzureVMDetail]{Items: vmResponse.Results, Total: vmResponse.TotalCount}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
     // Create both a rich map and detailed list
-    vmMap := make(map[string]interface{}, len(vmResponse.Results))
-    vmDetailsList := make([]interface{}, 0, len(vmResponse.Results))
+    vmMap := make(map[string]interface{}, len(vms.Items))
+    vmDetailsList := make([]interface{}, 0, len(vms.Items))
     
-    for _, vm := range vmResponse.Results {
+    for _, vm := range vms.Items {
         // Create detailed VM object
         vmDetails := map[string]interface{}{
             "veeam_id":                     vm.VeeamID,
@@ -379,7 +388,7 @@ func convertSetToStringSlice(set *schema.Set) []string {
     return result
 }
 
-func buildQueryParams(req AzureVMDataSourceModel) string {
+func buildQueryParams(req AzureVMDataSourceModel) url.Values {
     params := url.Values{}
     
     if req.Offset > 0 {
@@ -419,5 +428,5 @@ func buildQueryParams(req AzureVMDataSourceModel) string {
         params.Add("BackupDestination", dest)
     }
     
-    return params.Encode()
+    return params
 }
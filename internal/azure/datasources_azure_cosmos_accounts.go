@@ -55,7 +55,7 @@ type AzureCosmosDBAccounts struct {
 	PostgresVersion           string  `json:"postgresVersion"`
 	MongoDBServerVersion      string  `json:"mongoDbServerVersion"`
 	IsDeleted                 bool    `json:"isDeleted"`
-	CapacityMode              string  `json:capacityMode`
+	CapacityMode              string  `json:"capacityMode"`
 }
 
 func DataSourceAzureCosmosDbAccounts() *schema.Resource {
@@ -125,7 +125,7 @@ func DataSourceAzureCosmosDbAccounts() *schema.Resource {
 			"limit": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "The numbers of items to return.",
+				Description: "The numbers of items to return. When not set, all items are returned.",
 			},
 			"backup_destination": {
 				Type:        schema.TypeList,
@@ -289,44 +289,50 @@ func DataSourceAzureCosmosDbAccountsRead(ctx context.Context, d *schema.Resource
 		}
 		request.BackupDestination = &backupDestination
 	}
-	if v, ok := d.GetOk("offset"); ok {
-		val := v.(int)
-		request.Offset = &val
-	}
-	if v, ok := d.GetOk("limit"); ok {
-		val := v.(int)
-		request.Limit = &val
-	}
 
-	// Build query parameters
-	params := buildCosmosDbAccountsQueryParams(request)
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/cosmosDb?%s", params))
+	// Make API requests, following pagination unless a limit is set
+	accounts, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[AzureCosmosDBAccounts], error) {
+		request.Offset = &offset
+		request.Limit = &limit
 
-	// Make API request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiUrl, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to retrieve Azure Cosmos DB Accounts: %w", err))
-	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to read response body: %w", err))
-	}
-	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
+		// Build query parameters
+		params := buildCosmosDbAccountsQueryParams(request)
+		apiUrl := client.BuildAPIURL(vc.WithQuery("/cosmosDb", params))
 
-	// Parse response
-	var cosmosDbAccountsResponse AzureCosmosDBAccountsDataSourceResponse
-	if err := json.Unmarshal(body, &cosmosDbAccountsResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to parse response JSON: %w", err))
+		// Make API request
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiUrl, nil)
+		if err != nil {
+			return vc.Page[AzureCosmosDBAccounts]{}, fmt.Errorf("Failed to retrieve Azure Cosmos DB Accounts: %w", err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AzureCosmosDBAccounts]{}, fmt.Errorf("Failed to read response body: %w", err)
+		}
+		if resp.StatusCode != 200 {
+			return vc.Page[AzureCosmosDBAccounts]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
+
+		// Parse response
+		var cosmosDbAccountsResponse AzureCosmosDBAccountsDataSourceResponse
+		if err := json.Unmarshal(body, &cosmosDbAccountsResponse); err != nil {
+			return vc.Page[AzureCosmosDBAccounts]{}, fmt.Errorf("Failed to parse response JSON: %w", err)
+		}
+		page := vc.Page[AzureCosmosDBAccounts]{Items: cosmosDbAccountsResponse.Results, Total: -1}
+		if cosmosDbAccountsResponse.TotalCount != nil {
+			page.Total = *cosmosDbAccountsResponse.TotalCount
+		}
+		return page, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Create both a list and a map of SQL databases
-	cosmosDbAccountsMap := make(map[string]interface{}, len(cosmosDbAccountsResponse.Results))
-	cosmosDbAccountsList := make([]interface{}, 0, len(cosmosDbAccountsResponse.Results))
+	cosmosDbAccountsMap := make(map[string]interface{}, len(accounts.Items))
+	cosmosDbAccountsList := make([]interface{}, 0, len(accounts.Items))
 
-	for _, cosmosDbAccounts := range cosmosDbAccountsResponse.Results {
+	for _, cosmosDbAccounts := range accounts.Items {
 		// Create Cosmos Accounts object
 		cosmosDbAccountsDetails := map[string]interface{}{
 			"veeam_id":                    cosmosDbAccounts.VeeamID,
@@ -369,7 +375,7 @@ func DataSourceAzureCosmosDbAccountsRead(ctx context.Context, d *schema.Resource
 }
 
 // Helper function to build query paramerters from the request model
-func buildCosmosDbAccountsQueryParams(req AzureCosmosDBAccountsDataSourceModel) string {
+func buildCosmosDbAccountsQueryParams(req AzureCosmosDBAccountsDataSourceModel) url.Values {
 	params := url.Values{}
 	if req.Offset != nil {
 		params.Set("offset", strconv.Itoa(*req.Offset))
@@ -411,5 +417,5 @@ func buildCosmosDbAccountsQueryParams(req AzureCosmosDBAccountsDataSourceModel)
 		BackupDestinationJson, _ := json.Marshal(*req.BackupDestination)
 		params.Set("backupDestionation", string(BackupDestinationJson))
 	}
-	return params.Encode()
+	return params
 }
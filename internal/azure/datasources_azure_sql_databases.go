@@ -44,14 +44,14 @@ type AzureSQLDatabases struct {
 	VeeamID           string  `json:"id"`
 	ResourceID        string  `json:"resourceId"`
 	Name              string  `json:"name"`
-	ServerName        string  `json:"serverName`
-	ServerID          string  `json:"serverId`
+	ServerName        string  `json:"serverName"`
+	ServerID          string  `json:"serverId"`
 	ResourceGroupName string  `json:"resourceGroupName"`
 	SizeInMB          int     `json:"sizeInMb"`
 	SubscriptionID    *string `json:"subscriptionId,omitempty"`
 	RegionID          string  `json:"regionId"`
 	RegionName        string  `json:"regionName"`
-	HasElasticPool    bool    `json:hasElasticPool`
+	HasElasticPool    bool    `json:"hasElasticPool"`
 	Status            string  `json:"status"`
 	DatabaseType      string  `json:"databaseType"`
 }
@@ -69,7 +69,7 @@ func DataSourceAzureSqlDatabases() *schema.Resource {
 			"limit": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "The numbers of items to return.",
+				Description: "The numbers of items to return. When not set, all items are returned.",
 			},
 			"subscription_id": {
 				Type:        schema.TypeString,
@@ -217,14 +217,6 @@ func DataSourceAzureSQLDatabasesRead(ctx context.Context, d *schema.ResourceData
 	request := AzureSqlDatabasesDataSourceModel{}
 
 	// Handle optional values - only set if provided
-	if v, ok := d.GetOk("offset"); ok {
-		val := v.(int)
-		request.Offset = &val
-	}
-	if v, ok := d.GetOk("limit"); ok {
-		val := v.(int)
-		request.Limit = &val
-	}
 	if v, ok := d.GetOk("subscription_id"); ok {
 		val := v.(string)
 		request.SubscriptionID = &val
@@ -274,35 +266,49 @@ func DataSourceAzureSQLDatabasesRead(ctx context.Context, d *schema.ResourceData
 		val := v.(bool)
 		request.DBFromProtectedRegions = &val
 	}
-	// Build query parameters
-	params := buildSqlDatabasesQueryParams(request)
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/databases?%s", params))
+	// Make API requests, following pagination unless a limit is set
+	databases, err := vc.FetchPages(ctx, d.Get("offset").(int), d.Get("limit").(int), func(ctx context.Context, offset, limit int) (vc.Page[AzureSQLDatabases], error) {
+		request.Offset = &offset
+		request.Limit = &limit
 
-	// Make API request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiUrl, nil)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to retrieve Azure SQL Databases: %w", err))
-	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to read response body: %w", err))
-	}
-	if resp.StatusCode != 200 && resp.StatusCode != 202 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
-	}
+		// Build query parameters
+		params := buildSqlDatabasesQueryParams(request)
+		apiUrl := client.BuildAPIURL(vc.WithQuery("/databases", params))
 
-	// Parse response
-	var sqlDatabasesResponse AzureSqlDatabasesDataSourceResponse
-	if err := json.Unmarshal(body, &sqlDatabasesResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to parse response JSON: %w", err))
+		// Make API request
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiUrl, nil)
+		if err != nil {
+			return vc.Page[AzureSQLDatabases]{}, fmt.Errorf("Failed to retrieve Azure SQL Databases: %w", err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return vc.Page[AzureSQLDatabases]{}, fmt.Errorf("Failed to read response body: %w", err)
+		}
+		if resp.StatusCode != 200 && resp.StatusCode != 202 {
+			return vc.Page[AzureSQLDatabases]{}, vc.NewVeeamAPIError(resp.StatusCode, body)
+		}
+
+		// Parse response
+		var sqlDatabasesResponse AzureSqlDatabasesDataSourceResponse
+		if err := json.Unmarshal(body, &sqlDatabasesResponse); err != nil {
+			return vc.Page[AzureSQLDatabases]{}, fmt.Errorf("Failed to parse response JSON: %w", err)
+		}
+		page := vc.Page[AzureSQLDatabases]{Items: sqlDatabasesResponse.Results, Total: -1}
+		if sqlDatabasesResponse.Total != nil {
+			page.Total = *sqlDatabasesResponse.Total
+		}
+		return page, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Create both a list and a map of SQL databases
-	sqlDatabasesMap := make(map[string]interface{}, len(sqlDatabasesResponse.Results))
-	sqlDatabasesList := make([]interface{}, 0, len(sqlDatabasesResponse.Results))
+	sqlDatabasesMap := make(map[string]interface{}, len(databases.Items))
+	sqlDatabasesList := make([]interface{}, 0, len(databases.Items))
 
-	for _, sqlDatabases := range sqlDatabasesResponse.Results {
+	for _, sqlDatabases := range databases.Items {
 		// Create SQL Databases object
 		sqlDatabasesDetails := map[string]interface{}{
 			"veeam_id":        sqlDatabases.VeeamID,
@@ -336,7 +342,7 @@ func DataSourceAzureSQLDatabasesRead(ctx context.Context, d *schema.ResourceData
 }
 
 // Helper function to build query paramerters from the request model
-func buildSqlDatabasesQueryParams(req AzureSqlDatabasesDataSourceModel) string {
+func buildSqlDatabasesQueryParams(req AzureSqlDatabasesDataSourceModel) url.Values {
 	params := url.Values{}
 	if req.Offset != nil {
 		params.Set("offset", strconv.Itoa(*req.Offset))
@@ -374,5 +380,5 @@ func buildSqlDatabasesQueryParams(req AzureSqlDatabasesDataSourceModel) string {
 		BackupDestinationJson, _ := json.Marshal(*req.BackupDestination)
 		params.Set("backupDestionation", string(BackupDestinationJson))
 	}
-	return params.Encode()
+	return params
 }
@@ -0,0 +1,131 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// EmailAuthenticationMicrosoft365 is the SMTP authentication type that signs in to Exchange
+// Online with a Microsoft Entra application (OAuth 2.0) instead of a user name and password
+const EmailAuthenticationMicrosoft365 = "Microsoft365"
+
+const emailSettingsPath = "/settings/email"
+
+// Microsoft365EmailSettings configures the appliance to send email notifications through
+// Exchange Online, authenticating as a Microsoft Entra application
+type Microsoft365EmailSettings struct {
+	Enabled     bool
+	FromAddress string
+	SMTPServer  string
+	Port        int
+	TenantID    string
+	ClientID    string
+	// ClientSecret is never returned by the API; it is left unchanged when empty
+	ClientSecret string
+}
+
+type emailSettingsResponse struct {
+	IsEnabled bool   `json:"isEnabled"`
+	From      string `json:"from"`
+	SMTP      struct {
+		ServerName         string `json:"serverName"`
+		Port               int    `json:"port"`
+		AuthenticationType string `json:"authenticationType"`
+		Microsoft365       *struct {
+			TenantID string `json:"tenantId"`
+			ClientID string `json:"clientId"`
+		} `json:"microsoft365"`
+	} `json:"smtp"`
+}
+
+// GetMicrosoft365EmailSettings returns the email notification settings of the appliance, or nil
+// when they do not authenticate with Microsoft 365
+func GetMicrosoft365EmailSettings(ctx context.Context, client *vc.AzureBackupClient) (*Microsoft365EmailSettings, error) {
+	body, err := doSettingsRequest(ctx, client, http.MethodGet, emailSettingsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response emailSettingsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode Azure email settings response: %w", err)
+	}
+	if response.SMTP.AuthenticationType != EmailAuthenticationMicrosoft365 || response.SMTP.Microsoft365 == nil {
+		return nil, nil
+	}
+	return &Microsoft365EmailSettings{
+		Enabled:     response.IsEnabled,
+		FromAddress: response.From,
+		SMTPServer:  response.SMTP.ServerName,
+		Port:        response.SMTP.Port,
+		TenantID:    response.SMTP.Microsoft365.TenantID,
+		ClientID:    response.SMTP.Microsoft365.ClientID,
+	}, nil
+}
+
+// UpdateMicrosoft365EmailSettings makes the appliance send email notifications through Microsoft
+// 365. The settings are read and written back, so that the recipients and the other fields this
+// provider does not manage keep their values.
+func UpdateMicrosoft365EmailSettings(ctx context.Context, client *vc.AzureBackupClient, settings Microsoft365EmailSettings) error {
+	options, err := getEmailSettingsMap(ctx, client)
+	if err != nil {
+		return err
+	}
+	options["isEnabled"] = settings.Enabled
+	options["from"] = settings.FromAddress
+	smtp := childMap(options, "smtp")
+	smtp["serverName"] = settings.SMTPServer
+	smtp["port"] = settings.Port
+	smtp["authenticationType"] = EmailAuthenticationMicrosoft365
+	microsoft365 := childMap(smtp, "microsoft365")
+	microsoft365["tenantId"] = settings.TenantID
+	microsoft365["clientId"] = settings.ClientID
+	if settings.ClientSecret != "" {
+		microsoft365["clientSecret"] = settings.ClientSecret
+	}
+	return putEmailSettingsMap(ctx, client, options)
+}
+
+// DisableMicrosoft365EmailSettings stops email notifications and removes the Microsoft 365
+// application from the settings of the appliance
+func DisableMicrosoft365EmailSettings(ctx context.Context, client *vc.AzureBackupClient) error {
+	options, err := getEmailSettingsMap(ctx, client)
+	if err != nil {
+		return err
+	}
+	options["isEnabled"] = false
+	smtp := childMap(options, "smtp")
+	if smtp["authenticationType"] == EmailAuthenticationMicrosoft365 {
+		delete(smtp, "authenticationType")
+	}
+	delete(smtp, "microsoft365")
+	return putEmailSettingsMap(ctx, client, options)
+}
+
+func getEmailSettingsMap(ctx context.Context, client *vc.AzureBackupClient) (map[string]interface{}, error) {
+	body, err := doSettingsRequest(ctx, client, http.MethodGet, emailSettingsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var options map[string]interface{}
+	if err := json.Unmarshal(body, &options); err != nil {
+		return nil, fmt.Errorf("failed to decode Azure email settings response: %w", err)
+	}
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	return options, nil
+}
+
+func putEmailSettingsMap(ctx context.Context, client *vc.AzureBackupClient, options map[string]interface{}) error {
+	body, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("failed to marshal Azure email settings request: %w", err)
+	}
+	_, err = doSettingsRequest(ctx, client, http.MethodPut, emailSettingsPath, body)
+	return err
+}
@@ -0,0 +1,103 @@
+package azure
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestStructTags checks that every json struct tag of the package is well formed. A malformed tag
+// is ignored by encoding/json, which then silently uses the Go field name.
+func TestStructTags(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pkg := range pkgs {
+		ast.Inspect(pkg, func(n ast.Node) bool {
+			field, ok := n.(*ast.Field)
+			if !ok || field.Tag == nil {
+				return true
+			}
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				t.Errorf("%s: %v", fset.Position(field.Pos()), err)
+				return true
+			}
+			if !strings.Contains(tag, "json:") {
+				return true
+			}
+			if name, ok := reflect.StructTag(tag).Lookup("json"); !ok || name == "" {
+				t.Errorf("%s: malformed struct tag %s", fset.Position(field.Pos()), field.Tag.Value)
+			}
+			return true
+		})
+	}
+}
+
+// TestRequestJSONRoundTrip decodes API request bodies into the request structs and checks that
+// encoding them back yields the same JSON, so that no field is dropped or renamed
+func TestRequestJSONRoundTrip(t *testing.T) {
+	for name, tc := range map[string]struct {
+		body    string
+		request interface{}
+	}{
+		"VM restore": {
+			body: `{
+				"reason": "Restore after a failed update",
+				"serviceAccountId": "00000000-0000-0000-0000-00000000dddd",
+				"startVmAfterRestore": true,
+				"disks": ["disk-1"],
+				"toAlternative": {
+					"name": "restored-vm",
+					"subscription": {"id": "sub-1", "environment": "AzurePublic", "status": "", "availability": ""},
+					"diskType": "Premium_LRS",
+					"tags": [{"name": "env", "value": "prod"}],
+					"bootDiagnostics": {"isEnabled": true, "storageAccountId": "sa-1"},
+					"createPublicIp": true
+				}
+			}`,
+			request: &AzureVMRestoreRequest{},
+		},
+		"Cosmos DB policy": {
+			body: `{
+				"backupType": "SelectedItems",
+				"isEnabled": true,
+				"name": "cosmos",
+				"regions": [{"regionId": "eastus"}],
+				"selectedItems": {"cosmosDbAccounts": [{"id": "account-1"}]},
+				"excludedItems": {"cosmosDbAccounts": [{"id": "account-2"}]},
+				"continuousBackupType": "Continuous7Days",
+				"backupWorkloads": ["MongoDB"]
+			}`,
+			request: &ComsmosDbBackupPolicyRequest{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tc.body), tc.request); err != nil {
+				t.Fatal(err)
+			}
+			encoded, err := json.Marshal(tc.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var want, got interface{}
+			if err := json.Unmarshal([]byte(tc.body), &want); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(encoded, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %s, want %s", encoded, tc.body)
+			}
+		})
+	}
+}
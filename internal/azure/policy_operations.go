@@ -0,0 +1,73 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// createdPolicyID returns the ID of the backup policy created by the request resp answers. The
+// appliance answers 201 with the policy, or 202 with an operation that creates the policy in the
+// background; the operation is then waited for and its result holds the policy ID.
+func createdPolicyID(ctx context.Context, client *vc.AzureBackupClient, resp *http.Response) (string, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		var policy struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(body, &policy); err != nil {
+			return "", fmt.Errorf("failed to decode policy response: %w", err)
+		}
+		return policy.ID, nil
+	case http.StatusAccepted:
+		operationID, err := acceptedOperationID(body)
+		if err != nil {
+			return "", err
+		}
+		return waitForOperation(ctx, client, operationID)
+	default:
+		return "", vc.NewVeeamAPIError(resp.StatusCode, body)
+	}
+}
+
+// waitForPolicyUpdate returns once the backup policy update resp answers has been applied,
+// waiting for the operation of a 202 response
+func waitForPolicyUpdate(ctx context.Context, client *vc.AzureBackupClient, resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusAccepted:
+		operationID, err := acceptedOperationID(body)
+		if err != nil {
+			return err
+		}
+		return waitForOperationCompletion(ctx, client, operationID)
+	default:
+		return vc.NewVeeamAPIError(resp.StatusCode, body)
+	}
+}
+
+// acceptedOperationID returns the ID of the operation a 202 response started
+func acceptedOperationID(body []byte) (string, error) {
+	var operation OperationResponse
+	if err := json.Unmarshal(body, &operation); err != nil {
+		return "", fmt.Errorf("failed to parse operation response: %w", err)
+	}
+	if operation.ID == "" {
+		return "", fmt.Errorf("operation ID not found in response: %s", string(body))
+	}
+	return operation.ID, nil
+}
@@ -0,0 +1,73 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// Types of the items backup policies protect, as the API names them
+const (
+	policyTypeVirtualMachine  = "VirtualMachine"
+	policyTypeSQLDatabase     = "SqlDatabase"
+	policyTypeFileShare       = "FileShare"
+	policyTypeCosmosDBAccount = "CosmosDbAccount"
+)
+
+// policyPaths are the backup policy collections of the API by the type of item they protect
+var policyPaths = map[string]string{
+	policyTypeVirtualMachine:  "/policies/virtualMachines",
+	policyTypeSQLDatabase:     "/policies/sql",
+	policyTypeFileShare:       "/policies/fileShares",
+	policyTypeCosmosDBAccount: "/policies/cosmosDb",
+}
+
+// policyCollectionPath returns the path policies of policyType are listed and created at
+func policyCollectionPath(policyType string) string {
+	return policyPaths[policyType]
+}
+
+// policyItemPath returns the path the policy of policyType with the given ID is read, updated and
+// deleted at
+func policyItemPath(policyType, policyID string) string {
+	return vc.Endpoint(policyPaths[policyType]+"/%s", policyID)
+}
+
+// policySummary is a backup policy as the API lists it
+type policySummary struct {
+	ID      string         `json:"id"`
+	Name    string         `json:"name"`
+	Regions []PolicyRegion `json:"regions"`
+}
+
+type policyListResponse struct {
+	Results    []policySummary `json:"results"`
+	TotalCount int             `json:"totalCount"`
+}
+
+// listPolicies returns every backup policy of policyType
+func listPolicies(ctx context.Context, client *vc.AzureBackupClient, policyType string) ([]policySummary, error) {
+	policies, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, offset, limit int) (vc.Page[policySummary], error) {
+		params := url.Values{}
+		params.Set("Offset", strconv.Itoa(offset))
+		params.Set("Limit", strconv.Itoa(limit))
+		body, err := doSettingsRequest(ctx, client, http.MethodGet, vc.WithQuery(policyCollectionPath(policyType), params), nil)
+		if err != nil {
+			return vc.Page[policySummary]{}, fmt.Errorf("failed to list Azure %s backup policies: %w", policyType, err)
+		}
+
+		var response policyListResponse
+		if err := json.Unmarshal(body, &response); err != nil {
+			return vc.Page[policySummary]{}, fmt.Errorf("failed to parse response: %w", err)
+		}
+		return vc.Page[policySummary]{Items: response.Results, Total: response.TotalCount}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policies.Items, nil
+}
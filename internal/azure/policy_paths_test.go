@@ -0,0 +1,31 @@
+package azure
+
+import "testing"
+
+func TestPolicyPaths(t *testing.T) {
+	tests := []struct {
+		policyType string
+		collection string
+		item       string
+	}{
+		{policyTypeVirtualMachine, "/policies/virtualMachines", "/policies/virtualMachines/0e4a1c62-5e1a-4a2b-9a41-1b0d8b6f0a11"},
+		{policyTypeSQLDatabase, "/policies/sql", "/policies/sql/0e4a1c62-5e1a-4a2b-9a41-1b0d8b6f0a11"},
+		{policyTypeFileShare, "/policies/fileShares", "/policies/fileShares/0e4a1c62-5e1a-4a2b-9a41-1b0d8b6f0a11"},
+		{policyTypeCosmosDBAccount, "/policies/cosmosDb", "/policies/cosmosDb/0e4a1c62-5e1a-4a2b-9a41-1b0d8b6f0a11"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policyType, func(t *testing.T) {
+			if got := policyCollectionPath(tt.policyType); got != tt.collection {
+				t.Errorf("policyCollectionPath() = %q, want %q", got, tt.collection)
+			}
+			if got := policyItemPath(tt.policyType, "0e4a1c62-5e1a-4a2b-9a41-1b0d8b6f0a11"); got != tt.item {
+				t.Errorf("policyItemPath() = %q, want %q", got, tt.item)
+			}
+		})
+	}
+
+	// IDs are user input when importing, so they must not be able to reach other endpoints
+	if got, want := policyItemPath(policyTypeSQLDatabase, "a/../../accounts"), "/policies/sql/a%2F..%2F..%2Faccounts"; got != want {
+		t.Errorf("policyItemPath() = %q, want %q", got, want)
+	}
+}
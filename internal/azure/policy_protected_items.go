@@ -0,0 +1,145 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// countRestorePointsSchema is the count_restore_points attribute of the backup policy resources
+func countRestorePointsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Whether every refresh reads protected_item_count and restore_point_count. The API cannot filter protected items by policy, " +
+			"so this lists every protected item of the policy type, which takes long on large appliances. Defaults to false.",
+	}
+}
+
+// protectedItemCountSchema is the protected_item_count attribute of the backup policy resources
+func protectedItemCountSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of protected items the policy has created restore points of. Only read when count_restore_points is true.",
+	}
+}
+
+// restorePointCountSchema is the restore_point_count attribute of the backup policy resources
+func restorePointCountSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of restore points of the items protected by the policy, both snapshots and backups in repositories. Only read when count_restore_points is true.",
+	}
+}
+
+// onlyCountRestorePointsChanged reports whether an update only changes count_restore_points,
+// which is not sent to the API
+func onlyCountRestorePointsChanged(d *schema.ResourceData) bool {
+	return !d.HasChangeExcept("count_restore_points")
+}
+
+// listPolicyProtectedItems returns the protected items of policyType the policy with the given
+// name has created restore points of. The API reports the policy of a protected item by name.
+func listPolicyProtectedItems(ctx context.Context, client *vc.AzureBackupClient, policyType, policyName string) ([]AzureProtectedItem, error) {
+	items, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, offset, limit int) (vc.Page[AzureProtectedItem], error) {
+		params := url.Values{}
+		params.Set("Offset", strconv.Itoa(offset))
+		params.Set("Limit", strconv.Itoa(limit))
+		body, err := doSettingsRequest(ctx, client, http.MethodGet, vc.WithQuery(protectedItemPaths[policyType], params), nil)
+		if err != nil {
+			return vc.Page[AzureProtectedItem]{}, err
+		}
+
+		var itemsResponse AzureProtectedItemsResponse
+		if err := json.Unmarshal(body, &itemsResponse); err != nil {
+			return vc.Page[AzureProtectedItem]{}, fmt.Errorf("failed to parse response: %w", err)
+		}
+		return vc.Page[AzureProtectedItem]{Items: itemsResponse.Results, Total: itemsResponse.TotalCount}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the items protected by backup policy %q: %w", policyName, err)
+	}
+
+	var protected []AzureProtectedItem
+	for _, item := range items.Items {
+		if strings.EqualFold(item.PolicyName, policyName) {
+			protected = append(protected, item)
+		}
+	}
+	return protected, nil
+}
+
+// readPolicyRestorePointCounts sets protected_item_count and restore_point_count of the policy with
+// the given name when count_restore_points is true. The counts are informational, so a failure to
+// list the protected items, e.g. for lack of permissions, is only logged and keeps the previous
+// counts.
+func readPolicyRestorePointCounts(ctx context.Context, client *vc.AzureBackupClient, d *schema.ResourceData, policyType, policyName string) {
+	// The API does not return count_restore_points, so it is defaulted after an import
+	count, ok := d.GetOkExists("count_restore_points")
+	if !ok {
+		d.Set("count_restore_points", false)
+	}
+	if !count.(bool) {
+		d.Set("protected_item_count", nil)
+		d.Set("restore_point_count", nil)
+		return
+	}
+
+	items, err := listPolicyProtectedItems(ctx, client, policyType, policyName)
+	if err != nil {
+		tflog.Warn(ctx, "could not count the restore points of the Azure backup policy", map[string]interface{}{
+			"policy_id": d.Id(),
+			"error":     err.Error(),
+		})
+		return
+	}
+	protectedItems, restorePoints := countRestorePoints(items)
+	d.Set("protected_item_count", protectedItems)
+	d.Set("restore_point_count", restorePoints)
+}
+
+func countRestorePoints(items []AzureProtectedItem) (protectedItems, restorePoints int) {
+	for _, item := range items {
+		restorePoints += item.RestorePointsCount
+	}
+	return len(items), restorePoints
+}
+
+// keptRestorePointsWarning returns a warning when the policy of policyType, which is about to be
+// deleted, leaves restore points behind. Since the counts are only read on refresh when
+// count_restore_points is true, the protected items are listed here, once per destroy. A failure
+// to list them is only logged.
+func keptRestorePointsWarning(ctx context.Context, client *vc.AzureBackupClient, d *schema.ResourceData, policyType string) diag.Diagnostics {
+	policyName := d.Get("name").(string)
+	items, err := listPolicyProtectedItems(ctx, client, policyType, policyName)
+	if err != nil {
+		tflog.Warn(ctx, "could not count the restore points kept by the deleted Azure backup policy", map[string]interface{}{
+			"policy_id": d.Id(),
+			"error":     err.Error(),
+		})
+		return nil
+	}
+	protectedItems, restorePoints := countRestorePoints(items)
+	if restorePoints == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Restore points kept",
+		Detail: fmt.Sprintf("Backup policy %q was deleted, but the %d restore points of its %d protected items were kept. "+
+			"They can still be restored from until they are removed on the appliance.",
+			policyName, restorePoints, protectedItems),
+	}}
+}
@@ -71,7 +71,7 @@ type ComsmosDbBackupPolicyResponse struct {
 
 
 type CosmosDbBackupPolicySelectedItems struct {
-	CosmosDbAccounts *[]CosmosDbPolicyItems  `json:"cosmosDbAccounts,omitempty`     
+	CosmosDbAccounts *[]CosmosDbPolicyItems  `json:"cosmosDbAccounts,omitempty"`     
 	Subscriptions   *[]AzureSubscriptions    `json:"subscriptions,omitempty"`
 	ResourceGroups  *[]AzureResourceGroups   `json:"resourceGroups,omitempty"`
 	TagGroups       *[]AzureTagGroups        `json:"tagGroups,omitempty"`
@@ -79,7 +79,7 @@ type CosmosDbBackupPolicySelectedItems struct {
 }
 
 type CosmosDbBackupPolicyExcludedItems struct {
-	CosmosDbAccounts *[]CosmosDbPolicyItems  `json:"cosmosDbAccounts,omitempty`     
+	CosmosDbAccounts *[]CosmosDbPolicyItems  `json:"cosmosDbAccounts,omitempty"`     
 	Tags            *[]Tags                   `json:"tags,omitempty"`
 }
 
@@ -90,18 +90,21 @@ type  CosmosDbPolicyItems struct {
 
 // Azure Cosmos DB Backup policy terraform schema
 func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: ResourceAzureCosmosBackupPolicyCreate,
 		ReadContext:   ResourceAzureCosmosBackupPolicyRead,
 		UpdateContext: ResourceAzureCosmosBackupPolicyUpdate,
 		DeleteContext: ResourceAzureCosmosBackupPolicyDelete,
+		CustomizeDiff: resourceAzureCosmosBackupPolicyCustomizeDiff,
 
+		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"backup_type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Defines whether you want to include to the backup scope all resources residing in the specified Azure regions.",
-				ValidateFunc: validation.StringInSlice([]string{"AllSubscriptions", "SelectedItems", "Unknown"}, false),
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Defines whether you want to include to the backup scope all resources residing in the specified Azure regions.",
+				DiffSuppressFunc: vc.SuppressCaseDifference,
+				ValidateFunc:     validation.StringInSlice([]string{"AllSubscriptions", "SelectedItems", "Unknown"}, true),
 			},
 			"is_enabled": {
 				Type:        schema.TypeBool,
@@ -115,16 +118,18 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 255),
 			},
 			"regions": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Required:    true,
 				MinItems:    1,
 				Description: "Specifies Azure regions where the resources that will be backed up reside.",
+				Set:         vc.HashFieldIgnoreCase("name"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Azure region name.",
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "Azure region name.",
+							DiffSuppressFunc: vc.SuppressCaseDifference,
 						},
 					},
 				},
@@ -132,6 +137,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Specifies a Microsoft Azure ID assigned to a tenant.",
 			},
 			"service_account_id": {
@@ -148,7 +154,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"subscriptions": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of Azure subscription IDs to include in the backup scope.",
 							Elem: &schema.Resource{
@@ -162,7 +168,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 							},
 						},
 						"tags": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of tags assigned to Azure resources to include in the backup scope.",
 							Elem: &schema.Resource{
@@ -181,7 +187,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 							},
 						},
 						"resource_groups": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of Azure resource groups to include in the backup scope.",
 							Elem: &schema.Resource{
@@ -195,7 +201,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 							},
 						},
 						"cosmos_db_accounts": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of protected Cosmos DB accounts.",
 							Elem: &schema.Resource{
@@ -209,7 +215,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 							},
 						},
 						"tag_groups": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of tag groups assigned to Azure resources to include in the backup scope.",
 							Elem: &schema.Resource{
@@ -219,10 +225,25 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 										Required:    true,
 										Description: "Tag group name.",
 									},
+									"subscription": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "Specifies a list of Azure subscription IDs to include in the tag group. The appliance scopes a tag group to a single subscription, so a tag group with several is sent as one tag group per subscription.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"subscription_id": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "Azure subscription ID.",
+												},
+											},
+										},
+									},
 									"subsciption": {
 										Type:        schema.TypeList,
 										Optional:    true,
-										Description: "Specifies a list of Azure subscription IDs to include in the tag group.",
+										Deprecated:  "Use subscription instead.",
+										Description: "Deprecated misspelling of subscription, accepted for configurations written for earlier versions. Ignored when subscription is set.",
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"subscription_id": {
@@ -236,7 +257,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 									"resource_groups": {
 										Type:        schema.TypeList,
 										Optional:    true,
-										Description: "Specifies a list of Azure resource groups to include in the tag group.",
+										Description: "Specifies a list of Azure resource groups to include in the tag group. The appliance scopes a tag group to a single resource group, so a tag group with several is sent as one tag group per resource group.",
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"id": {
@@ -248,7 +269,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 										},
 									},
 									"tags": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies a list of tags assigned to Azure resources to include in the tag group.",
 										Elem: &schema.Resource{
@@ -279,7 +300,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cosmos_db_accounts": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of protected Cosmos DB accounts.",
 							Elem: &schema.Resource{
@@ -293,7 +314,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 							},
 						},
 						"tags": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of tags assigned to Azure resources to exclude from the backup policy.",
 							Elem: &schema.Resource{
@@ -317,7 +338,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 			"continuous_backup_type": {
 				Type: schema.TypeString,
 				Optional: true,
-				Description: "Specifies the retention period for Cosmos DB continuous backup.",
+				Description: "Specifies the retention period for Cosmos DB continuous backup. Continuous backup protects the accounts natively in Azure, independently of the Backup to repository option.",
 				ValidateFunc: validation.StringInSlice([]string{"Continuous7Days", "Continuous30Days"}, false),
 			},
 			"description": {
@@ -377,9 +398,9 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 				Optional: true,
 			},
 			"backup_workloads": {
-				Type: 	schema.TypeList,
+				Type: 	schema.TypeSet,
 				Optional: true,
-				Description: "Specifies kinds of the Cosmos DB accounts protected using the Backup to repository option.",
+				Description: "Specifies kinds of the Cosmos DB accounts protected using the Backup to repository option. Required by, and only allowed with, the schedules and default_backup_account_id, which configure that option.",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 					ValidateFunc: validation.StringInSlice([]string{"PostgreSQL", "MongoDB"}, false),
@@ -398,7 +419,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{"EveryDay", "Weekdays", "SelectedDays", "Unknown"}, false),
 						},
 						"selected_days": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies the days of the week when backups should be performed if the daily type is SelectedDays.",
 							Elem: &schema.Schema{
@@ -413,7 +434,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"hours": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the hours when backups should be performed.",
 										Elem: &schema.Schema{
@@ -442,9 +463,10 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 										},
 									},
 									"target_repository_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Specifies the system ID of the target repository for daily backups.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the system ID of the target repository for daily backups.",
 									},
 								},
 							},
@@ -470,7 +492,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"selected_days": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the days of the week when backups should be performed.",
 										Elem: &schema.Schema{
@@ -499,9 +521,10 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 										},
 									},
 									"target_repository_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Specifies the system ID of the target repository for weekly backups.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the system ID of the target repository for weekly backups.",
 									},
 								},
 							},
@@ -549,7 +572,7 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"selected_months": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the months when backups should be performed.",
 										Elem: &schema.Schema{
@@ -578,9 +601,10 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 										},
 									},
 									"target_repository_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Specifies the system ID of the target repository for monthly backups.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the system ID of the target repository for monthly backups.",
 									},
 								},
 							},
@@ -633,100 +657,95 @@ func ResourceAzureCosmosDbBackupPolicy() *schema.Resource {
 							Description: "Specifies the number of years to retain yearly backups.",
 						},
 						"target_repository_id": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Specifies the system ID of the target repository for yearly backups.",
-						},
-					},
-				},
-			},
-			"health_check_schedule": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Specifies health check settings for the backup policy.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"health_check_enabled": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     false,
-							Description: "Defines whether health checks are enabled for the backup policy.",
-						},
-						"local_time": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Specifies the date and time when the health check will run.",
-						},
-						"day_number_in_month": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Description:  "Specifies the day number in the month when the health check will run.",
-							ValidateFunc: validation.StringInSlice([]string{"First", "Second", "Third", "Fourth", "Last", "OnDay", "EveryDay", "EverySelectedDay", "Unknown"}, false),
-						},
-						"day_of_week": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Description:  "Specifies the day of the week when the health check will run.",
-							ValidateFunc: validation.StringInSlice([]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}, false),
-						},
-						"day_of_month": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Specifies the day of the month when the health check will run.",
-						},
-						"months": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							Description: "Specifies the months when the health check will run.",
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}, false),
-							},
+							ValidateFunc: validation.IsUUID,
+							Description:  "Specifies the system ID of the target repository for yearly backups.",
 						},
 					},
 				},
 			},
+			"health_check_schedule": healthCheckScheduleSchema(),
 			"default_backup_account_id":{
 					Type:         schema.TypeString,
 					Optional:     true,
 					Description: "[Applies only to backup policies that have the Backup to repository option enabled] Specifies the system ID assigned in the Veeam Backup for Microsoft Azure REST API to a default database account that will be used to access all protected databases.",
 			},
+			"count_restore_points": countRestorePointsSchema(),
+			"protected_item_count": protectedItemCountSchema(),
+			"restore_point_count":  restorePointCountSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		// tag_groups.subscription was misspelled subsciption in version 0. Configurations that still
+		// use the deprecated alias plan a one-time update after the upgrade, which the API ignores.
+		vc.RenameAttributeUpgrader(r, 0, "selected_items.tag_groups", "subsciption", "subscription"),
+	}
+	return r
 }
 
 
+// cosmosBackupSchedules configure the Backup to repository option of a Cosmos DB backup policy,
+// which only applies to the accounts of the kinds in backup_workloads
+var cosmosBackupSchedules = []string{"daily_schedule", "weekly_schedule", "monthly_schedule", "yearly_schedule"}
+
+// resourceAzureCosmosBackupPolicyCustomizeDiff rejects Backup to repository settings without the
+// account kinds they apply to, and account kinds without a schedule to back them up
+func resourceAzureCosmosBackupPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("backup_workloads") {
+		return nil
+	}
+	hasWorkloads := d.Get("backup_workloads").(*schema.Set).Len() > 0
+
+	var repositorySettings []string
+	hasSchedule := false
+	for _, attr := range append(cosmosBackupSchedules, "default_backup_account_id") {
+		if _, ok := d.GetOk(attr); ok {
+			repositorySettings = append(repositorySettings, attr)
+			hasSchedule = hasSchedule || attr != "default_backup_account_id"
+		}
+	}
+
+	if !hasWorkloads && len(repositorySettings) > 0 {
+		return fmt.Errorf("the Backup to repository option, configured by %s, requires backup_workloads; continuous backup does not use schedules", strings.Join(repositorySettings, ", "))
+	}
+	if hasWorkloads && !hasSchedule {
+		return fmt.Errorf("backup_workloads requires at least one of %s, to back up the accounts to a repository", strings.Join(cosmosBackupSchedules, ", "))
+	}
+	return nil
+}
+
 func ResourceAzureCosmosBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := vc.GetAzureClient(meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	policyRequest := buildCosmosBackupPolicyRequest(d)
+	policyRequest := buildCosmosBackupPolicyRequest(d, client)
 
 	jsonData, err := json.Marshal(policyRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to marshal Cosmos DB Backup Policy request: %w", err))
 	}
 
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/cosmosDb/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("POST", url, strings.NewReader(string(jsonData)))
+	url := client.BuildAPIURL(policyCollectionPath(policyTypeCosmosDBAccount))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to create Cosmos DB Backup Policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("Failed to create Cosmos DB Backup Policy, status: %s, response: %s", resp.Status, string(bodyBytes)))
-	}
-
-	var policyResponse ComsmosDbBackupPolicyResponse
-	if err := json.NewDecoder(resp.Body).Decode(&policyResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to decode Cosmos DB Backup Policy creation response: %w", err))
+	policyID, err := createdPolicyID(ctx, client, resp)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Failed to create Cosmos DB Backup Policy: %w", err))
 	}
-	defer resp.Body.Close()
 
-	d.SetId(policyResponse.ID)
+	d.SetId(policyID)
 	return ResourceAzureCosmosBackupPolicyRead(ctx, d, meta)
 }
 
@@ -735,8 +754,8 @@ func ResourceAzureCosmosBackupPolicyRead(ctx context.Context, d *schema.Resource
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/cosmosDb/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("GET", url, nil)
+	url := client.BuildAPIURL(policyItemPath(policyTypeCosmosDBAccount, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to read Cosmos DB Backup Policy: %w", err))
 	}
@@ -744,7 +763,11 @@ func ResourceAzureCosmosBackupPolicyRead(ctx context.Context, d *schema.Resource
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("Failed to read Cosmos DB Backup Policy, status: %s, response: %s", resp.Status, string(bodyBytes)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, bodyBytes)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Failed to read Cosmos DB Backup Policy: %w", apiErr))
 	}
 
 	var policyResponse ComsmosDbBackupPolicyResponse
@@ -752,15 +775,23 @@ func ResourceAzureCosmosBackupPolicyRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("Failed to decode Cosmos DB Backup Policy creation response: %w", err))
 	}
 
+	readPolicyRestorePointCounts(ctx, client, d, policyTypeCosmosDBAccount, policyResponse.Name)
+
 	// Map response fields to resource data
 	d.Set("backup_type", policyResponse.BackupType)
 	d.Set("is_enabled", policyResponse.IsEnabled)
 	d.Set("name", policyResponse.Name)
-	d.Set("description", policyResponse.Description)
+	var description string
+	if policyResponse.Description != nil {
+		description = *policyResponse.Description
+	}
+	d.Set("description", client.TrimDescriptionSuffix(description))
 	d.Set("tenant_id", policyResponse.TenantID)
 	d.Set("is_enabled", policyResponse.IsEnabled)
 	d.Set("service_account_id", policyResponse.ServiceAccountID)
 	d.Set("backup_type", policyResponse.BackupType)
+	d.Set("continuous_backup_type", policyResponse.ContinuousBackupType)
+	d.Set("backup_workloads", policyResponse.BackupWorkloads)
 
 	// Note: Regions are not returned in the response, so we keep the value from Terraform state
 	// Additional fields mapping can be added here as needed
@@ -770,27 +801,29 @@ func ResourceAzureCosmosBackupPolicyRead(ctx context.Context, d *schema.Resource
 }
 
 func ResourceAzureCosmosBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if onlyCountRestorePointsChanged(d) {
+		return ResourceAzureCosmosBackupPolicyRead(ctx, d, meta)
+	}
 	client, err := vc.GetAzureClient(meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	policyRequest := buildCosmosBackupPolicyRequest(d)
+	policyRequest := buildCosmosBackupPolicyRequest(d, client)
 
 	jsonData, err := json.Marshal(policyRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to marshal Cosmos DB Backup Policy request: %w", err))
 	}
 
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/cosmosDb/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("PUT", url, strings.NewReader(string(jsonData)))
+	url := client.BuildAPIURL(policyItemPath(policyTypeCosmosDBAccount, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "PUT", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to update Cosmos DB Backup Policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to update Cosmos DB backup policy (status %d): %s", resp.StatusCode, string(body)))
+	if err := waitForPolicyUpdate(ctx, client, resp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update Cosmos DB backup policy: %w", err))
 	}
 
 	return ResourceAzureCosmosBackupPolicyRead(ctx, d, meta)
@@ -802,24 +835,27 @@ func ResourceAzureCosmosBackupPolicyDelete(ctx context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	kept := keptRestorePointsWarning(ctx, client, d, policyTypeCosmosDBAccount)
 
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/cosmosDb/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("DELETE", url, nil)
+	url := client.BuildAPIURL(policyItemPath(policyTypeCosmosDBAccount, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete Cosmos DB backup policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+	if resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to delete Cosmos DB backup policy: %s", string(body)))
+		if apiErr := vc.NewVeeamAPIError(resp.StatusCode, body); !vc.IsGone(apiErr) {
+			return diag.FromErr(fmt.Errorf("failed to delete Cosmos DB backup policy: %w", apiErr))
+		}
 	}
 
 	d.SetId("")
-	return nil
+	return kept
 }
 
-func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolicyRequest {
+func buildCosmosBackupPolicyRequest(d *schema.ResourceData, client *vc.AzureBackupClient) ComsmosDbBackupPolicyRequest {
 	tenantID := d.Get("tenant_id").(string)
 	serviceAccountID := d.Get("service_account_id").(string)
 	
@@ -837,14 +873,22 @@ func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolic
 		request.ID = &id
 	}
 
-	if desc, ok := d.GetOk("description"); ok {
-		description := desc.(string)
+	if description := client.AppendDescriptionSuffix(d.Get("description").(string)); description != "" {
 		request.Description = &description
 	}
 
+	if v, ok := d.GetOk("continuous_backup_type"); ok {
+		continuousBackupType := v.(string)
+		request.ContinuousBackupType = &continuousBackupType
+	}
+	if v, ok := d.GetOk("backup_workloads"); ok {
+		backupWorkloads := convertSetToStringSlice(v.(*schema.Set))
+		request.BackupWorkloads = &backupWorkloads
+	}
+
 	// Build regions
 	if regionsData, ok := d.GetOk("regions"); ok {
-		regions := regionsData.([]interface{})
+		regions := regionsData.(*schema.Set).List()
 		for _, r := range regions {
 			region := r.(map[string]interface{})
 			policyRegion := PolicyRegion{
@@ -863,7 +907,7 @@ func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolic
 
 			// Handle Cosmos DB Accounts
 			if cdbs, ok := selectedItemsMap["cosmos_db_accounts"]; ok && cdbs != nil {
-				cdbsList := cdbs.([]interface{})
+				cdbsList := cdbs.(*schema.Set).List()
 				cosmosDbAccounts := []CosmosDbPolicyItems{}
 				for _, cdb := range cdbsList {
 					cdbMap := cdb.(map[string]interface{})
@@ -876,111 +920,19 @@ func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolic
 				selectedItems.CosmosDbAccounts = &cosmosDbAccounts
 			}
 
-			// Handle subscriptions
+			// Handle subscriptions, tags, resource groups and tag groups
 			if subs, ok := selectedItemsMap["subscriptions"]; ok && subs != nil {
-				subsList := subs.([]interface{})
-				if len(subsList) > 0 {
-					subscriptions := []AzureSubscriptions{}
-					for _, sub := range subsList {
-						subMap := sub.(map[string]interface{})
-						subscription := AzureSubscriptions{
-							SubscriptionID: subMap["subscription_id"].(string),
-						}
-						subscriptions = append(subscriptions, subscription)
-					}
-					selectedItems.Subscriptions = &subscriptions
-				}
+				selectedItems.Subscriptions = expandAzureSubscriptions(subs.(*schema.Set))
 			}
-
-			// Handle tags
-			if tags, ok := selectedItemsMap["tags"]; ok && tags != nil {
-				tagsList := tags.([]interface{})
-				if len(tagsList) > 0 {
-					tagsArray := []Tags{}
-					for _, tag := range tagsList {
-						tagMap := tag.(map[string]interface{})
-						tagObj := Tags{
-							Name:  tagMap["name"].(string),
-							Value: tagMap["value"].(string),
-						}
-						tagsArray = append(tagsArray, tagObj)
-					}
-					selectedItems.Tags = &tagsArray
-				}
+			if tags, ok := selectedItemsMap["tags"]; ok && tags != nil && tags.(*schema.Set).Len() > 0 {
+				tagsArray := expandTags(tags.(*schema.Set))
+				selectedItems.Tags = &tagsArray
 			}
-
-			// Handle resource groups
 			if rgs, ok := selectedItemsMap["resource_groups"]; ok && rgs != nil {
-				rgsList := rgs.([]interface{})
-				if len(rgsList) > 0 {
-					resourceGroups := []AzureResourceGroups{}
-					for _, rg := range rgsList {
-						rgMap := rg.(map[string]interface{})
-						resourceGroup := AzureResourceGroups{
-							ID: rgMap["id"].(string),
-						}
-						resourceGroups = append(resourceGroups, resourceGroup)
-					}
-					selectedItems.ResourceGroups = &resourceGroups
-				}
+				selectedItems.ResourceGroups = expandAzureResourceGroups(rgs.(*schema.Set))
 			}
-
-			// Handle tag groups
 			if tgs, ok := selectedItemsMap["tag_groups"]; ok && tgs != nil {
-				tgsList := tgs.([]interface{})
-				if len(tgsList) > 0 {
-					tagGroups := []AzureTagGroups{}
-					for _, tg := range tgsList {
-						tgMap := tg.(map[string]interface{})
-						tagGroup := AzureTagGroups{
-							Name: tgMap["name"].(string),
-						}
-
-						// Handle subscription in tag group (singular)
-						if tgSubs, ok := tgMap["subsciption"]; ok && tgSubs != nil {
-							tgSubsList := tgSubs.([]interface{})
-							if len(tgSubsList) > 0 && len(tgSubsList[0].(map[string]interface{})) > 0 {
-								subMap := tgSubsList[0].(map[string]interface{})
-								subscription := &AzureSubscriptions{
-									SubscriptionID: subMap["subscription_id"].(string),
-								}
-								tagGroup.Subscription = subscription
-							}
-						}
-
-						// Handle resource groups in tag group (singular)
-						if tgRgs, ok := tgMap["resource_groups"]; ok && tgRgs != nil {
-							tgRgsList := tgRgs.([]interface{})
-							if len(tgRgsList) > 0 && len(tgRgsList[0].(map[string]interface{})) > 0 {
-								rgMap := tgRgsList[0].(map[string]interface{})
-								resourceGroup := &AzureResourceGroups{
-									ID: rgMap["id"].(string),
-								}
-								tagGroup.ResourceGroups = resourceGroup
-							}
-						}
-
-						// Handle tags in tag group
-						if tgTags, ok := tgMap["tags"]; ok && tgTags != nil {
-							tgTagsList := tgTags.([]interface{})
-							if len(tgTagsList) > 0 {
-								tags := []Tags{}
-								for _, tag := range tgTagsList {
-									tagMap := tag.(map[string]interface{})
-									tagObj := Tags{
-										Name:  tagMap["name"].(string),
-										Value: tagMap["value"].(string),
-									}
-									tags = append(tags, tagObj)
-								}
-								tagGroup.Tags = tags
-							}
-						}
-
-						tagGroups = append(tagGroups, tagGroup)
-					}
-					selectedItems.TagGroups = &tagGroups
-				}
+				selectedItems.TagGroups = expandAzureTagGroups(tgs.(*schema.Set))
 			}
 
 			request.SelectedItems = &selectedItems
@@ -996,7 +948,7 @@ func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolic
 
 			// Handle Cosmos DB Accounts
 			if cdbs, ok := excludedItemsMap["cosmos_db_accounts"]; ok && cdbs != nil {
-				cdbsList := cdbs.([]interface{})
+				cdbsList := cdbs.(*schema.Set).List()
 				cosmosDbAccounts := []CosmosDbPolicyItems{}
 				for _, cdb := range cdbsList {
 					cdbMap := cdb.(map[string]interface{})
@@ -1010,7 +962,7 @@ func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolic
 			}
 			// Handle tags
 			if tags, ok := excludedItemsMap["tags"]; ok && tags != nil {
-				tagsList := tags.([]interface{})
+				tagsList := tags.(*schema.Set).List()
 				if len(tagsList) > 0 {
 					tagsArray := []Tags{}
 					for _, tag := range tagsList {
@@ -1074,7 +1026,7 @@ func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolic
 				dailySchedule.DailyType = &dailyTypeStr
 			}
 			if selectedDays, ok := dailyMap["selected_days"]; ok && selectedDays != nil {
-				daysList := selectedDays.([]interface{})
+				daysList := selectedDays.(*schema.Set).List()
 				days := []string{}
 				for _, day := range daysList {
 					days = append(days, day.(string))
@@ -1095,7 +1047,7 @@ func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolic
 
 					// Only include hours if explicitly set and not empty
 					if hours, ok := backupSchedMap["hours"]; ok && hours != nil {
-						hoursList := hours.([]interface{})
+						hoursList := hours.(*schema.Set).List()
 						if len(hoursList) > 0 {
 							hoursArray := []int{}
 							for _, hour := range hoursList {
@@ -1154,7 +1106,7 @@ func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolic
 					backupSchedule := BackupSchedule{}
 
 					if selectedDays, ok := backupSchedMap["selected_days"]; ok && selectedDays != nil {
-						daysList := selectedDays.([]interface{})
+						daysList := selectedDays.(*schema.Set).List()
 						days := []string{}
 						for _, day := range daysList {
 							days = append(days, day.(string))
@@ -1226,7 +1178,7 @@ func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolic
 					backupSchedule := BackupSchedule{}
 
 					if selectedMonths, ok := backupSchedMap["selected_months"]; ok && selectedMonths != nil {
-						monthsList := selectedMonths.([]interface{})
+						monthsList := selectedMonths.(*schema.Set).List()
 						months := []string{}
 						for _, month := range monthsList {
 							months = append(months, month.(string))
@@ -1307,43 +1259,8 @@ func buildCosmosBackupPolicyRequest(d *schema.ResourceData) ComsmosDbBackupPolic
 	}
 
 	// Build health check settings
-	if healthData, ok := d.GetOk("health_check_settings"); ok {
-		healthList := healthData.([]interface{})
-		if len(healthList) > 0 {
-			healthMap := healthList[0].(map[string]interface{})
-			healthSchedule := HealthCheckSchedule{}
-
-			if enabled, ok := healthMap["health_check_enabled"]; ok {
-				enabledBool := enabled.(bool)
-				healthSchedule.HealthCheckEnabled = &enabledBool
-			}
-			if localTime, ok := healthMap["local_time"]; ok && localTime != "" {
-				timeStr := localTime.(string)
-				healthSchedule.LocalTime = &timeStr
-			}
-			if dayNumberInMonth, ok := healthMap["day_number_in_month"]; ok && dayNumberInMonth != "" {
-				dayNum := dayNumberInMonth.(string)
-				healthSchedule.DayNumberInMonth = &dayNum
-			}
-			if dayOfWeek, ok := healthMap["day_of_week"]; ok && dayOfWeek != "" {
-				dow := dayOfWeek.(string)
-				healthSchedule.DayOfWeek = &dow
-			}
-			if dayOfMonth, ok := healthMap["day_of_month"]; ok {
-				dom := dayOfMonth.(int)
-				healthSchedule.DayOfMonth = &dom
-			}
-			if months, ok := healthMap["months"]; ok && months != nil {
-				monthsList := months.([]interface{})
-				monthsArray := []string{}
-				for _, month := range monthsList {
-					monthsArray = append(monthsArray, month.(string))
-				}
-				healthSchedule.Months = monthsArray
-			}
-
-			request.HealthCheckSchedule = &healthSchedule
-		}
+	if healthData, ok := d.GetOk("health_check_schedule"); ok {
+		request.HealthCheckSchedule = expandHealthCheckSchedule(healthData.([]interface{}))
 	}
 
 	return request
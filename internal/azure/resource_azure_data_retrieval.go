@@ -0,0 +1,279 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Data retrieval statuses reported on a restore point by the Veeam Backup for Microsoft Azure REST API.
+const (
+	azureDataRetrievalStatusNone       = "None"
+	azureDataRetrievalStatusRetrieving = "Retrieving"
+	azureDataRetrievalStatusRetrieved  = "Retrieved"
+)
+
+// AzureDataRetrievalRequest starts retrieval of archived restore point data.
+type AzureDataRetrievalRequest struct {
+	DataRetrievalPriority string `json:"dataRetrievalPriority"`
+	DaysToKeep            int    `json:"daysToKeep"`
+}
+
+func ResourceAzureDataRetrieval() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Starts data retrieval for an Azure VM restore point stored in the Archive access tier. Retrieved data must be available before the restore point can be used for a restore.",
+		CreateContext: ResourceAzureDataRetrievalCreate,
+		ReadContext:   ResourceAzureDataRetrievalRead,
+		UpdateContext: ResourceAzureDataRetrievalUpdate,
+		DeleteContext: ResourceAzureDataRetrievalDelete,
+		Schema: map[string]*schema.Schema{
+			"restore_point_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "Specifies the system ID assigned to the archived restore point in the Veeam Backup for Microsoft Azure REST API.",
+			},
+			"data_retrieval_priority": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Standard",
+				ValidateFunc: validation.StringInSlice([]string{"Standard", "High"}, false),
+				Description:  "Specifies the priority of the data retrieval. Valid values are Standard and High. High priority retrieval completes faster at a higher cost.",
+			},
+			"days_to_keep": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 60),
+				Description:  "Specifies the number of days for which the retrieved data remains available.",
+			},
+			"wait_for_ready": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, creation waits until the retrieved data is available or the create timeout is reached. A timeout is reported as a warning and leaves is_ready false, without starting another retrieval.",
+			},
+			"session_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The session ID of the data retrieval operation.",
+			},
+			"data_retrieval_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current data retrieval status of the restore point. Possible values are None, Retrieving, Retrieved and Unknown.",
+			},
+			"retrieved_data_expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time when the retrieval period expires.",
+			},
+			"is_ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the retrieved data is available and the restore point can be used for a restore.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(12 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func ResourceAzureDataRetrievalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	restorePointID := d.Get("restore_point_id").(string)
+	request := AzureDataRetrievalRequest{
+		DataRetrievalPriority: d.Get("data_retrieval_priority").(string),
+		DaysToKeep:            d.Get("days_to_keep").(int),
+	}
+
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal data retrieval request: %w", err))
+	}
+
+	url := client.BuildAPIURL(vc.Endpoint("/restorePoints/virtualMachines/%s/dataRetrieval", restorePointID))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to start data retrieval: %w", err))
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read data retrieval response: %w", err))
+	}
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return diag.FromErr(fmt.Errorf("failed to start data retrieval: %w", vc.NewVeeamAPIError(resp.StatusCode, body)))
+	}
+
+	var session AzureVMRestoreResponse
+	if err := json.Unmarshal(body, &session); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to decode data retrieval response: %w", err))
+	}
+
+	d.SetId(restorePointID)
+	if session.ID != nil {
+		if err := d.Set("session_id", *session.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to set session_id: %w", err))
+		}
+	}
+
+	if d.Get("wait_for_ready").(bool) {
+		// The retrieval is billed once started, so a failed wait must not taint the resource and
+		// start another one. The last status is stored instead, and is_ready reports completion.
+		if status, err := waitForAzureDataRetrieval(ctx, client, restorePointID); err != nil {
+			d.Set("data_retrieval_status", status)
+			d.Set("is_ready", false)
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Data retrieval is not ready",
+				Detail:   fmt.Sprintf("The data retrieval was started, but waiting for it failed: %s. is_ready becomes true once the data is available.", err),
+			}}
+		}
+	}
+
+	return ResourceAzureDataRetrievalRead(ctx, d, meta)
+}
+
+func ResourceAzureDataRetrievalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	restorePoint, found, err := getAzureVMRestorePoint(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	status := ""
+	if restorePoint.DataRetrievalStatus != nil {
+		status = *restorePoint.DataRetrievalStatus
+	}
+
+	// The restore point returns to None once the retrieved data expires. The retrieval is then
+	// removed from state, so that the next plan starts a new one.
+	previous := d.Get("data_retrieval_status").(string)
+	if status == azureDataRetrievalStatusNone && (previous == azureDataRetrievalStatusRetrieving || previous == azureDataRetrievalStatusRetrieved) {
+		log.Printf("[WARN] Retrieved data of restore point %s expired, removing the data retrieval from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("restore_point_id", restorePoint.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set restore_point_id: %w", err))
+	}
+	if err := d.Set("data_retrieval_status", status); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set data_retrieval_status: %w", err))
+	}
+	if err := d.Set("retrieved_data_expiration_date", restorePoint.RetrievedDataExpirationDate); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set retrieved_data_expiration_date: %w", err))
+	}
+	if err := d.Set("is_ready", status == azureDataRetrievalStatusRetrieved); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set is_ready: %w", err))
+	}
+
+	return nil
+}
+
+func ResourceAzureDataRetrievalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// wait_for_ready only affects creation, so there is nothing to send to the API
+	return ResourceAzureDataRetrievalRead(ctx, d, meta)
+}
+
+func ResourceAzureDataRetrievalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Retrieved data expires automatically after days_to_keep, so we just remove it from state
+	d.SetId("")
+	return nil
+}
+
+// getAzureVMRestorePoint fetches a VM restore point; found is false when the API returns 404 or 410.
+func getAzureVMRestorePoint(ctx context.Context, client *vc.AzureBackupClient, restorePointID string) (*AzureVMRestorePointsResults, bool, error) {
+	url := client.BuildAPIURL(vc.Endpoint("/restorePoints/virtualMachines/%s", restorePointID))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to retrieve Azure VM restore point: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read Azure VM restore point response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, body)
+		if vc.IsGone(apiErr) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to retrieve Azure VM restore point: %w", apiErr)
+	}
+
+	var restorePoint AzureVMRestorePointsResults
+	if err := json.Unmarshal(body, &restorePoint); err != nil {
+		return nil, false, fmt.Errorf("failed to decode Azure VM restore point response: %w", err)
+	}
+
+	return &restorePoint, true, nil
+}
+
+// waitForAzureDataRetrieval polls the restore point until its data has been retrieved from the
+// archive tier, or ctx is done. It returns the last status read.
+func waitForAzureDataRetrieval(ctx context.Context, client *vc.AzureBackupClient, restorePointID string) (string, error) {
+	status := ""
+	for {
+		restorePoint, found, err := getAzureVMRestorePoint(ctx, client, restorePointID)
+		if err != nil {
+			return status, err
+		}
+		if !found {
+			return status, fmt.Errorf("restore point %s not found while waiting for data retrieval", restorePointID)
+		}
+
+		status = ""
+		if restorePoint.DataRetrievalStatus != nil {
+			status = *restorePoint.DataRetrievalStatus
+		}
+
+		switch status {
+		case azureDataRetrievalStatusRetrieved:
+			return status, nil
+		case azureDataRetrievalStatusRetrieving, azureDataRetrievalStatusNone:
+			// Retrieval may not be reflected on the restore point immediately after the session starts
+		default:
+			return status, fmt.Errorf("unexpected data retrieval status for restore point %s: %s", restorePointID, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("timed out waiting for data retrieval of restore point %s (last status: %s): %w", restorePointID, status, ctx.Err())
+		case <-time.After(30 * time.Second):
+		}
+	}
+}
@@ -100,6 +100,7 @@ type AzureFileShareBackupPolicyResponse struct {
 	Priority                   int                         `json:"priority"`
 	TenantId                   string                      `json:"tenantId"`
 	ServiceAccountID           string                      `json:"serviceAccountId"`
+	Regions                    []PolicyRegion              `json:"regions"`
 	SnapshotStatus             string                      `json:"snapshotStatus"`
 	IndexingStatus             string                      `json:"indexingStatus"`
 	NextExecutionTime          string                      `json:"nextExecutionTime"`
@@ -128,10 +129,11 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"backup_type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"AllSubscriptions", "SelectedItems", "Unknown"}, false),
-				Description:  "Specifies the backup type for the policy. Possible values are 'AllSubscriptions', 'SelectedItems', and 'Unknown'.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"AllSubscriptions", "SelectedItems", "Unknown"}, true),
+				Description:      "Specifies the backup type for the policy. Possible values are 'AllSubscriptions', 'SelectedItems', and 'Unknown'.",
+				DiffSuppressFunc: vc.SuppressCaseDifference,
 			},
 			"is_enabled": {
 				Type:        schema.TypeBool,
@@ -144,15 +146,17 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 				Description: "The name of the backup policy.",
 			},
 			"regions": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Required:    true,
 				Description: "List of regions where the backup policy is applied.",
+				Set:         vc.HashFieldIgnoreCase("region_id"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"region_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Azure region ID.",
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "Azure region ID.",
+							DiffSuppressFunc: vc.SuppressCaseDifference,
 						},
 					},
 				},
@@ -160,6 +164,7 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Specifies a Microsoft Azure ID assigned to a tenant.",
 			},
 			"service_account_id": {
@@ -176,7 +181,7 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"file_shares": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "List of Azure File Shares to include in the backup policy.",
 							Elem: &schema.Resource{
@@ -190,7 +195,7 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 							},
 						},
 						"storage_accounts": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "List of Azure Storage Accounts to include in the backup policy.",
 							Elem: &schema.Resource{
@@ -204,7 +209,7 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 							},
 						},
 						"resource_groups": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "List of Azure Resource Groups to include in the backup policy.",
 							Elem: &schema.Resource{
@@ -228,7 +233,7 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"file_shares": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "List of Azure File Shares to exclude from the backup policy.",
 							Elem: &schema.Resource{
@@ -268,7 +273,7 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{"EveryDay", "WeekDays", "SelectedDays"}, false),
 						},
 						"selected_days": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "List of selected days for the daily schedule.",
 							Elem: &schema.Schema{
@@ -294,7 +299,7 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 										Description: "Number of snapshots to keep for the daily schedule.",
 									},
 									"hours": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "List of hours for the snapshot schedule.",
 										Elem: &schema.Schema{
@@ -332,7 +337,7 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 										Description: "Number of snapshots to keep for the weekly schedule.",
 									},
 									"selected_days": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "List of selected days for the weekly snapshot schedule.",
 										Elem: &schema.Schema{
@@ -393,7 +398,7 @@ func ResourceAzureFileSharesBackupPolicy() *schema.Resource {
 										Description: "Number of snapshots to keep for the monthly schedule.",
 									},
 									"selected_months": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "List of selected months for the monthly snapshot schedule.",
 										Elem: &schema.Schema{
@@ -423,36 +428,26 @@ func ResourceAzureFileSharesBackupPolicyCreate(ctx context.Context, d *schema.Re
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	policyRequest := buildFSBackupPolicyRequest(d)
+	policyRequest := buildFSBackupPolicyRequest(d, client)
 
 	jsonData, err := json.Marshal(policyRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error marshaling Azure File Shares Backup Policy request: %s", err))
 	}
 
-	url := client.BuildAPIURL("/policies/fileShares")
-	resp, err := client.MakeAuthenticatedRequest("POST", url, strings.NewReader(string(jsonData)))
+	url := client.BuildAPIURL(policyCollectionPath(policyTypeFileShare))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Azure File Shares Backup Policy: %s", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to create Azure File Shares Backup Policy, status: %d, response: %s", resp.StatusCode, string(bodyBytes)))
-	}
-
-	if resp.StatusCode == http.StatusUnauthorized {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("unauthorized (401): %s", string(bodyBytes)))
-	}
-
-	var policyResponse AzureFileShareBackupPolicyResponse
-	if err := json.NewDecoder(resp.Body).Decode(&policyResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("error decoding Azure File Shares Backup Policy creation response: %s", err))
+	policyID, err := createdPolicyID(ctx, client, resp)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create Azure File Shares Backup Policy: %w", err))
 	}
 
-	d.SetId(policyResponse.Id)
+	d.SetId(policyID)
 	return ResourceAzureFileSharesBackupPolicyRead(ctx, d, m)
 }
 
@@ -462,8 +457,8 @@ func ResourceAzureFileSharesBackupPolicyRead(ctx context.Context, d *schema.Reso
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/fileShares/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("GET", url, nil)
+	url := client.BuildAPIURL(policyItemPath(policyTypeFileShare, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error reading Azure File Shares Backup Policy: %s", err))
 	}
@@ -477,13 +472,13 @@ func ResourceAzureFileSharesBackupPolicyRead(ctx context.Context, d *schema.Reso
 		bodyBytes, _ := io.ReadAll(resp.Body)
 		return diag.FromErr(fmt.Errorf("forbidden (403): %s", string(bodyBytes)))
 	}
-	if resp.StatusCode == http.StatusNotFound {
-		d.SetId("")
-		return diag.FromErr(fmt.Errorf("404 Azure File Shares Backup Policy not found"))
-	}
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to read Azure File Shares Backup Policy, status: %d, response: %s", resp.StatusCode, string(bodyBytes)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, bodyBytes)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read Azure File Shares Backup Policy: %w", apiErr))
 	}
 
 	var policyResponse AzureFileShareBackupPolicyResponse
@@ -499,6 +494,27 @@ func ResourceAzureFileSharesBackupPolicyRead(ctx context.Context, d *schema.Reso
 	if err := d.Set("name", policyResponse.Name); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("tenant_id", policyResponse.TenantId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("service_account_id", policyResponse.ServiceAccountID); err != nil {
+		return diag.FromErr(err)
+	}
+	var description string
+	if policyResponse.Description != nil {
+		description = *policyResponse.Description
+	}
+	if err := d.Set("description", client.TrimDescriptionSuffix(description)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enable_indexing", policyResponse.EnableIndexing); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(policyResponse.Regions) > 0 {
+		if err := d.Set("regions", flattenPolicyRegions(policyResponse.Regions)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return nil
 }
 
@@ -508,21 +524,20 @@ func ResourceAzureFileSharesBackupPolicyUpdate(ctx context.Context, d *schema.Re
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	policyRequest := buildFSBackupPolicyRequest(d)
+	policyRequest := buildFSBackupPolicyRequest(d, client)
 	jsonData, err := json.Marshal(policyRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error marshaling Azure File Shares Backup Policy update request: %s", err))
 	}
 
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/fileShares/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("PUT", url, strings.NewReader(string(jsonData)))
+	url := client.BuildAPIURL(policyItemPath(policyTypeFileShare, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "PUT", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Azure File Shares Backup Policy: %s", err))
 	}
 	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to update Azure File Shares Backup Policy, status: %d, response: %s", resp.StatusCode, string(bodyBytes)))
+	if err := waitForPolicyUpdate(ctx, client, resp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update Azure File Shares Backup Policy: %w", err))
 	}
 
 	return ResourceAzureFileSharesBackupPolicyRead(ctx, d, m)
@@ -534,8 +549,8 @@ func ResourceAzureFileSharesBackupPolicyDelete(ctx context.Context, d *schema.Re
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/fileShares/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("DELETE", url, nil)
+	url := client.BuildAPIURL(policyItemPath(policyTypeFileShare, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error deleting Azure File Shares Backup Policy: %s", err))
 	}
@@ -543,30 +558,30 @@ func ResourceAzureFileSharesBackupPolicyDelete(ctx context.Context, d *schema.Re
 
 	if resp.StatusCode != http.StatusNoContent {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to delete Azure File Shares Backup Policy, status: %d, response: %s", resp.StatusCode, string(bodyBytes)))
+		if apiErr := vc.NewVeeamAPIError(resp.StatusCode, bodyBytes); !vc.IsGone(apiErr) {
+			return diag.FromErr(fmt.Errorf("failed to delete Azure File Shares Backup Policy: %w", apiErr))
+		}
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func buildFSBackupPolicyRequest(d *schema.ResourceData) AzureFileShareBackupPolicyRequest {
+func buildFSBackupPolicyRequest(d *schema.ResourceData, client *vc.AzureBackupClient) AzureFileShareBackupPolicyRequest {
 	request := AzureFileShareBackupPolicyRequest{
-		BackupType:                 d.Get("backup_type").(string),
-		IsEnabled:                  d.Get("is_enabled").(bool),
-		Name:                       d.Get("name").(string),
-		Regions:                    expandPolicyRegions(d.Get("regions").([]interface{})),
-		TenantId:                   d.Get("tenant_id").(string),
-		ServiceAccountId:           d.Get("service_account_id").(string),
-		SelectedItems:              expandAzureFileShareBackupPolicySelectedItems(d.Get("selected_items").([]interface{})),
-		ExclusionItems:             expandAzureFileShareBackupPolicyExclusionItems(d.Get("exclusion_items").([]interface{})),
-		Description:                d.Get("description").(string),
-		RetrySettings:              expandRetrySettings(d.Get("retry_settings").([]interface{})),
-		PolicyNotificationSettings: expandPolicyNotificationSettings(d.Get("policy_notification_settings").([]interface{})),
-		EnableIndexing:             d.Get("enable_indexing").(bool),
-		DailySchedule:              expandFSDailySchedule(d.Get("daily_schedule").([]interface{})),
-		WeeklySchedule:             expandFSWeeklySchedule(d.Get("weekly_schedule").([]interface{})),
-		MonthlySchedule:            expandFSMonthlySchedule(d.Get("monthly_schedule").([]interface{})),
+		BackupType:       d.Get("backup_type").(string),
+		IsEnabled:        d.Get("is_enabled").(bool),
+		Name:             d.Get("name").(string),
+		Regions:          expandPolicyRegions(d.Get("regions").(*schema.Set).List()),
+		TenantId:         d.Get("tenant_id").(string),
+		ServiceAccountId: d.Get("service_account_id").(string),
+		SelectedItems:    expandAzureFileShareBackupPolicySelectedItems(d.Get("selected_items").([]interface{})),
+		ExclusionItems:   expandAzureFileShareBackupPolicyExclusionItems(d.Get("exclusion_items").([]interface{})),
+		Description:      client.AppendDescriptionSuffix(d.Get("description").(string)),
+		EnableIndexing:   d.Get("enable_indexing").(bool),
+		DailySchedule:    expandFSDailySchedule(d.Get("daily_schedule").([]interface{})),
+		WeeklySchedule:   expandFSWeeklySchedule(d.Get("weekly_schedule").([]interface{})),
+		MonthlySchedule:  expandFSMonthlySchedule(d.Get("monthly_schedule").([]interface{})),
 	}
 	return request
 }
@@ -579,9 +594,9 @@ func expandAzureFileShareBackupPolicySelectedItems(input []interface{}) *[]Azure
 	for i, v := range input {
 		m := v.(map[string]interface{})
 		result[i] = AzureFileShareBackupPolicySelectedItems{
-			FileShares:      expandAzureFileShareBackupPolicyFileShares(m["file_shares"].([]interface{})),
-			StorageAccounts: expandAzureFileShareBackupPolicyStorageAccounts(m["storage_accounts"].([]interface{})),
-			ResourceGroups:  expandAzureFileShareBackupPolicyResourceGroups(m["resource_groups"].([]interface{})),
+			FileShares:      expandAzureFileShareBackupPolicyFileShares(m["file_shares"].(*schema.Set).List()),
+			StorageAccounts: expandAzureFileShareBackupPolicyStorageAccounts(m["storage_accounts"].(*schema.Set).List()),
+			ResourceGroups:  expandAzureFileShareBackupPolicyResourceGroups(m["resource_groups"].(*schema.Set).List()),
 		}
 	}
 	return &result
@@ -628,7 +643,7 @@ func expandAzureFileShareBackupPolicyExclusionItems(input []interface{}) *[]Azur
 	for i, v := range input {
 		m := v.(map[string]interface{})
 		result[i] = AzureFileShareBackupPolicyExclusionItems{
-			FileShares: expandAzureFileShareBackupPolicyFileShares(m["file_shares"].([]interface{})),
+			FileShares: expandAzureFileShareBackupPolicyFileShares(m["file_shares"].(*schema.Set).List()),
 		}
 	}
 	return &result
@@ -748,21 +763,25 @@ func getBoolPtr(input interface{}) *bool {
 }
 
 func getStringListPtr(input interface{}) *[]string {
-	if input == nil {
+	set, ok := input.(*schema.Set)
+	if !ok || set.Len() == 0 {
 		return nil
 	}
-	val := input.([]string)
-	return &val
+	result := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		result = append(result, v.(string))
+	}
+	return &result
 }
 
 func getIntListPtr(input interface{}) *[]int {
-	if input == nil {
+	set, ok := input.(*schema.Set)
+	if !ok || set.Len() == 0 {
 		return nil
 	}
-	val := input.([]int64)
-	result := make([]int, len(val))
-	for i, v := range val {
-		result[i] = int(v)
+	result := make([]int, 0, set.Len())
+	for _, v := range set.List() {
+		result = append(result, v.(int))
 	}
 	return &result
 }
@@ -0,0 +1,104 @@
+package azure
+
+import (
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestBuildFSBackupPolicyRequest checks the request body of the file shares backup policy for the
+// selected and excluded items and each schedule. The API does not return the items and schedules
+// of a policy, so there are no flatten functions to round trip through.
+func TestBuildFSBackupPolicyRequest(t *testing.T) {
+	base := func(settings map[string]interface{}) map[string]interface{} {
+		raw := map[string]interface{}{
+			"name":               "production-file-shares",
+			"is_enabled":         true,
+			"backup_type":        "SelectedItems",
+			"tenant_id":          "00000000-0000-0000-0000-00000000cccc",
+			"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+			"regions":            []interface{}{map[string]interface{}{"region_id": "northeurope"}},
+		}
+		for key, value := range settings {
+			raw[key] = value
+		}
+		return raw
+	}
+	const request = `"name": "production-file-shares", "isEnabled": true, "backupType": "SelectedItems",
+		"tenantId": "00000000-0000-0000-0000-00000000cccc", "serviceAccountId": "00000000-0000-0000-0000-00000000dddd",
+		"regions": [{"regionId": "northeurope"}]`
+
+	for name, tc := range map[string]struct {
+		raw  map[string]interface{}
+		want string
+	}{
+		"items": {
+			raw: base(map[string]interface{}{
+				"description":     "Shares of production",
+				"enable_indexing": true,
+				"selected_items": []interface{}{map[string]interface{}{
+					"file_shares":      []interface{}{map[string]interface{}{"id": "share-1"}},
+					"storage_accounts": []interface{}{map[string]interface{}{"id": "account-1"}},
+					"resource_groups":  []interface{}{map[string]interface{}{"id": "group-1"}},
+				}},
+				"exclusion_items": []interface{}{map[string]interface{}{
+					"file_shares": []interface{}{map[string]interface{}{"id": "share-2"}},
+				}},
+			}),
+			want: `{` + request + `, "description": "Shares of production", "enableIndexing": true,
+				"selectedItems": [{"fileShares": [{"id": "share-1"}], "storageAccounts": [{"id": "account-1"}], "resourceGroups": [{"id": "group-1"}]}],
+				"exclusionItems": [{"fileShares": [{"id": "share-2"}]}]}`,
+		},
+		"daily": {
+			raw: base(map[string]interface{}{
+				"daily_schedule": []interface{}{map[string]interface{}{
+					"daily_type":    "SelectedDays",
+					"selected_days": []interface{}{"Monday"},
+					"runs_per_hour": 2,
+					"snapshot_schedule": []interface{}{map[string]interface{}{
+						"snapshots_to_keep": 7,
+						"hours":             []interface{}{22},
+					}},
+				}},
+			}),
+			want: `{` + request + `, "dailySchedule": {"dailyType": "SelectedDays", "selectedDays": ["Monday"], "runsPerHour": 2,
+				"snapshotSchedule": {"snapshotsToKeep": 7, "hours": [22]}}}`,
+		},
+		"weekly": {
+			raw: base(map[string]interface{}{
+				"weekly_schedule": []interface{}{map[string]interface{}{
+					"start_time": 3,
+					"snapshot_schedule": []interface{}{map[string]interface{}{
+						"snapshots_to_keep": 4,
+						"selected_days":     []interface{}{"Sunday"},
+					}},
+				}},
+			}),
+			want: `{` + request + `, "weeklySchedule": {"startTime": 3, "snapshotSchedule": {"snapshotsToKeep": 4, "selectedDays": ["Sunday"]}}}`,
+		},
+		"monthly on the last day": {
+			raw: base(map[string]interface{}{
+				"monthly_schedule": []interface{}{map[string]interface{}{
+					"start_time":       1,
+					"type":             "DayOfMonth",
+					"day_of_month":     28,
+					"monthly_last_day": true,
+					"snapshot_schedule": []interface{}{map[string]interface{}{
+						"snapshots_to_keep": 12,
+						"selected_months":   []interface{}{"January"},
+					}},
+				}},
+			}),
+			want: `{` + request + `, "monthlySchedule": {"startTime": 1, "type": "DayOfMonth", "dayOfMonth": 28, "dayOfWeek": "", "monthlyLastDay": true,
+				"snapshotSchedule": {"snapshotsToKeep": 12, "selectedMonths": ["January"]}}}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceAzureFileSharesBackupPolicy().Schema, tc.raw)
+			acctest.CheckJSON(t, buildFSBackupPolicyRequest(d, &vc.AzureBackupClient{}), tc.want)
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Types of appliance users
+const (
+	AzureUserTypeLocal      = "Local"
+	AzureUserTypeEntraUser  = "EntraUser"
+	AzureUserTypeEntraGroup = "EntraGroup"
+)
+
+// AzureApplianceRoles are the roles that give access to the appliance
+var AzureApplianceRoles = []string{"PortalAdministrator", "PortalOperator", "RestoreOperator"}
+
+type AzureApplianceUserRequest struct {
+	UserName      string  `json:"userName,omitempty"`
+	UserType      string  `json:"userType,omitempty"`
+	Password      *string `json:"password,omitempty"`
+	EntraObjectID *string `json:"entraObjectId,omitempty"`
+	Role          string  `json:"role"`
+	Description   string  `json:"description"`
+}
+
+type AzureApplianceUser struct {
+	ID            string `json:"id"`
+	UserName      string `json:"userName"`
+	UserType      string `json:"userType"`
+	EntraObjectID string `json:"entraObjectId"`
+	Role          string `json:"role"`
+	Description   string `json:"description"`
+}
+
+func ResourceAzureRBACRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Gives a local user, or a Microsoft Entra ID user or group, a role on the Veeam Backup for Microsoft Azure appliance. " +
+			"Local users are created with the assignment and deleted with it; Entra ID users and groups sign in to the appliance portal with their Microsoft account.",
+		CreateContext: ResourceAzureRBACRoleAssignmentCreate,
+		ReadContext:   ResourceAzureRBACRoleAssignmentRead,
+		UpdateContext: ResourceAzureRBACRoleAssignmentUpdate,
+		DeleteContext: ResourceAzureRBACRoleAssignmentDelete,
+		CustomizeDiff: resourceAzureRBACRoleAssignmentCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the user, e.g. `operator` for a local user or `jane@example.com` for an Entra ID user, or name of the Entra ID group.",
+			},
+			"user_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      AzureUserTypeLocal,
+				ValidateFunc: validation.StringInSlice([]string{AzureUserTypeLocal, AzureUserTypeEntraUser, AzureUserTypeEntraGroup}, false),
+				Description:  "Type of the user: Local, EntraUser or EntraGroup.",
+			},
+			"entra_object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "Object ID of the Entra ID user or group. Required for the EntraUser and EntraGroup user types.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(AzureApplianceRoles, false),
+				Description:  "Role of the user: PortalAdministrator, PortalOperator or RestoreOperator.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the user.",
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+				Description:   "Password of a local user. The API never returns it, so it is kept as configured.",
+			},
+			"password_wo": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only variant of password that is never stored in state. Requires Terraform 1.11 or later.",
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Change this value to send an updated password_wo to the appliance.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+// resourceAzureRBACRoleAssignmentCustomizeDiff requires a password for local users and an object
+// ID for Entra ID users and groups, which sign in with their Microsoft account instead
+func resourceAzureRBACRoleAssignmentCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	userType := d.Get("user_type").(string)
+	entraObjectID := d.Get("entra_object_id").(string)
+	hasPassword := d.Get("password").(string) != ""
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		if v := raw.GetAttr("password_wo"); !v.IsNull() {
+			hasPassword = true
+		}
+		// An unknown password is only known once applied, e.g. when it comes from another resource
+		if v := raw.GetAttr("password"); !v.IsKnown() {
+			hasPassword = true
+		}
+	}
+
+	if userType == AzureUserTypeLocal {
+		if entraObjectID != "" {
+			return fmt.Errorf("entra_object_id only applies to the %s and %s user types", AzureUserTypeEntraUser, AzureUserTypeEntraGroup)
+		}
+		// The password of an imported user is not known, so it is only required for new users
+		if d.Id() == "" && !hasPassword {
+			return fmt.Errorf("password or password_wo is required for %s users", AzureUserTypeLocal)
+		}
+		return nil
+	}
+
+	if hasPassword {
+		return fmt.Errorf("password only applies to %s users; %s users sign in with their Microsoft account", AzureUserTypeLocal, userType)
+	}
+	if entraObjectID == "" && d.NewValueKnown("entra_object_id") {
+		return fmt.Errorf("entra_object_id is required for %s users", userType)
+	}
+	return nil
+}
+
+func ResourceAzureRBACRoleAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := buildAzureApplianceUserRequest(d)
+	if request.UserType == AzureUserTypeLocal {
+		password, diags := vc.GetSecretString(d, "password")
+		if diags.HasError() {
+			return diags
+		}
+		request.Password = &password
+	}
+
+	body, err := doAzureUserRequest(ctx, client, http.MethodPost, "/users", request)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create Azure appliance user: %w", err))
+	}
+
+	var user AzureApplianceUser
+	if err := json.Unmarshal(body, &user); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to decode Azure appliance user response: %w", err))
+	}
+	if user.ID == "" {
+		return diag.FromErr(fmt.Errorf("user ID was not returned when creating Azure appliance user %s", request.UserName))
+	}
+	d.SetId(user.ID)
+
+	return ResourceAzureRBACRoleAssignmentRead(ctx, d, meta)
+}
+
+func ResourceAzureRBACRoleAssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := doAzureUserRequest(ctx, client, http.MethodGet, vc.Endpoint("/users/%s", d.Id()), nil)
+	if err != nil {
+		if vc.RemoveFromStateIfGone(d, err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read Azure appliance user: %w", err))
+	}
+
+	var user AzureApplianceUser
+	if err := json.Unmarshal(body, &user); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to decode Azure appliance user response: %w", err))
+	}
+
+	d.Set("user_name", user.UserName)
+	d.Set("user_type", user.UserType)
+	d.Set("entra_object_id", user.EntraObjectID)
+	d.Set("role", user.Role)
+	d.Set("description", user.Description)
+	return nil
+}
+
+func ResourceAzureRBACRoleAssignmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := buildAzureApplianceUserRequest(d)
+	// The password is only sent when it changes, so that updating the role does not reset it
+	if d.HasChanges("password", "password_wo_version") {
+		password, diags := vc.GetSecretString(d, "password")
+		if diags.HasError() {
+			return diags
+		}
+		request.Password = &password
+	}
+
+	if _, err := doAzureUserRequest(ctx, client, http.MethodPut, vc.Endpoint("/users/%s", d.Id()), request); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update Azure appliance user: %w", err))
+	}
+
+	return ResourceAzureRBACRoleAssignmentRead(ctx, d, meta)
+}
+
+func ResourceAzureRBACRoleAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetAzureClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := doAzureUserRequest(ctx, client, http.MethodDelete, vc.Endpoint("/users/%s", d.Id()), nil); err != nil && !vc.IsGone(err) {
+		return diag.FromErr(fmt.Errorf("failed to delete Azure appliance user: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// buildAzureApplianceUserRequest converts the resource data into an appliance user, without its
+// password
+func buildAzureApplianceUserRequest(d *schema.ResourceData) AzureApplianceUserRequest {
+	request := AzureApplianceUserRequest{
+		UserName:    d.Get("user_name").(string),
+		UserType:    d.Get("user_type").(string),
+		Role:        d.Get("role").(string),
+		Description: d.Get("description").(string),
+	}
+	if v, ok := d.GetOk("entra_object_id"); ok {
+		objectID := v.(string)
+		request.EntraObjectID = &objectID
+	}
+	return request
+}
+
+// doAzureUserRequest sends request, when not nil, to the appliance user endpoint and returns the
+// response body, or a VeeamAPIError when the appliance rejects the request
+func doAzureUserRequest(ctx context.Context, client *vc.AzureBackupClient, method, endpoint string, request interface{}) ([]byte, error) {
+	var reqBody io.Reader
+	if request != nil {
+		payload, err := json.Marshal(request)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal Azure appliance user request: %w", err)
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, method, client.BuildAPIURL(endpoint), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Azure appliance user response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, vc.NewVeeamAPIError(resp.StatusCode, body)
+	}
+	return body, nil
+}
@@ -2,6 +2,7 @@
 
 import (
 	vc "terraform-provider-veeambackup/internal/client"
+	"terraform-provider-veeambackup/internal/client/azureapi"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,122 +10,13 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-type AzureRepositoryStorageConsumptionLimit struct {
-	LimitValue int    `json:"limitValue"`
-	LimitType  string `json:"limitType"`
-}
-
-type AzureRepositoryRequest struct {
-	AzureStorageAccountID     string                   `json:"azureStorageAccountId"`
-	AzureStorageFolder        string                   `json:"azureStorageFolder"`
-	AzureStorageContainer     string                   `json:"azureStorageContainer"`
-	AzureAccountID            string                   `json:"azureAccountId"`
-	KeyVaultID                *string                  `json:"keyVaultId,omitempty"`
-	KeyVaultKeyURI            *string                  `json:"keyVaultKeyUri,omitempty"`
-	StorageTier               *string                  `json:"storageTier,omitempty"`
-	ConcurrencyLimit          *int                     `json:"concurrencyLimit,omitempty"`
-	ImportIfFolderHasBackup   *bool                    `json:"importIfFolderHasBackup,omitempty"`
-	AutoCreateTiers           *bool                    `json:"autoCreateTiers,omitempty"`
-	Name                      *string                  `json:"name,omitempty"`
-	Description               *string                  `json:"description,omitempty"`
-	EnableEncryption          *bool                    `json:"enableEncryption,omitempty"`
-	Password                  *string                  `json:"password,omitempty"`
-	Hint                      *string                  `json:"hint,omitempty"`
-	StorageConsumptionLimit   *AzureRepositoryStorageConsumptionLimit `json:"storageConsumptionLimit,omitempty"`
-}
-
-type AzureRepositoryResponse struct {
-	Status                           string                                              `json:"status"`
-	ID                               *string                                             `json:"id,omitempty"`
-	Type                             string                                              `json:"type"`
-	LocalizedType                    *string                                             `json:"localizedType,omitempty"`
-	ExecutionStartTime               *string                                             `json:"executionStartTime,omitempty"`
-	ExecutionStopTime                *string                                             `json:"executionStopTime,omitempty"`
-	ExecutionDuration                *string                                             `json:"executionDuration,omitempty"`
-	BackupJobInfo                    *AzureRepositoryBackupJobInfo                      `json:"backupJobInfo,omitempty"`
-	HealthCheckJobInfo               *AzureRepositoryHealthCheckJobInfo                 `json:"healthCheckJobInfo,omitempty"`
-	RestoreJobInfo                   *AzureRepositoryRestoreJobInfo                     `json:"restoreJobInfo,omitempty"`
-	FileLevelRestoreJobInfo          *AzureRepositoryFileLevelRestoreJobInfo            `json:"fileLevelRestoreJobInfo,omitempty"`
-	FileShareFileLevelRestoreJobInfo *AzureRepositoryFileShareFileLevelRestoreJobInfo   `json:"fileShareFileLevelRestoreJobInfo,omitempty"`
-	RepositoryJobInfo                *AzureRepositoryRepositoryJobInfo                  `json:"repositoryJobInfo,omitempty"`
-	RestorePointDataRetrievalJobInfo *AzureRepositoryRestorePointDataRetrievalJobInfo   `json:"restorePointDataRetrievalJobInfo,omitempty"`
-	RetentionJobInfo                 *AzureRepositoryRetentionJobInfo                   `json:"retentionJobInfo,omitempty"`
-	Links                            map[string]Link                                    `json:"_links,omitempty"`
-}
-
-type AzureRepositoryBackupJobInfo struct {
-	PolicyID                *string `json:"policyId,omitempty"`
-	PolicyName              *string `json:"policyName,omitempty"`
-	PolicyType              string  `json:"policyType"`
-	ProtectedInstancesCount int32   `json:"protectedInstancesCount"`
-	PolicyRemoved           bool    `json:"policyRemoved"`
-}
-
-type AzureRepositoryHealthCheckJobInfo struct {
-	PolicyID              *string `json:"policyId,omitempty"`
-	PolicyName            *string `json:"policyName,omitempty"`
-	CheckedInstancesCount int32   `json:"checkedInstancesCount"`
-	PolicyRemoved         bool    `json:"policyRemoved"`
-}
-
-type AzureRepositoryRestoreJobInfo struct {
-	Reason                  *string `json:"reason,omitempty"`
-	BackupPolicyDisplayName *string `json:"backupPolicyDisplayName,omitempty"`
-}
-
-type AzureRepositoryFileLevelRestoreJobInfo struct {
-	Initiator                  *string                       `json:"initiator,omitempty"`
-	Reason                     *string                       `json:"reason,omitempty"`
-	FlrLink                    *AzureRepositoryFlrLink       `json:"flrLink,omitempty"`
-	VMID                       *string                       `json:"vmId,omitempty"`
-	VMName                     *string                       `json:"vmName,omitempty"`
-	BackupPolicyDisplayName    *string                       `json:"backupPolicyDisplayName,omitempty"`
-	RestorePointCreatedDateUTC *string                       `json:"restorePointCreatedDateUtc,omitempty"`
-	IsFlrSessionReady          bool                          `json:"isFlrSessionReady"`
-}
-
-type AzureRepositoryFileShareFileLevelRestoreJobInfo struct {
-	Initiator                  *string                       `json:"initiator,omitempty"`
-	Reason                     *string                       `json:"reason,omitempty"`
-	FlrLink                    *AzureRepositoryFlrLink       `json:"flrLink,omitempty"`
-	FileShareID                *string                       `json:"fileShareId,omitempty"`
-	FileShareName              *string                       `json:"fileShareName,omitempty"`
-	BackupPolicyDisplayName    *string                       `json:"backupPolicyDisplayName,omitempty"`
-	RestorePointCreatedDateUTC *string                       `json:"restorePointCreatedDateUtc,omitempty"`
-}
-
-type AzureRepositoryRepositoryJobInfo struct {
-	RepositoryID      *string `json:"repositoryId,omitempty"`
-	RepositoryName    *string `json:"repositoryName,omitempty"`
-	RepositoryRemoved bool    `json:"repositoryRemoved"`
-}
-
-type AzureRepositoryRestorePointDataRetrievalJobInfo struct {
-	RestorePointID         *string `json:"restorePointId,omitempty"`
-	SQLRestorePointID      *string `json:"sqlRestorePointId,omitempty"`
-	CosmosDBRestorePointID *string `json:"cosmosDbRestorePointId,omitempty"`
-	Initiator              *string `json:"initiator,omitempty"`
-	InstanceName           *string `json:"instanceName,omitempty"`
-	DaysToKeep             int32   `json:"daysToKeep"`
-	DataRetrievalPriority  string  `json:"dataRetrievalPriority"`
-}
-
-type AzureRepositoryRetentionJobInfo struct {
-	DeletedRestorePointsCount int32 `json:"deletedRestorePointsCount"`
-}
-
-type AzureRepositoryFlrLink struct {
-	Href       *string `json:"href,omitempty"`
-	Url        *string `json:"url,omitempty"`
-	Thumbprint *string `json:"thumbprint,omitempty"`
-}
-
 func ResourceAzureRepository() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Schema for Azure backup repository.",
@@ -136,16 +28,19 @@ func ResourceAzureRepository() *schema.Resource {
 			"azure_storage_account_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Specifies the Azure storage account ID.",
 			},
 			"azure_storage_folder": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Specifies the folder in the Azure storage container.",
 			},
 			"azure_storage_container": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Specifies the Azure storage container name.",
 			},
 			"azure_account_id": {
@@ -212,13 +107,28 @@ func ResourceAzureRepository() *schema.Resource {
 			"enable_encryption": {
 				Type:        schema.TypeBool,
 				Optional:    true,
+				ForceNew:    true,
 				Description: "Indicates whether repository-side encryption is enabled.",
 			},
 			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+				Description:   "Specifies the encryption password. The API never returns it, so it is kept as configured.",
+			},
+			"password_wo": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Sensitive:   true,
-				Description: "Specifies the encryption password.",
+				WriteOnly:   true,
+				Description: "Write-only variant of password that is never stored in state. Requires Terraform 1.11 or later.",
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Change this value to send an updated password_wo to the repository.",
 			},
 			"hint": {
 				Type:        schema.TypeString,
@@ -312,6 +222,12 @@ func ResourceAzureRepository() *schema.Resource {
 				},
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
@@ -321,7 +237,10 @@ func ResourceAzureRepositoryCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	request := buildAzureRepositoryRequest(d)
+	request, diags := buildAzureRepositoryRequest(d)
+	if diags.HasError() {
+		return diags
+	}
 
 	jsonData, err := json.Marshal(request)
 	if err != nil {
@@ -329,7 +248,7 @@ func ResourceAzureRepositoryCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	url := client.BuildAPIURL("/repositories")
-	resp, err := client.MakeAuthenticatedRequest("POST", url, strings.NewReader(string(jsonData)))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create Azure repository: %w", err))
 	}
@@ -341,7 +260,7 @@ func ResourceAzureRepositoryCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return diag.FromErr(fmt.Errorf("failed to create Azure repository, status: %d, response: %s", resp.StatusCode, string(body)))
+		return diag.FromErr(fmt.Errorf("failed to create Azure repository: %w", vc.NewVeeamAPIError(resp.StatusCode, body)))
 	}
 
 	repositoryResponse, err := decodeAzureRepositoryResponse(body)
@@ -390,12 +309,9 @@ func ResourceAzureRepositoryRead(ctx context.Context, d *schema.ResourceData, me
 		queryParams.Set("TenantId", value.(string))
 	}
 
-	requestURL := client.BuildAPIURL(fmt.Sprintf("/repositories/%s", d.Id()))
-	if encoded := queryParams.Encode(); encoded != "" {
-		requestURL = requestURL + "?" + encoded
-	}
+	requestURL := client.BuildAPIURL(vc.WithQuery(vc.Endpoint("/repositories/%s", d.Id()), queryParams))
 
-	resp, err := client.MakeAuthenticatedRequest("GET", requestURL, nil)
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Azure repository: %w", err))
 	}
@@ -406,16 +322,15 @@ func ResourceAzureRepositoryRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(fmt.Errorf("failed to read Azure repository response: %w", err))
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		d.SetId("")
-		return nil
-	}
-
 	if resp.StatusCode != http.StatusOK {
-		return diag.FromErr(fmt.Errorf("failed to read Azure repository, status: %d, response: %s", resp.StatusCode, string(body)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, body)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read Azure repository: %w", apiErr))
 	}
 
-	var repository BackupRepositoryDetail
+	var repository azureapi.Repository
 	if err := json.Unmarshal(body, &repository); err != nil {
 		return diag.FromErr(fmt.Errorf("failed to decode Azure repository response: %w", err))
 	}
@@ -438,14 +353,17 @@ func ResourceAzureRepositoryUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	request := buildAzureRepositoryRequest(d)
+	request, diags := buildAzureRepositoryRequest(d)
+	if diags.HasError() {
+		return diags
+	}
 	jsonData, err := json.Marshal(request)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to marshal Azure repository update request: %w", err))
 	}
 
-	url := client.BuildAPIURL(fmt.Sprintf("/repositories/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("PUT", url, strings.NewReader(string(jsonData)))
+	url := client.BuildAPIURL(vc.Endpoint("/repositories/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "PUT", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update Azure repository: %w", err))
 	}
@@ -457,7 +375,7 @@ func ResourceAzureRepositoryUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return diag.FromErr(fmt.Errorf("failed to update Azure repository, status: %d, response: %s", resp.StatusCode, string(body)))
+		return diag.FromErr(fmt.Errorf("failed to update Azure repository: %w", vc.NewVeeamAPIError(resp.StatusCode, body)))
 	}
 
 	if len(body) > 0 {
@@ -484,8 +402,8 @@ func ResourceAzureRepositoryDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	url := client.BuildAPIURL(fmt.Sprintf("/repositories/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("DELETE", url, nil)
+	url := client.BuildAPIURL(vc.Endpoint("/repositories/%s", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete Azure repository: %w", err))
 	}
@@ -496,21 +414,21 @@ func ResourceAzureRepositoryDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("failed to read Azure repository delete response: %w", err))
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		d.SetId("")
-		return nil
-	}
-
 	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		return diag.FromErr(fmt.Errorf("failed to delete Azure repository, status: %d, response: %s", resp.StatusCode, string(body)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, body)
+		if vc.IsGone(apiErr) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to delete Azure repository: %w", apiErr))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func buildAzureRepositoryRequest(d *schema.ResourceData) AzureRepositoryRequest {
-	request := AzureRepositoryRequest{
+func buildAzureRepositoryRequest(d *schema.ResourceData) (azureapi.RepositorySpec, diag.Diagnostics) {
+	request := azureapi.RepositorySpec{
 		AzureStorageAccountID: d.Get("azure_storage_account_id").(string),
 		AzureStorageFolder:    d.Get("azure_storage_folder").(string),
 		AzureStorageContainer: d.Get("azure_storage_container").(string),
@@ -528,12 +446,12 @@ func buildAzureRepositoryRequest(d *schema.ResourceData) AzureRepositoryRequest
 	}
 
 	if value, ok := d.GetOk("storage_tier"); ok && value.(string) != "" {
-		v := value.(string)
+		v := azureapi.StorageTier(value.(string))
 		request.StorageTier = &v
 	}
 
 	if value, ok := d.GetOk("concurrency_limit"); ok {
-		v := value.(int)
+		v := int32(value.(int))
 		request.ConcurrencyLimit = &v
 	}
 
@@ -562,9 +480,12 @@ func buildAzureRepositoryRequest(d *schema.ResourceData) AzureRepositoryRequest
 		request.EnableEncryption = &v
 	}
 
-	if value, ok := d.GetOk("password"); ok && value.(string) != "" {
-		v := value.(string)
-		request.Password = &v
+	password, diags := vc.GetSecretString(d, "password")
+	if diags.HasError() {
+		return request, diags
+	}
+	if password != "" {
+		request.Password = &password
 	}
 
 	if value, ok := d.GetOk("hint"); ok {
@@ -576,34 +497,34 @@ func buildAzureRepositoryRequest(d *schema.ResourceData) AzureRepositoryRequest
 		limitList := value.([]interface{})
 		if len(limitList) > 0 && limitList[0] != nil {
 			limitMap := limitList[0].(map[string]interface{})
-			request.StorageConsumptionLimit = &AzureRepositoryStorageConsumptionLimit{
-				LimitValue: limitMap["limit_value"].(int),
+			request.StorageConsumptionLimit = &azureapi.StorageConsumptionLimit{
+				LimitValue: int32(limitMap["limit_value"].(int)),
 				LimitType:  limitMap["limit_type"].(string),
 			}
 		}
 	}
 
-	return request
+	return request, nil
 }
 
-func decodeAzureRepositoryResponse(body []byte) (AzureRepositoryResponse, error) {
-	var responseArray []AzureRepositoryResponse
+func decodeAzureRepositoryResponse(body []byte) (azureapi.Session, error) {
+	var responseArray []azureapi.Session
 	if err := json.Unmarshal(body, &responseArray); err == nil {
 		if len(responseArray) == 0 {
-			return AzureRepositoryResponse{}, fmt.Errorf("empty response array")
+			return azureapi.Session{}, fmt.Errorf("empty response array")
 		}
 		return responseArray[0], nil
 	}
 
-	var response AzureRepositoryResponse
+	var response azureapi.Session
 	if err := json.Unmarshal(body, &response); err != nil {
-		return AzureRepositoryResponse{}, err
+		return azureapi.Session{}, err
 	}
 
 	return response, nil
 }
 
-func setAzureRepositorySessionFields(d *schema.ResourceData, response AzureRepositoryResponse) error {
+func setAzureRepositorySessionFields(d *schema.ResourceData, response azureapi.Session) error {
 	if err := d.Set("status", response.Status); err != nil {
 		return fmt.Errorf("failed to set status: %w", err)
 	}
@@ -666,8 +587,8 @@ func setAzureRepositorySessionFields(d *schema.ResourceData, response AzureRepos
 	return nil
 }
 
-func setAzureRepositoryStateFromDetails(d *schema.ResourceData, repository *BackupRepositoryDetail) error {
-	if err := d.Set("azure_storage_account_id", repository.AzureStorageAccountId); err != nil {
+func setAzureRepositoryStateFromDetails(d *schema.ResourceData, repository *azureapi.Repository) error {
+	if err := d.Set("azure_storage_account_id", repository.AzureStorageAccountID); err != nil {
 		return fmt.Errorf("failed to set azure_storage_account_id: %w", err)
 	}
 
@@ -679,18 +600,18 @@ func setAzureRepositoryStateFromDetails(d *schema.ResourceData, repository *Back
 		return fmt.Errorf("failed to set azure_storage_container: %w", err)
 	}
 
-	if err := d.Set("azure_account_id", repository.AzureAccountId); err != nil {
+	if err := d.Set("azure_account_id", repository.AzureAccountID); err != nil {
 		return fmt.Errorf("failed to set azure_account_id: %w", err)
 	}
 
-	if repository.StorageTier != "" {
-		if err := d.Set("storage_tier", repository.StorageTier); err != nil {
+	if repository.StorageTier != nil && *repository.StorageTier != "" {
+		if err := d.Set("storage_tier", string(*repository.StorageTier)); err != nil {
 			return fmt.Errorf("failed to set storage_tier: %w", err)
 		}
 	}
 
 	if repository.ConcurrencyLimit > 0 {
-		if err := d.Set("concurrency_limit", repository.ConcurrencyLimit); err != nil {
+		if err := d.Set("concurrency_limit", int(repository.ConcurrencyLimit)); err != nil {
 			return fmt.Errorf("failed to set concurrency_limit: %w", err)
 		}
 	}
@@ -701,19 +622,23 @@ func setAzureRepositoryStateFromDetails(d *schema.ResourceData, repository *Back
 		}
 	}
 
-	if err := d.Set("description", repository.Description); err != nil {
+	description := ""
+	if repository.Description != nil {
+		description = *repository.Description
+	}
+	if err := d.Set("description", description); err != nil {
 		return fmt.Errorf("failed to set description: %w", err)
 	}
 
-	if err := d.Set("enable_encryption", repository.EncryptionEnabled); err != nil {
+	if err := d.Set("enable_encryption", repository.EnabledEncryption); err != nil {
 		return fmt.Errorf("failed to set enable_encryption: %w", err)
 	}
 
-	if repository.StorageConsumptionLimit.LimitType != "" && repository.StorageConsumptionLimit.LimitValue > 0 {
+	if limit := repository.StorageConsumptionLimit; limit != nil && limit.LimitType != "" && limit.LimitValue > 0 {
 		storageLimit := []interface{}{
 			map[string]interface{}{
-				"limit_value": repository.StorageConsumptionLimit.LimitValue,
-				"limit_type":  repository.StorageConsumptionLimit.LimitType,
+				"limit_value": int(limit.LimitValue),
+				"limit_type":  limit.LimitType,
 			},
 		}
 		if err := d.Set("storage_consumption_limit", storageLimit); err != nil {
@@ -729,7 +654,7 @@ func setAzureRepositoryStateFromDetails(d *schema.ResourceData, repository *Back
 
 	repositoryJobInfo := []interface{}{
 		map[string]interface{}{
-			"repository_id":      repository.VeeamID,
+			"repository_id":      repository.ID,
 			"repository_name":    repository.Name,
 			"repository_removed": false,
 		},
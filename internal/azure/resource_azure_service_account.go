@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -114,6 +115,7 @@ func ResourceAzureServiceAccount() *schema.Resource {
 						"application_certificate": {
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 							Description: "The application certificate for the Azure service account.",
 						},
 						"certificate_password": {
@@ -164,6 +166,12 @@ func ResourceAzureServiceAccount() *schema.Resource {
 				Description: "The unique identifier of the created service account.",
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
@@ -241,7 +249,7 @@ func ResourceAzureServiceAccountCreate(ctx context.Context, d *schema.ResourceDa
 	apiURL := client.BuildAPIURL("/accounts/azure/service/saveByApp")
 
 	// Make the API request
-	resp, err := client.MakeAuthenticatedRequest("POST", apiURL, bytes.NewBuffer(jsonData))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "POST", apiURL, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create Azure service account: %w", err))
 	}
@@ -277,7 +285,7 @@ func ResourceAzureServiceAccountCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if resp.StatusCode != 201 && resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
+		return diag.FromErr(vc.NewVeeamAPIError(resp.StatusCode, body))
 	}
 
 	// For 200 response, the API returns the account ID directly as a string
@@ -302,28 +310,26 @@ func ResourceAzureServiceAccountRead(ctx context.Context, d *schema.ResourceData
 	accountID := d.Id()
 
 	// Construct the API URL for reading the service account
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/accounts/azure/service/%s", accountID))
+	apiURL := client.BuildAPIURL(vc.Endpoint("/accounts/azure/service/%s", accountID))
 
 	// Make the API request
-	resp, err := client.MakeAuthenticatedRequest("GET", apiURL, nil)
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Azure service account: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == 404 {
-		// Resource no longer exists
-		d.SetId("")
-		return nil
-	}
-
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
 	}
 
 	if resp.StatusCode != 200 {
-		return diag.FromErr(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, body)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(apiErr)
 	}
 
 	// Parse the response using the existing AzureServiceAccountDetail struct
@@ -418,10 +424,10 @@ func ResourceAzureServiceAccountUpdate(ctx context.Context, d *schema.ResourceDa
     }
 
     // Construct the API URL for update
-    apiURL := client.BuildAPIURL(fmt.Sprintf("/accounts/azure/service/updateByApp/%s", accountID))
+    apiURL := client.BuildAPIURL(vc.Endpoint("/accounts/azure/service/updateByApp/%s", accountID))
 
     // Make the PUT API request
-    resp, err := client.MakeAuthenticatedRequest("PUT", apiURL, bytes.NewBuffer(jsonData))
+    resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "PUT", apiURL, bytes.NewBuffer(jsonData))
     if err != nil {
         return diag.FromErr(fmt.Errorf("failed to update Azure service account: %w", err))
     }
@@ -432,12 +438,6 @@ func ResourceAzureServiceAccountUpdate(ctx context.Context, d *schema.ResourceDa
         return diag.FromErr(fmt.Errorf("failed to read response body: %w", err))
     }
 
-    if resp.StatusCode == 404 {
-        // Resource no longer exists
-        d.SetId("")
-        return diag.FromErr(fmt.Errorf("Azure service account with ID %s not found", accountID))
-    }
-
     if resp.StatusCode == 202 {
         // Async operation - wait for completion
         var operationResponse map[string]interface{}
@@ -456,7 +456,7 @@ func ResourceAzureServiceAccountUpdate(ctx context.Context, d *schema.ResourceDa
             return diag.FromErr(fmt.Errorf("failed to complete update operation: %w", err))
         }
     } else if resp.StatusCode != 200 && resp.StatusCode != 204 {
-        return diag.FromErr(fmt.Errorf("failed to update Azure service account with status %d: %s", resp.StatusCode, string(body)))
+        return diag.FromErr(fmt.Errorf("failed to update Azure service account: %w", vc.NewVeeamAPIError(resp.StatusCode, body)))
     }
 
     // Read the updated resource to refresh state
@@ -472,23 +472,23 @@ func ResourceAzureServiceAccountDelete(ctx context.Context, d *schema.ResourceDa
 	accountID := d.Id()
 
 	// Construct the API URL for deleting the service account
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/accounts/azure/service/%s", accountID))
+	apiURL := client.BuildAPIURL(vc.Endpoint("/accounts/azure/service/%s", accountID))
 
 	// Make the API request
-	resp, err := client.MakeAuthenticatedRequest("DELETE", apiURL, nil)
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "DELETE", apiURL, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete Azure service account: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == 404 {
-		// Resource already deleted
-		return nil
-	}
-
 	if resp.StatusCode != 204 && resp.StatusCode != 200 {
 		body, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to delete Azure service account with status %d: %s", resp.StatusCode, string(body)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, body)
+		if vc.IsGone(apiErr) {
+			// Resource already deleted
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to delete Azure service account: %w", apiErr))
 	}
 
 	return nil
@@ -504,19 +504,20 @@ type OperationResult struct {
 	Error     interface{} `json:"error,omitempty"`
 }
 
-// waitForOperation waits for an async operation to complete and returns the account ID
+// waitForOperation waits for an async operation to complete and returns the ID of the object it
+// created, e.g. the service account
 func waitForOperation(ctx context.Context, client *vc.AzureBackupClient, operationID string) (string, error) {
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/operations/%s", operationID))
+	apiURL := client.BuildAPIURL(vc.Endpoint("/operations/%s", operationID))
 	
 	for {
 		select {
 		case <-ctx.Done():
-			return "", fmt.Errorf("operation cancelled by context")
+			return "", fmt.Errorf("timed out waiting for operation %s: %w", operationID, ctx.Err())
 		default:
 			// Continue polling
 		}
 
-		resp, err := client.MakeAuthenticatedRequest("GET", apiURL, nil)
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
 		if err != nil {
 			return "", fmt.Errorf("failed to check operation status: %w", err)
 		}
@@ -528,7 +529,7 @@ func waitForOperation(ctx context.Context, client *vc.AzureBackupClient, operati
 		}
 
 		if resp.StatusCode != 200 {
-			return "", fmt.Errorf("operation status check failed with status %d: %s", resp.StatusCode, string(body))
+			return "", fmt.Errorf("operation status check failed: %w", vc.NewVeeamAPIError(resp.StatusCode, body))
 		}
 
 		var opResult OperationResult
@@ -538,11 +539,17 @@ func waitForOperation(ctx context.Context, client *vc.AzureBackupClient, operati
 
 		switch opResult.Status {
 		case "Success", "Completed":
-			// According to API docs and Python script, result field contains the account ID as a string
+			// According to API docs and Python script, result field contains the account ID as a string.
+			// Operations creating other objects, e.g. backup policies, may return the object instead.
 			if opResult.Result != nil {
 				if accountID, ok := opResult.Result.(string); ok {
 					return accountID, nil
 				}
+				if object, ok := opResult.Result.(map[string]interface{}); ok {
+					if id, ok := object["id"].(string); ok && id != "" {
+						return id, nil
+					}
+				}
 				// Log the actual result for debugging
 				resultJson, _ := json.Marshal(opResult.Result)
 				return "", fmt.Errorf("operation completed but result is not a string. Result: %s (type: %T)", string(resultJson), opResult.Result)
@@ -554,11 +561,14 @@ func waitForOperation(ctx context.Context, client *vc.AzureBackupClient, operati
 			if opResult.Error != nil {
 				errorMsg = fmt.Sprintf("operation failed: %v", opResult.Error)
 			}
-			return "", fmt.Errorf(errorMsg)
+			return "", errors.New(errorMsg)
 		
 		case "Running", "InProgress":
 			// Continue polling - wait 5 seconds before next check
-			time.Sleep(5 * time.Second)
+			select {
+			case <-ctx.Done():
+			case <-time.After(5 * time.Second):
+			}
 			continue
 		
 		default:
@@ -568,11 +578,11 @@ func waitForOperation(ctx context.Context, client *vc.AzureBackupClient, operati
 }
 
 // findServiceAccountByName searches for a service account by name and returns its ID
-func findServiceAccountByName(client *vc.AzureBackupClient, name string) (string, error) {
+func findServiceAccountByName(ctx context.Context, client *vc.AzureBackupClient, name string) (string, error) {
 	// Use the existing datasource logic to find the service account
 	apiURL := client.BuildAPIURL("/accounts/azure/service")
 	
-	resp, err := client.MakeAuthenticatedRequest("GET", apiURL, nil)
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to list service accounts: %w", err)
 	}
@@ -580,7 +590,7 @@ func findServiceAccountByName(client *vc.AzureBackupClient, name string) (string
 
 	if resp.StatusCode != 200 {
 		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("failed to list service accounts with status %d: %s", resp.StatusCode, string(body))
+		return "", fmt.Errorf("failed to list service accounts: %w", vc.NewVeeamAPIError(resp.StatusCode, body))
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -611,17 +621,17 @@ func findServiceAccountByName(client *vc.AzureBackupClient, name string) (string
 
 // waitForOperationCompletion waits for an async operation to complete (doesn't return result data)
 func waitForOperationCompletion(ctx context.Context, client *vc.AzureBackupClient, operationID string) error {
-	apiURL := client.BuildAPIURL(fmt.Sprintf("/operations/%s", operationID))
+	apiURL := client.BuildAPIURL(vc.Endpoint("/operations/%s", operationID))
 	
 	for {
 		select {
 		case <-ctx.Done():
-			return fmt.Errorf("operation cancelled by context")
+			return fmt.Errorf("timed out waiting for operation %s: %w", operationID, ctx.Err())
 		default:
 			// Continue polling
 		}
 
-		resp, err := client.MakeAuthenticatedRequest("GET", apiURL, nil)
+		resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", apiURL, nil)
 		if err != nil {
 			return fmt.Errorf("failed to check operation status: %w", err)
 		}
@@ -633,7 +643,7 @@ func waitForOperationCompletion(ctx context.Context, client *vc.AzureBackupClien
 		}
 
 		if resp.StatusCode != 200 {
-			return fmt.Errorf("operation status check failed with status %d: %s", resp.StatusCode, string(body))
+			return fmt.Errorf("operation status check failed: %w", vc.NewVeeamAPIError(resp.StatusCode, body))
 		}
 
 		var opResult OperationResult
@@ -651,11 +661,14 @@ func waitForOperationCompletion(ctx context.Context, client *vc.AzureBackupClien
 			if opResult.Error != nil {
 				errorMsg = fmt.Sprintf("operation failed: %v", opResult.Error)
 			}
-			return fmt.Errorf(errorMsg)
+			return errors.New(errorMsg)
 		
 		case "Running", "InProgress":
 			// Continue polling - wait 5 seconds before next check
-			time.Sleep(5 * time.Second)
+			select {
+			case <-ctx.Done():
+			case <-time.After(5 * time.Second):
+			}
 			continue
 		
 		default:
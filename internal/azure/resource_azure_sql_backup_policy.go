@@ -63,8 +63,12 @@ type SQLBackupPolicyResponse struct {
 }
 
 type SQLBackupPolicySelectedItems struct {
-	Databases  *[]SQLDatabases `json:"databases,omitempty"`
-	SQLServers *[]SQLServers   `json:"sqlServers,omitempty"`
+	Databases      *[]SQLDatabases        `json:"databases,omitempty"`
+	SQLServers     *[]SQLServers          `json:"sqlServers,omitempty"`
+	Subscriptions  *[]AzureSubscriptions  `json:"subscriptions,omitempty"`
+	ResourceGroups *[]AzureResourceGroups `json:"resourceGroups,omitempty"`
+	Tags           *[]Tags                `json:"tags,omitempty"`
+	TagGroups      *[]AzureTagGroups      `json:"tagGroups,omitempty"`
 }
 
 type SQLBackupPolicyExcludedItems struct {
@@ -105,16 +109,18 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 255),
 			},
 			"regions": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Required:    true,
 				MinItems:    1,
 				Description: "Specifies Azure regions where the resources that will be backed up reside.",
+				Set:         vc.HashFieldIgnoreCase("name"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Azure region name.",
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "Azure region name.",
+							DiffSuppressFunc: vc.SuppressCaseDifference,
 						},
 					},
 				},
@@ -122,6 +128,7 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 			"tenant_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				ForceNew: true,
 			},
 			"service_account_id": {
 				Type:        schema.TypeString,
@@ -132,11 +139,11 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 				Type:        schema.TypeList,
 				Optional:    true,
 				MaxItems:    1,
-				Description: "Specifies the SQL Servers and Databases to be included in the backup policy.",
+				Description: "Specifies the SQL Servers and Databases, or the subscriptions, resource groups, tags and tag groups of the databases, to be included in the backup policy.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"databases": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "List of SQL Databases to include in the backup policy.",
 							Elem: &schema.Resource{
@@ -149,7 +156,7 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 							},
 						},
 						"sql_servers": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "List of SQL Servers to include in the backup policy.",
 							Elem: &schema.Resource{
@@ -161,6 +168,116 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 								},
 							},
 						},
+						"subscriptions": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Specifies a list of Azure subscription IDs whose databases to include in the backup scope.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"subscription_id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Azure subscription ID.",
+									},
+								},
+							},
+						},
+						"resource_groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Specifies a list of Azure resource groups whose databases to include in the backup scope.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource group system ID.",
+									},
+								},
+							},
+						},
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Specifies a list of tags assigned to the databases to include in the backup scope.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag name.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag value.",
+									},
+								},
+							},
+						},
+						"tag_groups": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Specifies a list of tag groups assigned to the databases to include in the backup scope.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag group name.",
+									},
+									"subscription": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Description: "Specifies the Azure subscription of the tag group.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"subscription_id": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "Azure subscription ID.",
+												},
+											},
+										},
+									},
+									"resource_groups": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Description: "Specifies the Azure resource group of the tag group.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "Resource group system ID.",
+												},
+											},
+										},
+									},
+									"tags": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Description: "Specifies a list of tags that the databases of the tag group must all have.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "Tag name.",
+												},
+												"value": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "Tag value.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -172,7 +289,7 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"databases": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "List of SQL Databases to exclude from the backup policy.",
 							Elem: &schema.Resource{
@@ -264,7 +381,7 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{"EveryDay", "Weekdays", "SelectedDays", "Unknown"}, false),
 						},
 						"selected_days": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies the days of the week when backups should be performed if the daily type is SelectedDays.",
 							Elem: &schema.Schema{
@@ -285,7 +402,7 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"hours": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the hours when snapshots should be taken.",
 										Elem: &schema.Schema{
@@ -308,7 +425,7 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"hours": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the hours when backups should be performed.",
 										Elem: &schema.Schema{
@@ -337,9 +454,10 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 										},
 									},
 									"target_repository_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Specifies the system ID of the target repository for daily backups.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the system ID of the target repository for daily backups.",
 									},
 								},
 							},
@@ -365,7 +483,7 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"selected_days": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the days of the week when snapshots should be taken.",
 										Elem: &schema.Schema{
@@ -388,7 +506,7 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"selected_days": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the days of the week when backups should be performed.",
 										Elem: &schema.Schema{
@@ -417,9 +535,10 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 										},
 									},
 									"target_repository_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Specifies the system ID of the target repository for weekly backups.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the system ID of the target repository for weekly backups.",
 									},
 								},
 							},
@@ -467,7 +586,7 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"selected_months": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the months when snapshots should be taken.",
 										Elem: &schema.Schema{
@@ -490,7 +609,7 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"selected_months": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the months when backups should be performed.",
 										Elem: &schema.Schema{
@@ -519,9 +638,10 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 										},
 									},
 									"target_repository_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Specifies the system ID of the target repository for monthly backups.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the system ID of the target repository for monthly backups.",
 									},
 								},
 							},
@@ -574,59 +694,24 @@ func ResourceAzureSQLBackupPolicy() *schema.Resource {
 							Description: "Specifies the number of years to retain yearly backups.",
 						},
 						"target_repository_id": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Specifies the system ID of the target repository for yearly backups.",
-						},
-					},
-				},
-			},
-			"health_check_schedule": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Specifies health check settings for the backup policy.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"health_check_enabled": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     false,
-							Description: "Defines whether health checks are enabled for the backup policy.",
-						},
-						"local_time": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Specifies the date and time when the health check will run.",
-						},
-						"day_number_in_month": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Description:  "Specifies the day number in the month when the health check will run.",
-							ValidateFunc: validation.StringInSlice([]string{"First", "Second", "Third", "Fourth", "Last", "OnDay", "EveryDay", "EverySelectedDay", "Unknown"}, false),
-						},
-						"day_of_week": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Description:  "Specifies the day of the week when the health check will run.",
-							ValidateFunc: validation.StringInSlice([]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}, false),
-						},
-						"day_of_month": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Specifies the day of the month when the health check will run.",
-						},
-						"months": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							Description: "Specifies the months when the health check will run.",
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}, false),
-							},
+							ValidateFunc: validation.IsUUID,
+							Description:  "Specifies the system ID of the target repository for yearly backups.",
 						},
 					},
 				},
 			},
+			"health_check_schedule": healthCheckScheduleSchema(),
+			"count_restore_points": countRestorePointsSchema(),
+			"protected_item_count": protectedItemCountSchema(),
+			"restore_point_count":  restorePointCountSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 	}
 }
@@ -636,31 +721,26 @@ func ResourceAzureSQLBackupPolicyCreate(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	policyRequest := buildSQLBackupPolicyRequest(d)
+	policyRequest := buildSQLBackupPolicyRequest(d, client)
 
 	jsonData, err := json.Marshal(policyRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to marshal SQL Backup Policy request: %w", err))
 	}
 
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/sql/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("POST", url, strings.NewReader(string(jsonData)))
+	url := client.BuildAPIURL(policyCollectionPath(policyTypeSQLDatabase))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to create SQL Backup Policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("Failed to create SQL Backup Policy, status: %s, response: %s", resp.Status, string(bodyBytes)))
-	}
-
-	var policyResponse SQLBackupPolicyResponse
-	if err := json.NewDecoder(resp.Body).Decode(&policyResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("Failed to decode SQL Backup Policy creation response: %w", err))
+	policyID, err := createdPolicyID(ctx, client, resp)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Failed to create SQL Backup Policy: %w", err))
 	}
 
-	d.SetId(policyResponse.ID)
+	d.SetId(policyID)
 	return ResourceAzureSQLBackupPolicyRead(ctx, d, meta)
 }
 
@@ -669,8 +749,8 @@ func ResourceAzureSQLBackupPolicyRead(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/sql/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("GET", url, nil)
+	url := client.BuildAPIURL(policyItemPath(policyTypeSQLDatabase, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to read SQL Backup Policy: %w", err))
 	}
@@ -678,7 +758,11 @@ func ResourceAzureSQLBackupPolicyRead(ctx context.Context, d *schema.ResourceDat
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("Failed to read SQL Backup Policy, status: %s, response: %s", resp.Status, string(bodyBytes)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, bodyBytes)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Failed to read SQL Backup Policy: %w", apiErr))
 	}
 
 	var policyResponse SQLBackupPolicyResponse
@@ -686,11 +770,17 @@ func ResourceAzureSQLBackupPolicyRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("Failed to decode SQL Backup Policy read response: %w", err))
 	}
 
+	readPolicyRestorePointCounts(ctx, client, d, policyTypeSQLDatabase, policyResponse.Name)
+
 	// Map response fields to resource data
 	d.Set("backup_type", policyResponse.BackupType)
 	d.Set("is_enabled", policyResponse.IsEnabled)
 	d.Set("name", policyResponse.Name)
-	d.Set("description", policyResponse.Description)
+	var description string
+	if policyResponse.Description != nil {
+		description = *policyResponse.Description
+	}
+	d.Set("description", client.TrimDescriptionSuffix(description))
 	d.Set("tenant_id", policyResponse.TenantID)
 	d.Set("is_enabled", policyResponse.IsEnabled)
 	d.Set("service_account_id", policyResponse.ServiceAccountID)
@@ -708,27 +798,29 @@ func ResourceAzureSQLBackupPolicyRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func ResourceAzureSQLBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if onlyCountRestorePointsChanged(d) {
+		return ResourceAzureSQLBackupPolicyRead(ctx, d, meta)
+	}
 	client, err := vc.GetAzureClient(meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	policyRequest := buildSQLBackupPolicyRequest(d)
+	policyRequest := buildSQLBackupPolicyRequest(d, client)
 
 	jsonData, err := json.Marshal(policyRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to marshal SQL Backup Policy request: %w", err))
 	}
 
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/sql/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("PUT", url, strings.NewReader(string(jsonData)))
+	url := client.BuildAPIURL(policyItemPath(policyTypeSQLDatabase, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "PUT", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to update SQL Backup Policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("Failed to update SQL Backup Policy, status: %s, response: %s", resp.Status, string(bodyBytes)))
+	if err := waitForPolicyUpdate(ctx, client, resp); err != nil {
+		return diag.FromErr(fmt.Errorf("Failed to update SQL Backup Policy: %w", err))
 	}
 
 	return ResourceAzureSQLBackupPolicyRead(ctx, d, meta)
@@ -739,8 +831,9 @@ func ResourceAzureSQLBackupPolicyDelete(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/sql/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("DELETE", url, nil)
+	kept := keptRestorePointsWarning(ctx, client, d, policyTypeSQLDatabase)
+	url := client.BuildAPIURL(policyItemPath(policyTypeSQLDatabase, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to delete SQL Backup Policy: %w", err))
 	}
@@ -748,15 +841,17 @@ func ResourceAzureSQLBackupPolicyDelete(ctx context.Context, d *schema.ResourceD
 
 	if resp.StatusCode != http.StatusNoContent {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("Failed to delete SQL Backup Policy, status: %s, response: %s", resp.Status, string(bodyBytes)))
+		if apiErr := vc.NewVeeamAPIError(resp.StatusCode, bodyBytes); !vc.IsGone(apiErr) {
+			return diag.FromErr(fmt.Errorf("Failed to delete SQL Backup Policy: %w", apiErr))
+		}
 	}
 
 	d.SetId("")
-	return nil
+	return kept
 }
 
 // Helper functions
-func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest {
+func buildSQLBackupPolicyRequest(d *schema.ResourceData, client *vc.AzureBackupClient) *SQLBackupPolicyRequest {
 	policyRequest := &SQLBackupPolicyRequest{
 		BackupType: d.Get("backup_type").(string),
 		IsEnabled:  d.Get("is_enabled").(bool),
@@ -765,7 +860,7 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 
 	// Regions
 	if v, ok := d.GetOk("regions"); ok {
-		regionsList := v.([]interface{})
+		regionsList := v.(*schema.Set).List()
 		regions := make([]PolicyRegion, len(regionsList))
 		for i, region := range regionsList {
 			regionMap := region.(map[string]interface{})
@@ -786,8 +881,7 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 		policyRequest.ServiceAccountID = &serviceAccountID
 	}
 	// Description
-	if v, ok := d.GetOk("description"); ok {
-		description := v.(string)
+	if description := client.AppendDescriptionSuffix(d.Get("description").(string)); description != "" {
 		policyRequest.Description = &description
 	}
 	// Retry Settings
@@ -831,7 +925,7 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 			selectedItems := &SQLBackupPolicySelectedItems{}
 			// Databases
 			if dbs, ok := selectedItemsMap["databases"]; ok {
-				databasesList := dbs.([]interface{})
+				databasesList := dbs.(*schema.Set).List()
 				databases := make([]SQLDatabases, len(databasesList))
 				for i, db := range databasesList {
 					dbMap := db.(map[string]interface{})
@@ -843,7 +937,7 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 			}
 			// SQL Servers
 			if srs, ok := selectedItemsMap["sql_servers"]; ok {
-				sqlServersList := srs.([]interface{})
+				sqlServersList := srs.(*schema.Set).List()
 				sqlServers := make([]SQLServers, len(sqlServersList))
 				for i, sr := range sqlServersList {
 					srMap := sr.(map[string]interface{})
@@ -853,6 +947,20 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 				}
 				selectedItems.SQLServers = &sqlServers
 			}
+			// Subscriptions, resource groups, tags and tag groups
+			if subs, ok := selectedItemsMap["subscriptions"]; ok {
+				selectedItems.Subscriptions = expandAzureSubscriptions(subs.(*schema.Set))
+			}
+			if rgs, ok := selectedItemsMap["resource_groups"]; ok {
+				selectedItems.ResourceGroups = expandAzureResourceGroups(rgs.(*schema.Set))
+			}
+			if tags, ok := selectedItemsMap["tags"]; ok && tags.(*schema.Set).Len() > 0 {
+				tagsList := expandTags(tags.(*schema.Set))
+				selectedItems.Tags = &tagsList
+			}
+			if tgs, ok := selectedItemsMap["tag_groups"]; ok {
+				selectedItems.TagGroups = expandAzureTagGroups(tgs.(*schema.Set))
+			}
 			policyRequest.SelectedItems = selectedItems
 		}
 	}
@@ -864,7 +972,7 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 			excludedItems := &SQLBackupPolicyExcludedItems{}
 			// Databases
 			if dbs, ok := excludedItemsMap["databases"]; ok {
-				databasesList := dbs.([]interface{})
+				databasesList := dbs.(*schema.Set).List()
 				databases := make([]SQLDatabases, len(databasesList))
 				for i, db := range databasesList {
 					dbMap := db.(map[string]interface{})
@@ -951,7 +1059,7 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 					snapMap := snapList[0].(map[string]interface{})
 					snapshot := SnapshotSchedule{}
 					if days, ok := snapMap["selected_days"]; ok && days != nil {
-						for _, day := range days.([]interface{}) {
+						for _, day := range days.(*schema.Set).List() {
 							snapshot.SelectedDays = append(snapshot.SelectedDays, day.(string))
 						}
 					}
@@ -969,7 +1077,7 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 					backupMap := backupList[0].(map[string]interface{})
 					schedBackup := BackupSchedule{}
 					if days, ok := backupMap["selected_days"]; ok && days != nil {
-						for _, day := range days.([]interface{}) {
+						for _, day := range days.(*schema.Set).List() {
 							schedBackup.SelectedDays = append(schedBackup.SelectedDays, day.(string))
 						}
 					}
@@ -1035,7 +1143,7 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 					snapMap := snapList[0].(map[string]interface{})
 					snapshot := SnapshotSchedule{}
 					if months, ok := snapMap["selected_months"]; ok && months != nil {
-						for _, month := range months.([]interface{}) {
+						for _, month := range months.(*schema.Set).List() {
 							snapshot.SelectedMonths = append(snapshot.SelectedMonths, month.(string))
 						}
 					}
@@ -1053,7 +1161,7 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 					backupMap := backupList[0].(map[string]interface{})
 					schedBackup := BackupSchedule{}
 					if months, ok := backupMap["selected_months"]; ok && months != nil {
-						for _, month := range months.([]interface{}) {
+						for _, month := range months.(*schema.Set).List() {
 							schedBackup.SelectedMonths = append(schedBackup.SelectedMonths, month.(string))
 						}
 					}
@@ -1131,39 +1239,7 @@ func buildSQLBackupPolicyRequest(d *schema.ResourceData) *SQLBackupPolicyRequest
 
 	// Health check schedule
 	if v, ok := d.GetOk("health_check_schedule"); ok {
-		healthList := v.([]interface{})
-		if len(healthList) > 0 {
-			healthMap := healthList[0].(map[string]interface{})
-			sched := HealthCheckSchedule{}
-
-			if enabled, ok := healthMap["health_check_enabled"]; ok {
-				val := enabled.(bool)
-				sched.HealthCheckEnabled = &val
-			}
-			if local, ok := healthMap["local_time"]; ok && local != "" {
-				val := local.(string)
-				sched.LocalTime = &val
-			}
-			if dayNum, ok := healthMap["day_number_in_month"]; ok && dayNum != "" {
-				val := dayNum.(string)
-				sched.DayNumberInMonth = &val
-			}
-			if dow, ok := healthMap["day_of_week"]; ok && dow != "" {
-				val := dow.(string)
-				sched.DayOfWeek = &val
-			}
-			if dom, ok := healthMap["day_of_month"]; ok {
-				val := dom.(int)
-				sched.DayOfMonth = &val
-			}
-			if months, ok := healthMap["months"]; ok && months != nil {
-				for _, month := range months.([]interface{}) {
-					sched.Months = append(sched.Months, month.(string))
-				}
-			}
-
-			policyRequest.HealthCheckSchedule = &sched
-		}
+		policyRequest.HealthCheckSchedule = expandHealthCheckSchedule(v.([]interface{}))
 	}
 
 	return policyRequest
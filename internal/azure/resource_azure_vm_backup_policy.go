@@ -100,7 +100,7 @@ type ScriptSettings struct {
 
 // ResourceAzureVMBackupPolicy returns the resource for Azure VM backup policies
 func ResourceAzureVMBackupPolicy() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceVMBackupPolicyCreate,
 		ReadContext:   resourceVMBackupPolicyRead,
 		UpdateContext: resourceVMBackupPolicyUpdate,
@@ -108,6 +108,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"is_enabled": {
 				Type:        schema.TypeBool,
@@ -121,16 +122,18 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 255),
 			},
 			"regions": {
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Required:    true,
 				MinItems:    1,
 				Description: "Specifies Azure regions where the resources that will be backed up reside.",
+				Set:         vc.HashFieldIgnoreCase("name"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Azure region name.",
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "Azure region name.",
+							DiffSuppressFunc: vc.SuppressCaseDifference,
 						},
 					},
 				},
@@ -155,7 +158,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							Description: "Defines whether to enable application-aware processing.",
 						},
 						"additional_tags": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of additional tags to assign to the snapshots created by the backup policy.",
 							Elem: &schema.Resource{
@@ -295,6 +298,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "Specifies a Microsoft Azure ID assigned to a tenant.",
 			},
 			"service_account_id": {
@@ -311,7 +315,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"subscriptions": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of Azure subscription IDs to include in the backup scope.",
 							Elem: &schema.Resource{
@@ -325,7 +329,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							},
 						},
 						"tags": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of tags assigned to Azure resources to include in the backup scope.",
 							Elem: &schema.Resource{
@@ -344,7 +348,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							},
 						},
 						"resource_groups": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of Azure resource groups to include in the backup scope.",
 							Elem: &schema.Resource{
@@ -358,7 +362,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							},
 						},
 						"virtual_machines": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of protected Azure VMs.",
 							Elem: &schema.Resource{
@@ -372,7 +376,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							},
 						},
 						"tag_groups": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of tag groups assigned to Azure resources to include in the backup scope.",
 							Elem: &schema.Resource{
@@ -382,7 +386,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 										Required:    true,
 										Description: "Tag group name.",
 									},
-									"subsciption": {
+									"subscription": {
 										Type:        schema.TypeList,
 										Optional:    true,
 										Description: "Specifies a list of Azure subscription IDs to include in the tag group.",
@@ -396,6 +400,21 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 											},
 										},
 									},
+									"subsciption": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Deprecated:  "Use subscription instead.",
+										Description: "Deprecated misspelling of subscription, accepted for configurations written for earlier versions. Ignored when subscription is set.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"subscription_id": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "Azure subscription ID.",
+												},
+											},
+										},
+									},
 									"resource_groups": {
 										Type:        schema.TypeList,
 										Optional:    true,
@@ -411,7 +430,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 										},
 									},
 									"tags": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies a list of tags assigned to Azure resources to include in the tag group.",
 										Elem: &schema.Resource{
@@ -442,7 +461,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"virtual_machines": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of protected Azure VMs to exclude from the backup policy.",
 							Elem: &schema.Resource{
@@ -456,7 +475,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							},
 						},
 						"tags": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies a list of tags assigned to Azure resources to exclude from the backup policy.",
 							Elem: &schema.Resource{
@@ -530,10 +549,11 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 				},
 			},
 			"backup_type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Defines whether you want to include to the backup scope all resources residing in the specified Azure regions.",
-				ValidateFunc: validation.StringInSlice([]string{"AllSubscriptions", "SelectedItems", "Unknown"}, false),
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Defines whether you want to include to the backup scope all resources residing in the specified Azure regions.",
+				DiffSuppressFunc: vc.SuppressCaseDifference,
+				ValidateFunc:     validation.StringInSlice([]string{"AllSubscriptions", "SelectedItems", "Unknown"}, true),
 			},
 			"daily_schedule": {
 				Type:        schema.TypeList,
@@ -548,7 +568,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{"EveryDay", "Weekdays", "SelectedDays", "Unknown"}, false),
 						},
 						"selected_days": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "Specifies the days of the week when backups should be performed if the daily type is SelectedDays.",
 							Elem: &schema.Schema{
@@ -569,7 +589,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"hours": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the hours when snapshots should be taken.",
 										Elem: &schema.Schema{
@@ -592,7 +612,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"hours": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the hours when backups should be performed.",
 										Elem: &schema.Schema{
@@ -621,9 +641,10 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 										},
 									},
 									"target_repository_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Specifies the system ID of the target repository for daily backups.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the system ID of the target repository for daily backups.",
 									},
 								},
 							},
@@ -649,7 +670,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"selected_days": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the days of the week when snapshots should be taken.",
 										Elem: &schema.Schema{
@@ -672,7 +693,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"selected_days": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the days of the week when backups should be performed.",
 										Elem: &schema.Schema{
@@ -701,9 +722,10 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 										},
 									},
 									"target_repository_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Specifies the system ID of the target repository for weekly backups.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the system ID of the target repository for weekly backups.",
 									},
 								},
 							},
@@ -751,7 +773,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"selected_months": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the months when snapshots should be taken.",
 										Elem: &schema.Schema{
@@ -774,7 +796,7 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"selected_months": {
-										Type:        schema.TypeList,
+										Type:        schema.TypeSet,
 										Optional:    true,
 										Description: "Specifies the months when backups should be performed.",
 										Elem: &schema.Schema{
@@ -803,9 +825,10 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 										},
 									},
 									"target_repository_id": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Specifies the system ID of the target repository for monthly backups.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the system ID of the target repository for monthly backups.",
 									},
 								},
 							},
@@ -858,59 +881,15 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 							Description: "Specifies the number of years to retain yearly backups.",
 						},
 						"target_repository_id": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Specifies the system ID of the target repository for yearly backups.",
-						},
-					},
-				},
-			},
-			"health_check_settings": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Specifies health check settings for the backup policy.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"health_check_enabled": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     false,
-							Description: "Defines whether health checks are enabled for the backup policy.",
-						},
-						"local_time": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Specifies the date and time when the health check will run.",
-						},
-						"day_number_in_month": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Description:  "Specifies the day number in the month when the health check will run.",
-							ValidateFunc: validation.StringInSlice([]string{"First", "Second", "Third", "Fourth", "Last", "OnDay", "EveryDay", "EverySelectedDay", "Unknown"}, false),
-						},
-						"day_of_week": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							Description:  "Specifies the day of the week when the health check will run.",
-							ValidateFunc: validation.StringInSlice([]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}, false),
-						},
-						"day_of_month": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Specifies the day of the month when the health check will run.",
-						},
-						"months": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							Description: "Specifies the months when the health check will run.",
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}, false),
-							},
+							ValidateFunc: validation.IsUUID,
+							Description:  "Specifies the system ID of the target repository for yearly backups.",
 						},
 					},
 				},
-			}, // computed fields
+			},
+			"health_check_settings": healthCheckScheduleSchema(), // computed fields
 			"is_backup_configured": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -921,6 +900,9 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 				Computed:    true,
 				Description: "Indicates whether a backup schedule is configured for the policy.",
 			},
+			"count_restore_points": countRestorePointsSchema(),
+			"protected_item_count": protectedItemCountSchema(),
+			"restore_point_count":  restorePointCountSchema(),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -929,6 +911,12 @@ func ResourceAzureVMBackupPolicy() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		// tag_groups.subscription was misspelled subsciption in version 0. Configurations that still
+		// use the deprecated alias plan a one-time update after the upgrade, which the API ignores.
+		vc.RenameAttributeUpgrader(r, 0, "selected_items.tag_groups", "subsciption", "subscription"),
+	}
+	return r
 }
 
 func resourceVMBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -937,31 +925,26 @@ func resourceVMBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	policyRequest := buildVMBackupPolicyRequest(d)
+	policyRequest := buildVMBackupPolicyRequest(d, client)
 
 	jsonData, err := json.Marshal(policyRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to marshal policy request: %w", err))
 	}
 
-	url := client.BuildAPIURL("/policies/virtualMachines")
-	resp, err := client.MakeAuthenticatedRequest("POST", url, strings.NewReader(string(jsonData)))
+	url := client.BuildAPIURL(policyCollectionPath(policyTypeVirtualMachine))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create VM backup policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to create VM backup policy (status %d): %s", resp.StatusCode, string(body)))
-	}
-
-	var policyResponse VMBackupPolicyResponse
-	if err := json.NewDecoder(resp.Body).Decode(&policyResponse); err != nil {
-		return diag.FromErr(fmt.Errorf("failed to decode policy response: %w", err))
+	policyID, err := createdPolicyID(ctx, client, resp)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create VM backup policy: %w", err))
 	}
 
-	d.SetId(policyResponse.ID)
+	d.SetId(policyID)
 	return resourceVMBackupPolicyRead(ctx, d, meta)
 }
 
@@ -971,21 +954,20 @@ func resourceVMBackupPolicyRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/virtualMachines/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("GET", url, nil)
+	url := client.BuildAPIURL(policyItemPath(policyTypeVirtualMachine, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read VM backup policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		d.SetId("")
-		return nil
-	}
-
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to read VM backup policy: %s", string(body)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, body)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read VM backup policy: %w", apiErr))
 	}
 
 	var policyResponse VMBackupPolicyResponse
@@ -993,12 +975,18 @@ func resourceVMBackupPolicyRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(fmt.Errorf("failed to decode policy response: %w", err))
 	}
 
+	readPolicyRestorePointCounts(ctx, client, d, policyTypeVirtualMachine, policyResponse.Name)
+
 	// Set fields directly
 	d.Set("is_enabled", policyResponse.IsEnabled)
 	d.Set("name", policyResponse.Name)
 	d.Set("tenant_id", policyResponse.TenantID)
 	d.Set("service_account_id", policyResponse.ServiceAccountID)
-	d.Set("description", policyResponse.Description)
+	var description string
+	if policyResponse.Description != nil {
+		description = *policyResponse.Description
+	}
+	d.Set("description", client.TrimDescriptionSuffix(description))
 	d.Set("backup_type", policyResponse.BackupType)
 	// Set computed fields
 	d.Set("is_backup_configured", policyResponse.IsBackupConfigured)
@@ -1019,28 +1007,30 @@ func resourceVMBackupPolicyRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceVMBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if onlyCountRestorePointsChanged(d) {
+		return resourceVMBackupPolicyRead(ctx, d, meta)
+	}
 	client, err := vc.GetAzureClient(meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	policyRequest := buildVMBackupPolicyRequest(d)
+	policyRequest := buildVMBackupPolicyRequest(d, client)
 
 	jsonData, err := json.Marshal(policyRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to marshal policy request: %w", err))
 	}
 
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/virtualMachines/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("PUT", url, strings.NewReader(string(jsonData)))
+	url := client.BuildAPIURL(policyItemPath(policyTypeVirtualMachine, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "PUT", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update VM backup policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to update VM backup policy (status %d): %s", resp.StatusCode, string(body)))
+	if err := waitForPolicyUpdate(ctx, client, resp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update VM backup policy: %w", err))
 	}
 
 	return resourceVMBackupPolicyRead(ctx, d, meta)
@@ -1051,25 +1041,28 @@ func resourceVMBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	kept := keptRestorePointsWarning(ctx, client, d, policyTypeVirtualMachine)
 
-	url := client.BuildAPIURL(fmt.Sprintf("/policies/virtualMachines/%s", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("DELETE", url, nil)
+	url := client.BuildAPIURL(policyItemPath(policyTypeVirtualMachine, d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete VM backup policy: %w", err))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+	if resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("failed to delete VM backup policy: %s", string(body)))
+		if apiErr := vc.NewVeeamAPIError(resp.StatusCode, body); !vc.IsGone(apiErr) {
+			return diag.FromErr(fmt.Errorf("failed to delete VM backup policy: %w", apiErr))
+		}
 	}
 
 	d.SetId("")
-	return nil
+	return kept
 }
 
 // Helper functions
-func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
+func buildVMBackupPolicyRequest(d *schema.ResourceData, client *vc.AzureBackupClient) VMBackupPolicyRequest {
 	request := VMBackupPolicyRequest{
 		BackupType:       d.Get("backup_type").(string),
 		IsEnabled:        d.Get("is_enabled").(bool),
@@ -1084,14 +1077,13 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 		request.ID = &id
 	}
 
-	if desc, ok := d.GetOk("description"); ok {
-		description := desc.(string)
+	if description := client.AppendDescriptionSuffix(d.Get("description").(string)); description != "" {
 		request.Description = &description
 	}
 
 	// Build regions
 	if regionsData, ok := d.GetOk("regions"); ok {
-		regions := regionsData.([]interface{})
+		regions := regionsData.(*schema.Set).List()
 		for _, r := range regions {
 			region := r.(map[string]interface{})
 			policyRegion := PolicyRegion{
@@ -1112,7 +1104,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 
 			// Handle virtual machines
 			if vms, ok := selectedItemsMap["virtual_machines"]; ok && vms != nil {
-				vmsList := vms.([]interface{})
+				vmsList := vms.(*schema.Set).List()
 				for _, vm := range vmsList {
 					vmMap := vm.(map[string]interface{})
 					virtualMachine := VMPolicyVirtualMachine{
@@ -1124,7 +1116,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 
 			// Handle subscriptions
 			if subs, ok := selectedItemsMap["subscriptions"]; ok && subs != nil {
-				subsList := subs.([]interface{})
+				subsList := subs.(*schema.Set).List()
 				if len(subsList) > 0 {
 					subscriptions := []AzureSubscriptions{}
 					for _, sub := range subsList {
@@ -1140,7 +1132,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 
 			// Handle tags
 			if tags, ok := selectedItemsMap["tags"]; ok && tags != nil {
-				tagsList := tags.([]interface{})
+				tagsList := tags.(*schema.Set).List()
 				if len(tagsList) > 0 {
 					tagsArray := []Tags{}
 					for _, tag := range tagsList {
@@ -1157,7 +1149,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 
 			// Handle resource groups
 			if rgs, ok := selectedItemsMap["resource_groups"]; ok && rgs != nil {
-				rgsList := rgs.([]interface{})
+				rgsList := rgs.(*schema.Set).List()
 				if len(rgsList) > 0 {
 					resourceGroups := []AzureResourceGroups{}
 					for _, rg := range rgsList {
@@ -1173,60 +1165,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 
 			// Handle tag groups
 			if tgs, ok := selectedItemsMap["tag_groups"]; ok && tgs != nil {
-				tgsList := tgs.([]interface{})
-				if len(tgsList) > 0 {
-					tagGroups := []AzureTagGroups{}
-					for _, tg := range tgsList {
-						tgMap := tg.(map[string]interface{})
-						tagGroup := AzureTagGroups{
-							Name: tgMap["name"].(string),
-						}
-
-						// Handle subscription in tag group (singular)
-						if tgSubs, ok := tgMap["subsciption"]; ok && tgSubs != nil {
-							tgSubsList := tgSubs.([]interface{})
-							if len(tgSubsList) > 0 && len(tgSubsList[0].(map[string]interface{})) > 0 {
-								subMap := tgSubsList[0].(map[string]interface{})
-								subscription := &AzureSubscriptions{
-									SubscriptionID: subMap["subscription_id"].(string),
-								}
-								tagGroup.Subscription = subscription
-							}
-						}
-
-						// Handle resource groups in tag group (singular)
-						if tgRgs, ok := tgMap["resource_groups"]; ok && tgRgs != nil {
-							tgRgsList := tgRgs.([]interface{})
-							if len(tgRgsList) > 0 && len(tgRgsList[0].(map[string]interface{})) > 0 {
-								rgMap := tgRgsList[0].(map[string]interface{})
-								resourceGroup := &AzureResourceGroups{
-									ID: rgMap["id"].(string),
-								}
-								tagGroup.ResourceGroups = resourceGroup
-							}
-						}
-
-						// Handle tags in tag group
-						if tgTags, ok := tgMap["tags"]; ok && tgTags != nil {
-							tgTagsList := tgTags.([]interface{})
-							if len(tgTagsList) > 0 {
-								tags := []Tags{}
-								for _, tag := range tgTagsList {
-									tagMap := tag.(map[string]interface{})
-									tagObj := Tags{
-										Name:  tagMap["name"].(string),
-										Value: tagMap["value"].(string),
-									}
-									tags = append(tags, tagObj)
-								}
-								tagGroup.Tags = tags
-							}
-						}
-
-						tagGroups = append(tagGroups, tagGroup)
-					}
-					selectedItems.TagGroups = &tagGroups
-				}
+				selectedItems.TagGroups = expandAzureTagGroups(tgs.(*schema.Set))
 			}
 
 			request.SelectedItems = &selectedItems
@@ -1242,7 +1181,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 
 			// Handle virtual machines
 			if vms, ok := excludedItemsMap["virtual_machines"]; ok && vms != nil {
-				vmsList := vms.([]interface{})
+				vmsList := vms.(*schema.Set).List()
 				if len(vmsList) > 0 {
 					virtualMachines := []VMPolicyVirtualMachine{}
 					for _, vm := range vmsList {
@@ -1258,7 +1197,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 
 			// Handle tags
 			if tags, ok := excludedItemsMap["tags"]; ok && tags != nil {
-				tagsList := tags.([]interface{})
+				tagsList := tags.(*schema.Set).List()
 				if len(tagsList) > 0 {
 					tagsArray := []Tags{}
 					for _, tag := range tagsList {
@@ -1289,7 +1228,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 
 			// Handle additional tags
 			if addTags, ok := snapshot["additional_tags"]; ok && addTags != nil {
-				addTagsList := addTags.([]interface{})
+				addTagsList := addTags.(*schema.Set).List()
 				if len(addTagsList) > 0 {
 					tagsArray := []Tags{}
 					for _, tag := range addTagsList {
@@ -1426,7 +1365,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 				dailySchedule.DailyType = &dailyTypeStr
 			}
 			if selectedDays, ok := dailyMap["selected_days"]; ok && selectedDays != nil {
-				daysList := selectedDays.([]interface{})
+				daysList := selectedDays.(*schema.Set).List()
 				days := []string{}
 				for _, day := range daysList {
 					days = append(days, day.(string))
@@ -1446,7 +1385,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 					snapshotSchedule := SnapshotSchedule{}
 
 					if hours, ok := snapSchedMap["hours"]; ok && hours != nil {
-						hoursList := hours.([]interface{})
+						hoursList := hours.(*schema.Set).List()
 						hoursArray := []int{}
 						for _, hour := range hoursList {
 							hoursArray = append(hoursArray, hour.(int))
@@ -1470,7 +1409,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 
 					// Only include hours if explicitly set and not empty
 					if hours, ok := backupSchedMap["hours"]; ok && hours != nil {
-						hoursList := hours.([]interface{})
+						hoursList := hours.(*schema.Set).List()
 						if len(hoursList) > 0 {
 							hoursArray := []int{}
 							for _, hour := range hoursList {
@@ -1529,7 +1468,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 					snapshotSchedule := SnapshotSchedule{}
 
 					if selectedDays, ok := snapSchedMap["selected_days"]; ok && selectedDays != nil {
-						daysList := selectedDays.([]interface{})
+						daysList := selectedDays.(*schema.Set).List()
 						days := []string{}
 						for _, day := range daysList {
 							days = append(days, day.(string))
@@ -1552,7 +1491,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 					backupSchedule := BackupSchedule{}
 
 					if selectedDays, ok := backupSchedMap["selected_days"]; ok && selectedDays != nil {
-						daysList := selectedDays.([]interface{})
+						daysList := selectedDays.(*schema.Set).List()
 						days := []string{}
 						for _, day := range daysList {
 							days = append(days, day.(string))
@@ -1624,7 +1563,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 					snapshotSchedule := SnapshotSchedule{}
 
 					if selectedMonths, ok := snapSchedMap["selected_months"]; ok && selectedMonths != nil {
-						monthsList := selectedMonths.([]interface{})
+						monthsList := selectedMonths.(*schema.Set).List()
 						months := []string{}
 						for _, month := range monthsList {
 							months = append(months, month.(string))
@@ -1647,7 +1586,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 					backupSchedule := BackupSchedule{}
 
 					if selectedMonths, ok := backupSchedMap["selected_months"]; ok && selectedMonths != nil {
-						monthsList := selectedMonths.([]interface{})
+						monthsList := selectedMonths.(*schema.Set).List()
 						months := []string{}
 						for _, month := range monthsList {
 							months = append(months, month.(string))
@@ -1729,42 +1668,7 @@ func buildVMBackupPolicyRequest(d *schema.ResourceData) VMBackupPolicyRequest {
 
 	// Build health check settings
 	if healthData, ok := d.GetOk("health_check_settings"); ok {
-		healthList := healthData.([]interface{})
-		if len(healthList) > 0 {
-			healthMap := healthList[0].(map[string]interface{})
-			healthSchedule := HealthCheckSchedule{}
-
-			if enabled, ok := healthMap["health_check_enabled"]; ok {
-				enabledBool := enabled.(bool)
-				healthSchedule.HealthCheckEnabled = &enabledBool
-			}
-			if localTime, ok := healthMap["local_time"]; ok && localTime != "" {
-				timeStr := localTime.(string)
-				healthSchedule.LocalTime = &timeStr
-			}
-			if dayNumberInMonth, ok := healthMap["day_number_in_month"]; ok && dayNumberInMonth != "" {
-				dayNum := dayNumberInMonth.(string)
-				healthSchedule.DayNumberInMonth = &dayNum
-			}
-			if dayOfWeek, ok := healthMap["day_of_week"]; ok && dayOfWeek != "" {
-				dow := dayOfWeek.(string)
-				healthSchedule.DayOfWeek = &dow
-			}
-			if dayOfMonth, ok := healthMap["day_of_month"]; ok {
-				dom := dayOfMonth.(int)
-				healthSchedule.DayOfMonth = &dom
-			}
-			if months, ok := healthMap["months"]; ok && months != nil {
-				monthsList := months.([]interface{})
-				monthsArray := []string{}
-				for _, month := range monthsList {
-					monthsArray = append(monthsArray, month.(string))
-				}
-				healthSchedule.Months = monthsArray
-			}
-
-			request.HealthCheckSchedule = &healthSchedule
-		}
+		request.HealthCheckSchedule = expandHealthCheckSchedule(healthData.([]interface{}))
 	}
 
 	return request
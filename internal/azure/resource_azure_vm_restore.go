@@ -8,19 +8,27 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Locations a VM is restored to
+const (
+	AzureVMRestoreModeOriginal    = "Original"
+	AzureVMRestoreModeAlternative = "Alternative"
+)
+
 // Request
 type AzureVMRestoreRequest struct {
 	Reason                 string                           `json:"reason"`
 	ServiceAccountID       string                           `json:"serviceAccountId"`
 	SourceServiceAccountID *string                          `json:"sourceServiceAccountId,omitempty"`
 	ToAlternative          *AzureVMRestoreToAlternative `json:"toAlternative,omitempty"`
-	StartVMAfterRestore    bool                             `json:startVmAfterRestore`
+	StartVMAfterRestore    bool                             `json:"startVmAfterRestore"`
+	Disks                  []string                         `json:"disks,omitempty"`
 }
 
 type AzureVMRestoreToAlternative struct {
@@ -37,6 +45,14 @@ type AzureVMRestoreToAlternative struct {
 	DiskType             string                                `json:"diskType"`
 	OsDisk               *AzureRestoreDiskRestoreOptionsBase   `json:"osDisk,omitempty"`
 	DataDisks            *[]AzureRestoreDiskRestoreOptionsBase `json:"dataDisks,omitempty"`
+	Tags                 []Tags                                `json:"tags,omitempty"`
+	BootDiagnostics      *AzureRestoreBootDiagnostics          `json:"bootDiagnostics,omitempty"`
+	CreatePublicIP       bool                                  `json:"createPublicIp,omitempty"`
+}
+
+type AzureRestoreBootDiagnostics struct {
+	IsEnabled        bool    `json:"isEnabled"`
+	StorageAccountID *string `json:"storageAccountId,omitempty"`
 }
 
 type AzureRestoreResourceGroup struct {
@@ -215,11 +231,13 @@ func ResourceAzureVMRestore() *schema.Resource {
 		CreateContext: ResourceAzureVMRestoreCreate,
 		ReadContext:   ResourceAzureVMRestoreRead,
 		DeleteContext: ResourceAzureVMRestoreDelete,
+		CustomizeDiff: resourceAzureVMRestoreCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"restore_point_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Specifies the system ID assigned to a restore point in the Veeam Backup for Microsoft Azure REST API.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "Specifies the system ID assigned to a restore point in the Veeam Backup for Microsoft Azure REST API.",
 			},
 			"reason": {
 				Type:         schema.TypeString,
@@ -243,6 +261,22 @@ func ResourceAzureVMRestore() *schema.Resource {
 				Optional:    true,
 				Description: "Specifies the system ID assigned to the source service account in the Veeam Backup for Microsoft Azure REST API. This field is required when restoring a VM from a different service account.",
 			},
+			"restore_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{AzureVMRestoreModeOriginal, AzureVMRestoreModeAlternative}, false),
+				Description:  "Specifies where the VM is restored: Original, to replace the backed-up VM, or Alternative, with the settings of to_alternative. to_alternative is required for Alternative and not allowed for Original. When not set, Alternative is used if to_alternative is set and Original otherwise.",
+			},
+			"disks_to_restore": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Specifies the system IDs of the disks of the backed-up VM to restore. When set, only these disks are restored as managed disks, instead of the whole VM.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
 			"to_alternative": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -511,6 +545,52 @@ func ResourceAzureVMRestore() *schema.Resource {
 							Required:    true,
 							Description: "Specifies the type of disk to be used for the restored VM (e.g., Standard_LRS, Premium_LRS).",
 						},
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Specifies the tags to assign to the restored VM.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag name.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag value.",
+									},
+								},
+							},
+						},
+						"boot_diagnostics": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Configuration block for the boot diagnostics of the restored VM.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     true,
+										Description: "Defines whether boot diagnostics are enabled for the restored VM.",
+									},
+									"storage_account_id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Specifies the system ID assigned to the storage account that keeps the boot diagnostics data. A managed storage account is used when not set.",
+									},
+								},
+							},
+						},
+						"create_public_ip": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Defines whether a public IP address is created and assigned to the network interface of the restored VM.",
+						},
 						"os_disk": {
 							Type:        schema.TypeList,
 							Optional:    true,
@@ -1144,7 +1224,29 @@ func ResourceAzureVMRestore() *schema.Resource {
 				},
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+// resourceAzureVMRestoreCustomizeDiff requires to_alternative for restores to an alternative
+// location and rejects it for restores to the original location
+func resourceAzureVMRestoreCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	_, hasAlternative := d.GetOk("to_alternative")
+	switch d.Get("restore_mode").(string) {
+	case AzureVMRestoreModeAlternative:
+		if !hasAlternative {
+			return fmt.Errorf("restore_mode %s requires to_alternative", AzureVMRestoreModeAlternative)
+		}
+	case AzureVMRestoreModeOriginal:
+		if hasAlternative {
+			return fmt.Errorf("to_alternative is not allowed with restore_mode %s", AzureVMRestoreModeOriginal)
+		}
 	}
+	return nil
 }
 
 // Resource function - Create
@@ -1162,14 +1264,18 @@ func ResourceAzureVMRestoreCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(fmt.Errorf("Failed to marshal request: %w", err))
 	}
 
-	url := client.BuildAPIURL(fmt.Sprintf("/restorePoints/virtualMachines/%s/restoreVirtualMachine/", restorePointID))
-	resp, err := client.MakeAuthenticatedRequest("POST", url, strings.NewReader(string(jsonData)))
+	operation := "restoreVirtualMachine"
+	if len(restoreRequest.Disks) > 0 {
+		operation = "restoreDisks"
+	}
+	url := client.BuildAPIURL(vc.Endpoint("/restorePoints/virtualMachines/%s/%s/", restorePointID, operation))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to create VM restore request: %w", err))
 	}
 	if resp.StatusCode != http.StatusAccepted {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("Failed to create VM restore request, status: %s, response: %s", resp.Status, string(bodyBytes)))
+		return diag.FromErr(fmt.Errorf("Failed to create VM restore request: %w", vc.NewVeeamAPIError(resp.StatusCode, bodyBytes)))
 	}
 
 	var requestResponse AzureVMRestoreResponse
@@ -1179,6 +1285,7 @@ func ResourceAzureVMRestoreCreate(ctx context.Context, d *schema.ResourceData, m
 
 	if requestResponse.ID != nil {
 		d.SetId(*requestResponse.ID)
+		d.Set("restore_mode", azureVMRestoreMode(d))
 	} else {
 		return diag.FromErr(fmt.Errorf("Response ID is nil"))
 	}
@@ -1192,8 +1299,8 @@ func ResourceAzureVMRestoreRead(ctx context.Context, d *schema.ResourceData, met
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	url := client.BuildAPIURL(fmt.Sprintf("/jobSessions/%s/restoredItems", d.Id()))
-	resp, err := client.MakeAuthenticatedRequest("GET", url, nil)
+	url := client.BuildAPIURL(vc.Endpoint("/jobSessions/%s/restoredItems", d.Id()))
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Failed to read VM restore session: %w", err))
 	}
@@ -1201,7 +1308,11 @@ func ResourceAzureVMRestoreRead(ctx context.Context, d *schema.ResourceData, met
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return diag.FromErr(fmt.Errorf("Failed to read VM restore session, status: %s, response: %s", resp.Status, string(bodyBytes)))
+		apiErr := vc.NewVeeamAPIError(resp.StatusCode, bodyBytes)
+		if vc.RemoveFromStateIfGone(d, apiErr) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Failed to read VM restore session: %w", apiErr))
 	}
 
 	return nil
@@ -1229,13 +1340,30 @@ func buildAzureVMRestoreRequest(d *schema.ResourceData) *AzureVMRestoreRequest {
 		request.SourceServiceAccountID = &val
 	}
 
-	if v, ok := d.GetOk("to_alternative"); ok && len(v.([]interface{})) > 0 {
+	if v, ok := d.GetOk("disks_to_restore"); ok {
+		request.Disks = convertSetToStringSlice(v.(*schema.Set))
+	}
+
+	// Restores to the original location are requested without toAlternative
+	if v, ok := d.GetOk("to_alternative"); ok && len(v.([]interface{})) > 0 && azureVMRestoreMode(d) == AzureVMRestoreModeAlternative {
 		request.ToAlternative = expandAzureVMRestoreToAlternative(v.([]interface{}))
 	}
 
 	return request
 }
 
+// azureVMRestoreMode returns the configured restore_mode, or the mode implied by to_alternative
+// when it is not set
+func azureVMRestoreMode(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("restore_mode"); ok {
+		return v.(string)
+	}
+	if _, ok := d.GetOk("to_alternative"); ok {
+		return AzureVMRestoreModeAlternative
+	}
+	return AzureVMRestoreModeOriginal
+}
+
 func expandAzureVMRestoreToAlternative(alternative []interface{}) *AzureVMRestoreToAlternative {
 	if len(alternative) == 0 || alternative[0] == nil {
 		return nil
@@ -1272,6 +1400,25 @@ func expandAzureVMRestoreToAlternative(alternative []interface{}) *AzureVMRestor
 		}
 	}
 
+	if v, ok := m["tags"]; ok && v.(*schema.Set).Len() > 0 {
+		result.Tags = expandTags(v.(*schema.Set))
+	}
+
+	if v, ok := m["boot_diagnostics"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		bootData := v.([]interface{})[0].(map[string]interface{})
+		result.BootDiagnostics = &AzureRestoreBootDiagnostics{
+			IsEnabled: bootData["enabled"].(bool),
+		}
+		if sa, ok := bootData["storage_account_id"]; ok && sa != "" {
+			val := sa.(string)
+			result.BootDiagnostics.StorageAccountID = &val
+		}
+	}
+
+	if v, ok := m["create_public_ip"]; ok {
+		result.CreatePublicIP = v.(bool)
+	}
+
 	// Add resource_group, region, and other nested structures as needed
 	// This is a simplified version - expand based on actual schema requirements
 
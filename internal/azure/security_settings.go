@@ -0,0 +1,85 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+const securitySettingsPath = "/settings/security"
+
+// SecuritySettings holds the security settings of the appliance portal and REST API. When
+// updating, nil fields are left unchanged.
+type SecuritySettings struct {
+	// SessionTimeoutMinutes is the idle time after which users are signed out
+	SessionTimeoutMinutes *int
+	// MFARequiredForLocalUsers makes every local user sign in with multi-factor authentication
+	MFARequiredForLocalUsers *bool
+	// AllowedCORSOrigins are the origins other than the appliance from which browsers may call the
+	// REST API; nil leaves them unchanged
+	AllowedCORSOrigins []string
+}
+
+type securitySettingsResponse struct {
+	SessionTimeoutMinutes      int      `json:"sessionTimeoutMinutes"`
+	IsMfaRequiredForLocalUsers bool     `json:"isMfaRequiredForLocalUsers"`
+	AllowedCorsOrigins         []string `json:"allowedCorsOrigins"`
+}
+
+// GetSecuritySettings returns the security settings of the appliance
+func GetSecuritySettings(ctx context.Context, client *vc.AzureBackupClient) (*SecuritySettings, error) {
+	body, err := doSettingsRequest(ctx, client, http.MethodGet, securitySettingsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response securitySettingsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode Azure security settings response: %w", err)
+	}
+	origins := response.AllowedCorsOrigins
+	if origins == nil {
+		origins = []string{}
+	}
+	return &SecuritySettings{
+		SessionTimeoutMinutes:    &response.SessionTimeoutMinutes,
+		MFARequiredForLocalUsers: &response.IsMfaRequiredForLocalUsers,
+		AllowedCORSOrigins:       origins,
+	}, nil
+}
+
+// UpdateSecuritySettings changes the security settings of the appliance. The settings are read
+// and written back, so that settings left nil and fields this provider does not know keep their
+// values.
+func UpdateSecuritySettings(ctx context.Context, client *vc.AzureBackupClient, settings SecuritySettings) error {
+	body, err := doSettingsRequest(ctx, client, http.MethodGet, securitySettingsPath, nil)
+	if err != nil {
+		return err
+	}
+
+	var options map[string]interface{}
+	if err := json.Unmarshal(body, &options); err != nil {
+		return fmt.Errorf("failed to decode Azure security settings response: %w", err)
+	}
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	if settings.SessionTimeoutMinutes != nil {
+		options["sessionTimeoutMinutes"] = *settings.SessionTimeoutMinutes
+	}
+	if settings.MFARequiredForLocalUsers != nil {
+		options["isMfaRequiredForLocalUsers"] = *settings.MFARequiredForLocalUsers
+	}
+	if settings.AllowedCORSOrigins != nil {
+		options["allowedCorsOrigins"] = settings.AllowedCORSOrigins
+	}
+
+	payload, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("failed to marshal Azure security settings request: %w", err)
+	}
+	_, err = doSettingsRequest(ctx, client, http.MethodPut, securitySettingsPath, payload)
+	return err
+}
@@ -0,0 +1,43 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// doSettingsRequest sends payload, when not nil, to the appliance settings at endpoint and
+// returns the response body, or a VeeamAPIError when the appliance rejects the request
+func doSettingsRequest(ctx context.Context, client *vc.AzureBackupClient, method, endpoint string, payload []byte) ([]byte, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
+	resp, err := client.MakeAuthenticatedRequestWithContext(ctx, method, client.BuildAPIURL(endpoint), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Azure settings response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return nil, vc.NewVeeamAPIError(resp.StatusCode, body)
+	}
+	return body, nil
+}
+
+// childMap returns the object at key in m, adding an empty one when it is missing
+func childMap(m map[string]interface{}, key string) map[string]interface{} {
+	child, ok := m[key].(map[string]interface{})
+	if !ok {
+		child = map[string]interface{}{}
+		m[key] = child
+	}
+	return child
+}
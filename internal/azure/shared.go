@@ -1,5 +1,12 @@
 package azure
 
+import (
+	"terraform-provider-veeambackup/internal/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
 // ============================================================================
 // Shared Policy Settings
 // ============================================================================
@@ -36,6 +43,17 @@ func expandPolicyRegions(input []interface{}) []PolicyRegion {
 	return result
 }
 
+// flattenPolicyRegions converts a slice of PolicyRegion to a Terraform list
+func flattenPolicyRegions(regions []PolicyRegion) []interface{} {
+	result := make([]interface{}, len(regions))
+	for i, region := range regions {
+		result[i] = map[string]interface{}{
+			"region_id": region.RegionID,
+		}
+	}
+	return result
+}
+
 // expandRetrySettings converts a Terraform list to a RetrySettings pointer
 func expandRetrySettings(input []interface{}) *RetrySettings {
 	if len(input) == 0 {
@@ -85,66 +103,212 @@ type AzureTagGroups struct {
 	Tags           []Tags               `json:"tags,omitempty"`
 }
 
-type DailySchedule struct {
-	DailyType        *string           `json:"dailyType,omitempty"`
-	SelectedDays     []string          `json:"selectedDays,omitempty"`
-	RunsPerHour      *int              `json:"runsPerHour,omitempty"`
-	SnapshotSchedule *SnapshotSchedule `json:"snapshotSchedule,omitempty"`
-	BackupSchedule   *BackupSchedule   `json:"backupSchedule,omitempty"`
-}
+// The schedule and retention models are shared with the other cloud appliances
+type (
+	DailySchedule    = policy.DailySchedule
+	WeeklySchedule   = policy.WeeklySchedule
+	MonthlySchedule  = policy.MonthlySchedule
+	YearlySchedule   = policy.YearlySchedule
+	SnapshotSchedule = policy.SnapshotSchedule
+	BackupSchedule   = policy.BackupSchedule
+	Retention        = policy.Retention
+)
 
-type WeeklySchedule struct {
-	StartTime        *int              `json:"startTime,omitempty"`
-	SnapshotSchedule *SnapshotSchedule `json:"snapshotSchedule,omitempty"`
-	BackupSchedule   *BackupSchedule   `json:"backupSchedule,omitempty"`
+type HealthCheckSchedule struct {
+	HealthCheckEnabled *bool    `json:"healthCheckEnabled,omitempty"`
+	LocalTime          *string  `json:"localTime,omitempty"`
+	DayNumberInMonth   *string  `json:"dayNumberInMonth,omitempty"`
+	DayOfWeek          *string  `json:"dayOfWeek,omitempty"`
+	DayOfMonth         *int     `json:"dayOfMonth,omitempty"`
+	Months             []string `json:"months,omitempty"`
 }
 
-type MonthlySchedule struct {
-	StartTime        *int              `json:"startTime,omitempty"`
-	Type             *string           `json:"type,omitempty"`
-	DayOfWeek        *string           `json:"dayOfWeek,omitempty"`
-	DayOfMonth       *int              `json:"dayOfMonth,omitempty"`
-	MonthlyLastDay   *bool             `json:"monthlyLastDay,omitempty"`
-	SnapshotSchedule *SnapshotSchedule `json:"snapshotSchedule,omitempty"`
-	BackupSchedule   *BackupSchedule   `json:"backupSchedule,omitempty"`
+// expandAzureSubscriptions converts a Terraform set of subscriptions to API subscriptions, or nil
+// when the set is empty
+func expandAzureSubscriptions(input *schema.Set) *[]AzureSubscriptions {
+	if input == nil || input.Len() == 0 {
+		return nil
+	}
+	subscriptions := []AzureSubscriptions{}
+	for _, sub := range input.List() {
+		subMap := sub.(map[string]interface{})
+		subscriptions = append(subscriptions, AzureSubscriptions{
+			SubscriptionID: subMap["subscription_id"].(string),
+		})
+	}
+	return &subscriptions
 }
 
-type YearlySchedule struct {
-	StartTime           *int    `json:"startTime,omitempty"`
-	Type                *string `json:"type,omitempty"`
-	Month               *string `json:"month,omitempty"`
-	DayOfWeek           *string `json:"dayOfWeek,omitempty"`
-	DayOfMonth          *int    `json:"dayOfMonth,omitempty"`
-	YearlyLastDay       *bool   `json:"yearlyLastDay,omitempty"`
-	RetentionYearsCount *int    `json:"retentionYearsCount,omitempty"`
-	TargetRepositoryID  *string `json:"targetRepositoryId,omitempty"`
+// expandAzureResourceGroups converts a Terraform set of resource groups to API resource groups, or
+// nil when the set is empty
+func expandAzureResourceGroups(input *schema.Set) *[]AzureResourceGroups {
+	if input == nil || input.Len() == 0 {
+		return nil
+	}
+	resourceGroups := []AzureResourceGroups{}
+	for _, rg := range input.List() {
+		rgMap := rg.(map[string]interface{})
+		resourceGroups = append(resourceGroups, AzureResourceGroups{
+			ID: rgMap["id"].(string),
+		})
+	}
+	return &resourceGroups
 }
 
-type SnapshotSchedule struct {
-	Hours           []int    `json:"hours,omitempty"`
-	SelectedDays    []string `json:"selectedDays,omitempty"`
-	SelectedMonths  []string `json:"selectedMonths,omitempty"`
-	SnapshotsToKeep *int     `json:"snapshotsToKeep,omitempty"`
+// expandTags converts a Terraform set of tags to API tags
+func expandTags(input *schema.Set) []Tags {
+	if input == nil {
+		return nil
+	}
+	tags := []Tags{}
+	for _, tag := range input.List() {
+		tagMap := tag.(map[string]interface{})
+		tags = append(tags, Tags{
+			Name:  tagMap["name"].(string),
+			Value: tagMap["value"].(string),
+		})
+	}
+	return tags
 }
 
-type BackupSchedule struct {
-	Hours              []int      `json:"hours,omitempty"`
-	SelectedDays       []string   `json:"selectedDays,omitempty"`
-	SelectedMonths     []string   `json:"selectedMonths,omitempty"`
-	Retention          *Retention `json:"retention,omitempty"`
-	TargetRepositoryID *string    `json:"targetRepositoryId,omitempty"`
+// expandAzureTagGroups converts a Terraform set of tag groups to API tag groups, or nil when the
+// set is empty. The API scopes a tag group to at most one subscription and one resource group, so
+// a tag group with several of them is sent as one API tag group of the same name and tags per
+// resource group, or per subscription when it has no resource groups. The subscription is only
+// kept on the resource group tag groups when there is exactly one.
+func expandAzureTagGroups(input *schema.Set) *[]AzureTagGroups {
+	if input == nil || input.Len() == 0 {
+		return nil
+	}
+	tagGroups := []AzureTagGroups{}
+	for _, tg := range input.List() {
+		tgMap := tg.(map[string]interface{})
+		var tags []Tags
+		if set, ok := tgMap["tags"].(*schema.Set); ok && set.Len() > 0 {
+			tags = expandTags(set)
+		}
+
+		subs, _ := tgMap["subscription"].([]interface{})
+		if len(subs) == 0 {
+			// subsciption is the deprecated misspelling some policies still accept
+			subs, _ = tgMap["subsciption"].([]interface{})
+		}
+		var subscriptions []*AzureSubscriptions
+		for _, sub := range subs {
+			if subMap, ok := sub.(map[string]interface{}); ok && len(subMap) > 0 {
+				subscriptions = append(subscriptions, &AzureSubscriptions{SubscriptionID: subMap["subscription_id"].(string)})
+			}
+		}
+		rgs, _ := tgMap["resource_groups"].([]interface{})
+		var resourceGroups []*AzureResourceGroups
+		for _, rg := range rgs {
+			if rgMap, ok := rg.(map[string]interface{}); ok && len(rgMap) > 0 {
+				resourceGroups = append(resourceGroups, &AzureResourceGroups{ID: rgMap["id"].(string)})
+			}
+		}
+
+		name := tgMap["name"].(string)
+		switch {
+		case len(resourceGroups) > 0:
+			var subscription *AzureSubscriptions
+			if len(subscriptions) == 1 {
+				subscription = subscriptions[0]
+			}
+			for _, resourceGroup := range resourceGroups {
+				tagGroups = append(tagGroups, AzureTagGroups{Name: name, Subscription: subscription, ResourceGroups: resourceGroup, Tags: tags})
+			}
+		case len(subscriptions) > 0:
+			for _, subscription := range subscriptions {
+				tagGroups = append(tagGroups, AzureTagGroups{Name: name, Subscription: subscription, Tags: tags})
+			}
+		default:
+			tagGroups = append(tagGroups, AzureTagGroups{Name: name, Tags: tags})
+		}
+	}
+	return &tagGroups
 }
 
-type Retention struct {
-	TimeRetentionDuration *int    `json:"timeRetentionDuration,omitempty"`
-	RetentionDurationType *string `json:"retentionDurationType,omitempty"`
+// healthCheckScheduleSchema is the schema of the health check schedule of a backup policy. The
+// health check verifies the latest restore points in the repositories that the backup schedules
+// of the policy target; the API has no setting to select other repositories or restore points.
+func healthCheckScheduleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies health check settings for the backup policy. The health check verifies the latest restore points in the repositories targeted by the backup schedules, and failures are reported through policy_notification_settings.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"health_check_enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Defines whether health checks are enabled for the backup policy.",
+				},
+				"local_time": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the date and time when the health check will run.",
+				},
+				"day_number_in_month": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Specifies the day number in the month when the health check will run.",
+					ValidateFunc: validation.StringInSlice([]string{"First", "Second", "Third", "Fourth", "Last", "OnDay", "EveryDay", "EverySelectedDay", "Unknown"}, false),
+				},
+				"day_of_week": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Specifies the day of the week when the health check will run.",
+					ValidateFunc: validation.StringInSlice([]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}, false),
+				},
+				"day_of_month": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Specifies the day of the month when the health check will run, if day_number_in_month is OnDay.",
+					ValidateFunc: validation.IntBetween(1, 31),
+				},
+				"months": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Specifies the months when the health check will run.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}, false),
+					},
+				},
+			},
+		},
+	}
 }
 
-type HealthCheckSchedule struct {
-	HealthCheckEnabled *bool    `json:"healthCheckEnabled,omitempty"`
-	LocalTime          *string  `json:"localTime,omitempty"`
-	DayNumberInMonth   *string  `json:"dayNumberInMonth,omitempty"`
-	DayOfWeek          *string  `json:"dayOfWeek,omitempty"`
-	DayOfMonth         *int     `json:"dayOfMonth,omitempty"`
-	Months             []string `json:"months,omitempty"`
+// expandHealthCheckSchedule converts a Terraform list built from healthCheckScheduleSchema to a
+// HealthCheckSchedule pointer
+func expandHealthCheckSchedule(input []interface{}) *HealthCheckSchedule {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	m := input[0].(map[string]interface{})
+	schedule := &HealthCheckSchedule{}
+	if enabled, ok := m["health_check_enabled"].(bool); ok {
+		schedule.HealthCheckEnabled = &enabled
+	}
+	if localTime, ok := m["local_time"].(string); ok && localTime != "" {
+		schedule.LocalTime = &localTime
+	}
+	if dayNumber, ok := m["day_number_in_month"].(string); ok && dayNumber != "" {
+		schedule.DayNumberInMonth = &dayNumber
+	}
+	if dayOfWeek, ok := m["day_of_week"].(string); ok && dayOfWeek != "" {
+		schedule.DayOfWeek = &dayOfWeek
+	}
+	if dayOfMonth, ok := m["day_of_month"].(int); ok && dayOfMonth != 0 {
+		schedule.DayOfMonth = &dayOfMonth
+	}
+	if months, ok := m["months"].(*schema.Set); ok {
+		for _, month := range months.List() {
+			schedule.Months = append(schedule.Months, month.(string))
+		}
+	}
+	return schedule
 }
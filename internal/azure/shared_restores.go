@@ -17,12 +17,12 @@ type AzureVMRestorePointsResults struct {
 	State                                    string  `json:"state"`
 	GfsFlags                                 string  `json:"gfsFlags"`
 	JobSessionID                             *string `json:"jobSessionId,omitempty"`
-	DataRetrievalStatus                      *string `json:DataRetrievalStatus,omitempty`
+	DataRetrievalStatus                      *string `json:"dataRetrievalStatus,omitempty"`
 	RetrievedDataExpirationDate              *string `json:"retrievedDataExpirationDate,omitempty"`
-	NotifyBeforeRetrievedDataExpirationHours *int    `json:notifyBeforeRetrievedDataExpirationHours,omitempty`
+	NotifyBeforeRetrievedDataExpirationHours *int    `json:"notifyBeforeRetrievedDataExpirationHours,omitempty"`
 	ImmutableTill                            *string `json:"immutableTill,omitempty"`
 	AccessTier                               *string `json:"accessTier,omitempty"`
-	LatestChainSizeBytes                     *int    `json:latestChainSizeBytes,omitempty`
+	LatestChainSizeBytes                     *int    `json:"latestChainSizeBytes,omitempty"`
 }
 
 type AzureVMRestorePointDataSourceModel struct {
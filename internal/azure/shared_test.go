@@ -0,0 +1,113 @@
+package azure
+
+import (
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandAzureTagGroups(t *testing.T) {
+	tags := []interface{}{map[string]interface{}{"name": "env", "value": "production"}}
+	subscription := func(id string) map[string]interface{} {
+		return map[string]interface{}{"subscription_id": id}
+	}
+	resourceGroup := func(id string) map[string]interface{} {
+		return map[string]interface{}{"id": id}
+	}
+
+	for name, tc := range map[string]struct {
+		tagGroup map[string]interface{}
+		want     string
+	}{
+		"tags only": {
+			tagGroup: map[string]interface{}{"name": "production", "tags": tags},
+			want:     `[{"name": "production", "tags": [{"name": "env", "value": "production"}]}]`,
+		},
+		"subscriptions": {
+			tagGroup: map[string]interface{}{
+				"name":         "production",
+				"tags":         tags,
+				"subscription": []interface{}{subscription("sub-1"), subscription("sub-2")},
+			},
+			want: `[{"name": "production", "subscription": {"subscriptionId": "sub-1"}, "tags": [{"name": "env", "value": "production"}]},
+				{"name": "production", "subscription": {"subscriptionId": "sub-2"}, "tags": [{"name": "env", "value": "production"}]}]`,
+		},
+		"deprecated subscription alias": {
+			tagGroup: map[string]interface{}{
+				"name":        "production",
+				"subsciption": []interface{}{subscription("sub-1")},
+			},
+			want: `[{"name": "production", "subscription": {"subscriptionId": "sub-1"}}]`,
+		},
+		"resource groups of one subscription": {
+			tagGroup: map[string]interface{}{
+				"name":            "production",
+				"subscription":    []interface{}{subscription("sub-1")},
+				"resource_groups": []interface{}{resourceGroup("group-1"), resourceGroup("group-2")},
+			},
+			want: `[{"name": "production", "subscription": {"subscriptionId": "sub-1"}, "resourceGroups": {"id": "group-1"}},
+				{"name": "production", "subscription": {"subscriptionId": "sub-1"}, "resourceGroups": {"id": "group-2"}}]`,
+		},
+		"resource groups of several subscriptions": {
+			tagGroup: map[string]interface{}{
+				"name":            "production",
+				"subscription":    []interface{}{subscription("sub-1"), subscription("sub-2")},
+				"resource_groups": []interface{}{resourceGroup("group-1")},
+			},
+			want: `[{"name": "production", "resourceGroups": {"id": "group-1"}}]`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceAzureCosmosDbBackupPolicy().Schema, map[string]interface{}{
+				"selected_items": []interface{}{map[string]interface{}{"tag_groups": []interface{}{tc.tagGroup}}},
+			})
+			acctest.CheckJSON(t, *expandAzureTagGroups(d.Get("selected_items.0.tag_groups").(*schema.Set)), tc.want)
+		})
+	}
+
+	if got := expandAzureTagGroups(schema.NewSet(schema.HashString, nil)); got != nil {
+		t.Errorf("expandAzureTagGroups(empty set) = %v, want nil", *got)
+	}
+}
+
+func TestExpandHealthCheckSchedule(t *testing.T) {
+	s := map[string]*schema.Schema{"health_check_schedule": healthCheckScheduleSchema()}
+	for name, tc := range map[string]struct {
+		schedule map[string]interface{}
+		want     string
+	}{
+		"disabled": {
+			schedule: map[string]interface{}{"health_check_enabled": false},
+			want:     `{"healthCheckEnabled": false}`,
+		},
+		"day of week": {
+			schedule: map[string]interface{}{
+				"health_check_enabled": true,
+				"local_time":           "2024-01-01T02:00:00Z",
+				"day_number_in_month":  "Last",
+				"day_of_week":          "Saturday",
+				"months":               []interface{}{"March"},
+			},
+			want: `{"healthCheckEnabled": true, "localTime": "2024-01-01T02:00:00Z", "dayNumberInMonth": "Last", "dayOfWeek": "Saturday", "months": ["March"]}`,
+		},
+		"day of month": {
+			schedule: map[string]interface{}{
+				"health_check_enabled": true,
+				"day_number_in_month":  "OnDay",
+				"day_of_month":         15,
+			},
+			want: `{"healthCheckEnabled": true, "dayNumberInMonth": "OnDay", "dayOfMonth": 15}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, s, map[string]interface{}{"health_check_schedule": []interface{}{tc.schedule}})
+			acctest.CheckJSON(t, *expandHealthCheckSchedule(d.Get("health_check_schedule").([]interface{})), tc.want)
+		})
+	}
+
+	if got := expandHealthCheckSchedule(nil); got != nil {
+		t.Errorf("expandHealthCheckSchedule(nil) = %+v, want nil", got)
+	}
+}
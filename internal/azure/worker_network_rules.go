@@ -0,0 +1,101 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+const workerNetworkSettingsPath = "/settings/workers/network"
+
+// WorkerNetworkRule is the network the appliance deploys worker instances to in a region. Regions
+// without a rule get workers in a network the appliance creates.
+type WorkerNetworkRule struct {
+	RegionID         string `json:"regionId"`
+	VirtualNetworkID string `json:"virtualNetworkId"`
+	SubnetName       string `json:"subnetName"`
+	// NetworkSecurityGroupID is attached to the network interfaces of the workers when set
+	NetworkSecurityGroupID *string `json:"networkSecurityGroupId,omitempty"`
+}
+
+type workerNetworkSettings struct {
+	Rules []WorkerNetworkRule `json:"rules"`
+}
+
+// GetWorkerNetworkRules returns the worker network rules of the appliance
+func GetWorkerNetworkRules(ctx context.Context, client *vc.AzureBackupClient) ([]WorkerNetworkRule, error) {
+	body, err := doSettingsRequest(ctx, client, http.MethodGet, workerNetworkSettingsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings workerNetworkSettings
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, fmt.Errorf("failed to decode Azure worker network settings response: %w", err)
+	}
+	return settings.Rules, nil
+}
+
+// SetWorkerNetworkRules replaces the worker network rules of the appliance. The settings are read
+// and written back, so that fields this provider does not know keep their values.
+func SetWorkerNetworkRules(ctx context.Context, client *vc.AzureBackupClient, rules []WorkerNetworkRule) error {
+	body, err := doSettingsRequest(ctx, client, http.MethodGet, workerNetworkSettingsPath, nil)
+	if err != nil {
+		return err
+	}
+
+	var settings map[string]interface{}
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return fmt.Errorf("failed to decode Azure worker network settings response: %w", err)
+	}
+	if settings == nil {
+		settings = map[string]interface{}{}
+	}
+	if rules == nil {
+		rules = []WorkerNetworkRule{}
+	}
+	settings["rules"] = rules
+
+	payload, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal Azure worker network settings request: %w", err)
+	}
+	_, err = doSettingsRequest(ctx, client, http.MethodPut, workerNetworkSettingsPath, payload)
+	return err
+}
+
+// PolicyRegionsWithoutWorkerNetwork returns the regions, sorted, that backup policies of any type
+// protect but that none of rules covers
+func PolicyRegionsWithoutWorkerNetwork(ctx context.Context, client *vc.AzureBackupClient, rules []WorkerNetworkRule) ([]string, error) {
+	covered := map[string]bool{}
+	for _, rule := range rules {
+		covered[strings.ToLower(rule.RegionID)] = true
+	}
+
+	missing := map[string]string{}
+	for _, policyType := range azureItemTypes(policyPaths) {
+		policies, err := listPolicies(ctx, client, policyType)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, policy := range policies {
+			for _, region := range policy.Regions {
+				if key := strings.ToLower(region.RegionID); !covered[key] {
+					missing[key] = region.RegionID
+				}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(missing))
+	for _, region := range missing {
+		result = append(result, region)
+	}
+	sort.Strings(result)
+	return result, nil
+}
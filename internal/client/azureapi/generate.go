@@ -0,0 +1,6 @@
+// Package azureapi holds the Veeam Backup for Microsoft Azure REST API models generated from
+// api/openapi/azure.json. Run go generate after adding schemas to the document; the generated
+// file must not be edited by hand.
+package azureapi
+
+//go:generate go run ../../openapigen -spec ../../../api/openapi/azure.json -package azureapi -o models_gen.go
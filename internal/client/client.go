@@ -2,13 +2,13 @@ package client
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -22,6 +22,18 @@ type VeeamClient struct {
 
 	// AWS client
 	AWSClient *AWSBackupClient
+
+	// Google Cloud client
+	GCPClient *GCPBackupClient
+
+	// Microsoft 365 client
+	VB365Client *VB365Client
+
+	// Enterprise Manager client
+	EMClient *EMClient
+
+	// ReadOnly is set when the provider may only read from the Veeam services
+	ReadOnly bool
 }
 
 // AzureBackupClient handles authentication with Veeam Backup for Microsoft Azure REST API
@@ -34,18 +46,36 @@ type AzureBackupClient struct {
 	tokenExpiry  time.Time
 	apiVersion   string
 	httpClient   *http.Client
+	retry        RetryConfig
+	limiter      *rateLimiter
+	tokenMu      sync.Mutex // Guards accessToken, refreshToken and tokenExpiry
+	descriptionSuffix
+	requestPool
+	readOnly
 }
 
 // VBRClient handles Veeam Backup & Replication REST API
 type VBRClient struct {
-	hostname     string
-	username     string
-	password     string
-	apiVersion   string
-	accessToken  string
-	refreshToken string
-	tokenExpiry  time.Time
-	httpClient   *http.Client
+	hostname          string
+	username          string
+	password          string
+	authorizationCode string // One-time code for the Authorization_code grant
+	mfaCode           string // TOTP code for accounts with MFA enforced
+	apiVersion        string
+	detectVersion     bool // The API revision is detected by the first request
+	accessToken       string
+	refreshToken      string
+	tokenExpiry       time.Time
+	httpClient        *http.Client
+	retry             RetryConfig
+	limiter           *rateLimiter
+	tokenMu           sync.Mutex // Guards accessToken, refreshToken and tokenExpiry
+	versionMu         sync.Mutex // Guards apiVersion and detectVersion
+	jobLocks          MutexKV    // Serializes modifications of a single job
+	descriptionSuffix
+	requestPool
+	readOnly
+	referenceValidation
 }
 
 // AWSBackupClient handles Veeam Backup for AWS REST API
@@ -58,6 +88,12 @@ type AWSBackupClient struct {
 	refreshToken string
 	tokenExpiry  time.Time
 	httpClient   *http.Client
+	retry        RetryConfig
+	limiter      *rateLimiter
+	tokenMu      sync.Mutex // Guards accessToken, refreshToken and tokenExpiry
+	descriptionSuffix
+	requestPool
+	readOnly
 }
 
 // ClientConfig holds configuration for all Veeam services
@@ -65,32 +101,87 @@ type ClientConfig struct {
 	Azure *AzureConfig
 	VBR   *VBRConfig
 	AWS   *AWSConfig
+	GCP   *GCPConfig
+	VB365 *VB365Config
+	EM    *EMConfig
+	Retry RetryConfig // Shared by all service clients
+
+	// RequestsPerSecond caps the request rate of each service client; 0 disables limiting
+	RequestsPerSecond float64
+
+	// MaxConcurrentRequests caps the requests a fan-out operation sends at the same time; 0 uses DefaultMaxConcurrentRequests
+	MaxConcurrentRequests int
+
+	// ReadOnly makes every service client reject requests that modify the Veeam services
+	ReadOnly bool
+
+	// ProxyURL routes all API requests through the given proxy; when empty, HTTPS_PROXY/HTTP_PROXY are honored
+	ProxyURL string
+
+	// DescriptionSuffix is appended to the descriptions of the jobs and policies the provider manages
+	DescriptionSuffix string
+
+	// ValidateReferences makes VBR job resources look up the objects they reference while planning
+	ValidateReferences bool
+
+	// SkipSignIn defers signing in to each appliance until its first API request, instead of
+	// failing NewVeeamClient with an EndpointError when an appliance cannot be reached
+	SkipSignIn bool
 }
 
 type AzureConfig struct {
-	Hostname           string
-	Username           string
-	Password           string
-	APIVersion         string // Default: v8.1 or latest
-	InsecureSkipVerify bool   // Skip SSL certificate verification
+	Hostname   string
+	Username   string
+	Password   string
+	APIVersion string    // Default: v8.1 or latest
+	TLS        TLSConfig // TLS settings for the connection to the appliance
 }
 
 type VBRConfig struct {
-	Hostname           string
-	Port               string // Default: 9419
-	Username           string
-	Password           string
-	APIVersion         string // Default: 1.3-rev1
-	InsecureSkipVerify bool   // Skip SSL certificate verification
+	Hostname          string
+	Port              string // Default: 9419
+	Username          string
+	Password          string
+	AccessToken       string    // Pre-issued access token; used instead of signing in
+	AuthorizationCode string    // One-time code from POST /api/oauth2/authorization_code
+	MFACode           string    // TOTP code for accounts with MFA enforced
+	APIVersion        string    // Detected from /api/v1/serverInfo when empty
+	TLS               TLSConfig // TLS settings for the connection to the appliance
 }
 
 type AWSConfig struct {
-	Hostname           string
-	Port               string // Default: 11005
-	Username           string
-	Password           string
-	APIVersion         string // Default: 1.8-rev0
-	InsecureSkipVerify bool   // Skip SSL certificate verification
+	Hostname   string
+	Port       string    // Default: 11005
+	Username   string
+	Password   string
+	APIVersion string    // Default: 1.8-rev0
+	TLS        TLSConfig // TLS settings for the connection to the appliance
+}
+
+type GCPConfig struct {
+	Hostname   string
+	Port       string // Default: 13140
+	Username   string
+	Password   string
+	APIVersion string    // Default: 1.4-rev0
+	TLS        TLSConfig // TLS settings for the connection to the appliance
+}
+
+type VB365Config struct {
+	Hostname   string
+	Port       string // Default: 4443
+	Username   string
+	Password   string
+	APIVersion string    // Version segment of the API path. Default: v8
+	TLS        TLSConfig // TLS settings for the connection to the server
+}
+
+type EMConfig struct {
+	Hostname string
+	Port     string // Default: 9398
+	Username string
+	Password string
+	TLS      TLSConfig // TLS settings for the connection to the server
 }
 
 type VBRStartJobRequest struct {
@@ -129,7 +220,8 @@ type ErrorResponse struct {
 
 // NewVeeamClient creates a new unified client
 func NewVeeamClient(config ClientConfig) (*VeeamClient, error) {
-	client := &VeeamClient{}
+	client := &VeeamClient{ReadOnly: config.ReadOnly}
+	retry := config.Retry.normalize()
 
 	// Initialize Azure client if credentials provided
 	if config.Azure != nil {
@@ -138,10 +230,9 @@ func NewVeeamClient(config ClientConfig) (*VeeamClient, error) {
 			apiVersion = "8.1" // Default Azure API version
 		}
 
-		transport := &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: config.Azure.InsecureSkipVerify,
-			},
+		transport, err := newTransport(config.Azure.TLS, config.ProxyURL, config.maxConcurrentRequests())
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure Azure HTTP transport: %w", err)
 		}
 
 		azureClient := &AzureBackupClient{
@@ -151,12 +242,19 @@ func NewVeeamClient(config ClientConfig) (*VeeamClient, error) {
 			apiVersion: apiVersion,
 			httpClient: &http.Client{
 				Timeout:   10 * time.Minute,
-				Transport: transport,
+				Transport: newLoggingTransport(transport),
 			},
+			retry:             retry,
+			limiter:           newRateLimiter(config.RequestsPerSecond),
+			descriptionSuffix: descriptionSuffix{config.DescriptionSuffix},
+			requestPool:       requestPool{config.maxConcurrentRequests()},
+			readOnly:          readOnly{config.ReadOnly},
 		}
 
-		if err := azureClient.Authenticate(); err != nil {
-			return nil, fmt.Errorf("failed to authenticate with Azure Backup service: %w", err)
+		if !config.SkipSignIn {
+			if err := azureClient.Authenticate(); err != nil {
+				return nil, &EndpointError{Service: "Veeam Backup for Microsoft Azure", URL: azureClient.hostname, Err: err}
+			}
 		}
 
 		client.AzureClient = azureClient
@@ -169,33 +267,61 @@ func NewVeeamClient(config ClientConfig) (*VeeamClient, error) {
 			port = "9419" // Default VBR REST API port
 		}
 		apiVersion := config.VBR.APIVersion
-		if apiVersion == "" {
-			apiVersion = "1.3-rev1" // Default API version
+		detectAPIVersion := apiVersion == ""
+		if detectAPIVersion {
+			apiVersion = vbrBootstrapAPIVersion // Replaced by the appliance's own version below
 		}
 
-		transport := &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: config.VBR.InsecureSkipVerify,
-			},
+		transport, err := newTransport(config.VBR.TLS, config.ProxyURL, config.maxConcurrentRequests())
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure VBR HTTP transport: %w", err)
 		}
 
 		hostname := strings.TrimSuffix(config.VBR.Hostname, "/")
 		hostname = strings.TrimPrefix(hostname, "https://")
 		hostname = strings.TrimPrefix(hostname, "http://")
 
+		// VBR rejects concurrent modifications of a job with 409 Conflict until the first one completes
+		vbrRetry := retry
+		vbrRetry.retryConflicts = true
+
 		vbrClient := &VBRClient{
-			hostname:   fmt.Sprintf("%s:%s", hostname, port),
-			username:   config.VBR.Username,
-			password:   config.VBR.Password,
-			apiVersion: apiVersion,
+			hostname:          fmt.Sprintf("%s:%s", hostname, port),
+			username:          config.VBR.Username,
+			password:          config.VBR.Password,
+			authorizationCode: config.VBR.AuthorizationCode,
+			mfaCode:           config.VBR.MFACode,
+			apiVersion:        apiVersion,
 			httpClient: &http.Client{
 				Timeout:   10 * time.Minute,
-				Transport: transport,
+				Transport: newLoggingTransport(transport),
+			},
+			retry:             vbrRetry,
+			limiter:           newRateLimiter(config.RequestsPerSecond),
+			descriptionSuffix: descriptionSuffix{config.DescriptionSuffix},
+			requestPool:       requestPool{config.maxConcurrentRequests()},
+			readOnly:          readOnly{config.ReadOnly},
+			referenceValidation: referenceValidation{
+				enabled: config.ValidateReferences,
 			},
 		}
 
-		if err := vbrClient.AuthenticateVBR(apiVersion); err != nil {
-			return nil, fmt.Errorf("failed to authenticate with VBR service: %w", err)
+		if config.VBR.AccessToken != "" {
+			// A pre-issued token is used as-is; the client signs in only if it is rejected
+			vbrClient.accessToken = config.VBR.AccessToken
+			vbrClient.tokenExpiry = staticTokenExpiry
+		} else if !config.SkipSignIn {
+			if err := vbrClient.AuthenticateVBR(apiVersion); err != nil {
+				return nil, &EndpointError{Service: "Veeam Backup & Replication", URL: "https://" + vbrClient.hostname, Err: err}
+			}
+		}
+
+		if detectAPIVersion {
+			if config.SkipSignIn {
+				vbrClient.detectVersion = true
+			} else if err := vbrClient.detectAPIVersion(context.Background()); err != nil {
+				return nil, err
+			}
 		}
 
 		client.VBRClient = vbrClient
@@ -212,10 +338,9 @@ func NewVeeamClient(config ClientConfig) (*VeeamClient, error) {
 			apiVersion = "1.8-rev0" // Default API version
 		}
 
-		transport := &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: config.AWS.InsecureSkipVerify,
-			},
+		transport, err := newTransport(config.AWS.TLS, config.ProxyURL, config.maxConcurrentRequests())
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure AWS HTTP transport: %w", err)
 		}
 
 		hostname := strings.TrimSuffix(config.AWS.Hostname, "/")
@@ -229,22 +354,63 @@ func NewVeeamClient(config ClientConfig) (*VeeamClient, error) {
 			apiVersion: apiVersion,
 			httpClient: &http.Client{
 				Timeout:   10 * time.Minute,
-				Transport: transport,
+				Transport: newLoggingTransport(transport),
 			},
+			retry:             retry,
+			limiter:           newRateLimiter(config.RequestsPerSecond),
+			descriptionSuffix: descriptionSuffix{config.DescriptionSuffix},
+			requestPool:       requestPool{config.maxConcurrentRequests()},
+			readOnly:          readOnly{config.ReadOnly},
 		}
 
-		if err := awsClient.AuthenticateAWS(); err != nil {
-			return nil, fmt.Errorf("failed to authenticate with AWS Backup service: %w", err)
+		if !config.SkipSignIn {
+			if err := awsClient.AuthenticateAWS(); err != nil {
+				return nil, &EndpointError{Service: "Veeam Backup for AWS", URL: "https://" + awsClient.hostname, Err: err}
+			}
 		}
 
 		client.AWSClient = awsClient
 	}
 
+	// Initialize Google Cloud client if credentials provided
+	if config.GCP != nil {
+		gcpClient, err := newGCPBackupClient(*config.GCP, config, retry)
+		if err != nil {
+			return nil, err
+		}
+		client.GCPClient = gcpClient
+	}
+
+	// Initialize Microsoft 365 client if credentials provided
+	if config.VB365 != nil {
+		vb365Client, err := newVB365Client(*config.VB365, config, retry)
+		if err != nil {
+			return nil, err
+		}
+		client.VB365Client = vb365Client
+	}
+
+	// Initialize Enterprise Manager client if credentials provided
+	if config.EM != nil {
+		emClient, err := newEMClient(*config.EM, config, retry)
+		if err != nil {
+			return nil, err
+		}
+		client.EMClient = emClient
+	}
+
 	return client, nil
 }
 
 // Authenticate performs the initial authentication with username/password
 func (c *AzureBackupClient) Authenticate() error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.authenticate(context.Background())
+}
+
+// authenticate implements Authenticate; the caller must hold tokenMu
+func (c *AzureBackupClient) authenticate(ctx context.Context) error {
 	tokenURL := fmt.Sprintf("%s/api/oauth2/token", c.hostname)
 
 	formData := url.Values{
@@ -253,7 +419,7 @@ func (c *AzureBackupClient) Authenticate() error {
 		"password":   {c.password},
 	}
 
-	req, err := http.NewRequest("POST", tokenURL, strings.NewReader(formData.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(formData.Encode()))
 	if err != nil {
 		return fmt.Errorf("failed to create authentication request: %w", err)
 	}
@@ -273,11 +439,7 @@ func (c *AzureBackupClient) Authenticate() error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		var errorResp ErrorResponse
-		if err := json.Unmarshal(body, &errorResp); err != nil {
-			return fmt.Errorf("authentication failed with status %d: %s", resp.StatusCode, string(body))
-		}
-		return fmt.Errorf("authentication failed: %s (%s)", errorResp.Title, errorResp.Detail)
+		return fmt.Errorf("authentication failed: %w", NewVeeamAPIError(resp.StatusCode, body))
 	}
 
 	var tokenResp TokenResponse
@@ -287,13 +449,20 @@ func (c *AzureBackupClient) Authenticate() error {
 
 	c.accessToken = tokenResp.AccessToken
 	c.refreshToken = tokenResp.RefreshToken
-	c.tokenExpiry = tokenResp.Expires
+	c.tokenExpiry = tokenResp.expiresAt()
 
 	return nil
 }
 
 // RefreshAccessToken refreshes the access token using the refresh token
 func (c *AzureBackupClient) RefreshAccessToken() error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.refreshAccessToken(context.Background())
+}
+
+// refreshAccessToken implements RefreshAccessToken; the caller must hold tokenMu
+func (c *AzureBackupClient) refreshAccessToken(ctx context.Context) error {
 	if c.refreshToken == "" {
 		return fmt.Errorf("no refresh token available")
 	}
@@ -305,7 +474,7 @@ func (c *AzureBackupClient) RefreshAccessToken() error {
 		"refresh_token": {c.refreshToken},
 	}
 
-	req, err := http.NewRequest("POST", tokenURL, strings.NewReader(formData.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(formData.Encode()))
 	if err != nil {
 		return fmt.Errorf("failed to create refresh request: %w", err)
 	}
@@ -325,11 +494,7 @@ func (c *AzureBackupClient) RefreshAccessToken() error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		var errorResp ErrorResponse
-		if err := json.Unmarshal(body, &errorResp); err != nil {
-			return fmt.Errorf("token refresh failed with status %d: %s", resp.StatusCode, string(body))
-		}
-		return fmt.Errorf("token refresh failed: %s (%s)", errorResp.Title, errorResp.Detail)
+		return fmt.Errorf("token refresh failed: %w", NewVeeamAPIError(resp.StatusCode, body))
 	}
 
 	var tokenResp TokenResponse
@@ -339,33 +504,57 @@ func (c *AzureBackupClient) RefreshAccessToken() error {
 
 	c.accessToken = tokenResp.AccessToken
 	c.refreshToken = tokenResp.RefreshToken
-	c.tokenExpiry = tokenResp.Expires
+	c.tokenExpiry = tokenResp.expiresAt()
 
 	return nil
 }
 
-// GetValidToken returns a valid access token, refreshing if necessary
+// GetValidToken returns a valid access token, refreshing it shortly before expiry.
+// Concurrent callers share a single renewal; if the refresh token is rejected, the client re-authenticates.
 func (c *AzureBackupClient) GetValidToken() (string, error) {
-	if c.accessToken != "" && time.Now().Add(5*time.Minute).Before(c.tokenExpiry) {
+	return c.token(context.Background())
+}
+
+// token implements GetValidToken, bound to ctx
+func (c *AzureBackupClient) token(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if tokenIsFresh(c.accessToken, c.tokenExpiry) {
 		return c.accessToken, nil
 	}
 
 	if c.refreshToken != "" {
-		if err := c.RefreshAccessToken(); err != nil {
-			return c.GetValidToken()
+		err := c.refreshAccessToken(ctx)
+		if err == nil {
+			return c.accessToken, nil
 		}
-		return c.accessToken, nil
+		if ctx.Err() != nil {
+			return "", err
+		}
+		c.refreshToken = ""
 	}
 
-	if err := c.Authenticate(); err != nil {
+	if err := c.authenticate(ctx); err != nil {
 		return "", err
 	}
 
 	return c.accessToken, nil
 }
 
+func (c *AzureBackupClient) invalidateToken(accessToken string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	if c.accessToken == accessToken {
+		c.tokenExpiry = time.Time{}
+	}
+}
+
 // Logout revokes the current session
 func (c *AzureBackupClient) Logout() error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
 	if c.accessToken == "" {
 		return nil
 	}
@@ -391,7 +580,7 @@ func (c *AzureBackupClient) Logout() error {
 
 	if resp.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("logout failed with status %d: %s", resp.StatusCode, string(body))
+		return fmt.Errorf("logout failed: %w", NewVeeamAPIError(resp.StatusCode, body))
 	}
 
 	return nil
@@ -399,29 +588,37 @@ func (c *AzureBackupClient) Logout() error {
 
 // MakeAuthenticatedRequest makes an HTTP request with proper authentication headers
 func (c *AzureBackupClient) MakeAuthenticatedRequest(method, endpoint string, body io.Reader) (*http.Response, error) {
-	token, err := c.GetValidToken()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get valid token: %w", err)
-	}
+	return c.MakeAuthenticatedRequestWithContext(context.Background(), method, endpoint, body)
+}
 
-	req, err := http.NewRequest(method, endpoint, body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// MakeAuthenticatedRequestWithContext is MakeAuthenticatedRequest bound to ctx, so that the
+// request and its retries stop when the resource operation times out
+func (c *AzureBackupClient) MakeAuthenticatedRequestWithContext(ctx context.Context, method, endpoint string, body io.Reader) (*http.Response, error) {
+	if err := c.checkWritable(method, endpoint); err != nil {
+		return nil, err
 	}
+	return doAuthenticated(ctx, c.httpClient, c.retry, c.limiter, c, body, func(token string, reqBody io.Reader) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("X-API-Version", c.apiVersion)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("X-API-Version", c.apiVersion)
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
 
-	return c.httpClient.Do(req)
+		return req, nil
+	})
 }
 
 // IsAuthenticated checks if the client has a valid authentication state
 func (c *AzureBackupClient) IsAuthenticated() bool {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
 	return c.accessToken != "" && time.Now().Before(c.tokenExpiry)
 }
 
@@ -437,56 +634,47 @@ func (c *AzureBackupClient) Hostname() string {
 
 // AuthenticateVBR performs authentication with VBR REST API
 func (c *VBRClient) AuthenticateVBR(apiVersion string) error {
-	tokenURL := fmt.Sprintf("https://%s/api/oauth2/token", c.hostname)
-
-	formData := url.Values{
-		"grant_type": {"Password"},
-		"username":   {c.username},
-		"password":   {c.password},
-	}
-
-	req, err := http.NewRequest("POST", tokenURL, strings.NewReader(formData.Encode()))
-	if err != nil {
-		return fmt.Errorf("failed to create VBR authentication request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("x-api-version", apiVersion)
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.authenticateVBR(context.Background(), apiVersion)
+}
 
-	resp, err := c.httpClient.Do(req)
+// authenticateVBR implements AuthenticateVBR; the caller must hold tokenMu
+func (c *VBRClient) authenticateVBR(ctx context.Context, apiVersion string) error {
+	formData, err := c.signInFormVBR()
 	if err != nil {
-		return fmt.Errorf("VBR authentication request failed: %w", err)
+		return err
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	tokenResp, err := c.postTokenVBR(ctx, apiVersion, formData)
 	if err != nil {
-		return fmt.Errorf("failed to read VBR authentication response: %w", err)
+		return fmt.Errorf("VBR authentication failed: %w", err)
 	}
+	// Authorization codes are single-use; later sign-ins fall back to the password grant
+	c.authorizationCode = ""
 
-	if resp.StatusCode != http.StatusOK {
-		var errorResp ErrorResponse
-		if err := json.Unmarshal(body, &errorResp); err != nil {
-			return fmt.Errorf("VBR authentication failed with status %d: %s", resp.StatusCode, string(body))
+	if tokenResp.MfaEnabled && tokenResp.MfaToken != "" {
+		if tokenResp, err = c.completeMFAVBR(ctx, apiVersion, tokenResp.MfaToken); err != nil {
+			return err
 		}
-		return fmt.Errorf("VBR authentication failed: %s (%s)", errorResp.Title, errorResp.Detail)
-	}
-
-	var tokenResp TokenResponse
-	if err := json.Unmarshal(body, &tokenResp); err != nil {
-		return fmt.Errorf("failed to parse VBR token response: %w", err)
 	}
 
 	c.accessToken = tokenResp.AccessToken
 	c.refreshToken = tokenResp.RefreshToken
-	c.tokenExpiry = tokenResp.Expires
+	c.tokenExpiry = tokenResp.expiresAt()
 
 	return nil
 }
 
 // RefreshAccessTokenVBR refreshes the VBR access token using the refresh token
 func (c *VBRClient) RefreshAccessTokenVBR(apiVersion string) error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.refreshAccessTokenVBR(context.Background(), apiVersion)
+}
+
+// refreshAccessTokenVBR implements RefreshAccessTokenVBR; the caller must hold tokenMu
+func (c *VBRClient) refreshAccessTokenVBR(ctx context.Context, apiVersion string) error {
 	if c.refreshToken == "" {
 		return fmt.Errorf("no VBR refresh token available")
 	}
@@ -498,7 +686,7 @@ func (c *VBRClient) RefreshAccessTokenVBR(apiVersion string) error {
 		"refresh_token": {c.refreshToken},
 	}
 
-	req, err := http.NewRequest("POST", tokenURL, strings.NewReader(formData.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(formData.Encode()))
 	if err != nil {
 		return fmt.Errorf("failed to create VBR refresh request: %w", err)
 	}
@@ -519,11 +707,7 @@ func (c *VBRClient) RefreshAccessTokenVBR(apiVersion string) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		var errorResp ErrorResponse
-		if err := json.Unmarshal(body, &errorResp); err != nil {
-			return fmt.Errorf("VBR token refresh failed with status %d: %s", resp.StatusCode, string(body))
-		}
-		return fmt.Errorf("VBR token refresh failed: %s (%s)", errorResp.Title, errorResp.Detail)
+		return fmt.Errorf("VBR token refresh failed: %w", NewVeeamAPIError(resp.StatusCode, body))
 	}
 
 	var tokenResp TokenResponse
@@ -533,59 +717,119 @@ func (c *VBRClient) RefreshAccessTokenVBR(apiVersion string) error {
 
 	c.accessToken = tokenResp.AccessToken
 	c.refreshToken = tokenResp.RefreshToken
-	c.tokenExpiry = tokenResp.Expires
+	c.tokenExpiry = tokenResp.expiresAt()
 
 	return nil
 }
 
-// GetValidTokenVBR returns a valid VBR access token, refreshing if necessary
+// GetValidTokenVBR returns a valid VBR access token, refreshing it shortly before expiry.
+// Concurrent callers share a single renewal; if the refresh token is rejected, the client re-authenticates.
 func (c *VBRClient) GetValidTokenVBR(apiVersion string) (string, error) {
-	if c.accessToken != "" && time.Now().Add(5*time.Minute).Before(c.tokenExpiry) {
+	return c.validTokenVBR(context.Background(), apiVersion)
+}
+
+// validTokenVBR implements GetValidTokenVBR, bound to ctx
+func (c *VBRClient) validTokenVBR(ctx context.Context, apiVersion string) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if tokenIsFresh(c.accessToken, c.tokenExpiry) {
 		return c.accessToken, nil
 	}
 
 	if c.refreshToken != "" {
-		if err := c.RefreshAccessTokenVBR(apiVersion); err != nil {
-			return c.GetValidTokenVBR(apiVersion)
+		err := c.refreshAccessTokenVBR(ctx, apiVersion)
+		if err == nil {
+			return c.accessToken, nil
 		}
-		return c.accessToken, nil
+		if ctx.Err() != nil {
+			return "", err
+		}
+		c.refreshToken = ""
 	}
 
-	if err := c.AuthenticateVBR(apiVersion); err != nil {
+	if err := c.authenticateVBR(ctx, apiVersion); err != nil {
 		return "", err
 	}
 
 	return c.accessToken, nil
 }
 
-// MakeAuthenticatedRequestVBR makes an HTTP request with proper VBR authentication headers
-func (c *VBRClient) MakeAuthenticatedRequestVBR(method, endpoint string, body io.Reader, apiVersion string) (*http.Response, error) {
-	token, err := c.GetValidTokenVBR(apiVersion)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get valid VBR token: %w", err)
+func (c *VBRClient) token(ctx context.Context) (string, error) {
+	return c.validTokenVBR(ctx, c.apiVersion)
+}
+
+// negotiatedAPIVersion returns the API revision to send, first detecting it when detection was
+// deferred to the first request. A failed detection is retried by the next request.
+func (c *VBRClient) negotiatedAPIVersion(ctx context.Context) (string, error) {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+	if c.detectVersion {
+		if err := c.detectAPIVersion(ctx); err != nil {
+			return "", err
+		}
+		c.detectVersion = false
 	}
+	return c.apiVersion, nil
+}
 
-	req, err := http.NewRequest(method, endpoint, body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create VBR request: %w", err)
+func (c *VBRClient) invalidateToken(accessToken string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	if c.accessToken == accessToken {
+		c.tokenExpiry = time.Time{}
 	}
+}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("x-api-version", apiVersion)
+// MakeAuthenticatedRequestVBR makes an HTTP request with proper VBR authentication headers
+func (c *VBRClient) MakeAuthenticatedRequestVBR(method, endpoint string, body io.Reader, apiVersion string) (*http.Response, error) {
+	return c.MakeAuthenticatedRequestVBRWithContext(context.Background(), method, endpoint, body, apiVersion)
+}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+// MakeAuthenticatedRequestVBRWithContext is MakeAuthenticatedRequestVBR bound to ctx
+func (c *VBRClient) MakeAuthenticatedRequestVBRWithContext(ctx context.Context, method, endpoint string, body io.Reader, apiVersion string) (*http.Response, error) {
+	if err := c.checkWritable(method, endpoint); err != nil {
+		return nil, err
 	}
+	if _, err := c.negotiatedAPIVersion(ctx); err != nil {
+		return nil, err
+	}
+	return doAuthenticated(ctx, c.httpClient, c.retry, c.limiter, c, body, func(token string, reqBody io.Reader) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create VBR request: %w", err)
+		}
+
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("x-api-version", apiVersion)
+
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
 
-	return c.httpClient.Do(req)
+		return req, nil
+	})
 }
 
 // IsAuthenticatedVBR checks if the VBR client has a valid authentication state
 func (c *VBRClient) IsAuthenticatedVBR() bool {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
 	return c.accessToken != "" && time.Now().Before(c.tokenExpiry)
 }
 
+// LockJob blocks until no other resource modifies the job with the given ID. Callers must release
+// the job with UnlockJob.
+func (c *VBRClient) LockJob(jobID string) {
+	c.jobLocks.Lock(jobID)
+}
+
+// UnlockJob releases a job locked with LockJob
+func (c *VBRClient) UnlockJob(jobID string) {
+	c.jobLocks.Unlock(jobID)
+}
+
 // BuildAPIURL constructs API URL for VBR client
 func (c *VBRClient) BuildAPIURL(endpoint string) string {
 	return fmt.Sprintf("https://%s%s", c.hostname, endpoint)
@@ -593,29 +837,38 @@ func (c *VBRClient) BuildAPIURL(endpoint string) string {
 
 // DoRequest performs an authenticated HTTP request for VBR client
 func (c *VBRClient) DoRequest(ctx context.Context, method, endpoint string, body []byte) ([]byte, error) {
-	var reqBody io.Reader
-	if body != nil {
-		reqBody = strings.NewReader(string(body))
+	if err := c.checkWritable(method, endpoint); err != nil {
+		return nil, err
 	}
-
-	token, err := c.GetValidTokenVBR(c.apiVersion)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get valid VBR token: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	apiVersion, err := c.negotiatedAPIVersion(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return c.doRequest(ctx, method, endpoint, body, apiVersion)
+}
 
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("x-api-version", c.apiVersion)
+// doRequest implements DoRequest for the given API revision
+func (c *VBRClient) doRequest(ctx context.Context, method, endpoint string, body []byte, apiVersion string) ([]byte, error) {
+	var reqBody io.Reader
 	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+		reqBody = strings.NewReader(string(body))
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := doAuthenticated(ctx, c.httpClient, c.retry, c.limiter, c, reqBody, func(token string, reqBody io.Reader) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("x-api-version", apiVersion)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		return req, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -627,13 +880,20 @@ func (c *VBRClient) DoRequest(ctx context.Context, method, endpoint string, body
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return respBody, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+		return respBody, NewVeeamAPIError(resp.StatusCode, respBody)
 	}
 
 	return respBody, nil
 }
 // AuthenticateAWS performs the initial authentication with the Veeam Backup for AWS REST API
 func (c *AWSBackupClient) AuthenticateAWS() error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.authenticateAWS(context.Background())
+}
+
+// authenticateAWS implements AuthenticateAWS; the caller must hold tokenMu
+func (c *AWSBackupClient) authenticateAWS(ctx context.Context) error {
 	tokenURL := fmt.Sprintf("https://%s/api/v1/token", c.hostname)
 
 	formData := url.Values{
@@ -642,7 +902,7 @@ func (c *AWSBackupClient) AuthenticateAWS() error {
 		"password":   {c.password},
 	}
 
-	req, err := http.NewRequest("POST", tokenURL, strings.NewReader(formData.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(formData.Encode()))
 	if err != nil {
 		return fmt.Errorf("failed to create AWS authentication request: %w", err)
 	}
@@ -662,11 +922,7 @@ func (c *AWSBackupClient) AuthenticateAWS() error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		var errorResp ErrorResponse
-		if err := json.Unmarshal(body, &errorResp); err != nil {
-			return fmt.Errorf("AWS authentication failed with status %d: %s", resp.StatusCode, string(body))
-		}
-		return fmt.Errorf("AWS authentication failed: %s (%s)", errorResp.Title, errorResp.Detail)
+		return fmt.Errorf("AWS authentication failed: %w", NewVeeamAPIError(resp.StatusCode, body))
 	}
 
 	var tokenResp TokenResponse
@@ -676,13 +932,20 @@ func (c *AWSBackupClient) AuthenticateAWS() error {
 
 	c.accessToken = tokenResp.AccessToken
 	c.refreshToken = tokenResp.RefreshToken
-	c.tokenExpiry = tokenResp.Expires
+	c.tokenExpiry = tokenResp.expiresAt()
 
 	return nil
 }
 
 // RefreshAccessTokenAWS refreshes the AWS access token using the refresh token
 func (c *AWSBackupClient) RefreshAccessTokenAWS() error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.refreshAccessTokenAWS(context.Background())
+}
+
+// refreshAccessTokenAWS implements RefreshAccessTokenAWS; the caller must hold tokenMu
+func (c *AWSBackupClient) refreshAccessTokenAWS(ctx context.Context) error {
 	if c.refreshToken == "" {
 		return fmt.Errorf("no AWS refresh token available")
 	}
@@ -694,7 +957,7 @@ func (c *AWSBackupClient) RefreshAccessTokenAWS() error {
 		"refresh_token": {c.refreshToken},
 	}
 
-	req, err := http.NewRequest("POST", tokenURL, strings.NewReader(formData.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(formData.Encode()))
 	if err != nil {
 		return fmt.Errorf("failed to create AWS refresh request: %w", err)
 	}
@@ -714,11 +977,7 @@ func (c *AWSBackupClient) RefreshAccessTokenAWS() error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		var errorResp ErrorResponse
-		if err := json.Unmarshal(body, &errorResp); err != nil {
-			return fmt.Errorf("AWS token refresh failed with status %d: %s", resp.StatusCode, string(body))
-		}
-		return fmt.Errorf("AWS token refresh failed: %s (%s)", errorResp.Title, errorResp.Detail)
+		return fmt.Errorf("AWS token refresh failed: %w", NewVeeamAPIError(resp.StatusCode, body))
 	}
 
 	var tokenResp TokenResponse
@@ -728,57 +987,84 @@ func (c *AWSBackupClient) RefreshAccessTokenAWS() error {
 
 	c.accessToken = tokenResp.AccessToken
 	c.refreshToken = tokenResp.RefreshToken
-	c.tokenExpiry = tokenResp.Expires
+	c.tokenExpiry = tokenResp.expiresAt()
 
 	return nil
 }
 
-// GetValidTokenAWS returns a valid AWS access token, refreshing if necessary
+// GetValidTokenAWS returns a valid AWS access token, refreshing it shortly before expiry.
+// Concurrent callers share a single renewal; if the refresh token is rejected, the client re-authenticates.
 func (c *AWSBackupClient) GetValidTokenAWS() (string, error) {
-	if c.accessToken != "" && time.Now().Add(5*time.Minute).Before(c.tokenExpiry) {
+	return c.token(context.Background())
+}
+
+// token implements GetValidTokenAWS, bound to ctx
+func (c *AWSBackupClient) token(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if tokenIsFresh(c.accessToken, c.tokenExpiry) {
 		return c.accessToken, nil
 	}
 
 	if c.refreshToken != "" {
-		if err := c.RefreshAccessTokenAWS(); err != nil {
-			c.refreshToken = ""
-		} else {
+		err := c.refreshAccessTokenAWS(ctx)
+		if err == nil {
 			return c.accessToken, nil
 		}
+		if ctx.Err() != nil {
+			return "", err
+		}
+		c.refreshToken = ""
 	}
 
-	if err := c.AuthenticateAWS(); err != nil {
+	if err := c.authenticateAWS(ctx); err != nil {
 		return "", err
 	}
 
 	return c.accessToken, nil
 }
 
+func (c *AWSBackupClient) invalidateToken(accessToken string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	if c.accessToken == accessToken {
+		c.tokenExpiry = time.Time{}
+	}
+}
+
 // MakeAuthenticatedRequestAWS makes an HTTP request with proper AWS authentication headers
 func (c *AWSBackupClient) MakeAuthenticatedRequestAWS(method, endpoint string, body io.Reader) (*http.Response, error) {
-	token, err := c.GetValidTokenAWS()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get valid AWS token: %w", err)
-	}
+	return c.MakeAuthenticatedRequestAWSWithContext(context.Background(), method, endpoint, body)
+}
 
-	req, err := http.NewRequest(method, endpoint, body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create AWS request: %w", err)
+// MakeAuthenticatedRequestAWSWithContext is MakeAuthenticatedRequestAWS bound to ctx
+func (c *AWSBackupClient) MakeAuthenticatedRequestAWSWithContext(ctx context.Context, method, endpoint string, body io.Reader) (*http.Response, error) {
+	if err := c.checkWritable(method, endpoint); err != nil {
+		return nil, err
 	}
+	return doAuthenticated(ctx, c.httpClient, c.retry, c.limiter, c, body, func(token string, reqBody io.Reader) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create AWS request: %w", err)
+		}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("x-api-version", c.apiVersion)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("x-api-version", c.apiVersion)
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
 
-	return c.httpClient.Do(req)
+		return req, nil
+	})
 }
 
 // IsAuthenticatedAWS checks if the AWS client has a valid authentication state
 func (c *AWSBackupClient) IsAuthenticatedAWS() bool {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
 	return c.accessToken != "" && time.Now().Before(c.tokenExpiry)
 }
 
@@ -794,16 +1080,21 @@ func (c *AWSBackupClient) DoRequest(ctx context.Context, method, endpoint string
 		reqBody = strings.NewReader(string(body))
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
-	if err != nil {
+	if err := c.checkWritable(method, endpoint); err != nil {
 		return nil, err
 	}
+	resp, err := doAuthenticated(ctx, c.httpClient, c.retry, c.limiter, c, reqBody, func(token string, reqBody io.Reader) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+		if err != nil {
+			return nil, err
+		}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
-	req.Header.Set("x-api-version", c.apiVersion)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("x-api-version", c.apiVersion)
 
-	resp, err := c.httpClient.Do(req)
+		return req, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -815,7 +1106,7 @@ func (c *AWSBackupClient) DoRequest(ctx context.Context, method, endpoint string
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return respBody, fmt.Errorf("AWS API request failed with status %d", resp.StatusCode)
+		return respBody, fmt.Errorf("AWS %w", NewVeeamAPIError(resp.StatusCode, respBody))
 	}
 
 	return respBody, nil
@@ -839,6 +1130,21 @@ func (vc *VeeamClient) GetClientForResource(resourceType string) (interface{}, e
 			return nil, fmt.Errorf("AWS configuration is required for %s resources", resourceType)
 		}
 		return vc.AWSClient, nil
+	case strings.Contains(resourceType, "gcp"):
+		if vc.GCPClient == nil {
+			return nil, fmt.Errorf("Google Cloud configuration is required for %s resources", resourceType)
+		}
+		return vc.GCPClient, nil
+	case strings.Contains(resourceType, "vb365"):
+		if vc.VB365Client == nil {
+			return nil, fmt.Errorf("VB365 configuration is required for %s resources", resourceType)
+		}
+		return vc.VB365Client, nil
+	case strings.Contains(resourceType, "_em_"):
+		if vc.EMClient == nil {
+			return nil, fmt.Errorf("Enterprise Manager configuration is required for %s resources", resourceType)
+		}
+		return vc.EMClient, nil
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", resourceType)
 	}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// descriptionSuffix marks the jobs and policies created by the provider, so that operators can
+// tell Terraform-managed objects apart in the Veeam consoles. It is embedded in every service
+// client.
+//
+// The suffix may contain the variables of descriptionVariables, which are replaced whenever a
+// description is written, e.g. " (managed by Terraform, workspace {terraform_workspace})".
+type descriptionSuffix struct {
+	suffix string
+}
+
+// descriptionVariable is a variable of the description suffix, with the value it is replaced by
+// and a pattern matching any value it may have had when the description was written
+type descriptionVariable struct {
+	name    string
+	value   func() string
+	pattern string
+}
+
+// descriptionNow returns the time written for {timestamp}; tests replace it
+var descriptionNow = time.Now
+
+var descriptionVariables = []descriptionVariable{
+	{
+		name:    "{terraform_workspace}",
+		value:   terraformWorkspace,
+		pattern: `[\w.-]*`,
+	},
+	{
+		name:    "{timestamp}",
+		value:   func() string { return descriptionNow().UTC().Format(time.RFC3339) },
+		pattern: `\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z`,
+	},
+}
+
+// terraformWorkspace returns the name of the Terraform workspace the provider runs in. Terraform
+// does not pass it to providers, so it is taken from TF_WORKSPACE, which selects the workspace
+// in pipelines, or TFC_WORKSPACE_NAME, which HCP Terraform sets in its runs.
+func terraformWorkspace() string {
+	for _, env := range []string{"TF_WORKSPACE", "TFC_WORKSPACE_NAME"} {
+		if v := os.Getenv(env); v != "" {
+			return v
+		}
+	}
+	return "default"
+}
+
+// AppendDescriptionSuffix returns the description to send to the API for a configured description.
+// An empty description is replaced by the suffix alone.
+func (s descriptionSuffix) AppendDescriptionSuffix(description string) string {
+	if s.suffix == "" {
+		return description
+	}
+	suffix := s.suffix
+	for _, v := range descriptionVariables {
+		if strings.Contains(suffix, v.name) {
+			suffix = strings.ReplaceAll(suffix, v.name, v.value())
+		}
+	}
+	if description == "" {
+		return strings.TrimSpace(suffix)
+	}
+	return description + suffix
+}
+
+// TrimDescriptionSuffix reverts AppendDescriptionSuffix on a description read from the API, so
+// that the suffix never shows up as a difference from the configuration. Variables match any value
+// they may have been replaced by, such as the timestamp of an earlier apply.
+func (s descriptionSuffix) TrimDescriptionSuffix(description string) string {
+	if s.suffix == "" {
+		return description
+	}
+	if regexp.MustCompile(`^` + suffixPattern(strings.TrimSpace(s.suffix)) + `$`).MatchString(description) {
+		return ""
+	}
+	if loc := regexp.MustCompile(suffixPattern(s.suffix) + `$`).FindStringIndex(description); loc != nil {
+		return description[:loc[0]]
+	}
+	return description
+}
+
+// suffixPattern returns a regular expression matching suffix with its variables replaced by any
+// value
+func suffixPattern(suffix string) string {
+	expr := regexp.QuoteMeta(suffix)
+	for _, v := range descriptionVariables {
+		expr = strings.ReplaceAll(expr, regexp.QuoteMeta(v.name), v.pattern)
+	}
+	return expr
+}
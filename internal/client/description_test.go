@@ -0,0 +1,58 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDescriptionSuffix(t *testing.T) {
+	s := descriptionSuffix{" (managed by Terraform)"}
+	cases := []struct {
+		description, sent string
+	}{
+		{"Nightly backup", "Nightly backup (managed by Terraform)"},
+		{"", "(managed by Terraform)"},
+	}
+	for _, c := range cases {
+		sent := s.AppendDescriptionSuffix(c.description)
+		if sent != c.sent {
+			t.Errorf("AppendDescriptionSuffix(%q) = %q, want %q", c.description, sent, c.sent)
+		}
+		if got := s.TrimDescriptionSuffix(sent); got != c.description {
+			t.Errorf("TrimDescriptionSuffix(%q) = %q, want %q", sent, got, c.description)
+		}
+	}
+
+	if got := s.TrimDescriptionSuffix("Created in the console"); got != "Created in the console" {
+		t.Errorf("TrimDescriptionSuffix changed a description without suffix to %q", got)
+	}
+	if got := (descriptionSuffix{}).AppendDescriptionSuffix("Nightly backup"); got != "Nightly backup" {
+		t.Errorf("empty suffix changed the description to %q", got)
+	}
+}
+
+func TestDescriptionSuffixVariables(t *testing.T) {
+	t.Setenv("TF_WORKSPACE", "prod-eu")
+	now := descriptionNow
+	t.Cleanup(func() { descriptionNow = now })
+	descriptionNow = func() time.Time { return time.Date(2024, 5, 1, 22, 0, 0, 0, time.UTC) }
+
+	s := descriptionSuffix{" (workspace {terraform_workspace}, applied {timestamp})"}
+	sent := s.AppendDescriptionSuffix("Nightly backup")
+	if want := "Nightly backup (workspace prod-eu, applied 2024-05-01T22:00:00Z)"; sent != want {
+		t.Errorf("AppendDescriptionSuffix = %q, want %q", sent, want)
+	}
+
+	// Descriptions written by earlier applies, from another workspace or at another time, are
+	// trimmed all the same
+	for description, want := range map[string]string{
+		sent: "Nightly backup",
+		"Nightly backup (workspace staging, applied 2023-01-02T03:04:05Z)": "Nightly backup",
+		"(workspace prod-eu, applied 2024-05-01T22:00:00Z)":                "",
+		"Nightly backup (workspace prod-eu, applied yesterday)":            "Nightly backup (workspace prod-eu, applied yesterday)",
+	} {
+		if got := s.TrimDescriptionSuffix(description); got != want {
+			t.Errorf("TrimDescriptionSuffix(%q) = %q, want %q", description, got, want)
+		}
+	}
+}
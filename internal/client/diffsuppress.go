@@ -0,0 +1,125 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// timeOfDayLayouts are the formats in which times of day are configured or returned by the
+// appliances, which expand "22:00" to "22:00:00"
+var timeOfDayLayouts = []string{"15:04", "15:04:05", "15:04:05.9999999"}
+
+// SuppressEquivalentTime suppresses the diff between two times of day that only differ in
+// precision, such as "22:00" in configuration and "22:00:00" returned by the API.
+func SuppressEquivalentTime(_, old, new string, _ *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	o, ok := parseTimeOfDay(old)
+	if !ok {
+		return false
+	}
+	n, ok := parseTimeOfDay(new)
+	return ok && o.Equal(n)
+}
+
+func parseTimeOfDay(s string) (time.Time, bool) {
+	for _, layout := range timeOfDayLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// clockTimeLayouts are the 12-hour formats NormalizeTimeOfDay accepts besides timeOfDayLayouts
+var clockTimeLayouts = []string{"3PM", "3:04PM", "3:04:05PM"}
+
+// NormalizeTimeOfDay returns a time of day such as "22:00:00", "9:30" or "10 pm" in the hh:mm
+// format the local_time attributes take, with seconds only when they are not zero
+func NormalizeTimeOfDay(s string) (string, error) {
+	t, ok := parseTimeOfDay(strings.TrimSpace(s))
+	if !ok {
+		clock := strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+		for _, layout := range clockTimeLayouts {
+			if parsed, err := time.Parse(layout, clock); err == nil {
+				t, ok = parsed, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return "", fmt.Errorf("%q is not a time of day, e.g. 22:00 or 10:00 PM", s)
+	}
+	if t.Second() != 0 || t.Nanosecond() != 0 {
+		return t.Format("15:04:05"), nil
+	}
+	return t.Format("15:04"), nil
+}
+
+// SuppressCaseDifference suppresses the diff between enum values and names that the API returns
+// with a different case than configured, such as region names.
+func SuppressCaseDifference(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// SuppressMissingOptionalBlock suppresses the diff of an optional single nested block that is not
+// configured but filled in with defaults by the API. It must be set on the TypeList attribute of
+// the block, for which the SDK also calls it with the attributes of the block, so those are
+// suppressed too when the configuration leaves the block out. Without a configuration to check,
+// only the removal of the block itself is suppressed.
+func SuppressMissingOptionalBlock(k, old, new string, d *schema.ResourceData) bool {
+	if d == nil || d.GetRawConfig().IsNull() {
+		return strings.HasSuffix(k, ".#") && old == "1" && new == "0"
+	}
+	return blockMissingFromConfig(d.GetRawConfig(), strings.Split(k, "."))
+}
+
+// blockMissingFromConfig reports whether a block on the path of the flatmap key parts, such as
+// "schedule.0.retry.#", is left out of the configuration val
+func blockMissingFromConfig(val cty.Value, parts []string) bool {
+	for _, part := range parts {
+		if val.IsNull() {
+			return true
+		}
+		if !val.IsKnown() {
+			return false
+		}
+		ty := val.Type()
+		switch {
+		case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+			if val.LengthInt() == 0 {
+				return true
+			}
+			index, err := strconv.Atoi(part)
+			if err != nil || !ty.IsListType() || index >= val.LengthInt() {
+				return false
+			}
+			val = val.Index(cty.NumberIntVal(int64(index)))
+		case ty.IsObjectType():
+			if !ty.HasAttribute(part) {
+				return false
+			}
+			val = val.GetAttr(part)
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// HashFieldIgnoreCase returns a set hash function for nested blocks that identifies them by the
+// given string field regardless of case, so that names the API returns in another case, such as
+// region names, do not replace the configured block.
+func HashFieldIgnoreCase(field string) schema.SchemaSetFunc {
+	return func(v interface{}) int {
+		m, _ := v.(map[string]interface{})
+		name, _ := m[field].(string)
+		return schema.HashString(strings.ToLower(name))
+	}
+}
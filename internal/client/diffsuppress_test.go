@@ -0,0 +1,111 @@
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestSuppressEquivalentTime(t *testing.T) {
+	cases := []struct {
+		old, new string
+		want     bool
+	}{
+		{"22:00:00", "22:00", true},
+		{"22:00", "22:00:00", true},
+		{"08:30:00.0000000", "08:30", true},
+		{"22:00:00", "22:30", false},
+		{"", "22:00", false},
+		{"22:00:00", "", false},
+	}
+	for _, c := range cases {
+		if got := SuppressEquivalentTime("local_time", c.old, c.new, nil); got != c.want {
+			t.Errorf("SuppressEquivalentTime(%q, %q) = %t, want %t", c.old, c.new, got, c.want)
+		}
+	}
+}
+
+func TestNormalizeTimeOfDay(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"22:00", "22:00"},
+		{"22:00:00", "22:00"},
+		{"9:30", "09:30"},
+		{"08:30:15", "08:30:15"},
+		{"10 pm", "22:00"},
+		{"12:15 AM", "00:15"},
+		{"7:45PM", "19:45"},
+	}
+	for _, c := range cases {
+		got, err := NormalizeTimeOfDay(c.in)
+		if err != nil {
+			t.Errorf("NormalizeTimeOfDay(%q): %s", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("NormalizeTimeOfDay(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+
+	for _, in := range []string{"", "24:00", "22", "13 pm", "noon"} {
+		if _, err := NormalizeTimeOfDay(in); err == nil {
+			t.Errorf("NormalizeTimeOfDay(%q) succeeded, want an error", in)
+		}
+	}
+}
+
+func TestSuppressMissingOptionalBlock(t *testing.T) {
+	if !SuppressMissingOptionalBlock("schedule.0.retry.#", "1", "0", nil) {
+		t.Error("unconfigured block filled in by the API is not suppressed")
+	}
+	if SuppressMissingOptionalBlock("schedule.0.retry.#", "0", "1", nil) {
+		t.Error("added block is suppressed")
+	}
+	if SuppressMissingOptionalBlock("schedule.0.retry.0.retry_count", "1", "0", nil) {
+		t.Error("attribute of a configured block is suppressed")
+	}
+}
+
+func TestBlockMissingFromConfig(t *testing.T) {
+	retry := cty.List(cty.Object(map[string]cty.Type{"retry_count": cty.Number}))
+	config := func(retries ...cty.Value) cty.Value {
+		list := cty.ListValEmpty(retry.ElementType())
+		if len(retries) > 0 {
+			list = cty.ListVal(retries)
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"schedule": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"retry": list})}),
+		})
+	}
+	configured := config(cty.ObjectVal(map[string]cty.Value{"retry_count": cty.NullVal(cty.Number)}))
+
+	cases := []struct {
+		name   string
+		config cty.Value
+		key    string
+		want   bool
+	}{
+		{"unconfigured block", config(), "schedule.0.retry.#", true},
+		{"attribute of unconfigured block", config(), "schedule.0.retry.0.retry_count", true},
+		{"configured block", configured, "schedule.0.retry.#", false},
+		{"attribute of configured block", configured, "schedule.0.retry.0.retry_count", false},
+		{"unknown block", cty.ObjectVal(map[string]cty.Value{"schedule": cty.UnknownVal(cty.List(cty.DynamicPseudoType))}), "schedule.0.retry.#", false},
+	}
+	for _, c := range cases {
+		if got := blockMissingFromConfig(c.config, strings.Split(c.key, ".")); got != c.want {
+			t.Errorf("%s: blockMissingFromConfig(%q) = %t, want %t", c.name, c.key, got, c.want)
+		}
+	}
+}
+
+func TestHashFieldIgnoreCase(t *testing.T) {
+	hash := HashFieldIgnoreCase("name")
+	if hash(map[string]interface{}{"name": "EastUS"}) != hash(map[string]interface{}{"name": "eastus"}) {
+		t.Error("blocks differing in case hash differently")
+	}
+	if hash(map[string]interface{}{"name": "eastus"}) == hash(map[string]interface{}{"name": "westus"}) {
+		t.Error("different blocks hash the same")
+	}
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EMClient handles Veeam Backup Enterprise Manager REST API. Enterprise Manager signs in with
+// basic authentication and identifies the session by the X-RestSvcSessionId header instead of a
+// bearer token; a session that expires is replaced by signing in again.
+type EMClient struct {
+	hostname   string
+	username   string
+	password   string
+	sessionID  string
+	httpClient *http.Client
+	retry      RetryConfig
+	limiter    *rateLimiter
+	sessionMu  sync.Mutex // Guards sessionID
+	requestPool
+	readOnly
+}
+
+// newEMClient creates an Enterprise Manager client from config and, unless SkipSignIn is set, signs in to the server
+func newEMClient(config EMConfig, clientConfig ClientConfig, retry RetryConfig) (*EMClient, error) {
+	port := config.Port
+	if port == "" {
+		port = "9398" // Default Enterprise Manager REST API port
+	}
+
+	transport, err := newTransport(config.TLS, clientConfig.ProxyURL, clientConfig.maxConcurrentRequests())
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure Enterprise Manager HTTP transport: %w", err)
+	}
+
+	hostname := strings.TrimSuffix(config.Hostname, "/")
+	hostname = strings.TrimPrefix(hostname, "https://")
+	hostname = strings.TrimPrefix(hostname, "http://")
+
+	c := &EMClient{
+		hostname: fmt.Sprintf("%s:%s", hostname, port),
+		username: config.Username,
+		password: config.Password,
+		httpClient: &http.Client{
+			Timeout:   10 * time.Minute,
+			Transport: newLoggingTransport(transport),
+		},
+		retry:       retry,
+		limiter:     newRateLimiter(clientConfig.RequestsPerSecond),
+		requestPool: requestPool{clientConfig.maxConcurrentRequests()},
+		readOnly:    readOnly{clientConfig.ReadOnly},
+	}
+
+	if !clientConfig.SkipSignIn {
+		if _, err := c.token(context.Background()); err != nil {
+			return nil, &EndpointError{Service: "Veeam Backup Enterprise Manager", URL: "https://" + c.hostname, Err: err}
+		}
+	}
+	return c, nil
+}
+
+// signIn opens a new logon session; the caller must hold sessionMu
+func (c *EMClient) signIn(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("https://%s/api/sessionMngr/?v=latest", c.hostname), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create Enterprise Manager logon request: %w", err)
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Enterprise Manager logon request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read Enterprise Manager logon response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Enterprise Manager logon failed: %w", NewVeeamAPIError(resp.StatusCode, body))
+	}
+
+	sessionID := resp.Header.Get("X-RestSvcSessionId")
+	if sessionID == "" {
+		return fmt.Errorf("Enterprise Manager logon response has no X-RestSvcSessionId header")
+	}
+	c.sessionID = sessionID
+	return nil
+}
+
+func (c *EMClient) token(ctx context.Context) (string, error) {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+	if c.sessionID == "" {
+		if err := c.signIn(ctx); err != nil {
+			return "", err
+		}
+	}
+	return c.sessionID, nil
+}
+
+func (c *EMClient) invalidateToken(sessionID string) {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+	if c.sessionID == sessionID {
+		c.sessionID = ""
+	}
+}
+
+// BuildAPIURL constructs an API URL for the Enterprise Manager client
+func (c *EMClient) BuildAPIURL(endpoint string) string {
+	return fmt.Sprintf("https://%s/api%s", c.hostname, endpoint)
+}
+
+// DoRequest performs an authenticated HTTP request for the Enterprise Manager client, asking for
+// a JSON rather than the default XML response
+func (c *EMClient) DoRequest(ctx context.Context, method, endpoint string, body []byte) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = strings.NewReader(string(body))
+	}
+
+	if err := c.checkWritable(method, endpoint); err != nil {
+		return nil, err
+	}
+	resp, err := doAuthenticated(ctx, c.httpClient, c.retry, c.limiter, c, reqBody, func(sessionID string, reqBody io.Reader) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("X-RestSvcSessionId", sessionID)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return respBody, NewVeeamAPIError(resp.StatusCode, respBody)
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// EndpointError reports that the provider could not sign in to a configured appliance
+type EndpointError struct {
+	Service string // Product name of the appliance, e.g. "Veeam Backup for Microsoft Azure"
+	URL     string // Base URL of the appliance REST API
+	Err     error
+}
+
+func (e *EndpointError) Error() string {
+	return fmt.Sprintf("failed to sign in to %s at %s: %s", e.Service, e.URL, e.Err)
+}
+
+func (e *EndpointError) Unwrap() error {
+	return e.Err
+}
+
+// Hint names the provider settings most likely responsible for the failure, or returns "" when
+// the cause is not recognised
+func (e *EndpointError) Hint() string {
+	var certErr *tls.CertificateVerificationError
+	var apiErr *VeeamAPIError
+	var netErr net.Error
+
+	switch {
+	// Checked before net.Error, which also matches the *url.Error a TLS failure is wrapped in
+	case errors.As(e.Err, &certErr):
+		return "The TLS certificate of the appliance could not be verified. Set ca_cert_pem to the CA that issued it, or insecure_skip_verify = true for a self-signed certificate."
+	case errors.As(e.Err, &apiErr):
+		message := strings.ToLower(apiErr.ErrorCode + " " + apiErr.Message)
+		switch {
+		case apiErr.StatusCode == http.StatusNotFound || strings.Contains(message, "version"):
+			return "The appliance does not serve the configured REST API version. Check api_version against the versions the appliance supports, and that hostname and port point to the right Veeam product."
+		case apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden ||
+			strings.Contains(message, "grant") || strings.Contains(message, "password"):
+			return "The appliance rejected the credentials. Check username and password, and that the account is allowed to use the REST API."
+		}
+	case errors.As(e.Err, &netErr):
+		return "The appliance is not reachable. Check hostname and port, proxy_url, and that firewalls allow connections to the REST API."
+	}
+	return ""
+}
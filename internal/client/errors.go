@@ -0,0 +1,140 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// VeeamAPIError is returned when a Veeam REST API responds with a non-success status code
+type VeeamAPIError struct {
+	StatusCode int    // HTTP status code of the response
+	ErrorCode  string // Veeam error code (VBR/AWS errorCode, Azure title)
+	Message    string // Human-readable message from the error payload
+	TraceID    string // Azure trace ID, when present
+	Body       []byte // Raw response body
+}
+
+// vbrErrorResponse is the error payload returned by the VBR and AWS REST APIs
+type vbrErrorResponse struct {
+	ErrorCode  string `json:"errorCode"`
+	Message    string `json:"message"`
+	ResourceID string `json:"resourceId"`
+}
+
+// NewVeeamAPIError builds a VeeamAPIError from a response status code and body.
+// Both the VBR/AWS ({errorCode, message}) and Azure ({title, detail, errors}) payloads are understood.
+func NewVeeamAPIError(statusCode int, body []byte) *VeeamAPIError {
+	apiErr := &VeeamAPIError{
+		StatusCode: statusCode,
+		Body:       body,
+	}
+
+	var vbrErr vbrErrorResponse
+	if err := json.Unmarshal(body, &vbrErr); err == nil && (vbrErr.ErrorCode != "" || vbrErr.Message != "") {
+		apiErr.ErrorCode = vbrErr.ErrorCode
+		apiErr.Message = vbrErr.Message
+		return apiErr
+	}
+
+	var azureErr ErrorResponse
+	if err := json.Unmarshal(body, &azureErr); err == nil && (azureErr.Title != "" || azureErr.Detail != "") {
+		apiErr.ErrorCode = azureErr.Title
+		apiErr.Message = azureErr.Detail
+		apiErr.TraceID = azureErr.TraceID
+		if details := formatValidationErrors(azureErr.Errors); details != "" {
+			if apiErr.Message != "" {
+				apiErr.Message += "; "
+			}
+			apiErr.Message += details
+		}
+	}
+
+	return apiErr
+}
+
+func (e *VeeamAPIError) Error() string {
+	msg := fmt.Sprintf("API request failed with status %d", e.StatusCode)
+	if e.ErrorCode != "" {
+		msg = fmt.Sprintf("%s (%s)", msg, e.ErrorCode)
+	}
+
+	switch {
+	case e.Message != "":
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	case len(e.Body) > 0:
+		msg = fmt.Sprintf("%s: %s", msg, string(e.Body))
+	}
+
+	if e.TraceID != "" {
+		msg = fmt.Sprintf("%s [trace ID: %s]", msg, e.TraceID)
+	}
+	return msg
+}
+
+// IsNotFound reports whether err is a VeeamAPIError with a 404 status code
+func IsNotFound(err error) bool {
+	return HasStatusCode(err, http.StatusNotFound)
+}
+
+// IsGone reports whether err means the remote object no longer exists (404 or 410)
+func IsGone(err error) bool {
+	return HasStatusCode(err, http.StatusNotFound, http.StatusGone)
+}
+
+// RemoveFromStateIfGone clears the resource ID (via *schema.ResourceData.SetId) when err
+// reports that the object was deleted outside of Terraform. It returns true if the ID was cleared.
+func RemoveFromStateIfGone(d interface{ SetId(string) }, err error) bool {
+	if !IsGone(err) {
+		return false
+	}
+	log.Printf("[WARN] Remote object no longer exists, removing it from state: %s", err)
+	d.SetId("")
+	return true
+}
+
+// HasStatusCode reports whether err is a VeeamAPIError with one of the given status codes
+func HasStatusCode(err error, statusCodes ...int) bool {
+	var apiErr *VeeamAPIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, code := range statusCodes {
+		if apiErr.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// formatValidationErrors flattens the Azure "errors" map into a stable, readable string
+func formatValidationErrors(validationErrors map[string]interface{}) string {
+	if len(validationErrors) == 0 {
+		return ""
+	}
+
+	fields := make([]string, 0, len(validationErrors))
+	for field := range validationErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		switch v := validationErrors[field].(type) {
+		case []interface{}:
+			messages := make([]string, 0, len(v))
+			for _, m := range v {
+				messages = append(messages, fmt.Sprint(m))
+			}
+			parts = append(parts, fmt.Sprintf("%s: %s", field, strings.Join(messages, ", ")))
+		default:
+			parts = append(parts, fmt.Sprintf("%s: %v", field, v))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNewVeeamAPIError(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   int
+		body     string
+		wantCode string
+		wantMsg  string
+		wantErr  string
+	}{
+		{
+			name:     "vbr payload",
+			status:   http.StatusNotFound,
+			body:     `{"errorCode":"NotFound","message":"Job was not found.","resourceId":"abc"}`,
+			wantCode: "NotFound",
+			wantMsg:  "Job was not found.",
+			wantErr:  "API request failed with status 404 (NotFound): Job was not found.",
+		},
+		{
+			name:     "azure payload",
+			status:   http.StatusBadRequest,
+			body:     `{"title":"Bad Request","detail":"Validation failed","status":400,"traceId":"t-1","errors":{"name":["is required"]}}`,
+			wantCode: "Bad Request",
+			wantMsg:  "Validation failed; name: is required",
+			wantErr:  "API request failed with status 400 (Bad Request): Validation failed; name: is required [trace ID: t-1]",
+		},
+		{
+			name:    "plain body",
+			status:  http.StatusBadGateway,
+			body:    "upstream unavailable",
+			wantErr: "API request failed with status 502: upstream unavailable",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewVeeamAPIError(tc.status, []byte(tc.body))
+			if err.ErrorCode != tc.wantCode {
+				t.Errorf("ErrorCode = %q, want %q", err.ErrorCode, tc.wantCode)
+			}
+			if err.Message != tc.wantMsg {
+				t.Errorf("Message = %q, want %q", err.Message, tc.wantMsg)
+			}
+			if err.Error() != tc.wantErr {
+				t.Errorf("Error() = %q, want %q", err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	wrapped := fmt.Errorf("failed to read job: %w", NewVeeamAPIError(http.StatusNotFound, nil))
+	if !IsNotFound(wrapped) {
+		t.Errorf("IsNotFound should unwrap wrapped API errors")
+	}
+	if IsNotFound(NewVeeamAPIError(http.StatusInternalServerError, nil)) {
+		t.Errorf("IsNotFound should be false for a 500 error")
+	}
+	if IsNotFound(fmt.Errorf("404 in a plain message")) {
+		t.Errorf("IsNotFound should not match on error text")
+	}
+}
+
+type fakeState struct {
+	id string
+}
+
+func (f *fakeState) SetId(id string) {
+	f.id = id
+}
+
+func TestRemoveFromStateIfGone(t *testing.T) {
+	for _, status := range []int{http.StatusNotFound, http.StatusGone} {
+		state := &fakeState{id: "abc"}
+		if !RemoveFromStateIfGone(state, NewVeeamAPIError(status, nil)) || state.id != "" {
+			t.Errorf("status %d: expected the ID to be cleared", status)
+		}
+	}
+
+	state := &fakeState{id: "abc"}
+	if RemoveFromStateIfGone(state, NewVeeamAPIError(http.StatusForbidden, nil)) || state.id != "abc" {
+		t.Errorf("status 403: expected the ID to be kept")
+	}
+}
@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GCPBackupClient handles Veeam Backup for Google Cloud REST API
+type GCPBackupClient struct {
+	hostname     string
+	username     string
+	password     string
+	apiVersion   string
+	accessToken  string
+	refreshToken string
+	tokenExpiry  time.Time
+	httpClient   *http.Client
+	retry        RetryConfig
+	limiter      *rateLimiter
+	tokenMu      sync.Mutex // Guards accessToken, refreshToken and tokenExpiry
+	descriptionSuffix
+	requestPool
+	readOnly
+}
+
+// newGCPBackupClient creates a Google Cloud client from config and, unless SkipSignIn is set, signs in to the appliance
+func newGCPBackupClient(config GCPConfig, clientConfig ClientConfig, retry RetryConfig) (*GCPBackupClient, error) {
+	port := config.Port
+	if port == "" {
+		port = "13140" // Default Veeam Backup for Google Cloud REST API port
+	}
+	apiVersion := config.APIVersion
+	if apiVersion == "" {
+		apiVersion = "1.4-rev0" // Default API version
+	}
+
+	transport, err := newTransport(config.TLS, clientConfig.ProxyURL, clientConfig.maxConcurrentRequests())
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure Google Cloud HTTP transport: %w", err)
+	}
+
+	hostname := strings.TrimSuffix(config.Hostname, "/")
+	hostname = strings.TrimPrefix(hostname, "https://")
+	hostname = strings.TrimPrefix(hostname, "http://")
+
+	c := &GCPBackupClient{
+		hostname:   fmt.Sprintf("%s:%s", hostname, port),
+		username:   config.Username,
+		password:   config.Password,
+		apiVersion: apiVersion,
+		httpClient: &http.Client{
+			Timeout:   10 * time.Minute,
+			Transport: newLoggingTransport(transport),
+		},
+		retry:             retry,
+		limiter:           newRateLimiter(clientConfig.RequestsPerSecond),
+		descriptionSuffix: descriptionSuffix{clientConfig.DescriptionSuffix},
+		requestPool:       requestPool{clientConfig.maxConcurrentRequests()},
+		readOnly:          readOnly{clientConfig.ReadOnly},
+	}
+
+	if !clientConfig.SkipSignIn {
+		if err := c.AuthenticateGCP(); err != nil {
+			return nil, &EndpointError{Service: "Veeam Backup for Google Cloud", URL: "https://" + c.hostname, Err: err}
+		}
+	}
+	return c, nil
+}
+
+// AuthenticateGCP performs the initial authentication with the Veeam Backup for Google Cloud REST API
+func (c *GCPBackupClient) AuthenticateGCP() error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.requestTokenGCP(context.Background(), url.Values{
+		"grant_type": {"password"},
+		"username":   {c.username},
+		"password":   {c.password},
+	})
+}
+
+// requestTokenGCP obtains a new token pair with the given grant; the caller must hold tokenMu
+func (c *GCPBackupClient) requestTokenGCP(ctx context.Context, formData url.Values) error {
+	tokenURL := fmt.Sprintf("https://%s/api/v1/token", c.hostname)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(formData.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create Google Cloud token request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("x-api-version", c.apiVersion)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Google Cloud token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read Google Cloud token response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Google Cloud %s grant failed: %w", formData.Get("grant_type"), NewVeeamAPIError(resp.StatusCode, body))
+	}
+
+	var tokenResp TokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return fmt.Errorf("failed to parse Google Cloud token response: %w", err)
+	}
+
+	c.accessToken = tokenResp.AccessToken
+	c.refreshToken = tokenResp.RefreshToken
+	c.tokenExpiry = tokenResp.expiresAt()
+
+	return nil
+}
+
+// GetValidTokenGCP returns a valid Google Cloud access token, refreshing it shortly before expiry.
+// Concurrent callers share a single renewal; if the refresh token is rejected, the client re-authenticates.
+func (c *GCPBackupClient) GetValidTokenGCP() (string, error) {
+	return c.token(context.Background())
+}
+
+// token implements GetValidTokenGCP, bound to ctx
+func (c *GCPBackupClient) token(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if tokenIsFresh(c.accessToken, c.tokenExpiry) {
+		return c.accessToken, nil
+	}
+
+	if c.refreshToken != "" {
+		err := c.requestTokenGCP(ctx, url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {c.refreshToken},
+		})
+		if err == nil {
+			return c.accessToken, nil
+		}
+		if ctx.Err() != nil {
+			return "", err
+		}
+		c.refreshToken = ""
+	}
+
+	err := c.requestTokenGCP(ctx, url.Values{
+		"grant_type": {"password"},
+		"username":   {c.username},
+		"password":   {c.password},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return c.accessToken, nil
+}
+
+func (c *GCPBackupClient) invalidateToken(accessToken string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	if c.accessToken == accessToken {
+		c.tokenExpiry = time.Time{}
+	}
+}
+
+// BuildAPIURL constructs an API URL for the Google Cloud client
+func (c *GCPBackupClient) BuildAPIURL(endpoint string) string {
+	return fmt.Sprintf("https://%s/api/v1%s", c.hostname, endpoint)
+}
+
+// DoRequest performs an authenticated HTTP request for the Google Cloud client
+func (c *GCPBackupClient) DoRequest(ctx context.Context, method, endpoint string, body []byte) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = strings.NewReader(string(body))
+	}
+
+	if err := c.checkWritable(method, endpoint); err != nil {
+		return nil, err
+	}
+	resp, err := doAuthenticated(ctx, c.httpClient, c.retry, c.limiter, c, reqBody, func(token string, reqBody io.Reader) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("x-api-version", c.apiVersion)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return respBody, NewVeeamAPIError(resp.StatusCode, respBody)
+	}
+
+	return respBody, nil
+}
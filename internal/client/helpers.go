@@ -2,6 +2,17 @@ package client
 
 import "fmt"
 
+// ClientNotConfiguredError is returned when a resource needs a service client that the provider
+// configuration does not set up. Each provider configuration, including every alias, carries its
+// own independent clients, so a resource must use a configuration with the matching block.
+type ClientNotConfiguredError struct {
+	Block string // Provider block that configures the client, e.g. "vbr"
+}
+
+func (e *ClientNotConfiguredError) Error() string {
+	return fmt.Sprintf("%s client not configured; set the provider %q block, or select a provider configuration that sets it with the resource's provider meta-argument (e.g. provider = veeambackup.<alias>)", e.Block, e.Block)
+}
+
 // GetAzureClient extracts the AzureBackupClient from the provider meta value.
 func GetAzureClient(meta interface{}) (*AzureBackupClient, error) {
 	switch v := meta.(type) {
@@ -9,7 +20,7 @@ func GetAzureClient(meta interface{}) (*AzureBackupClient, error) {
 		return v, nil
 	case *VeeamClient:
 		if v == nil || v.AzureClient == nil {
-			return nil, fmt.Errorf("azure client not configured; set provider \"azure\" block")
+			return nil, &ClientNotConfiguredError{Block: "azure"}
 		}
 		return v.AzureClient, nil
 	default:
@@ -24,7 +35,7 @@ func GetVBRClient(meta interface{}) (*VBRClient, error) {
 		return v, nil
 	case *VeeamClient:
 		if v == nil || v.VBRClient == nil {
-			return nil, fmt.Errorf("vbr client not configured; set provider \"vbr\" block")
+			return nil, &ClientNotConfiguredError{Block: "vbr"}
 		}
 		return v.VBRClient, nil
 	default:
@@ -39,10 +50,55 @@ func GetAWSClient(meta interface{}) (*AWSBackupClient, error) {
 		return v, nil
 	case *VeeamClient:
 		if v == nil || v.AWSClient == nil {
-			return nil, fmt.Errorf("aws client not configured; set provider \"aws\" block")
+			return nil, &ClientNotConfiguredError{Block: "aws"}
 		}
 		return v.AWSClient, nil
 	default:
 		return nil, fmt.Errorf("unexpected provider client type: %T", meta)
 	}
 }
+
+// GetGCPClient extracts the GCPBackupClient from the provider meta value.
+func GetGCPClient(meta interface{}) (*GCPBackupClient, error) {
+	switch v := meta.(type) {
+	case *GCPBackupClient:
+		return v, nil
+	case *VeeamClient:
+		if v == nil || v.GCPClient == nil {
+			return nil, &ClientNotConfiguredError{Block: "gcp"}
+		}
+		return v.GCPClient, nil
+	default:
+		return nil, fmt.Errorf("unexpected provider client type: %T", meta)
+	}
+}
+
+// GetVB365Client extracts the VB365Client from the provider meta value.
+func GetVB365Client(meta interface{}) (*VB365Client, error) {
+	switch v := meta.(type) {
+	case *VB365Client:
+		return v, nil
+	case *VeeamClient:
+		if v == nil || v.VB365Client == nil {
+			return nil, &ClientNotConfiguredError{Block: "vb365"}
+		}
+		return v.VB365Client, nil
+	default:
+		return nil, fmt.Errorf("unexpected provider client type: %T", meta)
+	}
+}
+
+// GetEMClient extracts the EMClient from the provider meta value.
+func GetEMClient(meta interface{}) (*EMClient, error) {
+	switch v := meta.(type) {
+	case *EMClient:
+		return v, nil
+	case *VeeamClient:
+		if v == nil || v.EMClient == nil {
+			return nil, &ClientNotConfiguredError{Block: "enterprise_manager"}
+		}
+		return v.EMClient, nil
+	default:
+		return nil, fmt.Errorf("unexpected provider client type: %T", meta)
+	}
+}
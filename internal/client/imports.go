@@ -0,0 +1,139 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ImportCandidate is an object of an appliance, e.g. a job or a policy, that a resource of this
+// provider may import
+type ImportCandidate struct {
+	ID   string
+	Name string
+	Type string // Type of the object as the API names it
+	// ResourceType is the resource that manages objects of Type; empty when there is none
+	ResourceType string
+	// Importable is whether ResourceType supports import
+	Importable bool
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// ResourceName turns the name of an object into a Terraform resource name, e.g.
+// "Tenant A - VMs" into "tenant_a_vms"
+func ResourceName(name string) string {
+	resourceName := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if resourceName == "" {
+		return "unnamed"
+	}
+	if resourceName[0] >= '0' && resourceName[0] <= '9' {
+		return "_" + resourceName
+	}
+	return resourceName
+}
+
+// ImportCandidatesSchema returns the imports and import_blocks attributes of the data sources that
+// list the objects of an appliance to import, each of which is described as a kind, e.g. "job"
+func ImportCandidatesSchema(kind string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"imports": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("Every %s, sorted by name, with the resource address to import it to.", kind),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("ID of the %s, to import it with.", kind),
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("Name of the %s.", kind),
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("Type of the %s as the API names it.", kind),
+					},
+					"resource_type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("Resource type that manages the %s; empty when no resource of this provider does.", kind),
+					},
+					"address": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("Suggested resource address, named after the %s and unique among the listed ones; empty without resource_type.", kind),
+					},
+					"importable": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether resource_type supports import.",
+					},
+				},
+			},
+		},
+		"import_blocks": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Terraform import blocks for every importable %s, e.g. to be written to a file with the local_file resource.", kind),
+		},
+	}
+}
+
+// SetImportCandidates sets the imports and import_blocks attributes of d to candidates, sorted by
+// name and ID. Resource addresses are named after the objects, with a numeric suffix for the
+// objects whose names map to the same address as an earlier one.
+func SetImportCandidates(d *schema.ResourceData, candidates []ImportCandidate) error {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Name != candidates[j].Name {
+			return candidates[i].Name < candidates[j].Name
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+
+	imports := make([]map[string]interface{}, len(candidates))
+	var blocks []string
+	used := map[string]bool{}
+	for i, candidate := range candidates {
+		address := ""
+		if candidate.ResourceType != "" {
+			base := candidate.ResourceType + "." + ResourceName(candidate.Name)
+			address = base
+			for n := 2; used[address]; n++ {
+				address = base + "_" + strconv.Itoa(n)
+			}
+			used[address] = true
+		}
+		imports[i] = map[string]interface{}{
+			"id":            candidate.ID,
+			"name":          candidate.Name,
+			"type":          candidate.Type,
+			"resource_type": candidate.ResourceType,
+			"address":       address,
+			"importable":    candidate.Importable,
+		}
+		if candidate.Importable && address != "" {
+			blocks = append(blocks, fmt.Sprintf("import {\n  to = %s\n  id = %s\n}\n", address, hclString(candidate.ID)))
+		}
+	}
+
+	if err := d.Set("imports", imports); err != nil {
+		return err
+	}
+	return d.Set("import_blocks", strings.Join(blocks, "\n"))
+}
+
+// hclString quotes s as an HCL string literal, escaping template sequences
+func hclString(s string) string {
+	quoted := strconv.Quote(s)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
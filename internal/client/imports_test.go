@@ -0,0 +1,25 @@
+package client
+
+import "testing"
+
+func TestResourceName(t *testing.T) {
+	tests := map[string]string{
+		"Tenant A - VMs": "tenant_a_vms",
+		"production-vms": "production_vms",
+		"  Daily (SQL) ": "daily_sql",
+		"2024 archive":   "_2024_archive",
+		"Sauvegarde é":   "sauvegarde",
+		"---":            "unnamed",
+	}
+	for name, want := range tests {
+		if got := ResourceName(name); got != want {
+			t.Errorf("ResourceName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestHCLString(t *testing.T) {
+	if got, want := hclString(`a"${b}%{c}`), `"a\"$${b}%%{c}"`; got != want {
+		t.Errorf("hclString() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedValue replaces the value of sensitive fields in logged request and response bodies
+const redactedValue = "***"
+
+// sensitiveKeyFragments mark body fields whose values must never be logged. Keys are compared
+// in lower case with '_' and '-' removed, so "access_token", "accessToken" and "mfa-token" all match.
+var sensitiveKeyFragments = []string{
+	"password",
+	"passphrase",
+	"secret",
+	"token",
+	"privatekey",
+	"accesskey",
+	"apikey",
+	"mfacode",
+}
+
+// sensitiveKeys are matched exactly rather than as fragments, since they are common substrings
+var sensitiveKeys = map[string]bool{
+	"code": true, // OAuth2 authorization code
+}
+
+// loggingTransport writes every API call to the provider log via tflog. Method, URL, status and
+// duration are logged at DEBUG; at TRACE the request and response bodies are logged as well,
+// with credentials redacted.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+// newLoggingTransport wraps next so that the requests it sends are logged
+func newLoggingTransport(next http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{next: next}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	traceBodies := logBodies()
+
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"url":    req.URL.Redacted(),
+	}
+	if traceBodies && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			payload, _ := io.ReadAll(body)
+			body.Close()
+			if len(payload) > 0 {
+				fields["request_body"] = redactBody(req.Header.Get("Content-Type"), payload)
+			}
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Veeam API request failed", fields)
+		return resp, err
+	}
+	fields["status_code"] = resp.StatusCode
+
+	if traceBodies && resp.Body != nil {
+		payload, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(payload))
+		if readErr != nil {
+			return nil, readErr
+		}
+		if len(payload) > 0 {
+			fields["response_body"] = redactBody(resp.Header.Get("Content-Type"), payload)
+		}
+		tflog.Trace(ctx, "Veeam API request", fields)
+		return resp, nil
+	}
+
+	tflog.Debug(ctx, "Veeam API request", fields)
+	return resp, nil
+}
+
+// logBodies reports whether the provider log level is TRACE. Bodies are only buffered and logged
+// then, since responses such as restore point listings can be large.
+func logBodies() bool {
+	level := os.Getenv("TF_LOG_PROVIDER")
+	if level == "" {
+		level = os.Getenv("TF_LOG")
+	}
+	return strings.EqualFold(strings.TrimSpace(level), "TRACE")
+}
+
+// redactBody returns a body for logging with the values of credential fields replaced.
+// JSON and form-encoded bodies are redacted field by field; other bodies are logged as-is.
+func redactBody(contentType string, body []byte) string {
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err == nil {
+			for key := range values {
+				if isSensitiveKey(key) {
+					values[key] = []string{redactedValue}
+				}
+			}
+			return values.Encode()
+		}
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err == nil {
+		if redacted, err := json.Marshal(redactJSON(doc)); err == nil {
+			return string(redacted)
+		}
+	}
+
+	return string(body)
+}
+
+// redactJSON walks a decoded JSON document and replaces the values of sensitive keys
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveKey(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactJSON(value)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	if sensitiveKeys[normalized] {
+		return true
+	}
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+	return false
+}
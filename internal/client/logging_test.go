@@ -0,0 +1,73 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name:        "form",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "grant_type=Password&username=svc&password=hunter2",
+			want:        "grant_type=Password&password=%2A%2A%2A&username=svc",
+		},
+		{
+			name:        "nested json",
+			contentType: "application/json",
+			body:        `{"name":"repo","credentials":{"userName":"svc","password":"hunter2"},"keys":[{"secretKey":"abc","accessKeyId":"id"}]}`,
+			want:        `{"credentials":{"password":"***","userName":"svc"},"keys":[{"accessKeyId":"***","secretKey":"***"}],"name":"repo"}`,
+		},
+		{
+			name:        "token response",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"access_token":"a","refresh_token":"r","expires_in":900}`,
+			want:        `{"access_token":"***","expires_in":900,"refresh_token":"***"}`,
+		},
+		{
+			name:        "plain text",
+			contentType: "text/plain",
+			body:        "Bad Request",
+			want:        "Bad Request",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := redactBody(tc.contentType, []byte(tc.body)); got != tc.want {
+				t.Errorf("redactBody() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLoggingTransportPreservesBodies(t *testing.T) {
+	t.Setenv("TF_LOG", "TRACE")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newLoggingTransport(http.DefaultTransport)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"password":"hunter2"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"password":"hunter2"}` {
+		t.Errorf("response body = %s, want the unredacted echo", body)
+	}
+}
@@ -0,0 +1,36 @@
+package client
+
+import "sync"
+
+// MutexKV is a set of mutexes keyed by string. It serializes the modifications of a single remote
+// object, such as a VBR job, while other objects are still modified in parallel. The zero value
+// is ready to use.
+type MutexKV struct {
+	mu    sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+// Lock locks the mutex for key, waiting until it is available
+func (m *MutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+// Unlock unlocks the mutex for key
+func (m *MutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+// get returns the mutex for key, creating it on first use
+func (m *MutexKV) get(key string) *sync.Mutex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.store == nil {
+		m.store = map[string]*sync.Mutex{}
+	}
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultPageSize is the number of items requested per page when a list is fetched in full
+const DefaultPageSize = 100
+
+// maxPages guards against endpoints that keep returning full pages without advancing
+const maxPages = 10000
+
+// Page is one page of a paged list endpoint, or the concatenation of several pages
+type Page[T any] struct {
+	Items []T
+	Total int // Total number of items available, or -1 when the endpoint does not report it
+}
+
+// PageFetcher requests up to limit items starting at offset
+type PageFetcher[T any] func(ctx context.Context, offset, limit int) (Page[T], error)
+
+// FetchPages returns the single page selected by offset and limit. When limit is 0 the list is
+// fetched in full instead, starting at offset.
+func FetchPages[T any](ctx context.Context, offset, limit int, fetch PageFetcher[T]) (Page[T], error) {
+	if limit > 0 {
+		return fetch(ctx, offset, limit)
+	}
+	return FetchAllPages(ctx, offset, DefaultPageSize, fetch)
+}
+
+// FetchAllPages requests consecutive pages of pageSize items starting at offset and returns
+// their items concatenated. It stops once the reported total is reached, or, when the endpoint
+// does not report a total, at the first page shorter than pageSize.
+func FetchAllPages[T any](ctx context.Context, offset, pageSize int, fetch PageFetcher[T]) (Page[T], error) {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	all := Page[T]{Total: -1}
+	for page := 0; page < maxPages; page++ {
+		if err := ctx.Err(); err != nil {
+			return Page[T]{}, err
+		}
+
+		result, err := fetch(ctx, offset, pageSize)
+		if err != nil {
+			return Page[T]{}, err
+		}
+		all.Items = append(all.Items, result.Items...)
+		all.Total = result.Total
+		offset += len(result.Items)
+
+		if len(result.Items) == 0 {
+			return all, nil
+		}
+		if result.Total >= 0 && offset >= result.Total {
+			return all, nil
+		}
+		if result.Total < 0 && len(result.Items) < pageSize {
+			return all, nil
+		}
+	}
+	return Page[T]{}, fmt.Errorf("stopped paging after %d pages; the endpoint did not report the end of the list", maxPages)
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFetchAllPages(t *testing.T) {
+	all := make([]int, 250)
+	for i := range all {
+		all[i] = i
+	}
+
+	pageOf := func(offset, limit int) []int {
+		if offset >= len(all) {
+			return nil
+		}
+		end := offset + limit
+		if end > len(all) {
+			end = len(all)
+		}
+		return all[offset:end]
+	}
+
+	cases := []struct {
+		name      string
+		offset    int
+		withTotal bool
+		wantLen   int
+		wantCalls int
+	}{
+		{name: "with total", withTotal: true, wantLen: 250, wantCalls: 3},
+		{name: "without total", wantLen: 250, wantCalls: 3},
+		{name: "from offset", offset: 200, withTotal: true, wantLen: 50, wantCalls: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			result, err := FetchAllPages(context.Background(), tc.offset, 100, func(ctx context.Context, offset, limit int) (Page[int], error) {
+				calls++
+				total := -1
+				if tc.withTotal {
+					total = len(all)
+				}
+				return Page[int]{Items: pageOf(offset, limit), Total: total}, nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			items := result.Items
+			if len(items) != tc.wantLen {
+				t.Errorf("got %d items, want %d", len(items), tc.wantLen)
+			}
+			if len(items) > 0 && items[0] != tc.offset {
+				t.Errorf("first item = %d, want %d", items[0], tc.offset)
+			}
+			if calls != tc.wantCalls {
+				t.Errorf("fetched %d pages, want %d", calls, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestFetchPagesWithLimit(t *testing.T) {
+	calls := 0
+	result, err := FetchPages(context.Background(), 10, 5, func(ctx context.Context, offset, limit int) (Page[int], error) {
+		calls++
+		if offset != 10 || limit != 5 {
+			t.Errorf("fetch(%d, %d), want fetch(10, 5)", offset, limit)
+		}
+		return Page[int]{Items: []int{10, 11, 12, 13, 14}, Total: 100}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 1 || len(result.Items) != 5 || result.Total != 100 {
+		t.Errorf("got %d calls, %d items, total %d; want a single page of 5 with total 100", calls, len(result.Items), result.Total)
+	}
+}
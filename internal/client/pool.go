@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultMaxConcurrentRequests is the number of requests a fan-out operation sends at the same time
+// when the provider does not override it
+const DefaultMaxConcurrentRequests = 8
+
+// maxConcurrentRequests returns the configured request concurrency, or the default when unset
+func (c ClientConfig) maxConcurrentRequests() int {
+	if c.MaxConcurrentRequests <= 0 {
+		return DefaultMaxConcurrentRequests
+	}
+	return c.MaxConcurrentRequests
+}
+
+// requestPool bounds the number of requests a service client sends at the same time for fan-out
+// operations, such as reading or modifying many objects for a single resource
+type requestPool struct {
+	size int
+}
+
+// RunConcurrently calls fn for every index from 0 to n-1, with at most the provider's
+// max_concurrent_requests calls running at the same time. See RunConcurrently.
+func (p requestPool) RunConcurrently(ctx context.Context, n int, fn func(ctx context.Context, i int) error) []error {
+	return RunConcurrently(ctx, p.size, n, fn)
+}
+
+// RunConcurrently calls fn for every index from 0 to n-1, with at most limit calls running at the
+// same time, and returns once all calls have returned. The error of each call is returned at its
+// index, so that callers can report every failure instead of the first one. Indexes that were not
+// started yet when ctx is done get the context's error.
+func RunConcurrently(ctx context.Context, limit, n int, fn func(ctx context.Context, i int) error) []error {
+	errs := make([]error, n)
+	if limit < 1 {
+		limit = 1
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, limit)
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		case slots <- struct{}{}:
+		}
+		if err := ctx.Err(); err != nil {
+			<-slots
+			errs[i] = err
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			errs[i] = fn(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
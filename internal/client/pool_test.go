@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	var running, peak int32
+	errs := RunConcurrently(context.Background(), 3, 10, func(ctx context.Context, i int) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if i%4 == 0 {
+			return fmt.Errorf("item %d failed", i)
+		}
+		return nil
+	})
+
+	if peak != 3 {
+		t.Errorf("ran %d calls at the same time, want 3", peak)
+	}
+	if len(errs) != 10 {
+		t.Fatalf("got %d errors, want one per item", len(errs))
+	}
+	for i, err := range errs {
+		if failed := i%4 == 0; failed != (err != nil) {
+			t.Errorf("item %d: error = %v", i, err)
+		}
+	}
+}
+
+func TestRunConcurrentlyCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	errs := RunConcurrently(ctx, 1, 5, func(ctx context.Context, i int) error {
+		atomic.AddInt32(&calls, 1)
+		if i == 1 {
+			cancel()
+		}
+		return nil
+	})
+
+	if calls != 2 {
+		t.Errorf("made %d calls after the context was canceled", calls)
+	}
+	if !errors.Is(errs[4], context.Canceled) {
+		t.Errorf("last item error = %v, want context.Canceled", errs[4])
+	}
+}
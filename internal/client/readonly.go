@@ -0,0 +1,41 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ReadOnlyError is returned for requests that would modify a Veeam service while the provider is
+// configured with read_only = true
+type ReadOnlyError struct {
+	Method   string
+	Endpoint string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("refusing %s %s: the provider is configured with read_only = true and only reads from Veeam services", e.Method, e.Endpoint)
+}
+
+// readOnly rejects every API request that could modify a Veeam service, as the last safeguard of
+// the read_only provider mode. Sign-in requests are not affected. It is embedded in every service
+// client.
+type readOnly struct {
+	enabled bool
+}
+
+// IsReadOnly reports whether the provider is configured with read_only = true
+func (r readOnly) IsReadOnly() bool {
+	return r.enabled
+}
+
+// checkWritable returns a ReadOnlyError for any method but GET, HEAD and OPTIONS in read-only mode
+func (r readOnly) checkWritable(method, endpoint string) error {
+	if !r.enabled {
+		return nil
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return nil
+	}
+	return &ReadOnlyError{Method: method, Endpoint: endpoint}
+}
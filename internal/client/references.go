@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// referenceValidation holds the validate_references provider setting, with which resources look
+// up the objects they reference while planning, and the outcome of the lookups made so far, so
+// that an object referenced by many resources is looked up once. It is embedded in the VBR client.
+type referenceValidation struct {
+	enabled bool
+	mu      sync.Mutex
+	exists  map[string]bool // Keyed by API path
+}
+
+// ValidatesReferences reports whether the provider is configured with validate_references = true
+func (r *referenceValidation) ValidatesReferences() bool {
+	return r.enabled
+}
+
+// ReferenceExists reports whether an object exists at any of the given API paths, e.g. a
+// repository that may be a backup repository or a scale-out backup repository. Only a 404 or 410
+// for every path means the object does not exist; any other error is returned.
+func (c *VBRClient) ReferenceExists(ctx context.Context, paths ...string) (bool, error) {
+	for _, path := range paths {
+		c.referenceValidation.mu.Lock()
+		exists, known := c.referenceValidation.exists[path]
+		c.referenceValidation.mu.Unlock()
+
+		if !known {
+			_, err := c.DoRequest(ctx, http.MethodGet, c.BuildAPIURL(path), nil)
+			if err != nil && !IsGone(err) {
+				return false, err
+			}
+			exists = err == nil
+
+			c.referenceValidation.mu.Lock()
+			if c.referenceValidation.exists == nil {
+				c.referenceValidation.exists = map[string]bool{}
+			}
+			c.referenceValidation.exists[path] = exists
+			c.referenceValidation.mu.Unlock()
+		}
+		if exists {
+			return true, nil
+		}
+	}
+	return false, nil
+}
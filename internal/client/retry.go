@@ -0,0 +1,165 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+// RetryConfig controls how transient API failures are retried
+type RetryConfig struct {
+	MaxRetries   int           // Number of retries after the first attempt; 0 disables retries
+	RetryWaitMin time.Duration // Wait before the first retry; doubled for every further retry
+	RetryWaitMax time.Duration // Upper bound for the wait between retries
+
+	retryConflicts bool // Also retry 409 Conflict, returned by VBR while another modification of the same job is running
+}
+
+// DefaultRetryConfig returns the retry settings used when the provider does not override them
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries:   defaultMaxRetries,
+		RetryWaitMin: defaultRetryWaitMin,
+		RetryWaitMax: defaultRetryWaitMax,
+	}
+}
+
+// normalize fills unset wait bounds with defaults and keeps min <= max
+func (r RetryConfig) normalize() RetryConfig {
+	if r.MaxRetries < 0 {
+		r.MaxRetries = 0
+	}
+	if r.RetryWaitMin <= 0 {
+		r.RetryWaitMin = defaultRetryWaitMin
+	}
+	if r.RetryWaitMax <= 0 {
+		r.RetryWaitMax = defaultRetryWaitMax
+	}
+	if r.RetryWaitMax < r.RetryWaitMin {
+		r.RetryWaitMax = r.RetryWaitMin
+	}
+	return r
+}
+
+// backoff returns the wait before the given retry attempt (starting at 1)
+func (r RetryConfig) backoff(attempt int) time.Duration {
+	wait := r.RetryWaitMin
+	for i := 1; i < attempt; i++ {
+		wait *= 2
+		if wait >= r.RetryWaitMax {
+			return r.RetryWaitMax
+		}
+	}
+	if wait > r.RetryWaitMax {
+		return r.RetryWaitMax
+	}
+	return wait
+}
+
+// isRetryableStatus reports whether a response status indicates a transient appliance failure
+// after which the request can be sent again. Only throttling and unavailability, which the
+// appliance answers without processing the request, are retried for non-idempotent methods,
+// since a request that timed out behind a gateway may still have been carried out.
+func (r RetryConfig) isRetryableStatus(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	case http.StatusConflict:
+		return r.retryConflicts && isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+// isIdempotent reports whether sending a request with the given method twice has the same effect
+// as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// doWithRetry sends the request built by newRequest, retrying transient failures with exponential backoff.
+// A Retry-After header on a throttled response takes precedence over the computed backoff, unless it
+// asks for a longer wait than RetryWaitMax, which is returned as an error instead.
+// newRequest is called once per attempt with a fresh copy of the request body.
+// Network errors are only retried for non-idempotent methods when no connection was established,
+// so that the request cannot have reached the appliance.
+func doWithRetry(ctx context.Context, httpClient *http.Client, retry RetryConfig, limiter *rateLimiter, body io.Reader, newRequest func(body io.Reader) (*http.Request, error)) (*http.Response, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(payload)
+		}
+
+		req, err := newRequest(reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		var connected atomic.Bool
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), &httptrace.ClientTrace{
+			GotConn: func(httptrace.GotConnInfo) { connected.Store(true) },
+		}))
+
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := httpClient.Do(req)
+		if attempt >= retry.MaxRetries {
+			return resp, err
+		}
+		if err == nil && !retry.isRetryableStatus(req.Method, resp.StatusCode) {
+			return resp, nil
+		}
+		if err != nil && (ctx.Err() != nil || (connected.Load() && !isIdempotent(req.Method))) {
+			return nil, err
+		}
+
+		wait := retry.backoff(attempt + 1)
+		if resp != nil {
+			retryAfter := parseRetryAfter(resp)
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			if retryAfter > retry.RetryWaitMax {
+				return nil, fmt.Errorf("%s %s failed with status %d and the appliance asked to retry after %s, longer than the maximum wait between retries of %s",
+					req.Method, req.URL.Path, resp.StatusCode, retryAfter.Round(time.Second), retry.RetryWaitMax)
+			}
+			if retryAfter > 0 {
+				wait = retryAfter
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryConfigBackoff(t *testing.T) {
+	retry := RetryConfig{MaxRetries: 5, RetryWaitMin: time.Second, RetryWaitMax: 5 * time.Second}
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, want := range expected {
+		if got := retry.backoff(i + 1); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", i+1, got, want)
+		}
+	}
+}
+
+func TestDoWithRetry(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("attempt %d: body = %q, want %q", attempts, string(body), "payload")
+		}
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	retry := RetryConfig{MaxRetries: 3, RetryWaitMin: time.Millisecond, RetryWaitMax: time.Millisecond}
+	resp, err := doWithRetry(context.Background(), server.Client(), retry, nil, strings.NewReader("payload"), func(body io.Reader) (*http.Request, error) {
+		return http.NewRequest(http.MethodPost, server.URL, body)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestDoWithRetryGivesUp(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	retry := RetryConfig{MaxRetries: 2, RetryWaitMin: time.Millisecond, RetryWaitMax: time.Millisecond}
+	resp, err := doWithRetry(context.Background(), server.Client(), retry, nil, nil, func(body io.Reader) (*http.Request, error) {
+		return http.NewRequest(http.MethodGet, server.URL, body)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestDoWithRetryConflicts(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for _, c := range []struct {
+		retryConflicts bool
+		want           int
+	}{
+		{false, http.StatusConflict},
+		{true, http.StatusOK},
+	} {
+		attempts = 0
+		retry := RetryConfig{MaxRetries: 2, RetryWaitMin: time.Millisecond, RetryWaitMax: time.Millisecond, retryConflicts: c.retryConflicts}
+		resp, err := doWithRetry(context.Background(), server.Client(), retry, nil, nil, func(body io.Reader) (*http.Request, error) {
+			return http.NewRequest(http.MethodPut, server.URL, body)
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != c.want {
+			t.Errorf("retryConflicts = %t: status = %d, want %d", c.retryConflicts, resp.StatusCode, c.want)
+		}
+	}
+}
+
+func TestDoWithRetryNonIdempotent(t *testing.T) {
+	cases := []struct {
+		method string
+		status int
+		want   int
+	}{
+		{http.MethodGet, http.StatusBadGateway, 2},
+		{http.MethodPost, http.StatusBadGateway, 1},
+		{http.MethodPost, http.StatusGatewayTimeout, 1},
+		{http.MethodPost, http.StatusServiceUnavailable, 2},
+		{http.MethodPost, http.StatusTooManyRequests, 2},
+	}
+	for _, c := range cases {
+		var attempts int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 2 {
+				w.WriteHeader(c.status)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		retry := RetryConfig{MaxRetries: 2, RetryWaitMin: time.Millisecond, RetryWaitMax: time.Millisecond}
+		resp, err := doWithRetry(context.Background(), server.Client(), retry, nil, nil, func(body io.Reader) (*http.Request, error) {
+			return http.NewRequest(c.method, server.URL, body)
+		})
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s after %d: unexpected error: %s", c.method, c.status, err)
+		}
+		resp.Body.Close()
+
+		if attempts != c.want {
+			t.Errorf("%s after %d: attempts = %d, want %d", c.method, c.status, attempts, c.want)
+		}
+	}
+}
+
+func TestDoWithRetryNetworkErrors(t *testing.T) {
+	// A connection dropped after the request was sent may have been carried out
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatalf("hijacking connection: %s", err)
+		}
+		conn.Close()
+	}))
+	defer server.Close()
+
+	retry := RetryConfig{MaxRetries: 2, RetryWaitMin: time.Millisecond, RetryWaitMax: time.Millisecond}
+	for _, c := range []struct {
+		method string
+		want   int
+	}{
+		{http.MethodGet, 3},
+		{http.MethodPost, 1},
+	} {
+		attempts = 0
+		_, err := doWithRetry(context.Background(), server.Client(), retry, nil, nil, func(body io.Reader) (*http.Request, error) {
+			return http.NewRequest(c.method, server.URL, body)
+		})
+		if err == nil {
+			t.Fatalf("%s: expected an error", c.method)
+		}
+		if attempts != c.want {
+			t.Errorf("%s: attempts = %d, want %d", c.method, attempts, c.want)
+		}
+	}
+
+	// A request that never got a connection did not reach the appliance
+	var dials int
+	refused := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			dials++
+			return nil, errors.New("connection refused")
+		},
+	}}
+	_, err := doWithRetry(context.Background(), refused, retry, nil, nil, func(body io.Reader) (*http.Request, error) {
+		return http.NewRequest(http.MethodPost, "http://appliance.invalid", body)
+	})
+	if err == nil {
+		t.Fatal("POST without connection: expected an error")
+	}
+	if dials != 3 {
+		t.Errorf("POST without connection: dials = %d, want 3", dials)
+	}
+}
+
+func TestDoWithRetryAfter(t *testing.T) {
+	for _, c := range []struct {
+		retryAfter string
+		wantErr    bool
+		want       int
+	}{
+		{"1", false, 2},
+		{"3600", true, 1},
+	} {
+		var attempts int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts < 2 {
+				w.Header().Set("Retry-After", c.retryAfter)
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		retry := RetryConfig{MaxRetries: 2, RetryWaitMin: time.Millisecond, RetryWaitMax: time.Second}
+		resp, err := doWithRetry(context.Background(), server.Client(), retry, nil, nil, func(body io.Reader) (*http.Request, error) {
+			return http.NewRequest(http.MethodGet, server.URL, body)
+		})
+		server.Close()
+		if c.wantErr {
+			if err == nil {
+				resp.Body.Close()
+				t.Errorf("Retry-After %s: expected an error", c.retryAfter)
+			}
+		} else if err != nil {
+			t.Errorf("Retry-After %s: unexpected error: %s", c.retryAfter, err)
+		} else {
+			resp.Body.Close()
+		}
+		if attempts != c.want {
+			t.Errorf("Retry-After %s: attempts = %d, want %d", c.retryAfter, attempts, c.want)
+		}
+	}
+}
+
+func TestMutexKVSerializesKey(t *testing.T) {
+	var locks MutexKV
+	locks.Lock("job")
+
+	acquired := make(chan struct{})
+	go func() {
+		locks.Lock("job")
+		close(acquired)
+		locks.Unlock("job")
+	}()
+
+	// Other keys are not blocked
+	locks.Lock("other")
+	locks.Unlock("other")
+
+	select {
+	case <-acquired:
+		t.Fatal("second Lock acquired a held key")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	locks.Unlock("job")
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("second Lock was not released by Unlock")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	cases := map[string]time.Duration{
+		"":        0,
+		"5":       5 * time.Second,
+		"-1":      0,
+		"invalid": 0,
+	}
+	for header, want := range cases {
+		resp := &http.Response{Header: http.Header{}}
+		if header != "" {
+			resp.Header.Set("Retry-After", header)
+		}
+		if got := parseRetryAfter(resp); got != want {
+			t.Errorf("parseRetryAfter(%q) = %s, want %s", header, got, want)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if got := parseRetryAfter(resp); got <= 0 || got > time.Minute {
+		t.Errorf("parseRetryAfter(http-date) = %s, want within (0, 1m]", got)
+	}
+}
+
+func TestRateLimiterSpacesRequests(t *testing.T) {
+	limiter := newRateLimiter(100)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("5 requests at 100/s took %s, want at least 40ms", elapsed)
+	}
+
+	if newRateLimiter(0) != nil {
+		t.Errorf("newRateLimiter(0) should disable limiting")
+	}
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RenameAttributeUpgrader returns a state upgrader from the given schema version, in which the
+// attribute to was still called from. block is the dotted path of nested blocks holding the
+// attribute, or empty for a top-level attribute. The prior state type is derived from the current
+// schema of r, so it must only differ from version by this rename.
+func RenameAttributeUpgrader(r *schema.Resource, version int, block, from, to string) schema.StateUpgrader {
+	var path []string
+	if block != "" {
+		path = strings.Split(block, ".")
+	}
+
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    renameAttributeType(r.CoreConfigSchema().ImpliedType(), path, to, from),
+		Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			renameStateAttribute(rawState, path, from, to)
+			return rawState, nil
+		},
+	}
+}
+
+// renameAttributeType returns ty with the attribute from, found under the nested blocks in path,
+// renamed to to
+func renameAttributeType(ty cty.Type, path []string, from, to string) cty.Type {
+	switch {
+	case ty.IsListType():
+		return cty.List(renameAttributeType(ty.ElementType(), path, from, to))
+	case ty.IsSetType():
+		return cty.Set(renameAttributeType(ty.ElementType(), path, from, to))
+	case !ty.IsObjectType():
+		return ty
+	}
+
+	attrs := make(map[string]cty.Type, len(ty.AttributeTypes()))
+	for name, attrType := range ty.AttributeTypes() {
+		attrs[name] = attrType
+	}
+	if len(path) > 0 {
+		if attrType, ok := attrs[path[0]]; ok {
+			attrs[path[0]] = renameAttributeType(attrType, path[1:], from, to)
+		}
+	} else if attrType, ok := attrs[from]; ok {
+		delete(attrs, from)
+		attrs[to] = attrType
+	}
+	return cty.Object(attrs)
+}
+
+// renameStateAttribute moves the value of from to to in every block found under path in the JSON
+// state. A non-empty value of to is kept.
+func renameStateAttribute(state map[string]interface{}, path []string, from, to string) {
+	if len(path) == 0 {
+		if v, ok := state[from]; ok {
+			if isEmptyStateValue(state[to]) {
+				state[to] = v
+			}
+			delete(state, from)
+		}
+		return
+	}
+
+	blocks, _ := state[path[0]].([]interface{})
+	for _, block := range blocks {
+		if m, ok := block.(map[string]interface{}); ok {
+			renameStateAttribute(m, path[1:], from, to)
+		}
+	}
+}
+
+func isEmptyStateValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testUpgradeResource() *schema.Resource {
+	return &schema.Resource{
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+			"items": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subscription": {Type: schema.TypeString, Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRenameAttributeUpgrader(t *testing.T) {
+	upgrader := RenameAttributeUpgrader(testUpgradeResource(), 0, "items", "subsciption", "subscription")
+
+	items := upgrader.Type.AttributeType("items").ElementType()
+	if !items.HasAttribute("subsciption") || items.HasAttribute("subscription") {
+		t.Errorf("prior state type = %#v, want items to have subsciption only", items)
+	}
+	if upgrader.Type.AttributeType("name") != cty.String {
+		t.Errorf("unrelated attribute type changed: %#v", upgrader.Type)
+	}
+
+	state := map[string]interface{}{
+		"name": "policy",
+		"items": []interface{}{
+			map[string]interface{}{"subsciption": "a"},
+			map[string]interface{}{"subsciption": "b", "subscription": "c"},
+			map[string]interface{}{"subsciption": "d", "subscription": ""},
+		},
+	}
+	got, err := upgrader.Upgrade(context.Background(), state, nil)
+	if err != nil {
+		t.Fatalf("Upgrade: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"name": "policy",
+		"items": []interface{}{
+			map[string]interface{}{"subscription": "a"},
+			map[string]interface{}{"subscription": "c"},
+			map[string]interface{}{"subscription": "d"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Upgrade:\n got %#v\nwant %#v", got, want)
+	}
+}
+
+func TestRenameAttributeUpgraderTopLevel(t *testing.T) {
+	upgrader := RenameAttributeUpgrader(testUpgradeResource(), 0, "", "nmae", "name")
+
+	got, err := upgrader.Upgrade(context.Background(), map[string]interface{}{"nmae": "policy"}, nil)
+	if err != nil {
+		t.Fatalf("Upgrade: %s", err)
+	}
+	if want := map[string]interface{}{"name": "policy"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Upgrade = %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// tokenRenewalWindow is how long before expiry an access token is proactively renewed,
+// so that requests issued late in a long apply do not race the expiry
+const tokenRenewalWindow = 5 * time.Minute
+
+// expiresAt returns when the access token expires. The absolute ".expires" field is preferred;
+// when the appliance only reports "expires_in", the expiry is computed from the current time.
+func (t TokenResponse) expiresAt() time.Time {
+	if !t.Expires.IsZero() {
+		return t.Expires
+	}
+	if t.ExpiresIn > 0 {
+		return time.Now().Add(time.Duration(t.ExpiresIn) * time.Second)
+	}
+	return time.Time{}
+}
+
+// tokenIsFresh reports whether an access token can be used without renewing it first
+func tokenIsFresh(accessToken string, expiry time.Time) bool {
+	return accessToken != "" && time.Now().Add(tokenRenewalWindow).Before(expiry)
+}
+
+// tokenProvider is implemented by the service clients, which cache their tokens behind a mutex
+type tokenProvider interface {
+	// token returns a valid access token, refreshing or re-authenticating when needed; the
+	// renewal requests are bound to ctx
+	token(ctx context.Context) (string, error)
+	// invalidateToken discards the cached access token if it is still the given one
+	invalidateToken(accessToken string)
+}
+
+// doAuthenticated sends the request built by newRequest with a bearer token or session ID from tokens.
+// A 401 response means the token expired or was revoked before its reported expiry, so the
+// token is invalidated and the request is sent once more with a renewed token.
+func doAuthenticated(ctx context.Context, httpClient *http.Client, retry RetryConfig, limiter *rateLimiter, tokens tokenProvider, body io.Reader, newRequest func(token string, body io.Reader) (*http.Request, error)) (*http.Response, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		token, err := tokens.token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get valid token: %w", err)
+		}
+
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(payload)
+		}
+
+		resp, err := doWithRetry(ctx, httpClient, retry, limiter, reqBody, func(reqBody io.Reader) (*http.Request, error) {
+			return newRequest(token, reqBody)
+		})
+		if err != nil || resp.StatusCode != http.StatusUnauthorized || attempt > 0 {
+			return resp, err
+		}
+
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		tokens.invalidateToken(token)
+	}
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenResponseExpiresAt(t *testing.T) {
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := (TokenResponse{Expires: expires, ExpiresIn: 60}).expiresAt(); !got.Equal(expires) {
+		t.Errorf("expiresAt() = %s, want %s", got, expires)
+	}
+
+	got := TokenResponse{ExpiresIn: 3600}.expiresAt()
+	if until := time.Until(got); until <= 59*time.Minute || until > time.Hour {
+		t.Errorf("expiresAt() from expires_in is %s away, want about 1h", until)
+	}
+
+	if got := (TokenResponse{}).expiresAt(); !got.IsZero() {
+		t.Errorf("expiresAt() = %s, want zero time", got)
+	}
+}
+
+func TestMakeAuthenticatedRequestRenewsRejectedToken(t *testing.T) {
+	var tokensIssued int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/oauth2/token" {
+			n := atomic.AddInt32(&tokensIssued, 1)
+			fmt.Fprintf(w, `{"access_token": "token-%d", "refresh_token": "refresh-%d", "expires_in": 3600}`, n, n)
+			return
+		}
+		// The first token is revoked server-side before its reported expiry
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &AzureBackupClient{
+		hostname:   server.URL,
+		apiVersion: "8.1",
+		httpClient: server.Client(),
+	}
+	if err := client.Authenticate(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.MakeAuthenticatedRequest(http.MethodGet, client.BuildAPIURL("/policies"), nil)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if issued := atomic.LoadInt32(&tokensIssued); issued != 2 {
+		t.Errorf("tokens issued = %d, want 2 (initial login and one renewal)", issued)
+	}
+}
+
+func TestTokenRenewalHonorsContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The appliance hangs on token requests
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := &AzureBackupClient{
+		hostname:     server.URL,
+		apiVersion:   "8.1",
+		httpClient:   server.Client(),
+		accessToken:  "expired",
+		refreshToken: "refresh",
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := client.MakeAuthenticatedRequestWithContext(ctx, http.MethodGet, client.BuildAPIURL("/policies"), nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request returned after %s, want it aborted with the context", elapsed)
+	}
+	if client.refreshToken != "refresh" {
+		t.Errorf("refresh token = %q, want it kept when the refresh is cancelled", client.refreshToken)
+	}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TLSConfig holds the TLS settings used to reach a Veeam appliance
+type TLSConfig struct {
+	InsecureSkipVerify bool   // Skip SSL certificate verification
+	CACertPEM          string // PEM-encoded CA bundle trusted in addition to the system roots
+	ClientCertPEM      string // PEM-encoded client certificate for mutual TLS
+	ClientKeyPEM       string // PEM-encoded private key of the client certificate
+}
+
+// minIdleConnsPerHost keeps enough idle connections for the resources Terraform applies at the
+// same time by default (-parallelism=10)
+const minIdleConnsPerHost = 10
+
+// newTransport builds the HTTP transport shared by the service clients from the given TLS settings.
+// Requests go through proxyURL when it is set; otherwise the HTTPS_PROXY, HTTP_PROXY and NO_PROXY
+// environment variables are honored. Connections are kept alive and reused; the transport keeps
+// enough of them idle for maxConcurrentRequests requests of a fan-out operation, on top of the
+// resources Terraform applies in parallel, so that they are not reopened for every request.
+func newTransport(config TLSConfig, proxyURL string, maxConcurrentRequests int) (*http.Transport, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+			return nil, fmt.Errorf("failed to parse ca_cert_pem: no PEM-encoded certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertPEM != "" || config.ClientKeyPEM != "" {
+		if config.ClientCertPEM == "" || config.ClientKeyPEM == "" {
+			return nil, fmt.Errorf("client_cert_pem and client_key_pem must be set together")
+		}
+		cert, err := tls.X509KeyPair([]byte(config.ClientCertPEM), []byte(config.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = http.ProxyFromEnvironment
+	transport.MaxIdleConnsPerHost = minIdleConnsPerHost + maxConcurrentRequests
+	if transport.MaxIdleConns < transport.MaxIdleConnsPerHost {
+		transport.MaxIdleConns = transport.MaxIdleConnsPerHost
+	}
+
+	if proxyURL != "" {
+		proxy, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy_url: %w", err)
+		}
+		switch proxy.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("unsupported proxy_url scheme %q: must be http, https or socks5", proxy.Scheme)
+		}
+		if proxy.Host == "" {
+			return nil, fmt.Errorf("proxy_url %q has no host", proxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	return transport, nil
+}
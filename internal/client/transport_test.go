@@ -0,0 +1,83 @@
+package client
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewTransportTrustsCACertPEM(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	transport, err := newTransport(TLSConfig{CACertPEM: string(caCertPEM)}, "", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("request with ca_cert_pem failed: %s", err)
+	}
+	resp.Body.Close()
+
+	transport, err = newTransport(TLSConfig{}, "", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := (&http.Client{Transport: transport}).Get(server.URL); err == nil {
+		t.Errorf("expected certificate verification to fail without ca_cert_pem")
+	}
+}
+
+func TestNewTransportRejectsInvalidConfig(t *testing.T) {
+	cases := map[string]TLSConfig{
+		"invalid CA":       {CACertPEM: "not a certificate"},
+		"cert without key": {ClientCertPEM: "cert"},
+		"key without cert": {ClientKeyPEM: "key"},
+		"invalid key pair": {ClientCertPEM: "cert", ClientKeyPEM: "key"},
+	}
+	for name, config := range cases {
+		if _, err := newTransport(config, "", 0); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestNewTransportProxyURL(t *testing.T) {
+	transport, err := newTransport(TLSConfig{}, "http://proxy.example.com:3128", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://vbr.example.com:9419/api/v1/jobs", nil)
+	proxy, err := transport.Proxy(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if proxy == nil || proxy.Host != "proxy.example.com:3128" {
+		t.Errorf("proxy = %v, want proxy.example.com:3128", proxy)
+	}
+
+	for _, proxyURL := range []string{"ftp://proxy.example.com", "http://", "://bad"} {
+		if _, err := newTransport(TLSConfig{}, proxyURL, 0); err == nil {
+			t.Errorf("%q: expected an error", proxyURL)
+		}
+	}
+}
+
+func TestNewTransportKeepsConnectionsForConcurrentRequests(t *testing.T) {
+	transport, err := newTransport(TLSConfig{}, "", 32)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if transport.DisableKeepAlives {
+		t.Error("keep-alives are disabled")
+	}
+	if transport.MaxIdleConnsPerHost < 32 {
+		t.Errorf("MaxIdleConnsPerHost = %d, want at least the 32 concurrent requests", transport.MaxIdleConnsPerHost)
+	}
+}
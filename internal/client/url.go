@@ -0,0 +1,33 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Endpoint formats an API endpoint like fmt.Sprintf, escaping every argument as a path segment so
+// that IDs and names cannot change the path they are put in, e.g.
+// Endpoint("/api/v1/jobs/%s/start", jobID). The result is passed to the BuildAPIURL method of a
+// client, optionally through WithQuery.
+func Endpoint(format string, segments ...string) string {
+	args := make([]interface{}, len(segments))
+	for i, segment := range segments {
+		args[i] = url.PathEscape(segment)
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// WithQuery appends the encoded query parameters, e.g. filters and paging, to endpoint. The
+// endpoint is returned unchanged when query is empty.
+func WithQuery(endpoint string, query url.Values) string {
+	encoded := query.Encode()
+	if encoded == "" {
+		return endpoint
+	}
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	return endpoint + separator + encoded
+}
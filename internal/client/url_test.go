@@ -0,0 +1,52 @@
+package client
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		segments []string
+		want     string
+	}{
+		{"no segments", "/api/v1/jobs", nil, "/api/v1/jobs"},
+		{"ID", "/api/v1/jobs/%s", []string{"0e4a1c62-5e1a-4a2b-9a41-1b0d8b6f0a11"}, "/api/v1/jobs/0e4a1c62-5e1a-4a2b-9a41-1b0d8b6f0a11"},
+		{"several segments", "/restorePoints/virtualMachines/%s/%s/", []string{"rp1", "restoreToOriginal"}, "/restorePoints/virtualMachines/rp1/restoreToOriginal/"},
+		{"slashes", "/api/v1/jobs/%s/start", []string{"../../credentials"}, "/api/v1/jobs/..%2F..%2Fcredentials/start"},
+		{"query characters", "/api/v1/jobs/%s", []string{"job?name=x#y"}, "/api/v1/jobs/job%3Fname=x%23y"},
+		{"spaces", "/api/v1/repositories/%s", []string{"Default Backup Repository"}, "/api/v1/repositories/Default%20Backup%20Repository"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Endpoint(tt.format, tt.segments...); got != tt.want {
+				t.Errorf("Endpoint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		query    url.Values
+		want     string
+	}{
+		{"no query", "/api/v1/jobs", nil, "/api/v1/jobs"},
+		{"empty query", "/api/v1/jobs", url.Values{}, "/api/v1/jobs"},
+		{"paging", "/api/v1/jobs", url.Values{"skip": {"200"}, "limit": {"100"}}, "/api/v1/jobs?limit=100&skip=200"},
+		{"filter", "/virtualMachines", url.Values{"SearchPattern": {"web & db*"}}, "/virtualMachines?SearchPattern=web+%26+db%2A"},
+		{"existing query", "/api/v1/jobs?typeFilter=Backup", url.Values{"limit": {"100"}}, "/api/v1/jobs?typeFilter=Backup&limit=100"},
+		{"URL", "https://vbr:9419/api/v1/jobs", url.Values{"limit": {"100"}}, "https://vbr:9419/api/v1/jobs?limit=100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WithQuery(tt.endpoint, tt.query); got != tt.want {
+				t.Errorf("WithQuery() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	"fmt"
+	"time"
+)
+
+// ValidateDuration is a schema ValidateFunc that checks that a value is a Go duration such as 24h
+func ValidateDuration(v interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as 24h or 90m: %w", k, err)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,220 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// VB365Client handles Veeam Backup for Microsoft 365 REST API. The API version is part of the
+// request path rather than a header.
+type VB365Client struct {
+	hostname     string
+	username     string
+	password     string
+	apiVersion   string
+	accessToken  string
+	refreshToken string
+	tokenExpiry  time.Time
+	httpClient   *http.Client
+	retry        RetryConfig
+	limiter      *rateLimiter
+	tokenMu      sync.Mutex // Guards accessToken, refreshToken and tokenExpiry
+	descriptionSuffix
+	requestPool
+	readOnly
+}
+
+// newVB365Client creates a VB365 client from config and, unless SkipSignIn is set, signs in to the backup server
+func newVB365Client(config VB365Config, clientConfig ClientConfig, retry RetryConfig) (*VB365Client, error) {
+	port := config.Port
+	if port == "" {
+		port = "4443" // Default Veeam Backup for Microsoft 365 REST API port
+	}
+	apiVersion := config.APIVersion
+	if apiVersion == "" {
+		apiVersion = "v8" // Default API version
+	}
+
+	transport, err := newTransport(config.TLS, clientConfig.ProxyURL, clientConfig.maxConcurrentRequests())
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure VB365 HTTP transport: %w", err)
+	}
+
+	hostname := strings.TrimSuffix(config.Hostname, "/")
+	hostname = strings.TrimPrefix(hostname, "https://")
+	hostname = strings.TrimPrefix(hostname, "http://")
+
+	c := &VB365Client{
+		hostname:   fmt.Sprintf("%s:%s", hostname, port),
+		username:   config.Username,
+		password:   config.Password,
+		apiVersion: apiVersion,
+		httpClient: &http.Client{
+			Timeout:   10 * time.Minute,
+			Transport: newLoggingTransport(transport),
+		},
+		retry:             retry,
+		limiter:           newRateLimiter(clientConfig.RequestsPerSecond),
+		descriptionSuffix: descriptionSuffix{clientConfig.DescriptionSuffix},
+		requestPool:       requestPool{clientConfig.maxConcurrentRequests()},
+		readOnly:          readOnly{clientConfig.ReadOnly},
+	}
+
+	if !clientConfig.SkipSignIn {
+		if err := c.AuthenticateVB365(); err != nil {
+			return nil, &EndpointError{Service: "Veeam Backup for Microsoft 365", URL: "https://" + c.hostname, Err: err}
+		}
+	}
+	return c, nil
+}
+
+// AuthenticateVB365 performs the initial authentication with the Veeam Backup for Microsoft 365 REST API
+func (c *VB365Client) AuthenticateVB365() error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.requestTokenVB365(context.Background(), url.Values{
+		"grant_type": {"password"},
+		"username":   {c.username},
+		"password":   {c.password},
+	})
+}
+
+// requestTokenVB365 obtains a new token pair with the given grant; the caller must hold tokenMu
+func (c *VB365Client) requestTokenVB365(ctx context.Context, formData url.Values) error {
+	tokenURL := fmt.Sprintf("https://%s/%s/Token", c.hostname, c.apiVersion)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(formData.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create VB365 token request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("VB365 token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read VB365 token response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("VB365 %s grant failed: %w", formData.Get("grant_type"), NewVeeamAPIError(resp.StatusCode, body))
+	}
+
+	var tokenResp TokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return fmt.Errorf("failed to parse VB365 token response: %w", err)
+	}
+
+	c.accessToken = tokenResp.AccessToken
+	c.refreshToken = tokenResp.RefreshToken
+	c.tokenExpiry = tokenResp.expiresAt()
+
+	return nil
+}
+
+// GetValidTokenVB365 returns a valid VB365 access token, refreshing it shortly before expiry.
+// Concurrent callers share a single renewal; if the refresh token is rejected, the client re-authenticates.
+func (c *VB365Client) GetValidTokenVB365() (string, error) {
+	return c.token(context.Background())
+}
+
+// token implements GetValidTokenVB365, bound to ctx
+func (c *VB365Client) token(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if tokenIsFresh(c.accessToken, c.tokenExpiry) {
+		return c.accessToken, nil
+	}
+
+	if c.refreshToken != "" {
+		err := c.requestTokenVB365(ctx, url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {c.refreshToken},
+		})
+		if err == nil {
+			return c.accessToken, nil
+		}
+		if ctx.Err() != nil {
+			return "", err
+		}
+		c.refreshToken = ""
+	}
+
+	err := c.requestTokenVB365(ctx, url.Values{
+		"grant_type": {"password"},
+		"username":   {c.username},
+		"password":   {c.password},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return c.accessToken, nil
+}
+
+func (c *VB365Client) invalidateToken(accessToken string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	if c.accessToken == accessToken {
+		c.tokenExpiry = time.Time{}
+	}
+}
+
+// BuildAPIURL constructs an API URL for the VB365 client
+func (c *VB365Client) BuildAPIURL(endpoint string) string {
+	return fmt.Sprintf("https://%s/%s%s", c.hostname, c.apiVersion, endpoint)
+}
+
+// DoRequest performs an authenticated HTTP request for the VB365 client
+func (c *VB365Client) DoRequest(ctx context.Context, method, endpoint string, body []byte) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = strings.NewReader(string(body))
+	}
+
+	if err := c.checkWritable(method, endpoint); err != nil {
+		return nil, err
+	}
+	resp, err := doAuthenticated(ctx, c.httpClient, c.retry, c.limiter, c, reqBody, func(token string, reqBody io.Reader) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return respBody, NewVeeamAPIError(resp.StatusCode, respBody)
+	}
+
+	return respBody, nil
+}
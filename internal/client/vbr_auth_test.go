@@ -0,0 +1,77 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newVBRTokenServer(t *testing.T) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse form: %s", err)
+		}
+		switch r.PostForm.Get("grant_type") {
+		case "Password":
+			fmt.Fprint(w, `{"mfa_enabled": true, "mfa_token": "mfa-token"}`)
+		case "Mfa":
+			if r.PostForm.Get("mfa_token") != "mfa-token" || r.PostForm.Get("mfa_code") != "123456" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"access_token": "mfa-access", "refresh_token": "refresh", "expires_in": 900}`)
+		case "Authorization_code":
+			fmt.Fprintf(w, `{"access_token": "code-%s", "refresh_token": "refresh", "expires_in": 900}`, r.PostForm.Get("code"))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+func TestAuthenticateVBRWithMFA(t *testing.T) {
+	server := newVBRTokenServer(t)
+	defer server.Close()
+
+	client := &VBRClient{
+		hostname:   strings.TrimPrefix(server.URL, "https://"),
+		username:   "svc-terraform",
+		password:   "secret",
+		mfaCode:    "123456",
+		httpClient: server.Client(),
+	}
+	if err := client.AuthenticateVBR("1.3-rev1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if client.accessToken != "mfa-access" {
+		t.Errorf("accessToken = %q, want %q", client.accessToken, "mfa-access")
+	}
+
+	client.mfaCode = ""
+	if err := client.AuthenticateVBR("1.3-rev1"); err == nil || !strings.Contains(err.Error(), "mfa_code") {
+		t.Errorf("expected an error asking for mfa_code, got %v", err)
+	}
+}
+
+func TestAuthenticateVBRWithAuthorizationCode(t *testing.T) {
+	server := newVBRTokenServer(t)
+	defer server.Close()
+
+	client := &VBRClient{
+		hostname:          strings.TrimPrefix(server.URL, "https://"),
+		authorizationCode: "abc",
+		httpClient:        server.Client(),
+	}
+	if err := client.AuthenticateVBR("1.3-rev1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if client.accessToken != "code-abc" {
+		t.Errorf("accessToken = %q, want %q", client.accessToken, "code-abc")
+	}
+
+	// The code is single-use and no password is configured to sign in again
+	if err := client.AuthenticateVBR("1.3-rev1"); err == nil {
+		t.Errorf("expected an error when signing in again without credentials")
+	}
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// vbrBootstrapAPIVersion is sent while signing in and querying /api/v1/serverInfo, before the
+// appliance's own API revision is known. Every supported VBR release accepts it.
+const vbrBootstrapAPIVersion = "1.1-rev0"
+
+// vbrAPIVersions maps the first VBR build of each release to the REST API revision it introduced, newest first
+var vbrAPIVersions = []struct {
+	build      []int
+	apiVersion string
+}{
+	{[]int{13, 0, 1}, "1.3-rev1"},
+	{[]int{13, 0}, "1.3-rev0"},
+	{[]int{12, 3}, "1.2-rev1"},
+	{[]int{12, 2}, "1.2-rev0"},
+	{[]int{12, 1, 2}, "1.1-rev2"},
+	{[]int{12, 1}, "1.1-rev1"},
+	{[]int{12, 0}, "1.1-rev0"},
+}
+
+// VBRServerInfo is the subset of GET /api/v1/serverInfo used for version negotiation
+type VBRServerInfo struct {
+	VbrID        string `json:"vbrId"`
+	Name         string `json:"name"`
+	BuildVersion string `json:"buildVersion"`
+	Platform     string `json:"platform"`
+}
+
+// vbrAPIVersionForBuild returns the newest REST API revision supported by the given VBR build (e.g. 12.1.2.172)
+func vbrAPIVersionForBuild(buildVersion string) (string, error) {
+	build, err := parseBuildVersion(buildVersion)
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range vbrAPIVersions {
+		if compareBuild(build, v.build) >= 0 {
+			return v.apiVersion, nil
+		}
+	}
+	return "", fmt.Errorf("VBR build %s is not supported; version 12.0 or later is required", buildVersion)
+}
+
+// detectAPIVersion queries the appliance build and switches the client to the matching API
+// revision. A failure is reported as an EndpointError, like a failed sign-in.
+func (c *VBRClient) detectAPIVersion(ctx context.Context) error {
+	apiVersion, err := c.serverAPIVersion(ctx)
+	if err != nil {
+		return &EndpointError{
+			Service: "Veeam Backup & Replication",
+			URL:     "https://" + c.hostname,
+			Err:     fmt.Errorf("failed to detect the REST API version (set api_version explicitly to skip detection): %w", err),
+		}
+	}
+
+	c.apiVersion = apiVersion
+	return nil
+}
+
+// serverAPIVersion returns the newest REST API revision supported by the appliance
+func (c *VBRClient) serverAPIVersion(ctx context.Context) (string, error) {
+	respBody, err := c.doRequest(ctx, "GET", c.BuildAPIURL("/api/v1/serverInfo"), nil, vbrBootstrapAPIVersion)
+	if err != nil {
+		return "", fmt.Errorf("failed to read VBR server info: %w", err)
+	}
+
+	var info VBRServerInfo
+	if err := json.Unmarshal(respBody, &info); err != nil {
+		return "", fmt.Errorf("failed to parse VBR server info: %w", err)
+	}
+
+	apiVersion, err := vbrAPIVersionForBuild(info.BuildVersion)
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("[INFO] Detected VBR build %s, using REST API version %s", info.BuildVersion, apiVersion)
+	return apiVersion, nil
+}
+
+func parseBuildVersion(buildVersion string) ([]int, error) {
+	fields := strings.Split(strings.TrimSpace(buildVersion), ".")
+	build := make([]int, 0, len(fields))
+	for _, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VBR build version %q", buildVersion)
+		}
+		build = append(build, n)
+	}
+	return build, nil
+}
+
+// compareBuild compares two build numbers component by component; missing components count as 0
+func compareBuild(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,32 @@
+package client
+
+import "testing"
+
+func TestVBRAPIVersionForBuild(t *testing.T) {
+	cases := map[string]string{
+		"12.0.0.1420":  "1.1-rev0",
+		"12.1.0.2131":  "1.1-rev1",
+		"12.1.2.172":   "1.1-rev2",
+		"12.2.0.334":   "1.2-rev0",
+		"12.3.1.1139":  "1.2-rev1",
+		"13.0.0.4967":  "1.3-rev0",
+		"13.0.1.180":   "1.3-rev1",
+		"14.0.0.10000": "1.3-rev1",
+	}
+	for build, want := range cases {
+		got, err := vbrAPIVersionForBuild(build)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", build, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("vbrAPIVersionForBuild(%q) = %q, want %q", build, got, want)
+		}
+	}
+
+	for _, build := range []string{"11.0.1.1261", "", "12.x"} {
+		if _, err := vbrAPIVersionForBuild(build); err == nil {
+			t.Errorf("%q: expected an error", build)
+		}
+	}
+}
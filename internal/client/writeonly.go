@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GetSecretString returns a secret that can be set either through the top-level attribute key or
+// through its write-only "<key>_wo" variant. Write-only values are never persisted to state, so they
+// are read from the raw configuration, which is only available while planning and applying.
+func GetSecretString(d *schema.ResourceData, key string) (string, diag.Diagnostics) {
+	if d.GetRawConfig().IsNull() {
+		return d.Get(key).(string), nil
+	}
+
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath(key + "_wo"))
+	if diags.HasError() {
+		return "", diags
+	}
+	if v.IsKnown() && !v.IsNull() && v.Type().Equals(cty.String) {
+		return v.AsString(), nil
+	}
+	return d.Get(key).(string), nil
+}
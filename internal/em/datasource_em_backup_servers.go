@@ -0,0 +1,117 @@
+package em
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type EMBackupServersResponse struct {
+	BackupServers []EMBackupServer `json:"BackupServers"`
+}
+
+type EMBackupServer struct {
+	UID         string `json:"UID"`
+	Name        string `json:"Name"`
+	Description string `json:"Description"`
+	Port        int    `json:"Port"`
+	Version     string `json:"Version"`
+}
+
+func DataSourceEMBackupServers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the Veeam Backup & Replication servers connected to Veeam Backup Enterprise Manager.",
+		ReadContext: dataSourceEMBackupServersRead,
+		Schema: map[string]*schema.Schema{
+			"name_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only the backup servers whose names contain this value, ignoring case.",
+			},
+			"backup_servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Backup servers connected to Enterprise Manager.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the backup server in Enterprise Manager.",
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UID of the backup server, e.g. urn:veeam:BackupServer:<id>.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS name or IP address of the backup server, usable as the hostname of a vbr provider block.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the backup server.",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Port Enterprise Manager uses to connect to the backup server. This is not the port of the VBR REST API.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of Veeam Backup & Replication on the backup server.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEMBackupServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetEMClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL("/backupServers?format=Entity"), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list backup servers: %w", err))
+	}
+
+	var serversResp EMBackupServersResponse
+	if err := json.Unmarshal(respBody, &serversResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse backup servers response: %w", err))
+	}
+
+	nameFilter := strings.ToLower(d.Get("name_filter").(string))
+	servers := make([]map[string]interface{}, 0, len(serversResp.BackupServers))
+	for _, server := range serversResp.BackupServers {
+		if nameFilter != "" && !strings.Contains(strings.ToLower(server.Name), nameFilter) {
+			continue
+		}
+		servers = append(servers, map[string]interface{}{
+			"id":          idFromUID(server.UID),
+			"uid":         server.UID,
+			"name":        server.Name,
+			"description": server.Description,
+			"port":        server.Port,
+			"version":     server.Version,
+		})
+	}
+
+	if err := d.Set("backup_servers", servers); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set backup_servers: %w", err))
+	}
+
+	d.SetId("em_backup_servers")
+	return nil
+}
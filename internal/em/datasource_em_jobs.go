@@ -0,0 +1,175 @@
+package em
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type EMJobsResponse struct {
+	Jobs []EMJob `json:"Jobs"`
+}
+
+type EMJob struct {
+	UID                string   `json:"UID"`
+	Name               string   `json:"Name"`
+	Description        string   `json:"Description"`
+	JobType            string   `json:"JobType"`
+	Platform           string   `json:"Platform"`
+	ScheduleConfigured bool     `json:"ScheduleConfigured"`
+	ScheduleEnabled    bool     `json:"ScheduleEnabled"`
+	NextRun            string   `json:"NextRun"`
+	Links              []EMLink `json:"Links"`
+}
+
+// backupServer returns the link to the backup server that runs the job
+func (j EMJob) backupServer() EMLink {
+	for _, link := range j.Links {
+		if link.Type == "BackupServerReference" {
+			return link
+		}
+	}
+	return EMLink{}
+}
+
+func DataSourceEMJobs() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the jobs of all Veeam Backup & Replication servers connected to Veeam Backup Enterprise Manager.",
+		ReadContext: dataSourceEMJobsRead,
+		Schema: map[string]*schema.Schema{
+			"backup_server_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only the jobs of the backup server with this ID, e.g. an id from the veeambackup_em_backup_servers data source.",
+			},
+			"name_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only the jobs whose names contain this value, ignoring case.",
+			},
+			"job_type_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns only the jobs of this type, e.g. Backup or Replica.",
+			},
+			"jobs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Jobs of the connected backup servers.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the job.",
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UID of the job, e.g. urn:veeam:Job:<id>.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the job.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the job.",
+						},
+						"job_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the job, e.g. Backup.",
+						},
+						"platform": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Platform the job processes, e.g. VMware or HyperV.",
+						},
+						"schedule_enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the job runs on a schedule.",
+						},
+						"next_run": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the next scheduled run.",
+						},
+						"backup_server_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the backup server that runs the job.",
+						},
+						"backup_server_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the backup server that runs the job.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEMJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetEMClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL("/jobs?format=Entity"), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list jobs: %w", err))
+	}
+
+	var jobsResp EMJobsResponse
+	if err := json.Unmarshal(respBody, &jobsResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse jobs response: %w", err))
+	}
+
+	serverID := d.Get("backup_server_id").(string)
+	nameFilter := strings.ToLower(d.Get("name_filter").(string))
+	jobType := d.Get("job_type_filter").(string)
+
+	jobs := make([]map[string]interface{}, 0, len(jobsResp.Jobs))
+	for _, job := range jobsResp.Jobs {
+		server := job.backupServer()
+		if serverID != "" && !strings.EqualFold(idFromHref(server.Href), idFromUID(serverID)) {
+			continue
+		}
+		if nameFilter != "" && !strings.Contains(strings.ToLower(job.Name), nameFilter) {
+			continue
+		}
+		if jobType != "" && !strings.EqualFold(job.JobType, jobType) {
+			continue
+		}
+		jobs = append(jobs, map[string]interface{}{
+			"id":                 idFromUID(job.UID),
+			"uid":                job.UID,
+			"name":               job.Name,
+			"description":        job.Description,
+			"job_type":           job.JobType,
+			"platform":           job.Platform,
+			"schedule_enabled":   job.ScheduleConfigured && job.ScheduleEnabled,
+			"next_run":           job.NextRun,
+			"backup_server_id":   idFromHref(server.Href),
+			"backup_server_name": server.Name,
+		})
+	}
+
+	if err := d.Set("jobs", jobs); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set jobs: %w", err))
+	}
+
+	d.SetId("em_jobs")
+	return nil
+}
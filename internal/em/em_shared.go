@@ -0,0 +1,24 @@
+// Package em implements the data sources of Veeam Backup Enterprise Manager, which federates the
+// Veeam Backup & Replication servers connected to it
+package em
+
+import "strings"
+
+// EMLink is a reference from an Enterprise Manager entity to a related entity
+type EMLink struct {
+	Rel  string `json:"Rel"`
+	Href string `json:"Href"`
+	Name string `json:"Name"`
+	Type string `json:"Type"`
+}
+
+// idFromUID returns the ID part of an Enterprise Manager UID such as urn:veeam:Job:<id>
+func idFromUID(uid string) string {
+	return uid[strings.LastIndex(uid, ":")+1:]
+}
+
+// idFromHref returns the last path segment of an Enterprise Manager link
+func idFromHref(href string) string {
+	href = strings.TrimSuffix(href, "/")
+	return href[strings.LastIndex(href, "/")+1:]
+}
@@ -0,0 +1,21 @@
+// Package gcp implements the resources of Veeam Backup for Google Cloud
+package gcp
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// expandStringSet converts a set or list of strings to a slice, returning nil when it is empty
+func expandStringSet(v interface{}) []string {
+	var items []interface{}
+	switch v := v.(type) {
+	case *schema.Set:
+		items = v.List()
+	case []interface{}:
+		items = v
+	}
+
+	var result []string
+	for _, item := range items {
+		result = append(result, item.(string))
+	}
+	return result
+}
@@ -0,0 +1,257 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// GCPRepositoryRequest is the body of POST /repositories and PUT /repositories/{id}
+type GCPRepositoryRequest struct {
+	Name             string  `json:"name"`
+	Description      *string `json:"description,omitempty"`
+	ServiceAccountID string  `json:"serviceAccountId"`
+	ProjectID        string  `json:"projectId"`
+	BucketName       string  `json:"bucketName"`
+	FolderName       string  `json:"folderName"`
+	StorageClass     string  `json:"storageClass"`
+	EnableEncryption bool    `json:"enableEncryption"`
+	Password         *string `json:"password,omitempty"`
+	Hint             *string `json:"hint,omitempty"`
+}
+
+// GCPRepositoryResponse is a repository as returned by the API; the password is never returned
+type GCPRepositoryResponse struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	ServiceAccountID string `json:"serviceAccountId"`
+	ProjectID        string `json:"projectId"`
+	BucketName       string `json:"bucketName"`
+	FolderName       string `json:"folderName"`
+	StorageClass     string `json:"storageClass"`
+	EnableEncryption bool   `json:"enableEncryption"`
+	Hint             string `json:"hint"`
+	Region           string `json:"region"`
+}
+
+// ResourceGCPRepository returns the resource for Google Cloud Storage backup repositories
+func ResourceGCPRepository() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGCPRepositoryCreate,
+		ReadContext:   resourceGCPRepositoryRead,
+		UpdateContext: resourceGCPRepositoryUpdate,
+		DeleteContext: resourceGCPRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the repository in Veeam Backup for Google Cloud.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the repository.",
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "System ID of the Google Cloud service account used to access the bucket.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Google Cloud project that owns the bucket.",
+			},
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Google Cloud Storage bucket that stores the backups.",
+			},
+			"folder_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the folder in the bucket that stores the backups.",
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Standard",
+				ForceNew:     true,
+				Description:  "Storage class of the backups. Possible values are Standard, Nearline, Coldline and Archive.",
+				ValidateFunc: validation.StringInSlice([]string{"Standard", "Nearline", "Coldline", "Archive"}, false),
+			},
+			"enable_encryption": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Whether backups stored in the repository are encrypted with a password.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password used to encrypt the backups; required when enable_encryption is true. The API never returns it, so changes made outside of Terraform are not detected.",
+			},
+			"hint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Hint for the encryption password.",
+			},
+			// Computed
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Google Cloud region where the bucket resides.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func buildRepositoryRequest(d *schema.ResourceData) GCPRepositoryRequest {
+	req := GCPRepositoryRequest{
+		Name:             d.Get("name").(string),
+		ServiceAccountID: d.Get("service_account_id").(string),
+		ProjectID:        d.Get("project_id").(string),
+		BucketName:       d.Get("bucket_name").(string),
+		FolderName:       d.Get("folder_name").(string),
+		StorageClass:     d.Get("storage_class").(string),
+		EnableEncryption: d.Get("enable_encryption").(bool),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		desc := v.(string)
+		req.Description = &desc
+	}
+	if v, ok := d.GetOk("password"); ok {
+		password := v.(string)
+		req.Password = &password
+	}
+	if v, ok := d.GetOk("hint"); ok {
+		hint := v.(string)
+		req.Hint = &hint
+	}
+
+	return req
+}
+
+func resourceGCPRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetGCPClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := buildRepositoryRequest(d)
+	if req.EnableEncryption && req.Password == nil {
+		return diag.Errorf("password is required when enable_encryption is true")
+	}
+
+	bodyBytes, err := json.Marshal(req)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal repository request: %w", err))
+	}
+
+	respBody, err := client.DoRequest(ctx, "POST", client.BuildAPIURL("/repositories"), bodyBytes)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create repository: %w", err))
+	}
+
+	var repositoryResp GCPRepositoryResponse
+	if err := json.Unmarshal(respBody, &repositoryResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse repository response: %w", err))
+	}
+
+	d.SetId(repositoryResp.ID)
+	return resourceGCPRepositoryRead(ctx, d, meta)
+}
+
+func resourceGCPRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetGCPClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL(vc.Endpoint("/repositories/%s", d.Id())), nil)
+	if err != nil {
+		if vc.RemoveFromStateIfGone(d, err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read repository: %w", err))
+	}
+
+	var repositoryResp GCPRepositoryResponse
+	if err := json.Unmarshal(respBody, &repositoryResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse repository response: %w", err))
+	}
+
+	values := map[string]interface{}{
+		"name":               repositoryResp.Name,
+		"description":        repositoryResp.Description,
+		"service_account_id": repositoryResp.ServiceAccountID,
+		"project_id":         repositoryResp.ProjectID,
+		"bucket_name":        repositoryResp.BucketName,
+		"folder_name":        repositoryResp.FolderName,
+		"storage_class":      repositoryResp.StorageClass,
+		"enable_encryption":  repositoryResp.EnableEncryption,
+		"hint":               repositoryResp.Hint,
+		"region":             repositoryResp.Region,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to set %s: %w", key, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceGCPRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetGCPClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(buildRepositoryRequest(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal repository update request: %w", err))
+	}
+
+	if _, err := client.DoRequest(ctx, "PUT", client.BuildAPIURL(vc.Endpoint("/repositories/%s", d.Id())), bodyBytes); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update repository: %w", err))
+	}
+
+	return resourceGCPRepositoryRead(ctx, d, meta)
+}
+
+func resourceGCPRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetGCPClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.DoRequest(ctx, "DELETE", client.BuildAPIURL(vc.Endpoint("/repositories/%s", d.Id())), nil); err != nil && !vc.IsGone(err) {
+		return diag.FromErr(fmt.Errorf("failed to delete repository: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
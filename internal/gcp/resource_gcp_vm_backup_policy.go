@@ -0,0 +1,277 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	vc "terraform-provider-veeambackup/internal/client"
+	"terraform-provider-veeambackup/internal/policy"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// GCPVMBackupPolicyRequest is the body of POST /policies/vm and PUT /policies/vm/{id}
+type GCPVMBackupPolicyRequest struct {
+	Name             string                  `json:"name"`
+	Description      *string                 `json:"description,omitempty"`
+	IsEnabled        bool                    `json:"isEnabled"`
+	ServiceAccountID string                  `json:"serviceAccountId"`
+	ProjectIDs       []string                `json:"projectIds"`
+	Regions          []string                `json:"regions,omitempty"`
+	SelectedItems    *GCPPolicySelectedItems `json:"selectedItems,omitempty"`
+	DailySchedule    *policy.DailySchedule   `json:"dailySchedule,omitempty"`
+	WeeklySchedule   *policy.WeeklySchedule  `json:"weeklySchedule,omitempty"`
+	MonthlySchedule  *policy.MonthlySchedule `json:"monthlySchedule,omitempty"`
+	YearlySchedule   *policy.YearlySchedule  `json:"yearlySchedule,omitempty"`
+}
+
+// GCPPolicySelectedItems narrows a policy down to the listed instances and the instances that
+// carry all of the labels; the policy protects every instance in its projects when it is omitted
+type GCPPolicySelectedItems struct {
+	InstanceIDs []string   `json:"instanceIds,omitempty"`
+	Labels      []GCPLabel `json:"labels,omitempty"`
+}
+
+type GCPLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type GCPVMBackupPolicyResponse struct {
+	ID string `json:"id"`
+	GCPVMBackupPolicyRequest
+}
+
+// ResourceGCPVMBackupPolicy returns the resource for Google Cloud VM instance backup policies
+func ResourceGCPVMBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGCPVMBackupPolicyCreate,
+		ReadContext:   resourceGCPVMBackupPolicyRead,
+		UpdateContext: resourceGCPVMBackupPolicyUpdate,
+		DeleteContext: resourceGCPVMBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Specifies a name for the backup policy.",
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies a description for the backup policy.",
+			},
+			"is_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Defines whether the policy is enabled.",
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Specifies the system ID of the Google Cloud service account used to create snapshots and backups.",
+			},
+			"project_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Specifies the IDs of the Google Cloud projects whose VM instances are protected by the policy.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"regions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Specifies the Google Cloud regions where the protected VM instances reside. All regions are processed if omitted.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"selected_items": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Specifies the VM instances to protect. All VM instances in the projects are protected if omitted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_ids": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Specifies the IDs of the VM instances to protect.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Specifies labels; VM instances carrying all of them are protected.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"daily_schedule":   policy.DailyScheduleSchema(),
+			"weekly_schedule":  policy.WeeklyScheduleSchema(),
+			"monthly_schedule": policy.MonthlyScheduleSchema(),
+			"yearly_schedule":  policy.YearlyScheduleSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func buildVMBackupPolicyRequest(d *schema.ResourceData, client *vc.GCPBackupClient) GCPVMBackupPolicyRequest {
+	req := GCPVMBackupPolicyRequest{
+		Name:             d.Get("name").(string),
+		IsEnabled:        d.Get("is_enabled").(bool),
+		ServiceAccountID: d.Get("service_account_id").(string),
+		ProjectIDs:       expandStringSet(d.Get("project_ids")),
+		Regions:          expandStringSet(d.Get("regions")),
+		DailySchedule:    policy.ExpandDailySchedule(d.Get("daily_schedule").([]interface{})),
+		WeeklySchedule:   policy.ExpandWeeklySchedule(d.Get("weekly_schedule").([]interface{})),
+		MonthlySchedule:  policy.ExpandMonthlySchedule(d.Get("monthly_schedule").([]interface{})),
+		YearlySchedule:   policy.ExpandYearlySchedule(d.Get("yearly_schedule").([]interface{})),
+	}
+
+	if description := client.AppendDescriptionSuffix(d.Get("description").(string)); description != "" {
+		req.Description = &description
+	}
+
+	if v, ok := d.Get("selected_items").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		items := &GCPPolicySelectedItems{InstanceIDs: expandStringSet(m["instance_ids"])}
+		for key, value := range m["labels"].(map[string]interface{}) {
+			items.Labels = append(items.Labels, GCPLabel{Key: key, Value: value.(string)})
+		}
+		// Map iteration order is random; keep the request stable
+		sort.Slice(items.Labels, func(i, j int) bool { return items.Labels[i].Key < items.Labels[j].Key })
+		req.SelectedItems = items
+	}
+
+	return req
+}
+
+func flattenSelectedItems(items *GCPPolicySelectedItems) []interface{} {
+	if items == nil {
+		return []interface{}{}
+	}
+	labels := make(map[string]interface{}, len(items.Labels))
+	for _, label := range items.Labels {
+		labels[label.Key] = label.Value
+	}
+	return []interface{}{map[string]interface{}{
+		"instance_ids": items.InstanceIDs,
+		"labels":       labels,
+	}}
+}
+
+func resourceGCPVMBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetGCPClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(buildVMBackupPolicyRequest(d, client))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal VM backup policy request: %w", err))
+	}
+
+	respBody, err := client.DoRequest(ctx, "POST", client.BuildAPIURL("/policies/vm"), bodyBytes)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create VM backup policy: %w", err))
+	}
+
+	var policyResp GCPVMBackupPolicyResponse
+	if err := json.Unmarshal(respBody, &policyResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse VM backup policy response: %w", err))
+	}
+
+	d.SetId(policyResp.ID)
+	return resourceGCPVMBackupPolicyRead(ctx, d, meta)
+}
+
+func resourceGCPVMBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetGCPClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL(vc.Endpoint("/policies/vm/%s", d.Id())), nil)
+	if err != nil {
+		if vc.RemoveFromStateIfGone(d, err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read VM backup policy: %w", err))
+	}
+
+	var policyResp GCPVMBackupPolicyResponse
+	if err := json.Unmarshal(respBody, &policyResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse VM backup policy response: %w", err))
+	}
+
+	var description string
+	if policyResp.Description != nil {
+		description = *policyResp.Description
+	}
+
+	values := map[string]interface{}{
+		"name":               policyResp.Name,
+		"description":        client.TrimDescriptionSuffix(description),
+		"is_enabled":         policyResp.IsEnabled,
+		"service_account_id": policyResp.ServiceAccountID,
+		"project_ids":        policyResp.ProjectIDs,
+		"regions":            policyResp.Regions,
+		"selected_items":     flattenSelectedItems(policyResp.SelectedItems),
+		"daily_schedule":     policy.FlattenDailySchedule(policyResp.DailySchedule),
+		"weekly_schedule":    policy.FlattenWeeklySchedule(policyResp.WeeklySchedule),
+		"monthly_schedule":   policy.FlattenMonthlySchedule(policyResp.MonthlySchedule),
+		"yearly_schedule":    policy.FlattenYearlySchedule(policyResp.YearlySchedule),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to set %s: %w", key, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceGCPVMBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetGCPClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(buildVMBackupPolicyRequest(d, client))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal VM backup policy update request: %w", err))
+	}
+
+	if _, err := client.DoRequest(ctx, "PUT", client.BuildAPIURL(vc.Endpoint("/policies/vm/%s", d.Id())), bodyBytes); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update VM backup policy: %w", err))
+	}
+
+	return resourceGCPVMBackupPolicyRead(ctx, d, meta)
+}
+
+func resourceGCPVMBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetGCPClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.DoRequest(ctx, "DELETE", client.BuildAPIURL(vc.Endpoint("/policies/vm/%s", d.Id())), nil); err != nil && !vc.IsGone(err) {
+		return diag.FromErr(fmt.Errorf("failed to delete VM backup policy: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,98 @@
+package gcp
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	vc "terraform-provider-veeambackup/internal/client"
+	"terraform-provider-veeambackup/internal/policy"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestVMBackupPolicyRoundTrip(t *testing.T) {
+	r := ResourceGCPVMBackupPolicy()
+	base := func(settings map[string]interface{}) map[string]interface{} {
+		raw := map[string]interface{}{
+			"name":               "production-vms",
+			"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+			"project_ids":        []interface{}{"production"},
+		}
+		for key, value := range settings {
+			raw[key] = value
+		}
+		return raw
+	}
+
+	for name, raw := range map[string]map[string]interface{}{
+		"all instances": base(map[string]interface{}{"is_enabled": false}),
+		"selected instances and labels": base(map[string]interface{}{
+			"description": "Production instances",
+			"regions":     []interface{}{"europe-west1"},
+			"selected_items": []interface{}{map[string]interface{}{
+				"instance_ids": []interface{}{"instance-1"},
+				"labels":       map[string]interface{}{"env": "production", "tier": "web"},
+			}},
+		}),
+		"schedules": base(map[string]interface{}{
+			"daily_schedule": []interface{}{map[string]interface{}{
+				"daily_type":        "EveryDay",
+				"snapshot_schedule": []interface{}{map[string]interface{}{"hours": []interface{}{1}, "snapshots_to_keep": 7}},
+			}},
+			"weekly_schedule": []interface{}{map[string]interface{}{
+				"start_time":        2,
+				"snapshot_schedule": []interface{}{map[string]interface{}{"selected_days": []interface{}{"Sunday"}, "snapshots_to_keep": 4}},
+			}},
+			"yearly_schedule": []interface{}{map[string]interface{}{
+				"type":                  "Last",
+				"month":                 "December",
+				"day_of_week":           "Friday",
+				"retention_years_count": 3,
+				"target_repository_id":  "repository-1",
+			}},
+		}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := &vc.GCPBackupClient{}
+			d := schema.TestResourceDataRaw(t, r.Schema, raw)
+			request := buildVMBackupPolicyRequest(d, client)
+
+			body, err := json.Marshal(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var response GCPVMBackupPolicyResponse
+			if err := json.Unmarshal(body, &response); err != nil {
+				t.Fatal(err)
+			}
+
+			// Set the response as resourceGCPVMBackupPolicyRead does
+			var description string
+			if response.Description != nil {
+				description = *response.Description
+			}
+			for key, value := range map[string]interface{}{
+				"name":               response.Name,
+				"description":        client.TrimDescriptionSuffix(description),
+				"is_enabled":         response.IsEnabled,
+				"service_account_id": response.ServiceAccountID,
+				"project_ids":        response.ProjectIDs,
+				"regions":            response.Regions,
+				"selected_items":     flattenSelectedItems(response.SelectedItems),
+				"daily_schedule":     policy.FlattenDailySchedule(response.DailySchedule),
+				"weekly_schedule":    policy.FlattenWeeklySchedule(response.WeeklySchedule),
+				"monthly_schedule":   policy.FlattenMonthlySchedule(response.MonthlySchedule),
+				"yearly_schedule":    policy.FlattenYearlySchedule(response.YearlySchedule),
+			} {
+				if err := d.Set(key, value); err != nil {
+					t.Fatalf("setting %s: %s", key, err)
+				}
+			}
+
+			if got := buildVMBackupPolicyRequest(d, client); !reflect.DeepEqual(got, request) {
+				t.Errorf("round trip:\n got %+v\nwant %+v", got, request)
+			}
+		})
+	}
+}
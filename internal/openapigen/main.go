@@ -0,0 +1,262 @@
+// Command openapigen generates Go models from the component schemas of an OpenAPI 3 document.
+//
+// Object schemas become structs whose fields follow the json names of their properties. Required
+// properties are plain values; optional ones are pointers with omitempty, except slices and maps,
+// which are already nil when absent. String schemas with an enum become a named type with one
+// constant per value. It is run through go generate, see internal/client/azureapi.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// document is the part of an OpenAPI 3 document models are generated from
+type document struct {
+	Components struct {
+		Schemas map[string]*schemaObject `json:"schemas"`
+	} `json:"components"`
+}
+
+type schemaObject struct {
+	Ref                  string                   `json:"$ref"`
+	Type                 string                   `json:"type"`
+	Format               string                   `json:"format"`
+	Description          string                   `json:"description"`
+	Enum                 []string                 `json:"enum"`
+	Properties           map[string]*schemaObject `json:"properties"`
+	Required             []string                 `json:"required"`
+	Items                *schemaObject            `json:"items"`
+	AdditionalProperties json.RawMessage          `json:"additionalProperties"`
+}
+
+// initialisms are the words written in upper case in Go identifiers
+var initialisms = map[string]bool{
+	"API": true, "DB": true, "FLR": true, "HTTP": true, "ID": true, "IP": true, "JSON": true,
+	"SQL": true, "URI": true, "URL": true, "UTC": true, "UUID": true, "VM": true,
+}
+
+func main() {
+	spec := flag.String("spec", "", "path of the OpenAPI document")
+	pkg := flag.String("package", "", "name of the generated package")
+	out := flag.String("o", "", "path of the generated file")
+	flag.Parse()
+	if *spec == "" || *pkg == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	src, err := generateFile(*spec, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generateFile reads the OpenAPI document at path and returns the formatted Go source of its models
+func generateFile(path, pkg string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	src, err := generate(filepath.Base(path), pkg, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return src, nil
+}
+
+func generate(source, pkg string, doc *document) ([]byte, error) {
+	names := make([]string, 0, len(doc.Components.Schemas))
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by openapigen from %s. DO NOT EDIT.\n\npackage %s\n", source, pkg)
+
+	for _, name := range names {
+		s := doc.Components.Schemas[name]
+		typeName := goName(name)
+		buf.WriteString("\n")
+		writeComment(&buf, typeName, name, s.Description)
+
+		switch {
+		case s.Type == "object" || len(s.Properties) > 0:
+			fmt.Fprintf(&buf, "type %s struct {\n", typeName)
+			if err := writeFields(&buf, s); err != nil {
+				return nil, fmt.Errorf("schema %s: %w", name, err)
+			}
+			buf.WriteString("}\n")
+		case s.Type == "string" && len(s.Enum) > 0:
+			fmt.Fprintf(&buf, "type %s string\n\nconst (\n", typeName)
+			for _, value := range s.Enum {
+				fmt.Fprintf(&buf, "\t%s%s %s = %q\n", typeName, goName(value), typeName, value)
+			}
+			buf.WriteString(")\n")
+		default:
+			goType, err := fieldType(s)
+			if err != nil {
+				return nil, fmt.Errorf("schema %s: %w", name, err)
+			}
+			fmt.Fprintf(&buf, "type %s %s\n", typeName, goType)
+		}
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format the generated models: %w", err)
+	}
+	return src, nil
+}
+
+func writeFields(buf *bytes.Buffer, s *schemaObject) error {
+	required := map[string]bool{}
+	for _, property := range s.Required {
+		required[property] = true
+	}
+
+	properties := make([]string, 0, len(s.Properties))
+	for property := range s.Properties {
+		properties = append(properties, property)
+	}
+	sort.Strings(properties)
+
+	for _, property := range properties {
+		goType, err := fieldType(s.Properties[property])
+		if err != nil {
+			return fmt.Errorf("property %s: %w", property, err)
+		}
+
+		tag := property
+		if !required[property] {
+			if !strings.HasPrefix(goType, "[]") && !strings.HasPrefix(goType, "map[") {
+				goType = "*" + goType
+			}
+			tag += ",omitempty"
+		}
+
+		if description := s.Properties[property].Description; description != "" {
+			fmt.Fprintf(buf, "\t// %s\n", description)
+		}
+		fmt.Fprintf(buf, "\t%s %s `json:%q`\n", goName(property), goType, tag)
+	}
+	return nil
+}
+
+// fieldType returns the Go type of a property schema, without the pointer of optional properties
+func fieldType(s *schemaObject) (string, error) {
+	if s.Ref != "" {
+		const prefix = "#/components/schemas/"
+		if !strings.HasPrefix(s.Ref, prefix) {
+			return "", fmt.Errorf("unsupported reference %s", s.Ref)
+		}
+		return goName(strings.TrimPrefix(s.Ref, prefix)), nil
+	}
+
+	switch s.Type {
+	case "string":
+		return "string", nil
+	case "boolean":
+		return "bool", nil
+	case "integer":
+		switch s.Format {
+		case "int32":
+			return "int32", nil
+		case "int64":
+			return "int64", nil
+		}
+		return "int", nil
+	case "number":
+		if s.Format == "float" {
+			return "float32", nil
+		}
+		return "float64", nil
+	case "array":
+		if s.Items == nil {
+			return "", fmt.Errorf("array without items")
+		}
+		item, err := fieldType(s.Items)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + item, nil
+	case "object":
+		if len(s.Properties) > 0 {
+			return "", fmt.Errorf("inline object schemas are not supported; declare the object under components/schemas")
+		}
+		var value schemaObject
+		if len(s.AdditionalProperties) > 0 && json.Unmarshal(s.AdditionalProperties, &value) == nil && (value.Ref != "" || value.Type != "") {
+			elem, err := fieldType(&value)
+			if err != nil {
+				return "", err
+			}
+			return "map[string]" + elem, nil
+		}
+		return "map[string]interface{}", nil
+	}
+	return "", fmt.Errorf("unsupported type %q", s.Type)
+}
+
+// goName converts a schema, property or enum value name into an exported Go identifier,
+// e.g. keyVaultKeyUri into KeyVaultKeyURI and _links into Links
+func goName(name string) string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+	}
+
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && len(word) > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))):
+			flush()
+			word = append(word, r)
+		default:
+			word = append(word, r)
+		}
+	}
+	flush()
+
+	var b strings.Builder
+	for _, w := range words {
+		if upper := strings.ToUpper(w); initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		r := []rune(w)
+		b.WriteString(strings.ToUpper(string(r[0])) + string(r[1:]))
+	}
+	return b.String()
+}
+
+func writeComment(buf *bytes.Buffer, typeName, schemaName, description string) {
+	fmt.Fprintf(buf, "// %s is generated from the %s schema.\n", typeName, schemaName)
+	if description != "" {
+		fmt.Fprintf(buf, "//\n// %s\n", description)
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGoName(t *testing.T) {
+	for name, want := range map[string]string{
+		"azureStorageAccountId":      "AzureStorageAccountID",
+		"keyVaultKeyUri":             "KeyVaultKeyURI",
+		"cosmosDbRestorePointId":     "CosmosDBRestorePointID",
+		"_links":                     "Links",
+		"SQLDatabase":                "SQLDatabase",
+		"restorePointCreatedDateUtc": "RestorePointCreatedDateUTC",
+		"GB":                         "GB",
+	} {
+		if got := goName(name); got != want {
+			t.Errorf("goName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+// TestGeneratedModels checks that the committed models match the OpenAPI documents, so that an
+// edit to either side without running go generate fails the build
+func TestGeneratedModels(t *testing.T) {
+	for _, tc := range []struct {
+		spec, pkg, models string
+	}{
+		{"../../api/openapi/azure.json", "azureapi", "../client/azureapi/models_gen.go"},
+	} {
+		want, err := generateFile(tc.spec, tc.pkg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(tc.models)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date with %s; run go generate ./internal/client/%s", tc.models, tc.spec, tc.pkg)
+		}
+	}
+}
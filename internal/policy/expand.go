@@ -0,0 +1,155 @@
+package policy
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// ExpandDailySchedule converts the daily_schedule block into the API model
+func ExpandDailySchedule(input []interface{}) *DailySchedule {
+	return expandBlock(input, func(m map[string]interface{}) *DailySchedule {
+		return &DailySchedule{
+			DailyType:        stringPtr(m, "daily_type"),
+			SelectedDays:     stringList(m["selected_days"]),
+			RunsPerHour:      nonZeroIntPtr(m, "runs_per_hour"),
+			SnapshotSchedule: nestedBlock(m, "snapshot_schedule", expandSnapshotSchedule),
+			BackupSchedule:   nestedBlock(m, "backup_schedule", expandBackupSchedule),
+		}
+	})
+}
+
+// ExpandWeeklySchedule converts the weekly_schedule block into the API model
+func ExpandWeeklySchedule(input []interface{}) *WeeklySchedule {
+	return expandBlock(input, func(m map[string]interface{}) *WeeklySchedule {
+		return &WeeklySchedule{
+			StartTime:        intPtr(m, "start_time"),
+			SnapshotSchedule: nestedBlock(m, "snapshot_schedule", expandSnapshotSchedule),
+			BackupSchedule:   nestedBlock(m, "backup_schedule", expandBackupSchedule),
+		}
+	})
+}
+
+// ExpandMonthlySchedule converts the monthly_schedule block into the API model
+func ExpandMonthlySchedule(input []interface{}) *MonthlySchedule {
+	return expandBlock(input, func(m map[string]interface{}) *MonthlySchedule {
+		return &MonthlySchedule{
+			StartTime:        intPtr(m, "start_time"),
+			Type:             stringPtr(m, "type"),
+			DayOfWeek:        stringPtr(m, "day_of_week"),
+			DayOfMonth:       nonZeroIntPtr(m, "day_of_month"),
+			MonthlyLastDay:   boolPtr(m, "monthly_last_day"),
+			SnapshotSchedule: nestedBlock(m, "snapshot_schedule", expandSnapshotSchedule),
+			BackupSchedule:   nestedBlock(m, "backup_schedule", expandBackupSchedule),
+		}
+	})
+}
+
+// ExpandYearlySchedule converts the yearly_schedule block into the API model
+func ExpandYearlySchedule(input []interface{}) *YearlySchedule {
+	return expandBlock(input, func(m map[string]interface{}) *YearlySchedule {
+		return &YearlySchedule{
+			StartTime:           intPtr(m, "start_time"),
+			Type:                stringPtr(m, "type"),
+			Month:               stringPtr(m, "month"),
+			DayOfWeek:           stringPtr(m, "day_of_week"),
+			DayOfMonth:          nonZeroIntPtr(m, "day_of_month"),
+			YearlyLastDay:       boolPtr(m, "yearly_last_day"),
+			RetentionYearsCount: intPtr(m, "retention_years_count"),
+			TargetRepositoryID:  stringPtr(m, "target_repository_id"),
+		}
+	})
+}
+
+func expandSnapshotSchedule(m map[string]interface{}) *SnapshotSchedule {
+	return &SnapshotSchedule{
+		Hours:           intList(m["hours"]),
+		SelectedDays:    stringList(m["selected_days"]),
+		SelectedMonths:  stringList(m["selected_months"]),
+		SnapshotsToKeep: intPtr(m, "snapshots_to_keep"),
+	}
+}
+
+func expandBackupSchedule(m map[string]interface{}) *BackupSchedule {
+	return &BackupSchedule{
+		Hours:              intList(m["hours"]),
+		SelectedDays:       stringList(m["selected_days"]),
+		SelectedMonths:     stringList(m["selected_months"]),
+		Retention:          nestedBlock(m, "retention", expandRetention),
+		TargetRepositoryID: stringPtr(m, "target_repository_id"),
+	}
+}
+
+func expandRetention(m map[string]interface{}) *Retention {
+	return &Retention{
+		TimeRetentionDuration: intPtr(m, "time_retention_duration"),
+		RetentionDurationType: stringPtr(m, "retention_duration_type"),
+	}
+}
+
+// expandBlock expands the single item of a MaxItems: 1 list block with fn. It returns nil when
+// the block is not set.
+func expandBlock[T any](input []interface{}, fn func(m map[string]interface{}) *T) *T {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	return fn(input[0].(map[string]interface{}))
+}
+
+// nestedBlock expands the block stored under key in m
+func nestedBlock[T any](m map[string]interface{}, key string, fn func(m map[string]interface{}) *T) *T {
+	v, _ := m[key].([]interface{})
+	return expandBlock(v, fn)
+}
+
+func stringPtr(m map[string]interface{}, key string) *string {
+	if s, ok := m[key].(string); ok && s != "" {
+		return &s
+	}
+	return nil
+}
+
+func intPtr(m map[string]interface{}, key string) *int {
+	if i, ok := m[key].(int); ok {
+		return &i
+	}
+	return nil
+}
+
+// nonZeroIntPtr is intPtr for attributes where 0 means unset
+func nonZeroIntPtr(m map[string]interface{}, key string) *int {
+	if i, ok := m[key].(int); ok && i != 0 {
+		return &i
+	}
+	return nil
+}
+
+func boolPtr(m map[string]interface{}, key string) *bool {
+	if b, ok := m[key].(bool); ok {
+		return &b
+	}
+	return nil
+}
+
+// listItems returns the elements of a list or set attribute value
+func listItems(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+func stringList(v interface{}) []string {
+	var result []string
+	for _, item := range listItems(v) {
+		result = append(result, item.(string))
+	}
+	return result
+}
+
+func intList(v interface{}) []int {
+	var result []int
+	for _, item := range listItems(v) {
+		result = append(result, item.(int))
+	}
+	return result
+}
@@ -0,0 +1,117 @@
+package policy
+
+// FlattenDailySchedule converts the API daily schedule into the value stored for the
+// daily_schedule block
+func FlattenDailySchedule(s *DailySchedule) []interface{} {
+	return flattenBlock(s, func(s *DailySchedule) map[string]interface{} {
+		return map[string]interface{}{
+			"daily_type":        deref(s.DailyType),
+			"selected_days":     s.SelectedDays,
+			"runs_per_hour":     deref(s.RunsPerHour),
+			"snapshot_schedule": flattenSnapshotSchedule(s.SnapshotSchedule, "hours"),
+			"backup_schedule":   flattenBackupSchedule(s.BackupSchedule, "hours"),
+		}
+	})
+}
+
+// FlattenWeeklySchedule converts the API weekly schedule into the value stored for the
+// weekly_schedule block
+func FlattenWeeklySchedule(s *WeeklySchedule) []interface{} {
+	return flattenBlock(s, func(s *WeeklySchedule) map[string]interface{} {
+		return map[string]interface{}{
+			"start_time":        deref(s.StartTime),
+			"snapshot_schedule": flattenSnapshotSchedule(s.SnapshotSchedule, "selected_days"),
+			"backup_schedule":   flattenBackupSchedule(s.BackupSchedule, "selected_days"),
+		}
+	})
+}
+
+// FlattenMonthlySchedule converts the API monthly schedule into the value stored for the
+// monthly_schedule block
+func FlattenMonthlySchedule(s *MonthlySchedule) []interface{} {
+	return flattenBlock(s, func(s *MonthlySchedule) map[string]interface{} {
+		return map[string]interface{}{
+			"start_time":        deref(s.StartTime),
+			"type":              deref(s.Type),
+			"day_of_week":       deref(s.DayOfWeek),
+			"day_of_month":      deref(s.DayOfMonth),
+			"monthly_last_day":  deref(s.MonthlyLastDay),
+			"snapshot_schedule": flattenSnapshotSchedule(s.SnapshotSchedule, "selected_months"),
+			"backup_schedule":   flattenBackupSchedule(s.BackupSchedule, "selected_months"),
+		}
+	})
+}
+
+// FlattenYearlySchedule converts the API yearly schedule into the value stored for the
+// yearly_schedule block
+func FlattenYearlySchedule(s *YearlySchedule) []interface{} {
+	return flattenBlock(s, func(s *YearlySchedule) map[string]interface{} {
+		return map[string]interface{}{
+			"start_time":            deref(s.StartTime),
+			"type":                  deref(s.Type),
+			"month":                 deref(s.Month),
+			"day_of_week":           deref(s.DayOfWeek),
+			"day_of_month":          deref(s.DayOfMonth),
+			"yearly_last_day":       deref(s.YearlyLastDay),
+			"retention_years_count": deref(s.RetentionYearsCount),
+			"target_repository_id":  deref(s.TargetRepositoryID),
+		}
+	})
+}
+
+// flattenSnapshotSchedule flattens s, storing only the selector its block declares
+func flattenSnapshotSchedule(s *SnapshotSchedule, selector string) []interface{} {
+	return flattenBlock(s, func(s *SnapshotSchedule) map[string]interface{} {
+		return map[string]interface{}{
+			selector:            flattenSelector(selector, s.Hours, s.SelectedDays, s.SelectedMonths),
+			"snapshots_to_keep": deref(s.SnapshotsToKeep),
+		}
+	})
+}
+
+// flattenBackupSchedule flattens s, storing only the selector its block declares
+func flattenBackupSchedule(s *BackupSchedule, selector string) []interface{} {
+	return flattenBlock(s, func(s *BackupSchedule) map[string]interface{} {
+		return map[string]interface{}{
+			selector:               flattenSelector(selector, s.Hours, s.SelectedDays, s.SelectedMonths),
+			"retention":            flattenBlock(s.Retention, flattenRetention),
+			"target_repository_id": deref(s.TargetRepositoryID),
+		}
+	})
+}
+
+func flattenRetention(r *Retention) map[string]interface{} {
+	return map[string]interface{}{
+		"time_retention_duration": deref(r.TimeRetentionDuration),
+		"retention_duration_type": deref(r.RetentionDurationType),
+	}
+}
+
+func flattenSelector(selector string, hours []int, days, months []string) interface{} {
+	switch selector {
+	case "hours":
+		return hours
+	case "selected_days":
+		return days
+	default:
+		return months
+	}
+}
+
+// flattenBlock converts v into the single-item list stored for a MaxItems: 1 block. It returns an
+// empty list when v is nil so that the block is cleared from state.
+func flattenBlock[T any](v *T, fn func(v *T) map[string]interface{}) []interface{} {
+	if v == nil {
+		return []interface{}{}
+	}
+	return []interface{}{fn(v)}
+}
+
+// deref returns the value p points to, or the zero value when p is nil
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,190 @@
+package policy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"daily_schedule":   DailyScheduleSchema(),
+		"weekly_schedule":  WeeklyScheduleSchema(),
+		"monthly_schedule": MonthlyScheduleSchema(),
+		"yearly_schedule":  YearlyScheduleSchema(),
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	retention := []interface{}{
+		map[string]interface{}{"time_retention_duration": 30, "retention_duration_type": "Days"},
+	}
+	raw := map[string]interface{}{
+		"daily_schedule": []interface{}{
+			map[string]interface{}{
+				"daily_type":    "SelectedDays",
+				"selected_days": []interface{}{"Monday", "Friday"},
+				"snapshot_schedule": []interface{}{
+					map[string]interface{}{"hours": []interface{}{2, 14}, "snapshots_to_keep": 5},
+				},
+				"backup_schedule": []interface{}{
+					map[string]interface{}{"hours": []interface{}{22}, "retention": retention, "target_repository_id": "repo-1"},
+				},
+			},
+		},
+		"monthly_schedule": []interface{}{
+			map[string]interface{}{
+				"start_time":  3,
+				"type":        "Last",
+				"day_of_week": "Sunday",
+				"backup_schedule": []interface{}{
+					map[string]interface{}{"selected_months": []interface{}{"January", "July"}, "retention": retention, "target_repository_id": "repo-1"},
+				},
+			},
+		},
+		"yearly_schedule": []interface{}{
+			map[string]interface{}{
+				"type":                  "SelectedDay",
+				"month":                 "December",
+				"day_of_month":          31,
+				"retention_years_count": 7,
+				"target_repository_id":  "repo-2",
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, testSchema(), raw)
+	daily := ExpandDailySchedule(d.Get("daily_schedule").([]interface{}))
+	weekly := ExpandWeeklySchedule(d.Get("weekly_schedule").([]interface{}))
+	monthly := ExpandMonthlySchedule(d.Get("monthly_schedule").([]interface{}))
+	yearly := ExpandYearlySchedule(d.Get("yearly_schedule").([]interface{}))
+
+	if daily == nil || len(daily.SelectedDays) != 2 || daily.SnapshotSchedule == nil || len(daily.SnapshotSchedule.Hours) != 2 {
+		t.Fatalf("daily schedule not expanded: %+v", daily)
+	}
+	if daily.RunsPerHour != nil {
+		t.Errorf("runs_per_hour = %d, want nil when not set", *daily.RunsPerHour)
+	}
+	if weekly != nil {
+		t.Errorf("weekly = %+v, want nil when the block is not set", weekly)
+	}
+	if monthly.SnapshotSchedule != nil || monthly.BackupSchedule.Retention == nil || len(monthly.BackupSchedule.SelectedMonths) != 2 {
+		t.Errorf("monthly schedule not expanded: %+v", monthly)
+	}
+	if yearly.DayOfMonth == nil || *yearly.DayOfMonth != 31 || *yearly.TargetRepositoryID != "repo-2" {
+		t.Errorf("yearly schedule not expanded: %+v", yearly)
+	}
+
+	flattened := schema.TestResourceDataRaw(t, testSchema(), map[string]interface{}{})
+	for key, value := range map[string][]interface{}{
+		"daily_schedule":   FlattenDailySchedule(daily),
+		"weekly_schedule":  FlattenWeeklySchedule(weekly),
+		"monthly_schedule": FlattenMonthlySchedule(monthly),
+		"yearly_schedule":  FlattenYearlySchedule(yearly),
+	} {
+		if err := flattened.Set(key, value); err != nil {
+			t.Fatalf("setting %s: %s", key, err)
+		}
+	}
+
+	if got := ExpandDailySchedule(flattened.Get("daily_schedule").([]interface{})); !reflect.DeepEqual(got, daily) {
+		t.Errorf("daily schedule round trip:\n got %+v\nwant %+v", got, daily)
+	}
+	if got := ExpandWeeklySchedule(flattened.Get("weekly_schedule").([]interface{})); got != nil {
+		t.Errorf("weekly schedule round trip: got %+v, want nil", got)
+	}
+	if got := ExpandMonthlySchedule(flattened.Get("monthly_schedule").([]interface{})); !reflect.DeepEqual(got, monthly) {
+		t.Errorf("monthly schedule round trip:\n got %+v\nwant %+v", got, monthly)
+	}
+	if got := ExpandYearlySchedule(flattened.Get("yearly_schedule").([]interface{})); !reflect.DeepEqual(got, yearly) {
+		t.Errorf("yearly schedule round trip:\n got %+v\nwant %+v", got, yearly)
+	}
+}
+
+// TestRoundTripVariants checks that flattening an expanded schedule and expanding it again is
+// lossless for the options TestRoundTrip does not set
+func TestRoundTripVariants(t *testing.T) {
+	retention := []interface{}{
+		map[string]interface{}{"time_retention_duration": 4, "retention_duration_type": "Weeks"},
+	}
+	for name, raw := range map[string]map[string]interface{}{
+		"daily every hour": {
+			"daily_schedule": []interface{}{
+				map[string]interface{}{
+					"daily_type":    "EveryDay",
+					"runs_per_hour": 2,
+					"snapshot_schedule": []interface{}{
+						map[string]interface{}{"hours": []interface{}{0, 6, 12, 18}, "snapshots_to_keep": 8},
+					},
+				},
+			},
+		},
+		"weekly": {
+			"weekly_schedule": []interface{}{
+				map[string]interface{}{
+					"start_time": 23,
+					"snapshot_schedule": []interface{}{
+						map[string]interface{}{"selected_days": []interface{}{"Saturday"}, "snapshots_to_keep": 2},
+					},
+					"backup_schedule": []interface{}{
+						map[string]interface{}{"selected_days": []interface{}{"Sunday", "Wednesday"}, "retention": retention, "target_repository_id": "repo-1"},
+					},
+				},
+			},
+		},
+		"monthly on a day": {
+			"monthly_schedule": []interface{}{
+				map[string]interface{}{
+					"start_time":   1,
+					"type":         "SelectedDay",
+					"day_of_month": 15,
+					"snapshot_schedule": []interface{}{
+						map[string]interface{}{"selected_months": []interface{}{"March"}, "snapshots_to_keep": 1},
+					},
+				},
+			},
+		},
+		"last days": {
+			"monthly_schedule": []interface{}{
+				map[string]interface{}{"type": "Last", "monthly_last_day": true},
+			},
+			"yearly_schedule": []interface{}{
+				map[string]interface{}{"type": "Last", "month": "June", "yearly_last_day": true, "retention_years_count": 1},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, testSchema(), raw)
+			daily := ExpandDailySchedule(d.Get("daily_schedule").([]interface{}))
+			weekly := ExpandWeeklySchedule(d.Get("weekly_schedule").([]interface{}))
+			monthly := ExpandMonthlySchedule(d.Get("monthly_schedule").([]interface{}))
+			yearly := ExpandYearlySchedule(d.Get("yearly_schedule").([]interface{}))
+
+			flattened := schema.TestResourceDataRaw(t, testSchema(), map[string]interface{}{})
+			for key, value := range map[string][]interface{}{
+				"daily_schedule":   FlattenDailySchedule(daily),
+				"weekly_schedule":  FlattenWeeklySchedule(weekly),
+				"monthly_schedule": FlattenMonthlySchedule(monthly),
+				"yearly_schedule":  FlattenYearlySchedule(yearly),
+			} {
+				if err := flattened.Set(key, value); err != nil {
+					t.Fatalf("setting %s: %s", key, err)
+				}
+			}
+
+			if got := ExpandDailySchedule(flattened.Get("daily_schedule").([]interface{})); !reflect.DeepEqual(got, daily) {
+				t.Errorf("daily schedule round trip:\n got %+v\nwant %+v", got, daily)
+			}
+			if got := ExpandWeeklySchedule(flattened.Get("weekly_schedule").([]interface{})); !reflect.DeepEqual(got, weekly) {
+				t.Errorf("weekly schedule round trip:\n got %+v\nwant %+v", got, weekly)
+			}
+			if got := ExpandMonthlySchedule(flattened.Get("monthly_schedule").([]interface{})); !reflect.DeepEqual(got, monthly) {
+				t.Errorf("monthly schedule round trip:\n got %+v\nwant %+v", got, monthly)
+			}
+			if got := ExpandYearlySchedule(flattened.Get("yearly_schedule").([]interface{})); !reflect.DeepEqual(got, yearly) {
+				t.Errorf("yearly schedule round trip:\n got %+v\nwant %+v", got, yearly)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	weekDays = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	months   = []string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+	dayTypes = []string{"First", "Second", "Third", "Fourth", "Last", "SelectedDay"}
+)
+
+// DailyScheduleSchema returns the optional daily_schedule block of a backup policy
+func DailyScheduleSchema() *schema.Schema {
+	return block("Specifies the daily snapshot and backup schedule.", map[string]*schema.Schema{
+		"daily_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies the days the policy runs on.",
+			ValidateFunc: validation.StringInSlice([]string{"EveryDay", "Weekdays", "SelectedDays"}, false),
+		},
+		"selected_days": daysSchema("Applies if SelectedDays is specified for daily_type. Specifies the days of the week when the policy runs."),
+		"runs_per_hour": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Specifies the number of snapshots taken per hour.",
+			ValidateFunc: validation.IntBetween(1, 24),
+		},
+		"snapshot_schedule": snapshotScheduleSchema("hours"),
+		"backup_schedule":   backupScheduleSchema("hours"),
+	})
+}
+
+// WeeklyScheduleSchema returns the optional weekly_schedule block of a backup policy
+func WeeklyScheduleSchema() *schema.Schema {
+	return block("Specifies the weekly snapshot and backup schedule.", map[string]*schema.Schema{
+		"start_time":        startTimeSchema(),
+		"snapshot_schedule": snapshotScheduleSchema("selected_days"),
+		"backup_schedule":   backupScheduleSchema("selected_days"),
+	})
+}
+
+// MonthlyScheduleSchema returns the optional monthly_schedule block of a backup policy
+func MonthlyScheduleSchema() *schema.Schema {
+	return block("Specifies the monthly snapshot and backup schedule.", map[string]*schema.Schema{
+		"start_time": startTimeSchema(),
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies the week of the month, or SelectedDay to run on day_of_month.",
+			ValidateFunc: validation.StringInSlice(dayTypes, false),
+		},
+		"day_of_week": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Applies if one of the First, Second, Third, Fourth or Last values is specified for type. Specifies the day of the week when the policy runs.",
+			ValidateFunc: validation.StringInSlice(weekDays, false),
+		},
+		"day_of_month": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Applies if SelectedDay is specified for type. Specifies the day of the month when the policy runs.",
+			ValidateFunc: validation.IntBetween(1, 31),
+		},
+		"monthly_last_day": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Defines whether the policy runs on the last day of the month.",
+		},
+		"snapshot_schedule": snapshotScheduleSchema("selected_months"),
+		"backup_schedule":   backupScheduleSchema("selected_months"),
+	})
+}
+
+// YearlyScheduleSchema returns the optional yearly_schedule block of a backup policy
+func YearlyScheduleSchema() *schema.Schema {
+	return block("Specifies the yearly backup schedule.", map[string]*schema.Schema{
+		"start_time": startTimeSchema(),
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies the week of the month, or SelectedDay to run on day_of_month.",
+			ValidateFunc: validation.StringInSlice(dayTypes, false),
+		},
+		"month": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies the month when the policy runs.",
+			ValidateFunc: validation.StringInSlice(months, false),
+		},
+		"day_of_week": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Applies if one of the First, Second, Third, Fourth or Last values is specified for type. Specifies the day of the week when the policy runs.",
+			ValidateFunc: validation.StringInSlice(weekDays, false),
+		},
+		"day_of_month": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Applies if SelectedDay is specified for type. Specifies the day of the month when the policy runs.",
+			ValidateFunc: validation.IntBetween(1, 31),
+		},
+		"yearly_last_day": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Defines whether the policy runs on the last day of the month.",
+		},
+		"retention_years_count": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			Description:  "Specifies the number of years to keep yearly backups.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"target_repository_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "Specifies the ID of the repository that stores yearly backups.",
+		},
+	})
+}
+
+// block returns an optional block holding a single item with the given attributes
+func block(description string, attributes map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem:        &schema.Resource{Schema: attributes},
+	}
+}
+
+func startTimeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Specifies the hour of the day (0-23) when the policy runs.",
+		ValidateFunc: validation.IntBetween(0, 23),
+	}
+}
+
+func daysSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(weekDays, false),
+		},
+	}
+}
+
+// selectorSchema returns the attribute that selects when a snapshot or backup is created: the
+// hours of the day, the days of the week or the months of the year
+func selectorSchema(selector string) *schema.Schema {
+	switch selector {
+	case "hours":
+		return &schema.Schema{
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Specifies the hours of the day (0-23) when the policy runs.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+		}
+	case "selected_days":
+		s := daysSchema("Specifies the days of the week when the policy runs.")
+		s.Optional, s.Required = false, true
+		return s
+	default:
+		return &schema.Schema{
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Specifies the months when the policy runs.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(months, false),
+			},
+		}
+	}
+}
+
+func snapshotScheduleSchema(selector string) *schema.Schema {
+	return block("Specifies when snapshots are taken and how many of them are kept.", map[string]*schema.Schema{
+		selector: selectorSchema(selector),
+		"snapshots_to_keep": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			Description:  "Specifies the number of snapshots to keep.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	})
+}
+
+func backupScheduleSchema(selector string) *schema.Schema {
+	return block("Specifies when backups are created, where they are stored and how long they are kept.", map[string]*schema.Schema{
+		selector: selectorSchema(selector),
+		"retention": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "Specifies how long backups are kept.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time_retention_duration": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						Description:  "Specifies the number of days, months or years to keep backups.",
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"retention_duration_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "Specifies the unit of time_retention_duration.",
+						ValidateFunc: validation.StringInSlice([]string{"Days", "Months", "Years"}, false),
+					},
+				},
+			},
+		},
+		"target_repository_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+			Description:  "Specifies the ID of the repository that stores the backups.",
+		},
+	})
+}
@@ -0,0 +1,66 @@
+// Package policy holds the daily, weekly, monthly and yearly snapshot and backup schedules and the
+// retention settings that the Veeam Backup for Azure and Google Cloud appliances use in their
+// backup policies, together with the schemas, expanders and flatteners of the schedule blocks.
+package policy
+
+// DailySchedule takes snapshots and backups at selected hours of every, working or selected day
+type DailySchedule struct {
+	DailyType        *string           `json:"dailyType,omitempty"`
+	SelectedDays     []string          `json:"selectedDays,omitempty"`
+	RunsPerHour      *int              `json:"runsPerHour,omitempty"`
+	SnapshotSchedule *SnapshotSchedule `json:"snapshotSchedule,omitempty"`
+	BackupSchedule   *BackupSchedule   `json:"backupSchedule,omitempty"`
+}
+
+// WeeklySchedule takes snapshots and backups on selected days of the week
+type WeeklySchedule struct {
+	StartTime        *int              `json:"startTime,omitempty"`
+	SnapshotSchedule *SnapshotSchedule `json:"snapshotSchedule,omitempty"`
+	BackupSchedule   *BackupSchedule   `json:"backupSchedule,omitempty"`
+}
+
+// MonthlySchedule takes snapshots and backups once a month in selected months
+type MonthlySchedule struct {
+	StartTime        *int              `json:"startTime,omitempty"`
+	Type             *string           `json:"type,omitempty"`
+	DayOfWeek        *string           `json:"dayOfWeek,omitempty"`
+	DayOfMonth       *int              `json:"dayOfMonth,omitempty"`
+	MonthlyLastDay   *bool             `json:"monthlyLastDay,omitempty"`
+	SnapshotSchedule *SnapshotSchedule `json:"snapshotSchedule,omitempty"`
+	BackupSchedule   *BackupSchedule   `json:"backupSchedule,omitempty"`
+}
+
+// YearlySchedule takes a backup once a year, kept for RetentionYearsCount years
+type YearlySchedule struct {
+	StartTime           *int    `json:"startTime,omitempty"`
+	Type                *string `json:"type,omitempty"`
+	Month               *string `json:"month,omitempty"`
+	DayOfWeek           *string `json:"dayOfWeek,omitempty"`
+	DayOfMonth          *int    `json:"dayOfMonth,omitempty"`
+	YearlyLastDay       *bool   `json:"yearlyLastDay,omitempty"`
+	RetentionYearsCount *int    `json:"retentionYearsCount,omitempty"`
+	TargetRepositoryID  *string `json:"targetRepositoryId,omitempty"`
+}
+
+// SnapshotSchedule selects when snapshots are taken and how many of them are kept
+type SnapshotSchedule struct {
+	Hours           []int    `json:"hours,omitempty"`
+	SelectedDays    []string `json:"selectedDays,omitempty"`
+	SelectedMonths  []string `json:"selectedMonths,omitempty"`
+	SnapshotsToKeep *int     `json:"snapshotsToKeep,omitempty"`
+}
+
+// BackupSchedule selects when backups are created, where they are stored and how long they are kept
+type BackupSchedule struct {
+	Hours              []int      `json:"hours,omitempty"`
+	SelectedDays       []string   `json:"selectedDays,omitempty"`
+	SelectedMonths     []string   `json:"selectedMonths,omitempty"`
+	Retention          *Retention `json:"retention,omitempty"`
+	TargetRepositoryID *string    `json:"targetRepositoryId,omitempty"`
+}
+
+// Retention keeps backups for TimeRetentionDuration days, months or years
+type Retention struct {
+	TimeRetentionDuration *int    `json:"timeRetentionDuration,omitempty"`
+	RetentionDurationType *string `json:"retentionDurationType,omitempty"`
+}
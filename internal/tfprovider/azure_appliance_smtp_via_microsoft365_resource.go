@@ -0,0 +1,314 @@
+package tfprovider
+
+import (
+	"context"
+	iazure "terraform-provider-veeambackup/internal/azure"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &azureApplianceSMTPMicrosoft365Resource{}
+var _ resource.ResourceWithConfigure = &azureApplianceSMTPMicrosoft365Resource{}
+var _ resource.ResourceWithImportState = &azureApplianceSMTPMicrosoft365Resource{}
+
+// azureApplianceSMTPID is the ID of the email settings, of which every appliance has one
+const azureApplianceSMTPID = "email"
+
+type azureApplianceSMTPMicrosoft365Resource struct {
+	client *vc.AzureBackupClient
+}
+
+type azureApplianceSMTPMicrosoft365ResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	Enabled               types.Bool     `tfsdk:"enabled"`
+	FromAddress           types.String   `tfsdk:"from_address"`
+	SMTPServer            types.String   `tfsdk:"smtp_server"`
+	Port                  types.Int64    `tfsdk:"port"`
+	TenantID              types.String   `tfsdk:"tenant_id"`
+	ClientID              types.String   `tfsdk:"client_id"`
+	ClientSecret          types.String   `tfsdk:"client_secret"`
+	ClientSecretWO        types.String   `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion types.Int64    `tfsdk:"client_secret_wo_version"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewAzureApplianceSMTPMicrosoft365Resource() resource.Resource {
+	return &azureApplianceSMTPMicrosoft365Resource{}
+}
+
+func (r *azureApplianceSMTPMicrosoft365Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_appliance_smtp_via_microsoft365"
+}
+
+func (r *azureApplianceSMTPMicrosoft365Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Makes a Veeam Backup for Microsoft Azure appliance send email notifications through Exchange Online with modern authentication (OAuth 2.0), " +
+			"signing in as a Microsoft Entra application instead of with a user name and password. " +
+			"The application needs the `SMTP.SendAsApp` permission and an Exchange Online service principal allowed to send as `from_address`. " +
+			"The appliance has a single set of email settings, so only one instance of this resource may exist per appliance. " +
+			"The notification recipients keep the values set in the console.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `email`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the appliance sends email notifications. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"from_address": schema.StringAttribute{
+				MarkdownDescription: "The mailbox notifications are sent from.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"smtp_server": schema.StringAttribute{
+				MarkdownDescription: "The Exchange Online SMTP server. Defaults to `smtp.office365.com`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("smtp.office365.com"),
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port of the SMTP server. Defaults to `587`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(587),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Microsoft Entra tenant of the application.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The application (client) ID of the Microsoft Entra application.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The client secret of the application. The API does not return it, so it is kept as configured. Exactly one of `client_secret` and `client_secret_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("client_secret_wo")),
+				},
+			},
+			"client_secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `client_secret` that is never stored in state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"client_secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to send an updated `client_secret_wo` to the appliance.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("client_secret_wo")),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *azureApplianceSMTPMicrosoft365Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureAzureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *azureApplianceSMTPMicrosoft365Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan azureApplianceSMTPMicrosoft365ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, req.Config, &resp.State, &resp.Diagnostics)
+}
+
+func (r *azureApplianceSMTPMicrosoft365Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state azureApplianceSMTPMicrosoft365ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	settings, err := iazure.GetMicrosoft365EmailSettings(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Azure appliance email settings", err.Error())
+		return
+	}
+	// The settings were switched to another authentication type outside Terraform
+	if settings == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setFromAPI(settings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *azureApplianceSMTPMicrosoft365Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan azureApplianceSMTPMicrosoft365ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, req.Config, &resp.State, &resp.Diagnostics)
+}
+
+// Delete disables email notifications and removes the application from the email settings
+func (r *azureApplianceSMTPMicrosoft365Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state azureApplianceSMTPMicrosoft365ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := iazure.DisableMicrosoft365EmailSettings(ctx, r.client); err != nil {
+		resp.Diagnostics.AddError("Failed to disable Azure appliance email notifications", err.Error())
+	}
+}
+
+// ImportState imports the email settings of the appliance whatever the given ID. The client
+// secret is not returned by the API, so the next apply sends the configured one.
+func (r *azureApplianceSMTPMicrosoft365Resource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), azureApplianceSMTPID)...)
+}
+
+// apply writes the planned settings and stores the settings read back in state
+func (r *azureApplianceSMTPMicrosoft365Resource) apply(ctx context.Context, plan *azureApplianceSMTPMicrosoft365ResourceModel, config tfsdk.Config, state *tfsdk.State, diags *diag.Diagnostics) {
+	// Write-only values are only present in the configuration, never in the plan
+	var clientSecretWO types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("client_secret_wo"), &clientSecretWO)...)
+	if diags.HasError() {
+		return
+	}
+	secret := plan.ClientSecret.ValueString()
+	if !clientSecretWO.IsNull() {
+		secret = clientSecretWO.ValueString()
+	}
+
+	err := iazure.UpdateMicrosoft365EmailSettings(ctx, r.client, iazure.Microsoft365EmailSettings{
+		Enabled:      plan.Enabled.ValueBool(),
+		FromAddress:  plan.FromAddress.ValueString(),
+		SMTPServer:   plan.SMTPServer.ValueString(),
+		Port:         int(plan.Port.ValueInt64()),
+		TenantID:     plan.TenantID.ValueString(),
+		ClientID:     plan.ClientID.ValueString(),
+		ClientSecret: secret,
+	})
+	if err != nil {
+		diags.AddError("Failed to update Azure appliance email settings", err.Error())
+		return
+	}
+
+	settings, err := iazure.GetMicrosoft365EmailSettings(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read Azure appliance email settings", err.Error())
+		return
+	}
+	if settings == nil {
+		diags.AddError("Failed to update Azure appliance email settings",
+			"The appliance did not keep Microsoft 365 authentication for email notifications.")
+		return
+	}
+
+	plan.setFromAPI(settings)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the email settings returned by the API into the model, keeping the client
+// secret, which the API does not return
+func (m *azureApplianceSMTPMicrosoft365ResourceModel) setFromAPI(settings *iazure.Microsoft365EmailSettings) {
+	m.ID = types.StringValue(azureApplianceSMTPID)
+	m.Enabled = types.BoolValue(settings.Enabled)
+	m.FromAddress = types.StringValue(settings.FromAddress)
+	m.SMTPServer = types.StringValue(settings.SMTPServer)
+	m.Port = types.Int64Value(int64(settings.Port))
+	m.TenantID = types.StringValue(settings.TenantID)
+	m.ClientID = types.StringValue(settings.ClientID)
+	m.ClientSecretWO = types.StringNull()
+}
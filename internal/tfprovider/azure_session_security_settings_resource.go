@@ -0,0 +1,241 @@
+package tfprovider
+
+import (
+	"context"
+	"regexp"
+	iazure "terraform-provider-veeambackup/internal/azure"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &azureSessionSecuritySettingsResource{}
+var _ resource.ResourceWithConfigure = &azureSessionSecuritySettingsResource{}
+var _ resource.ResourceWithImportState = &azureSessionSecuritySettingsResource{}
+
+// azureSessionSecuritySettingsID is the ID of the security settings, of which every appliance has
+// one
+const azureSessionSecuritySettingsID = "security"
+
+// corsOriginPattern matches a browser origin: a scheme and host with an optional port, but no path
+var corsOriginPattern = regexp.MustCompile(`^https?://[^/\s:]+(:[0-9]{1,5})?$`)
+
+type azureSessionSecuritySettingsResource struct {
+	client *vc.AzureBackupClient
+}
+
+type azureSessionSecuritySettingsResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	SessionTimeoutMinutes    types.Int64    `tfsdk:"session_timeout_minutes"`
+	MFARequiredForLocalUsers types.Bool     `tfsdk:"mfa_required_for_local_users"`
+	AllowedCORSOrigins       types.Set      `tfsdk:"allowed_cors_origins"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewAzureSessionSecuritySettingsResource() resource.Resource {
+	return &azureSessionSecuritySettingsResource{}
+}
+
+func (r *azureSessionSecuritySettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_session_security_settings"
+}
+
+func (r *azureSessionSecuritySettingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the session and sign-in security settings of a Veeam Backup for Microsoft Azure appliance. " +
+			"The appliance has a single set of these settings, so only one instance of this resource may exist per appliance. " +
+			"Only the configured settings are managed; the others keep the values set in the console.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `security`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"session_timeout_minutes": schema.Int64Attribute{
+				MarkdownDescription: "The number of minutes of inactivity after which users are signed out of the appliance portal.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1440),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"mfa_required_for_local_users": schema.BoolAttribute{
+				MarkdownDescription: "Whether every local user must sign in with multi-factor authentication. Users without MFA set up are asked to set it up at their next sign-in.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_cors_origins": schema.SetAttribute{
+				MarkdownDescription: "The origins, such as `https://portal.example.com`, from which browsers may call the REST API of the appliance besides the appliance itself. An empty set allows no other origin.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(corsOriginPattern,
+						"must be an origin: http:// or https:// followed by a host name and optional port, without a path")),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *azureSessionSecuritySettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureAzureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *azureSessionSecuritySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config azureSessionSecuritySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, config, &resp.State, &resp.Diagnostics)
+}
+
+func (r *azureSessionSecuritySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state azureSessionSecuritySettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	settings, err := iazure.GetSecuritySettings(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Azure appliance security settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromAPI(ctx, settings)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *azureSessionSecuritySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config azureSessionSecuritySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, config, &resp.State, &resp.Diagnostics)
+}
+
+// Delete only removes the settings from state: the appliance always has security settings, which
+// keep their last values
+func (r *azureSessionSecuritySettingsResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ImportState imports the security settings of the appliance whatever the given ID
+func (r *azureSessionSecuritySettingsResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), azureSessionSecuritySettingsID)...)
+}
+
+// apply writes the configured settings and stores the settings read back in state. Only
+// configured settings are written, so that settings left to the console are not overwritten.
+func (r *azureSessionSecuritySettingsResource) apply(ctx context.Context, plan *azureSessionSecuritySettingsResourceModel, config azureSessionSecuritySettingsResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings := iazure.SecuritySettings{
+		SessionTimeoutMinutes:    optionalIntValue(config.SessionTimeoutMinutes),
+		MFARequiredForLocalUsers: optionalBoolValue(config.MFARequiredForLocalUsers),
+	}
+	if !config.AllowedCORSOrigins.IsNull() && !config.AllowedCORSOrigins.IsUnknown() {
+		settings.AllowedCORSOrigins = []string{}
+		diags.Append(config.AllowedCORSOrigins.ElementsAs(ctx, &settings.AllowedCORSOrigins, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+	if err := iazure.UpdateSecuritySettings(ctx, r.client, settings); err != nil {
+		diags.AddError("Failed to update Azure appliance security settings", err.Error())
+		return
+	}
+
+	current, err := iazure.GetSecuritySettings(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read Azure appliance security settings", err.Error())
+		return
+	}
+
+	diags.Append(plan.setFromAPI(ctx, current)...)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the security settings returned by the API into the model
+func (m *azureSessionSecuritySettingsResourceModel) setFromAPI(ctx context.Context, settings *iazure.SecuritySettings) diag.Diagnostics {
+	m.ID = types.StringValue(azureSessionSecuritySettingsID)
+	m.SessionTimeoutMinutes = int64PointerValue(settings.SessionTimeoutMinutes)
+	m.MFARequiredForLocalUsers = types.BoolPointerValue(settings.MFARequiredForLocalUsers)
+	origins, diags := types.SetValueFrom(ctx, types.StringType, settings.AllowedCORSOrigins)
+	m.AllowedCORSOrigins = origins
+	return diags
+}
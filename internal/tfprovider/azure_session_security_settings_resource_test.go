@@ -0,0 +1,18 @@
+package tfprovider
+
+import "testing"
+
+func TestCORSOriginPattern(t *testing.T) {
+	for origin, valid := range map[string]bool{
+		"https://portal.example.com":      true,
+		"http://localhost:8080":           true,
+		"https://portal.example.com/":     false,
+		"https://portal.example.com/path": false,
+		"portal.example.com":              false,
+		"*":                               false,
+	} {
+		if got := corsOriginPattern.MatchString(origin); got != valid {
+			t.Errorf("corsOriginPattern.MatchString(%q) = %t, want %t", origin, got, valid)
+		}
+	}
+}
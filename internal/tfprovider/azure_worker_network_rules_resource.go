@@ -0,0 +1,353 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	iazure "terraform-provider-veeambackup/internal/azure"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &azureWorkerNetworkRulesResource{}
+var _ resource.ResourceWithConfigure = &azureWorkerNetworkRulesResource{}
+var _ resource.ResourceWithImportState = &azureWorkerNetworkRulesResource{}
+var _ resource.ResourceWithValidateConfig = &azureWorkerNetworkRulesResource{}
+
+// azureWorkerNetworkRulesID is the ID of the worker network rules, of which every appliance has one
+// set
+const azureWorkerNetworkRulesID = "worker_network"
+
+var (
+	// azureVirtualNetworkIDPattern matches the Azure resource ID of a virtual network
+	azureVirtualNetworkIDPattern = regexp.MustCompile(`(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.Network/virtualNetworks/[^/]+$`)
+	// azureNetworkSecurityGroupIDPattern matches the Azure resource ID of a network security group
+	azureNetworkSecurityGroupIDPattern = regexp.MustCompile(`(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.Network/networkSecurityGroups/[^/]+$`)
+)
+
+type azureWorkerNetworkRulesResource struct {
+	client *vc.AzureBackupClient
+}
+
+type azureWorkerNetworkRulesResourceModel struct {
+	ID                      types.String   `tfsdk:"id"`
+	RequireAllPolicyRegions types.Bool     `tfsdk:"require_all_policy_regions"`
+	Rules                   types.Set      `tfsdk:"rule"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+type azureWorkerNetworkRuleModel struct {
+	Region                 types.String `tfsdk:"region"`
+	VirtualNetworkID       types.String `tfsdk:"virtual_network_id"`
+	SubnetName             types.String `tfsdk:"subnet_name"`
+	NetworkSecurityGroupID types.String `tfsdk:"network_security_group_id"`
+}
+
+var azureWorkerNetworkRuleType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"region":                    types.StringType,
+	"virtual_network_id":        types.StringType,
+	"subnet_name":               types.StringType,
+	"network_security_group_id": types.StringType,
+}}
+
+func NewAzureWorkerNetworkRulesResource() resource.Resource {
+	return &azureWorkerNetworkRulesResource{}
+}
+
+func (r *azureWorkerNetworkRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_worker_network_rules"
+}
+
+func (r *azureWorkerNetworkRulesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the virtual network, subnet and network security group that a Veeam Backup for Microsoft Azure appliance deploys worker instances to in each region, for deployments where workers must stay on private networks. " +
+			"The appliance has a single set of these rules, so only one instance of this resource may exist per appliance. " +
+			"Regions without a rule get workers in a network the appliance creates.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `worker_network`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"require_all_policy_regions": schema.BoolAttribute{
+				MarkdownDescription: "Whether applying fails when a region protected by a backup policy of the appliance has no rule, so that no worker is deployed outside the configured networks. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.SetNestedBlock{
+				MarkdownDescription: "The network of the workers in a region.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							MarkdownDescription: "The Azure region, e.g. `westeurope`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"virtual_network_id": schema.StringAttribute{
+							MarkdownDescription: "The Azure resource ID of the virtual network, which must be in the region.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(azureVirtualNetworkIDPattern, "must be the Azure resource ID of a virtual network"),
+							},
+						},
+						"subnet_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the subnet of the virtual network.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"network_security_group_id": schema.StringAttribute{
+							MarkdownDescription: "The Azure resource ID of a network security group attached to the network interfaces of the workers.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(azureNetworkSecurityGroupIDPattern, "must be the Azure resource ID of a network security group"),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *azureWorkerNetworkRulesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureAzureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig rejects regions with more than one rule
+func (r *azureWorkerNetworkRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config azureWorkerNetworkRulesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Rules.IsUnknown() {
+		return
+	}
+
+	var rules []azureWorkerNetworkRuleModel
+	resp.Diagnostics.Append(config.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateWorkerNetworkRules(rules)...)
+}
+
+// validateWorkerNetworkRules checks that every region has at most one rule. Region names are
+// compared regardless of case, as Azure does.
+func validateWorkerNetworkRules(rules []azureWorkerNetworkRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	seen := map[string]bool{}
+	for _, rule := range rules {
+		if rule.Region.IsUnknown() || rule.Region.IsNull() {
+			continue
+		}
+		region := strings.ToLower(rule.Region.ValueString())
+		if seen[region] {
+			diags.AddAttributeError(path.Root("rule"), "Duplicate worker network region",
+				fmt.Sprintf("Region %q has more than one rule; workers of a region use a single network.", rule.Region.ValueString()))
+		}
+		seen[region] = true
+	}
+	return diags
+}
+
+func (r *azureWorkerNetworkRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan azureWorkerNetworkRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *azureWorkerNetworkRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state azureWorkerNetworkRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	rules, err := iazure.GetWorkerNetworkRules(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Azure worker network rules", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromAPI(ctx, rules)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *azureWorkerNetworkRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan azureWorkerNetworkRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete removes every rule, so that the appliance deploys workers to the networks it creates
+func (r *azureWorkerNetworkRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state azureWorkerNetworkRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		azureClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := iazure.SetWorkerNetworkRules(ctx, r.client, nil); err != nil {
+		resp.Diagnostics.AddError("Failed to remove Azure worker network rules", err.Error())
+	}
+}
+
+// ImportState imports the worker network rules of the appliance whatever the given ID
+func (r *azureWorkerNetworkRulesResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), azureWorkerNetworkRulesID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("require_all_policy_regions"), true)...)
+}
+
+// apply checks that the rules cover every policy region when required, replaces the rules of the
+// appliance and stores the rules read back in state
+func (r *azureWorkerNetworkRulesResource) apply(ctx context.Context, plan *azureWorkerNetworkRulesResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	var models []azureWorkerNetworkRuleModel
+	diags.Append(plan.Rules.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return
+	}
+	rules := make([]iazure.WorkerNetworkRule, 0, len(models))
+	for _, m := range models {
+		rules = append(rules, iazure.WorkerNetworkRule{
+			RegionID:               m.Region.ValueString(),
+			VirtualNetworkID:       m.VirtualNetworkID.ValueString(),
+			SubnetName:             m.SubnetName.ValueString(),
+			NetworkSecurityGroupID: optionalStringValue(m.NetworkSecurityGroupID),
+		})
+	}
+
+	if plan.RequireAllPolicyRegions.ValueBool() {
+		missing, err := iazure.PolicyRegionsWithoutWorkerNetwork(ctx, r.client, rules)
+		if err != nil {
+			diags.AddError("Failed to read Azure backup policy regions", err.Error())
+			return
+		}
+		if len(missing) > 0 {
+			diags.AddAttributeError(path.Root("rule"), "Policy regions without worker network",
+				fmt.Sprintf("Backup policies protect regions without a worker network rule: %s. Add a rule for each of them, or set require_all_policy_regions to false to let the appliance create networks there.",
+					strings.Join(missing, ", ")))
+			return
+		}
+	}
+
+	if err := iazure.SetWorkerNetworkRules(ctx, r.client, rules); err != nil {
+		diags.AddError("Failed to update Azure worker network rules", err.Error())
+		return
+	}
+
+	current, err := iazure.GetWorkerNetworkRules(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read Azure worker network rules", err.Error())
+		return
+	}
+
+	diags.Append(plan.setFromAPI(ctx, current)...)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the worker network rules returned by the API into the model
+func (m *azureWorkerNetworkRulesResourceModel) setFromAPI(ctx context.Context, rules []iazure.WorkerNetworkRule) diag.Diagnostics {
+	sort.Slice(rules, func(i, j int) bool { return rules[i].RegionID < rules[j].RegionID })
+	models := make([]azureWorkerNetworkRuleModel, 0, len(rules))
+	for _, rule := range rules {
+		models = append(models, azureWorkerNetworkRuleModel{
+			Region:                 types.StringValue(rule.RegionID),
+			VirtualNetworkID:       types.StringValue(rule.VirtualNetworkID),
+			SubnetName:             types.StringValue(rule.SubnetName),
+			NetworkSecurityGroupID: types.StringPointerValue(rule.NetworkSecurityGroupID),
+		})
+	}
+
+	m.ID = types.StringValue(azureWorkerNetworkRulesID)
+	set, diags := types.SetValueFrom(ctx, azureWorkerNetworkRuleType, models)
+	m.Rules = set
+	return diags
+}
@@ -0,0 +1,30 @@
+package tfprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateWorkerNetworkRules(t *testing.T) {
+	rule := func(region string) azureWorkerNetworkRuleModel {
+		return azureWorkerNetworkRuleModel{Region: types.StringValue(region)}
+	}
+	tests := []struct {
+		name   string
+		rules  []azureWorkerNetworkRuleModel
+		errors int
+	}{
+		{"one rule per region", []azureWorkerNetworkRuleModel{rule("westeurope"), rule("northeurope")}, 0},
+		{"duplicate region", []azureWorkerNetworkRuleModel{rule("westeurope"), rule("westeurope")}, 1},
+		{"duplicate region in another case", []azureWorkerNetworkRuleModel{rule("westeurope"), rule("WestEurope")}, 1},
+		{"unknown region", []azureWorkerNetworkRuleModel{rule("westeurope"), {Region: types.StringUnknown()}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateWorkerNetworkRules(tt.rules).ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+}
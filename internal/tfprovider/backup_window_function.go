@@ -0,0 +1,70 @@
+package tfprovider
+
+import (
+	"context"
+	"terraform-provider-veeambackup/internal/vbr/schedule"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &backupWindowFunction{}
+
+// backupWindowFunction builds the days of a backup window from readable specs, so that jobs need
+// not spell out 24 hour flags for every day of the week
+type backupWindowFunction struct{}
+
+func NewBackupWindowFunction() function.Function {
+	return &backupWindowFunction{}
+}
+
+func (f *backupWindowFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "backup_window"
+}
+
+func (f *backupWindowFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the days of a VBR backup window",
+		MarkdownDescription: "Builds the days of a VBR backup window from specs such as `Mon-Fri 20:00-06:00`: days, ranges of days or one of `daily`, `weekdays` and `weekends`, followed by comma-separated time intervals on the full hour. " +
+			"Intervals that end before they start run overnight into the next day. " +
+			"Returns one object with the `day` and its `hours` per day of the week, the hours being 24 comma-separated flags, for a `dynamic \"days\"` block of a job's `backup_window`. Days no spec names allow no hours.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "specs",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Days and the time intervals during which jobs may run on them, e.g. `[\"Mon-Fri 20:00-06:00\", \"Sat,Sun 00:00-24:00\"]`.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: vbrBackupWindowDayType,
+		},
+	}
+}
+
+func (f *backupWindowFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var specs []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &specs))
+	if resp.Error != nil {
+		return
+	}
+
+	window, err := schedule.WindowFromSpecs(specs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	days := make([]vbrBackupWindowDayModel, len(window))
+	for i, day := range window {
+		days[i] = vbrBackupWindowDayModel{
+			Day:   types.StringValue(day.Day),
+			Hours: types.StringValue(day.Hours),
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, vbrBackupWindowDayType, days)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, list))
+}
@@ -0,0 +1,62 @@
+package tfprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBackupWindowFunction(t *testing.T) {
+	ctx := context.Background()
+	f := NewBackupWindowFunction()
+
+	var def function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &def)
+	var validate function.DefinitionValidateResponse
+	def.Definition.ValidateImplementation(ctx, function.DefinitionValidateRequest{FuncName: "backup_window"}, &validate)
+	if validate.Diagnostics.HasError() {
+		t.Fatalf("invalid definition: %v", validate.Diagnostics)
+	}
+
+	run := func(specs ...string) (types.List, *function.FuncError) {
+		values := make([]attr.Value, len(specs))
+		for i, spec := range specs {
+			values[i] = types.StringValue(spec)
+		}
+		resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(vbrBackupWindowDayType))}
+		f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+			types.ListValueMust(types.StringType, values),
+		})}, &resp)
+		list, _ := resp.Result.Value().(types.List)
+		return list, resp.Error
+	}
+
+	list, funcErr := run("Mon-Fri 20:00-06:00")
+	if funcErr != nil {
+		t.Fatalf("backup_window: %s", funcErr)
+	}
+	var days []vbrBackupWindowDayModel
+	if diags := list.ElementsAs(ctx, &days, false); diags.HasError() {
+		t.Fatalf("reading days: %v", diags)
+	}
+	want := map[string]string{
+		"sunday":   "0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0",
+		"monday":   "0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1",
+		"saturday": "1,1,1,1,1,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0",
+	}
+	if len(days) != 7 {
+		t.Fatalf("got %d days, want 7", len(days))
+	}
+	for _, day := range days {
+		if w, ok := want[day.Day.ValueString()]; ok && day.Hours.ValueString() != w {
+			t.Errorf("%s hours = %s, want %s", day.Day.ValueString(), day.Hours.ValueString(), w)
+		}
+	}
+
+	if _, funcErr := run("Mon-Fri 20:30-06:00"); funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+		t.Errorf("backup_window with an interval off the full hour: got %v, want an error on argument 0", funcErr)
+	}
+}
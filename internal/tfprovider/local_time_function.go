@@ -0,0 +1,51 @@
+package tfprovider
+
+import (
+	"context"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &localTimeFunction{}
+
+// localTimeFunction normalizes a time of day for the local_time attributes of schedules
+type localTimeFunction struct{}
+
+func NewLocalTimeFunction() function.Function {
+	return &localTimeFunction{}
+}
+
+func (f *localTimeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "local_time"
+}
+
+func (f *localTimeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalizes a time of day for schedules",
+		MarkdownDescription: "Returns a time of day such as `22:00:00`, `9:30` or `10 PM` in the `hh:mm` format the `local_time` attributes of schedules take, with seconds only when they are not zero. " +
+			"Fails on anything that is not a time of day, so that mistakes surface at plan time rather than in the Veeam API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "time",
+				MarkdownDescription: "Time of day in 24-hour or 12-hour format, e.g. `22:00` or `10:00 PM`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *localTimeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var s string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &s))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := vc.NormalizeTimeOfDay(s)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalized))
+}
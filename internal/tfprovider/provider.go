@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +13,7 @@ import (
 
 var _ fwprovider.Provider = &muxProvider{}
 var _ fwprovider.ProviderWithActions = &muxProvider{}
+var _ fwprovider.ProviderWithFunctions = &muxProvider{}
 
 type providerMetaSource interface {
 	Meta() interface{}
@@ -36,6 +38,48 @@ func (p *muxProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest,
 
 func (p *muxProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
 	resp.Schema = providerschema.Schema{
+		Attributes: map[string]providerschema.Attribute{
+			"max_retries": providerschema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries for API requests that fail with a transient error (429, 502, 503, 504) (default: 3)",
+			},
+			"retry_wait_min": providerschema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum time in seconds to wait before retrying a failed API request; doubled on every retry (default: 1)",
+			},
+			"retry_wait_max": providerschema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds to wait between retries of a failed API request; a request whose Retry-After header asks for a longer wait fails instead (default: 30)",
+			},
+			"requests_per_second": providerschema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests per second sent to each Veeam service; 0 disables client-side rate limiting (default: 0)",
+			},
+			"max_concurrent_requests": providerschema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests sent at the same time by resources that read or modify many objects, such as veeambackup_vbr_job_set (default: 8)",
+			},
+			"proxy_url": providerschema.StringAttribute{
+				Optional:    true,
+				Description: "URL of an HTTP, HTTPS or SOCKS5 proxy used for all API requests; when unset, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are honored",
+			},
+			"default_description_suffix": providerschema.StringAttribute{
+				Optional:    true,
+				Description: "Text appended to the description of every job and policy the provider creates or updates, e.g. \" (managed by Terraform)\", to identify Terraform-managed objects in the Veeam consoles. The suffix is removed again when reading, so it never shows up as a change. The suffix may contain the variables {terraform_workspace}, taken from TF_WORKSPACE or TFC_WORKSPACE_NAME, and {timestamp}, the UTC time the description is written.",
+			},
+			"read_only": providerschema.BoolAttribute{
+				Optional:    true,
+				Description: "Only read from the Veeam services: resources and actions refuse to create, update or delete anything, so that terraform plan can safely report drift on production appliances (default: false)",
+			},
+			"validate_references": providerschema.BoolAttribute{
+				Optional:    true,
+				Description: "Look up the repositories, proxies, servers, encryption passwords and KMS servers that VBR job resources reference while planning, so that IDs that do not exist on the backup server fail the plan instead of the apply. Each object is looked up once per run, and only for jobs that are created or changed (default: false)",
+			},
+			"endpoint_healthcheck": providerschema.BoolAttribute{
+				Optional:    true,
+				Description: "Sign in to every configured appliance when the provider is configured, so that an unreachable appliance, rejected credentials or an unsupported API version fail before any resource is planned; when false, each appliance is first contacted by the first resource or data source that uses it (default: true)",
+			},
+		},
 		Blocks: map[string]providerschema.Block{
 			"azure": providerschema.ListNestedBlock{
 				Description: "Configuration for Veeam Backup for Azure",
@@ -62,6 +106,19 @@ func (p *muxProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp
 							Optional:    true,
 							Description: "Skip SSL certificate verification (default: false)",
 						},
+						"ca_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the Veeam Backup for Azure server certificate, e.g. for self-signed appliances",
+						},
+						"client_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the Veeam Backup for Azure server for mutual TLS",
+						},
+						"client_key_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+						},
 					},
 				},
 			},
@@ -94,6 +151,150 @@ func (p *muxProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp
 							Optional:    true,
 							Description: "Skip SSL certificate verification (default: false)",
 						},
+						"ca_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the Veeam Backup for AWS server certificate, e.g. for self-signed appliances",
+						},
+						"client_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the Veeam Backup for AWS server for mutual TLS",
+						},
+						"client_key_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+						},
+					},
+				},
+			},
+			"gcp": providerschema.ListNestedBlock{
+				Description: "Configuration for Veeam Backup for Google Cloud",
+				NestedObject: providerschema.NestedBlockObject{
+					Attributes: map[string]providerschema.Attribute{
+						"hostname": providerschema.StringAttribute{
+							Required:    true,
+							Description: "Hostname or IP address of the Veeam Backup for Google Cloud server",
+						},
+						"port": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "Port for Google Cloud REST API (default: 13140)",
+						},
+						"username": providerschema.StringAttribute{
+							Required:    true,
+							Description: "Username for Veeam Backup for Google Cloud authentication",
+						},
+						"password": providerschema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password for Veeam Backup for Google Cloud authentication",
+						},
+						"api_version": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "Google Cloud Backup REST API version (default: 1.4-rev0)",
+						},
+						"insecure_skip_verify": providerschema.BoolAttribute{
+							Optional:    true,
+							Description: "Skip SSL certificate verification (default: false)",
+						},
+						"ca_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the Veeam Backup for Google Cloud server certificate, e.g. for self-signed appliances",
+						},
+						"client_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the Veeam Backup for Google Cloud server for mutual TLS",
+						},
+						"client_key_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+						},
+					},
+				},
+			},
+			"vb365": providerschema.ListNestedBlock{
+				Description: "Configuration for Veeam Backup for Microsoft 365",
+				NestedObject: providerschema.NestedBlockObject{
+					Attributes: map[string]providerschema.Attribute{
+						"hostname": providerschema.StringAttribute{
+							Required:    true,
+							Description: "Hostname or IP address of the Veeam Backup for Microsoft 365 server",
+						},
+						"port": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "Port for VB365 REST API (default: 4443)",
+						},
+						"username": providerschema.StringAttribute{
+							Required:    true,
+							Description: "Username for Veeam Backup for Microsoft 365 authentication",
+						},
+						"password": providerschema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password for Veeam Backup for Microsoft 365 authentication",
+						},
+						"api_version": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "VB365 REST API version used in the request path (default: v8)",
+						},
+						"insecure_skip_verify": providerschema.BoolAttribute{
+							Optional:    true,
+							Description: "Skip SSL certificate verification (default: false)",
+						},
+						"ca_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the Veeam Backup for Microsoft 365 server certificate, e.g. for self-signed appliances",
+						},
+						"client_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the Veeam Backup for Microsoft 365 server for mutual TLS",
+						},
+						"client_key_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+						},
+					},
+				},
+			},
+			"enterprise_manager": providerschema.ListNestedBlock{
+				Description: "Configuration for Veeam Backup Enterprise Manager, which federates multiple VBR servers",
+				NestedObject: providerschema.NestedBlockObject{
+					Attributes: map[string]providerschema.Attribute{
+						"hostname": providerschema.StringAttribute{
+							Required:    true,
+							Description: "Hostname or IP address of the Enterprise Manager server",
+						},
+						"port": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "Port for Enterprise Manager REST API (default: 9398)",
+						},
+						"username": providerschema.StringAttribute{
+							Required:    true,
+							Description: "Username for Enterprise Manager authentication",
+						},
+						"password": providerschema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password for Enterprise Manager authentication",
+						},
+						"insecure_skip_verify": providerschema.BoolAttribute{
+							Optional:    true,
+							Description: "Skip SSL certificate verification (default: false)",
+						},
+						"ca_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the Enterprise Manager server certificate, e.g. for self-signed appliances",
+						},
+						"client_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the Enterprise Manager server for mutual TLS",
+						},
+						"client_key_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+						},
 					},
 				},
 			},
@@ -110,22 +311,50 @@ func (p *muxProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp
 							Description: "Port for VBR REST API (default: 9419)",
 						},
 						"username": providerschema.StringAttribute{
-							Required:    true,
-							Description: "Username for VBR authentication",
+							Optional:    true,
+							Description: "Username for VBR authentication; required unless access_token or authorization_code is set",
 						},
 						"password": providerschema.StringAttribute{
-							Required:    true,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Password for VBR authentication; required unless access_token or authorization_code is set",
+						},
+						"access_token": providerschema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Pre-issued VBR access token used instead of signing in with username and password",
+						},
+						"authorization_code": providerschema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "One-time authorization code issued by POST /api/oauth2/authorization_code, used to sign in without a password",
+						},
+						"mfa_code": providerschema.StringAttribute{
+							Optional:    true,
 							Sensitive:   true,
-							Description: "Password for VBR authentication",
+							Description: "One-time MFA code for VBR accounts with multi-factor authentication enforced",
 						},
 						"api_version": providerschema.StringAttribute{
 							Optional:    true,
-							Description: "VBR REST API version (default: 1.3-rev1)",
+							Description: "VBR REST API version sent in the x-api-version header, e.g. 1.2-rev0; detected from the appliance build when unset",
 						},
 						"insecure_skip_verify": providerschema.BoolAttribute{
 							Optional:    true,
 							Description: "Skip SSL certificate verification (default: false)",
 						},
+						"ca_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the VBR server certificate, e.g. for self-signed appliances",
+						},
+						"client_cert_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the VBR server for mutual TLS",
+						},
+						"client_key_pem": providerschema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+						},
 					},
 				},
 			},
@@ -141,7 +370,25 @@ func (p *muxProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest
 }
 
 func (p *muxProvider) Resources(context.Context) []func() resource.Resource {
-	return nil
+	return []func() resource.Resource{
+		NewVBREncryptionPasswordResource,
+		NewVBRBackupWindowTemplateResource,
+		NewVBRHardenedRepositoryImmutabilitySettingsResource,
+		NewVBRSOBROffloadSettingsResource,
+		NewVBRSyslogSettingsResource,
+		NewVBRBackupIOControlResource,
+		NewVBRDatabaseMaintenanceResource,
+		NewVBRAntivirusIntegrationResource,
+		NewVBRGuestInteractionProxyResource,
+		NewVBRLocationResource,
+		NewVBRLocationAssignmentResource,
+		NewVBRAgentDeploymentResource,
+		NewVBRSureBackupApplicationGroupResource,
+		NewVBRSureBackupVirtualLabResource,
+		NewAzureApplianceSMTPMicrosoft365Resource,
+		NewAzureSessionSecuritySettingsResource,
+		NewAzureWorkerNetworkRulesResource,
+	}
 }
 
 func (p *muxProvider) DataSources(context.Context) []func() datasource.DataSource {
@@ -151,5 +398,14 @@ func (p *muxProvider) DataSources(context.Context) []func() datasource.DataSourc
 func (p *muxProvider) Actions(context.Context) []func() action.Action {
 	return []func() action.Action{
 		NewVBRStartBackupJobAction,
+		NewVBRRepositoryMaintenanceAction,
+		NewVBRBackupEncryptionRotationAction,
 	}
-}
\ No newline at end of file
+}
+
+func (p *muxProvider) Functions(context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewBackupWindowFunction,
+		NewLocalTimeFunction,
+	}
+}
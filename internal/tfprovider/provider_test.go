@@ -0,0 +1,68 @@
+package tfprovider_test
+
+import (
+	"context"
+	"terraform-provider-veeambackup/internal/tfprovider"
+	"terraform-provider-veeambackup/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceSchemas(t *testing.T) {
+	ctx := context.Background()
+	p := tfprovider.New("test", provider.Provider())
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		var metadata fwresource.MetadataResponse
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "veeambackup"}, &metadata)
+
+		var resp fwresource.SchemaResponse
+		r.Schema(ctx, fwresource.SchemaRequest{}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: schema diagnostics: %v", metadata.TypeName, resp.Diagnostics)
+		}
+		if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("%s: invalid schema: %v", metadata.TypeName, diags)
+		}
+	}
+}
+
+// TestMuxServer checks that the SDKv2 and framework providers can be served together, which fails
+// when both declare the same resource or disagree on the provider schema.
+func TestMuxServer(t *testing.T) {
+	ctx := context.Background()
+	primary := provider.Provider()
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		func() tfprotov5.ProviderServer { return schema.NewGRPCProviderServer(primary) },
+		providerserver.NewProtocol5(tfprovider.New("test", primary)),
+	)
+	if err != nil {
+		t.Fatalf("creating mux server: %s", err)
+	}
+
+	resp, err := muxServer.ProviderServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("GetProviderSchema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	if _, ok := resp.ResourceSchemas["veeambackup_vbr_encryption_password"]; !ok {
+		t.Errorf("framework resource veeambackup_vbr_encryption_password is missing from the muxed schema")
+	}
+	for _, name := range []string{"backup_window", "local_time"} {
+		if _, ok := resp.Functions[name]; !ok {
+			t.Errorf("function %s is missing from the muxed schema", name)
+		}
+	}
+}
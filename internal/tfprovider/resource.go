@@ -0,0 +1,68 @@
+package tfprovider
+
+import (
+	"errors"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Default operation timeouts for framework resources, matching the SDKv2 resources
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
+
+// configureVBRClient returns the VBR client from the provider data passed to a resource's
+// Configure method. It returns nil without error while the provider is not yet configured, and
+// when the provider configuration has no vbr block, which is only reported once the resource is
+// actually used.
+func configureVBRClient(providerData interface{}, diags *diag.Diagnostics) *vc.VBRClient {
+	if providerData == nil {
+		return nil
+	}
+
+	client, err := vc.GetVBRClient(providerData)
+	var notConfigured *vc.ClientNotConfiguredError
+	if errors.As(err, &notConfigured) {
+		return nil
+	}
+	if err != nil {
+		diags.AddError("Unexpected Resource Configure Type", err.Error())
+		return nil
+	}
+	return client
+}
+
+// vbrClientNotConfigured reports that a VBR resource was used without a vbr provider block
+func vbrClientNotConfigured(diags *diag.Diagnostics) {
+	diags.AddError("VBR Client Not Configured", (&vc.ClientNotConfiguredError{Block: "vbr"}).Error())
+}
+
+// configureAzureClient returns the VB for Azure client from the provider data passed to a
+// resource's Configure method, like configureVBRClient does for the vbr block
+func configureAzureClient(providerData interface{}, diags *diag.Diagnostics) *vc.AzureBackupClient {
+	if providerData == nil {
+		return nil
+	}
+
+	client, err := vc.GetAzureClient(providerData)
+	var notConfigured *vc.ClientNotConfiguredError
+	if errors.As(err, &notConfigured) {
+		return nil
+	}
+	if err != nil {
+		diags.AddError("Unexpected Resource Configure Type", err.Error())
+		return nil
+	}
+	return client
+}
+
+// azureClientNotConfigured reports that a VB for Azure resource was used without an azure
+// provider block
+func azureClientNotConfigured(diags *diag.Diagnostics) {
+	diags.AddError("Azure Client Not Configured", (&vc.ClientNotConfiguredError{Block: "azure"}).Error())
+}
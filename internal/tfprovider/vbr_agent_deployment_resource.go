@@ -0,0 +1,247 @@
+package tfprovider
+
+import (
+	"context"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrAgentDeploymentResource{}
+var _ resource.ResourceWithConfigure = &vbrAgentDeploymentResource{}
+var _ resource.ResourceWithImportState = &vbrAgentDeploymentResource{}
+var _ resource.ResourceWithValidateConfig = &vbrAgentDeploymentResource{}
+
+// vbrAgentDeploymentID is the ID of the agent deployment settings, of which every backup server
+// has one
+const vbrAgentDeploymentID = "agent_deployment"
+
+type vbrAgentDeploymentResource struct {
+	client *vc.VBRClient
+}
+
+type vbrAgentDeploymentResourceModel struct {
+	ID                      types.String   `tfsdk:"id"`
+	DistributionServerID    types.String   `tfsdk:"distribution_server_id"`
+	PackageCachePath        types.String   `tfsdk:"package_cache_path"`
+	AutomaticUpgradeEnabled types.Bool     `tfsdk:"automatic_upgrade_enabled"`
+	RebootIfRequired        types.Bool     `tfsdk:"reboot_if_required"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewVBRAgentDeploymentResource() resource.Resource {
+	return &vbrAgentDeploymentResource{}
+}
+
+func (r *vbrAgentDeploymentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_agent_deployment"
+}
+
+func (r *vbrAgentDeploymentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	optionalString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	optionalBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages how Veeam Backup & Replication deploys Veeam agents to the computers of protection groups: the distribution server that stores the agent packages, and whether deployed agents are upgraded automatically. " +
+			"The backup server has a single set of these settings, so only one instance of this resource may exist per backup server. " +
+			"Only the configured settings are managed; the others keep the values set in the console.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `agent_deployment`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"distribution_server_id":    optionalString("The ID of the managed Windows server that stores the agent packages and deploys them to protected computers, which offloads the backup server in large or remote sites."),
+			"package_cache_path":        optionalString("The folder on the distribution server where the agent packages are cached, e.g. `D:\\VeeamAgentPackages`."),
+			"automatic_upgrade_enabled": optionalBool("Whether the agents deployed by protection groups are upgraded automatically after the backup server is upgraded."),
+			"reboot_if_required":        optionalBool("Whether automatic upgrades may reboot protected computers when the new agent requires it."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrAgentDeploymentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig rejects reboot settings that would be ignored
+func (r *vbrAgentDeploymentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vbrAgentDeploymentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateAgentDeployment(config)...)
+}
+
+// validateAgentDeployment checks that reboots are only allowed when agents are upgraded
+// automatically
+func validateAgentDeployment(config vbrAgentDeploymentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !config.AutomaticUpgradeEnabled.IsNull() && !config.AutomaticUpgradeEnabled.IsUnknown() &&
+		!config.AutomaticUpgradeEnabled.ValueBool() && config.RebootIfRequired.ValueBool() {
+		diags.AddAttributeError(path.Root("reboot_if_required"), "Unused reboot setting",
+			"reboot_if_required only applies when automatic_upgrade_enabled is true.")
+	}
+	return diags
+}
+
+func (r *vbrAgentDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config vbrAgentDeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, config, &resp.State, &resp.Diagnostics)
+}
+
+func (r *vbrAgentDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrAgentDeploymentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	settings, err := ivbr.GetAgentDeploymentSettings(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read VBR agent deployment settings", err.Error())
+		return
+	}
+
+	state.setFromAPI(settings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrAgentDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config vbrAgentDeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, config, &resp.State, &resp.Diagnostics)
+}
+
+// Delete only removes the settings from state: the backup server always deploys agents somehow,
+// and keeps the last settings
+func (r *vbrAgentDeploymentResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ImportState imports the agent deployment settings of the backup server whatever the given ID
+func (r *vbrAgentDeploymentResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vbrAgentDeploymentID)...)
+}
+
+// apply writes the configured settings and stores the settings read back in state. Only
+// configured settings are written, so that settings left to the console are not overwritten.
+func (r *vbrAgentDeploymentResource) apply(ctx context.Context, plan *vbrAgentDeploymentResourceModel, config vbrAgentDeploymentResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings := ivbr.AgentDeploymentSettings{
+		DistributionServerID:    optionalStringValue(config.DistributionServerID),
+		PackageCachePath:        optionalStringValue(config.PackageCachePath),
+		AutomaticUpgradeEnabled: optionalBoolValue(config.AutomaticUpgradeEnabled),
+		RebootIfRequired:        optionalBoolValue(config.RebootIfRequired),
+	}
+	if err := ivbr.UpdateAgentDeploymentSettings(ctx, r.client, settings); err != nil {
+		diags.AddError("Failed to update VBR agent deployment settings", err.Error())
+		return
+	}
+
+	current, err := ivbr.GetAgentDeploymentSettings(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read VBR agent deployment settings", err.Error())
+		return
+	}
+
+	plan.setFromAPI(current)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the agent deployment settings returned by the API into the model
+func (m *vbrAgentDeploymentResourceModel) setFromAPI(settings *ivbr.AgentDeploymentSettings) {
+	m.ID = types.StringValue(vbrAgentDeploymentID)
+	m.DistributionServerID = types.StringPointerValue(settings.DistributionServerID)
+	m.PackageCachePath = types.StringPointerValue(settings.PackageCachePath)
+	m.AutomaticUpgradeEnabled = types.BoolPointerValue(settings.AutomaticUpgradeEnabled)
+	m.RebootIfRequired = types.BoolPointerValue(settings.RebootIfRequired)
+}
@@ -0,0 +1,39 @@
+package tfprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateAgentDeployment(t *testing.T) {
+	tests := []struct {
+		name   string
+		config vbrAgentDeploymentResourceModel
+		errors int
+	}{
+		{"reboot with automatic upgrade", vbrAgentDeploymentResourceModel{
+			AutomaticUpgradeEnabled: types.BoolValue(true),
+			RebootIfRequired:        types.BoolValue(true),
+		}, 0},
+		{"reboot without automatic upgrade", vbrAgentDeploymentResourceModel{
+			AutomaticUpgradeEnabled: types.BoolValue(false),
+			RebootIfRequired:        types.BoolValue(true),
+		}, 1},
+		{"no reboot without automatic upgrade", vbrAgentDeploymentResourceModel{
+			AutomaticUpgradeEnabled: types.BoolValue(false),
+			RebootIfRequired:        types.BoolValue(false),
+		}, 0},
+		{"automatic upgrade left to the console", vbrAgentDeploymentResourceModel{
+			AutomaticUpgradeEnabled: types.BoolNull(),
+			RebootIfRequired:        types.BoolValue(true),
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateAgentDeployment(tt.config).ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+}
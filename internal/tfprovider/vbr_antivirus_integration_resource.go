@@ -0,0 +1,267 @@
+package tfprovider
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrAntivirusIntegrationResource{}
+var _ resource.ResourceWithConfigure = &vbrAntivirusIntegrationResource{}
+var _ resource.ResourceWithImportState = &vbrAntivirusIntegrationResource{}
+var _ resource.ResourceWithValidateConfig = &vbrAntivirusIntegrationResource{}
+
+// vbrAntivirusIntegrationID is the ID of the antivirus integration, of which every backup server
+// has one
+const vbrAntivirusIntegrationID = "antivirus_integration"
+
+type vbrAntivirusIntegrationResource struct {
+	client *vc.VBRClient
+}
+
+type vbrAntivirusIntegrationResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Scanner         types.String   `tfsdk:"scanner"`
+	CustomConfigXML types.String   `tfsdk:"custom_config_xml"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewVBRAntivirusIntegrationResource() resource.Resource {
+	return &vbrAntivirusIntegrationResource{}
+}
+
+func (r *vbrAntivirusIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_antivirus_integration"
+}
+
+func (r *vbrAntivirusIntegrationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the antivirus software the mount servers of Veeam Backup & Replication scan machines with during secure restore. " +
+			"The backup server has a single antivirus integration, so only one instance of this resource may exist per backup server.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `antivirus_integration`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scanner": schema.StringAttribute{
+				MarkdownDescription: "The antivirus to scan with: `MicrosoftDefender`, `SymantecProtectionEngine`, `ESET`, `Kaspersky`, `Bitdefender`, `Trellix`, " +
+					"or `Custom` for the antivirus described by `custom_config_xml`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ivbr.AntivirusScanners...),
+				},
+			},
+			"custom_config_xml": schema.StringAttribute{
+				MarkdownDescription: "The XML configuration of a custom antivirus, in the format of the `AntivirusInfos.xml` file shipped with VBR, e.g. read with `file()`. " +
+					"Required when `scanner` is `Custom` and not allowed otherwise.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrAntivirusIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig checks that a custom XML configuration is given exactly for the Custom scanner
+func (r *vbrAntivirusIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vbrAntivirusIntegrationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateAntivirusIntegration(config)...)
+}
+
+// validateAntivirusIntegration checks the antivirus integration values that are known
+func validateAntivirusIntegration(config vbrAntivirusIntegrationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if config.Scanner.IsUnknown() || config.CustomConfigXML.IsUnknown() {
+		return diags
+	}
+
+	custom := config.Scanner.ValueString() == ivbr.AntivirusScannerCustom
+	switch {
+	case custom && config.CustomConfigXML.IsNull():
+		diags.AddAttributeError(path.Root("custom_config_xml"), "Missing custom antivirus configuration",
+			"custom_config_xml is required when scanner is Custom.")
+	case !custom && !config.CustomConfigXML.IsNull():
+		diags.AddAttributeError(path.Root("custom_config_xml"), "Unexpected custom antivirus configuration",
+			fmt.Sprintf("custom_config_xml is only used with the Custom scanner, not with %s.", config.Scanner.ValueString()))
+	case custom:
+		if err := checkWellFormedXML(config.CustomConfigXML.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("custom_config_xml"), "Invalid custom antivirus configuration",
+				fmt.Sprintf("custom_config_xml is not well-formed XML: %s", err))
+		}
+	}
+	return diags
+}
+
+// checkWellFormedXML returns an error when document is not a single well-formed XML element
+func checkWellFormedXML(document string) error {
+	decoder := xml.NewDecoder(strings.NewReader(document))
+	depth, roots := 0, 0
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch token.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				roots++
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	if roots != 1 {
+		return fmt.Errorf("found %d root elements, want 1", roots)
+	}
+	return nil
+}
+
+func (r *vbrAntivirusIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vbrAntivirusIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *vbrAntivirusIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrAntivirusIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	integration, err := ivbr.GetAntivirusIntegration(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read VBR antivirus integration", err.Error())
+		return
+	}
+
+	state.setFromAPI(integration)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrAntivirusIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vbrAntivirusIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete only removes the integration from state: secure restore needs an antivirus, so the
+// backup server keeps the last one selected
+func (r *vbrAntivirusIntegrationResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ImportState imports the antivirus integration of the backup server whatever the given ID
+func (r *vbrAntivirusIntegrationResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vbrAntivirusIntegrationID)...)
+}
+
+// apply selects the planned antivirus and stores the integration read back in state. The custom
+// configuration is only written with the Custom scanner, which is the only one that uses it.
+func (r *vbrAntivirusIntegrationResource) apply(ctx context.Context, plan *vbrAntivirusIntegrationResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	integration := ivbr.AntivirusIntegration{
+		Scanner:         plan.Scanner.ValueStringPointer(),
+		CustomConfigXML: plan.CustomConfigXML.ValueStringPointer(),
+	}
+	if err := ivbr.UpdateAntivirusIntegration(ctx, r.client, integration); err != nil {
+		diags.AddError("Failed to update VBR antivirus integration", err.Error())
+		return
+	}
+
+	current, err := ivbr.GetAntivirusIntegration(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read VBR antivirus integration", err.Error())
+		return
+	}
+
+	plan.setFromAPI(current)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the antivirus integration returned by the API into the model
+func (m *vbrAntivirusIntegrationResourceModel) setFromAPI(integration *ivbr.AntivirusIntegration) {
+	m.ID = types.StringValue(vbrAntivirusIntegrationID)
+	m.Scanner = types.StringPointerValue(integration.Scanner)
+	m.CustomConfigXML = types.StringPointerValue(integration.CustomConfigXML)
+}
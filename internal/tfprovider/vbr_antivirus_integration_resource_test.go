@@ -0,0 +1,61 @@
+package tfprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateAntivirusIntegration(t *testing.T) {
+	const customXML = `<?xml version="1.0" encoding="utf-8"?>
+<Antiviruses>
+  <AntivirusInfo Name="ClamAV" IsPortableSoftware="true" ExecutableFilePath="C:\ClamAV\clamscan.exe" CommandLineParameters="%Path%" RegPath="" ServiceName="" ThreatExistsRegEx="FOUND" IsParallelScanAvailable="false">
+    <ExitCodes>
+      <ExitCode Type="Success">0</ExitCode>
+      <ExitCode Type="Infected">1</ExitCode>
+    </ExitCodes>
+  </AntivirusInfo>
+</Antiviruses>`
+
+	tests := []struct {
+		name   string
+		config vbrAntivirusIntegrationResourceModel
+		errors int
+	}{
+		{"built-in scanner", vbrAntivirusIntegrationResourceModel{
+			Scanner:         types.StringValue("MicrosoftDefender"),
+			CustomConfigXML: types.StringNull(),
+		}, 0},
+		{"custom scanner", vbrAntivirusIntegrationResourceModel{
+			Scanner:         types.StringValue("Custom"),
+			CustomConfigXML: types.StringValue(customXML),
+		}, 0},
+		{"custom scanner without configuration", vbrAntivirusIntegrationResourceModel{
+			Scanner:         types.StringValue("Custom"),
+			CustomConfigXML: types.StringNull(),
+		}, 1},
+		{"configuration with a built-in scanner", vbrAntivirusIntegrationResourceModel{
+			Scanner:         types.StringValue("ESET"),
+			CustomConfigXML: types.StringValue(customXML),
+		}, 1},
+		{"malformed configuration", vbrAntivirusIntegrationResourceModel{
+			Scanner:         types.StringValue("Custom"),
+			CustomConfigXML: types.StringValue("<Antiviruses><AntivirusInfo></Antiviruses>"),
+		}, 1},
+		{"two root elements", vbrAntivirusIntegrationResourceModel{
+			Scanner:         types.StringValue("Custom"),
+			CustomConfigXML: types.StringValue("<Antiviruses/><Antiviruses/>"),
+		}, 1},
+		{"configuration not yet known", vbrAntivirusIntegrationResourceModel{
+			Scanner:         types.StringValue("Custom"),
+			CustomConfigXML: types.StringUnknown(),
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateAntivirusIntegration(tt.config).ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+}
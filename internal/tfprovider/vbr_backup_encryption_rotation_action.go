@@ -0,0 +1,218 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &vbrBackupEncryptionRotationAction{}
+var _ action.ActionWithConfigure = &vbrBackupEncryptionRotationAction{}
+var _ action.ActionWithValidateConfig = &vbrBackupEncryptionRotationAction{}
+
+type vbrBackupEncryptionRotationAction struct {
+	client *vc.VBRClient
+}
+
+type vbrBackupEncryptionRotationActionModel struct {
+	OldPasswordID types.String `tfsdk:"old_password_id"`
+	NewPasswordID types.String `tfsdk:"new_password_id"`
+	JobIDs        types.List   `tfsdk:"job_ids"`
+	Rekey         types.Bool   `tfsdk:"rekey"`
+}
+
+func NewVBRBackupEncryptionRotationAction() action.Action {
+	return &vbrBackupEncryptionRotationAction{}
+}
+
+func (a *vbrBackupEncryptionRotationAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_backup_encryption_rotation"
+}
+
+func (a *vbrBackupEncryptionRotationAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		MarkdownDescription: "Rotate the encryption password of Veeam Backup & Replication jobs: every job that encrypts its backups with the old password is changed to the new one, and optionally runs an active full so that its backups are re-keyed.",
+		Attributes: map[string]actionschema.Attribute{
+			"old_password_id": actionschema.StringAttribute{
+				MarkdownDescription: "The ID of the encryption password to rotate away from.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"new_password_id": actionschema.StringAttribute{
+				MarkdownDescription: "The ID of the encryption password to rotate to, e.g. the `id` of a `veeambackup_vbr_encryption_password`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"job_ids": actionschema.ListAttribute{
+				MarkdownDescription: "The IDs of the jobs to rotate. Each must use the old password. Defaults to every job that uses it.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"rekey": actionschema.BoolAttribute{
+				MarkdownDescription: "Whether to start an active full of each rotated job, so that a new backup chain encrypted with the new password is written. " +
+					"Without it, the new password applies from the next active full on. Defaults to false.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *vbrBackupEncryptionRotationAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	a.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (a *vbrBackupEncryptionRotationAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data vbrBackupEncryptionRotationActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateBackupEncryptionRotation(data)...)
+}
+
+// validateBackupEncryptionRotation checks that the passwords differ
+func validateBackupEncryptionRotation(data vbrBackupEncryptionRotationActionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.OldPasswordID.IsUnknown() || data.NewPasswordID.IsUnknown() {
+		return diags
+	}
+	if strings.EqualFold(strings.TrimSpace(data.OldPasswordID.ValueString()), strings.TrimSpace(data.NewPasswordID.ValueString())) {
+		diags.AddAttributeError(path.Root("new_password_id"), "Same encryption password",
+			"new_password_id must be a different encryption password than old_password_id.")
+	}
+	return diags
+}
+
+func (a *vbrBackupEncryptionRotationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data vbrBackupEncryptionRotationActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	var jobIDs []string
+	if !data.JobIDs.IsNull() {
+		resp.Diagnostics.Append(data.JobIDs.ElementsAs(ctx, &jobIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	oldPasswordID := strings.TrimSpace(data.OldPasswordID.ValueString())
+	newPasswordID := strings.TrimSpace(data.NewPasswordID.ValueString())
+
+	// A missing new password would only be reported by the first job update, after the jobs
+	// before it were read
+	if _, err := ivbr.GetEncryptionPassword(ctx, a.client, newPasswordID); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("new_password_id"), "Failed to read VBR encryption password", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("finding jobs that use encryption password %s", oldPasswordID)})
+	jobs, err := ivbr.ListJobsUsingEncryptionPassword(ctx, a.client, oldPasswordID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list VBR jobs", err.Error())
+		return
+	}
+	jobs, diags := selectEncryptedJobs(jobs, jobIDs, oldPasswordID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(jobs) == 0 {
+		resp.Diagnostics.AddWarning("No jobs to rotate", fmt.Sprintf("No VBR job uses encryption password %s.", oldPasswordID))
+		return
+	}
+
+	// Every job is rotated before the first active full starts, so that a failed update leaves no
+	// job re-keyed with a password the other jobs do not use yet
+	var rotated []ivbr.EncryptedJob
+	for _, job := range jobs {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("changing the encryption password of job %q (%s) to %s", job.Name, job.ID, newPasswordID)})
+		if _, err := ivbr.ReplaceJobEncryptionPassword(ctx, a.client, job.ID, oldPasswordID, newPasswordID); err != nil {
+			resp.Diagnostics.AddError("Failed to rotate VBR job encryption password",
+				fmt.Sprintf("Job %q (%s): %s\n\nJobs rotated before the failure: %s", job.Name, job.ID, err, encryptedJobNames(rotated)))
+			return
+		}
+		rotated = append(rotated, job)
+	}
+
+	if data.Rekey.ValueBool() {
+		activeFull := true
+		for _, job := range rotated {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("starting an active full of job %q (%s)", job.Name, job.ID)})
+			if _, err := ivbr.StartBackupJob(ctx, a.client, ivbr.StartBackupJobInput{JobID: job.ID, PerformActiveFull: &activeFull}); err != nil {
+				resp.Diagnostics.AddError("Failed to start VBR active full",
+					fmt.Sprintf("Job %q (%s) uses encryption password %s, but its active full could not be started: %s", job.Name, job.ID, newPasswordID, err))
+			}
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("rotated jobs %s from encryption password %s to %s", encryptedJobNames(rotated), oldPasswordID, newPasswordID)})
+}
+
+// selectEncryptedJobs returns the jobs of jobs with the given IDs, in the order of jobIDs, or every
+// job when jobIDs is empty. Job IDs that are not among jobs, which are the jobs using the old
+// password, are errors.
+func selectEncryptedJobs(jobs []ivbr.EncryptedJob, jobIDs []string, oldPasswordID string) ([]ivbr.EncryptedJob, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(jobIDs) == 0 {
+		return jobs, diags
+	}
+
+	byID := make(map[string]ivbr.EncryptedJob, len(jobs))
+	for _, job := range jobs {
+		byID[strings.ToLower(job.ID)] = job
+	}
+	selected := make([]ivbr.EncryptedJob, 0, len(jobIDs))
+	for i, id := range jobIDs {
+		job, ok := byID[strings.ToLower(strings.TrimSpace(id))]
+		if !ok {
+			diags.AddAttributeError(path.Root("job_ids").AtListIndex(i), "Job does not use the encryption password",
+				fmt.Sprintf("VBR job %s does not exist or does not use encryption password %s.", id, oldPasswordID))
+			continue
+		}
+		selected = append(selected, job)
+	}
+	return selected, diags
+}
+
+func encryptedJobNames(jobs []ivbr.EncryptedJob) string {
+	if len(jobs) == 0 {
+		return "none"
+	}
+	names := make([]string, len(jobs))
+	for i, job := range jobs {
+		names[i] = fmt.Sprintf("%q", job.Name)
+	}
+	return strings.Join(names, ", ")
+}
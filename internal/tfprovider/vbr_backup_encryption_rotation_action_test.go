@@ -0,0 +1,95 @@
+package tfprovider_test
+
+import (
+	"context"
+	"reflect"
+	"terraform-provider-veeambackup/internal/acctest"
+	"terraform-provider-veeambackup/internal/tfprovider"
+	"terraform-provider-veeambackup/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestVBRBackupEncryptionRotationAction(t *testing.T) {
+	ctx := context.Background()
+
+	server := acctest.NewVBRServer(t)
+	server.Collection(acctest.Collection{Path: "/api/v1/jobs"})
+	server.Collection(acctest.Collection{Path: "/api/v1/encryptionPasswords"})
+	server.Put("/api/v1/encryptionPasswords/new", acctest.Object{"id": "new", "hint": "2026"})
+	encryption := func(passwordID string) acctest.Object {
+		return acctest.Object{"storage": acctest.Object{"advancedSettings": acctest.Object{"storageData": acctest.Object{
+			"encryption": acctest.Object{"isEnabled": true, "encryptionType": "ByUserPassword", "encryptionPasswordId": passwordID},
+		}}}}
+	}
+	for id, passwordID := range map[string]string{"vms": "old", "sql": "old", "files": "other"} {
+		job := encryption(passwordID)
+		job["id"], job["name"], job["type"] = id, id, "Backup"
+		server.Put("/api/v1/jobs/"+id, job)
+	}
+	server.Collection(acctest.Collection{Path: "/api/v1/jobs/sql/start"})
+
+	p := provider.Provider()
+	acctest.ConfigureProvider(t, p, acctest.VBRProviderConfig(server))
+
+	a := tfprovider.NewVBRBackupEncryptionRotationAction()
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: p.Meta()}, &action.ConfigureResponse{})
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	invoke := func(jobIDs ...string) action.InvokeResponse {
+		ids := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+		if len(jobIDs) > 0 {
+			var values []tftypes.Value
+			for _, id := range jobIDs {
+				values = append(values, tftypes.NewValue(tftypes.String, id))
+			}
+			ids = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+		}
+		config := tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"old_password_id": tftypes.NewValue(tftypes.String, "old"),
+				"new_password_id": tftypes.NewValue(tftypes.String, "new"),
+				"job_ids":         ids,
+				"rekey":           tftypes.NewValue(tftypes.Bool, true),
+			}),
+		}
+		resp := action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+		a.Invoke(ctx, action.InvokeRequest{Config: config}, &resp)
+		return resp
+	}
+	passwordOf := func(id string) interface{} {
+		job, _ := server.Get("/api/v1/jobs/" + id)
+		return job["storage"].(map[string]interface{})["advancedSettings"].(map[string]interface{})["storageData"].(map[string]interface{})["encryption"].(map[string]interface{})["encryptionPasswordId"]
+	}
+
+	if resp := invoke("sql", "files"); !resp.Diagnostics.HasError() {
+		t.Fatal("rotating a job that does not use the old password succeeded")
+	}
+	if got := passwordOf("sql"); got != "old" {
+		t.Fatalf("job sql uses %v after a failed rotation, want old", got)
+	}
+
+	start := len(server.Requests())
+	if resp := invoke("sql"); resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	var got []string
+	for _, r := range server.Requests()[start:] {
+		if r[:4] == "PUT " || r[:4] == "POST" {
+			got = append(got, r)
+		}
+	}
+	if want := []string{"PUT /api/v1/jobs/sql", "POST /api/v1/jobs/sql/start"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+	for id, want := range map[string]string{"vms": "old", "sql": "new", "files": "other"} {
+		if got := passwordOf(id); got != want {
+			t.Errorf("job %s uses %v, want %s", id, got, want)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrBackupIOControlResource{}
+var _ resource.ResourceWithConfigure = &vbrBackupIOControlResource{}
+var _ resource.ResourceWithImportState = &vbrBackupIOControlResource{}
+var _ resource.ResourceWithValidateConfig = &vbrBackupIOControlResource{}
+
+// vbrBackupIOControlID is the ID of the storage latency control settings, of which every backup
+// server has one
+const vbrBackupIOControlID = "backup_io_control"
+
+type vbrBackupIOControlResource struct {
+	client *vc.VBRClient
+}
+
+type vbrBackupIOControlResourceModel struct {
+	ID                  types.String               `tfsdk:"id"`
+	Enabled             types.Bool                 `tfsdk:"enabled"`
+	LatencyLimitMs      types.Int64                `tfsdk:"latency_limit_ms"`
+	ThrottlingIOLimitMs types.Int64                `tfsdk:"throttling_io_limit_ms"`
+	Datastores          []vbrDatastoreLatencyModel `tfsdk:"datastore"`
+	Timeouts            timeouts.Value             `tfsdk:"timeouts"`
+}
+
+type vbrDatastoreLatencyModel struct {
+	DatastoreID         types.String `tfsdk:"datastore_id"`
+	LatencyLimitMs      types.Int64  `tfsdk:"latency_limit_ms"`
+	ThrottlingIOLimitMs types.Int64  `tfsdk:"throttling_io_limit_ms"`
+}
+
+func NewVBRBackupIOControlResource() resource.Resource {
+	return &vbrBackupIOControlResource{}
+}
+
+func (r *vbrBackupIOControlResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_backup_io_control"
+}
+
+func (r *vbrBackupIOControlResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	limit := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the storage latency control (backup I/O control) of Veeam Backup & Replication, which keeps backup jobs from slowing down production datastores. " +
+			"The backup server has a single set of these settings, so only one instance of this resource may exist per backup server; destroying it disables storage latency control.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `backup_io_control`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether storage latency control is enabled.",
+				Required:            true,
+			},
+			"latency_limit_ms":       limit("The datastore latency in milliseconds above which no new tasks are assigned to the datastore. Defaults to the value set on the backup server, 20 unless changed."),
+			"throttling_io_limit_ms": limit("The datastore latency in milliseconds above which the I/O of running tasks is throttled. Must be greater than `latency_limit_ms`. Defaults to the value set on the backup server, 30 unless changed."),
+		},
+		Blocks: map[string]schema.Block{
+			"datastore": schema.ListNestedBlock{
+				MarkdownDescription: "Latency limits of a single datastore, overriding the global limits. Requires the Enterprise Plus edition. Datastores not listed use the global limits.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"datastore_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the datastore, e.g. the `object_id` of a `Datastore` from `veeambackup_vbr_vmware_inventory`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"latency_limit_ms": schema.Int64Attribute{
+							MarkdownDescription: "The latency in milliseconds above which no new tasks are assigned to the datastore.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"throttling_io_limit_ms": schema.Int64Attribute{
+							MarkdownDescription: "The latency in milliseconds above which the I/O of running tasks on the datastore is throttled. Must be greater than `latency_limit_ms`.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrBackupIOControlResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig checks that every throttling limit is above its latency limit and that no
+// datastore is listed twice
+func (r *vbrBackupIOControlResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vbrBackupIOControlResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateLatencyLimits(path.Empty(), config.LatencyLimitMs, config.ThrottlingIOLimitMs)...)
+	seen := map[string]bool{}
+	for i, datastore := range config.Datastores {
+		p := path.Root("datastore").AtListIndex(i)
+		resp.Diagnostics.Append(validateLatencyLimits(p, datastore.LatencyLimitMs, datastore.ThrottlingIOLimitMs)...)
+		if datastore.DatastoreID.IsUnknown() {
+			continue
+		}
+		if id := datastore.DatastoreID.ValueString(); seen[id] {
+			resp.Diagnostics.AddAttributeError(p.AtName("datastore_id"), "Duplicate datastore",
+				fmt.Sprintf("Datastore %s is listed more than once.", id))
+		}
+		seen[datastore.DatastoreID.ValueString()] = true
+	}
+}
+
+// validateLatencyLimits checks that the throttling limit below parent is greater than the latency
+// limit, when both are set
+func validateLatencyLimits(parent path.Path, latency, throttling types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if latency.IsNull() || latency.IsUnknown() || throttling.IsNull() || throttling.IsUnknown() {
+		return diags
+	}
+	if throttling.ValueInt64() <= latency.ValueInt64() {
+		diags.AddAttributeError(parent.AtName("throttling_io_limit_ms"), "Invalid throttling limit",
+			fmt.Sprintf("throttling_io_limit_ms (%d) must be greater than latency_limit_ms (%d): I/O is only throttled once no new tasks are assigned.",
+				throttling.ValueInt64(), latency.ValueInt64()))
+	}
+	return diags
+}
+
+func (r *vbrBackupIOControlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vbrBackupIOControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *vbrBackupIOControlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrBackupIOControlResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	settings, err := ivbr.GetStorageLatencySettings(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read VBR storage latency control", err.Error())
+		return
+	}
+
+	state.setFromAPI(settings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrBackupIOControlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vbrBackupIOControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete disables storage latency control and restores the default limits
+func (r *vbrBackupIOControlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vbrBackupIOControlResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	enabled := false
+	latencyLimit := ivbr.DefaultStorageLatencyLimitMs
+	throttlingLimit := ivbr.DefaultStorageThrottlingIOLimitMs
+	err := ivbr.UpdateStorageLatencySettings(ctx, r.client, ivbr.StorageLatencySettings{
+		Enabled:             &enabled,
+		LatencyLimitMs:      &latencyLimit,
+		ThrottlingIOLimitMs: &throttlingLimit,
+		Datastores:          []ivbr.DatastoreLatencySettings{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to disable VBR storage latency control", err.Error())
+	}
+}
+
+// ImportState imports the storage latency control of the backup server whatever the given ID
+func (r *vbrBackupIOControlResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vbrBackupIOControlID)...)
+}
+
+// apply writes the planned settings and stores the settings read back in state
+func (r *vbrBackupIOControlResource) apply(ctx context.Context, plan *vbrBackupIOControlResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings := ivbr.StorageLatencySettings{
+		Enabled:             optionalBoolValue(plan.Enabled),
+		LatencyLimitMs:      optionalIntValue(plan.LatencyLimitMs),
+		ThrottlingIOLimitMs: optionalIntValue(plan.ThrottlingIOLimitMs),
+		Datastores:          make([]ivbr.DatastoreLatencySettings, 0, len(plan.Datastores)),
+	}
+	for _, datastore := range plan.Datastores {
+		settings.Datastores = append(settings.Datastores, ivbr.DatastoreLatencySettings{
+			DatastoreID:         datastore.DatastoreID.ValueString(),
+			LatencyLimitMs:      int(datastore.LatencyLimitMs.ValueInt64()),
+			ThrottlingIOLimitMs: int(datastore.ThrottlingIOLimitMs.ValueInt64()),
+		})
+	}
+
+	if err := ivbr.UpdateStorageLatencySettings(ctx, r.client, settings); err != nil {
+		diags.AddError("Failed to update VBR storage latency control", err.Error())
+		return
+	}
+
+	current, err := ivbr.GetStorageLatencySettings(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read VBR storage latency control", err.Error())
+		return
+	}
+
+	plan.setFromAPI(current)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the storage latency control settings returned by the API into the model
+func (m *vbrBackupIOControlResourceModel) setFromAPI(settings *ivbr.StorageLatencySettings) {
+	m.ID = types.StringValue(vbrBackupIOControlID)
+	m.Enabled = types.BoolPointerValue(settings.Enabled)
+	m.LatencyLimitMs = int64PointerValue(settings.LatencyLimitMs)
+	m.ThrottlingIOLimitMs = int64PointerValue(settings.ThrottlingIOLimitMs)
+	m.Datastores = nil
+	for _, datastore := range settings.Datastores {
+		m.Datastores = append(m.Datastores, vbrDatastoreLatencyModel{
+			DatastoreID:         types.StringValue(datastore.DatastoreID),
+			LatencyLimitMs:      types.Int64Value(int64(datastore.LatencyLimitMs)),
+			ThrottlingIOLimitMs: types.Int64Value(int64(datastore.ThrottlingIOLimitMs)),
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package tfprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateLatencyLimits(t *testing.T) {
+	tests := []struct {
+		name                string
+		latency, throttling types.Int64
+		errors              int
+	}{
+		{"throttling above latency", types.Int64Value(20), types.Int64Value(30), 0},
+		{"throttling equal to latency", types.Int64Value(20), types.Int64Value(20), 1},
+		{"throttling below latency", types.Int64Value(30), types.Int64Value(20), 1},
+		{"latency left to the server", types.Int64Null(), types.Int64Value(10), 0},
+		{"unknown throttling", types.Int64Value(20), types.Int64Unknown(), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateLatencyLimits(path.Root("datastore").AtListIndex(0), tt.latency, tt.throttling)
+			if got := diags.ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package tfprovider
+
+import (
+	"context"
+	"terraform-provider-veeambackup/internal/vbr/schedule"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrBackupWindowTemplateResource{}
+var _ resource.ResourceWithModifyPlan = &vbrBackupWindowTemplateResource{}
+
+// vbrBackupWindowTemplateResource is a backup window kept in Terraform state only, since VBR has no
+// API for shared backup windows. Jobs copy its days into their own backup_window blocks.
+type vbrBackupWindowTemplateResource struct{}
+
+type vbrBackupWindowTemplateResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	AllowedHours      types.String `tfsdk:"allowed_hours"`
+	AllowedHoursByDay types.Map    `tfsdk:"allowed_hours_by_day"`
+	Days              types.List   `tfsdk:"days"`
+}
+
+type vbrBackupWindowDayModel struct {
+	Day   types.String `tfsdk:"day"`
+	Hours types.String `tfsdk:"hours"`
+}
+
+var vbrBackupWindowDayType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"day":   types.StringType,
+	"hours": types.StringType,
+}}
+
+func NewVBRBackupWindowTemplateResource() resource.Resource {
+	return &vbrBackupWindowTemplateResource{}
+}
+
+func (r *vbrBackupWindowTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_backup_window_template"
+}
+
+func (r *vbrBackupWindowTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Defines a named backup window once so that several VBR jobs can share it. " +
+			"The template is kept in Terraform state only; jobs copy its `days` into their `backup_window` blocks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The name of the template.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the template.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the template.",
+				Optional:            true,
+			},
+			"allowed_hours": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated hours or inclusive hour ranges during which jobs may run on every day not listed in `allowed_hours_by_day`, e.g. `0-5,22-23`. Jobs never run on days without allowed hours.",
+				Optional:            true,
+				Validators: []validator.String{
+					hourRangesValidator{},
+				},
+			},
+			"allowed_hours_by_day": schema.MapAttribute{
+				MarkdownDescription: "Allowed hours for individual days of the week, keyed by lowercase day name, e.g. `{ saturday = \"0-23\" }`. Overrides `allowed_hours` for those days.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(schedule.WeekDays...)),
+					mapvalidator.ValueStringsAre(hourRangesValidator{}),
+				},
+			},
+			"days": schema.ListAttribute{
+				MarkdownDescription: "The backup window in the format of the VBR API, one object with the `day` and its `hours` per day of the week, the hours being 24 comma-separated flags. Use it in a `dynamic \"days\"` block of a job's `backup_window`.",
+				Computed:            true,
+				ElementType:         vbrBackupWindowDayType,
+			},
+		},
+	}
+}
+
+// ModifyPlan computes the days from the configured hours, so that jobs using the template see the
+// window in their own plans rather than a value known only after apply
+func (r *vbrBackupWindowTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vbrBackupWindowTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.AllowedHours.IsUnknown() || plan.AllowedHoursByDay.IsUnknown() {
+		return
+	}
+
+	days, diags := plan.windowDays(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("days"), days)...)
+}
+
+func (r *vbrBackupWindowTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vbrBackupWindowTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setComputed(ctx)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as it is: the template only exists in Terraform
+func (r *vbrBackupWindowTemplateResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+func (r *vbrBackupWindowTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vbrBackupWindowTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setComputed(ctx)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vbrBackupWindowTemplateResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (m *vbrBackupWindowTemplateResourceModel) setComputed(ctx context.Context) diag.Diagnostics {
+	m.ID = m.Name
+	days, diags := m.windowDays(ctx)
+	m.Days = days
+	return diags
+}
+
+// windowDays returns the backup window of every day of the week in the format of the VBR API
+func (m *vbrBackupWindowTemplateResourceModel) windowDays(ctx context.Context) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	byDay := map[string]string{}
+	diags.Append(m.AllowedHoursByDay.ElementsAs(ctx, &byDay, false)...)
+	if diags.HasError() {
+		return types.ListNull(vbrBackupWindowDayType), diags
+	}
+
+	days := make([]vbrBackupWindowDayModel, 0, len(schedule.WeekDays))
+	for _, day := range schedule.WeekDays {
+		ranges, ok := byDay[day]
+		if !ok {
+			ranges = m.AllowedHours.ValueString()
+		}
+		hours, err := schedule.HourRangesToWindow(ranges)
+		if err != nil {
+			diags.AddError("Invalid backup window", err.Error())
+			return types.ListNull(vbrBackupWindowDayType), diags
+		}
+		days = append(days, vbrBackupWindowDayModel{
+			Day:   types.StringValue(day),
+			Hours: types.StringValue(hours),
+		})
+	}
+
+	list, d := types.ListValueFrom(ctx, vbrBackupWindowDayType, days)
+	diags.Append(d...)
+	return list, diags
+}
+
+// hourRangesValidator checks that a string is a list of hours or hour ranges such as "0-5,22-23"
+type hourRangesValidator struct{}
+
+func (v hourRangesValidator) Description(context.Context) string {
+	return "value must be comma-separated hours or inclusive hour ranges between 0 and 23"
+}
+
+func (v hourRangesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hourRangesValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := schedule.HourRangesToWindow(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid hour ranges", err.Error())
+	}
+}
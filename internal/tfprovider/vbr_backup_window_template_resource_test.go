@@ -0,0 +1,61 @@
+package tfprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBackupWindowTemplateDays(t *testing.T) {
+	ctx := context.Background()
+	nights := "1,1,1,1,1,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1"
+	allDay := "1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1"
+
+	tests := []struct {
+		name  string
+		model vbrBackupWindowTemplateResourceModel
+		want  map[string]string
+	}{
+		{
+			name: "every day",
+			model: vbrBackupWindowTemplateResourceModel{
+				AllowedHours:      types.StringValue("0-5,22-23"),
+				AllowedHoursByDay: types.MapNull(types.StringType),
+			},
+			want: map[string]string{"sunday": nights, "wednesday": nights, "saturday": nights},
+		},
+		{
+			name: "weekend override",
+			model: vbrBackupWindowTemplateResourceModel{
+				AllowedHours: types.StringValue("0-5,22-23"),
+				AllowedHoursByDay: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"saturday": types.StringValue("0-23"),
+					"sunday":   types.StringValue("0-23"),
+				}),
+			},
+			want: map[string]string{"sunday": allDay, "wednesday": nights, "saturday": allDay},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, diags := tt.model.windowDays(ctx)
+			if diags.HasError() {
+				t.Fatalf("windowDays: %v", diags)
+			}
+			var days []vbrBackupWindowDayModel
+			if diags := list.ElementsAs(ctx, &days, false); diags.HasError() {
+				t.Fatalf("reading days: %v", diags)
+			}
+			if len(days) != 7 {
+				t.Fatalf("got %d days, want 7", len(days))
+			}
+			for _, day := range days {
+				if want, ok := tt.want[day.Day.ValueString()]; ok && day.Hours.ValueString() != want {
+					t.Errorf("%s hours = %s, want %s", day.Day.ValueString(), day.Hours.ValueString(), want)
+				}
+			}
+		})
+	}
+}
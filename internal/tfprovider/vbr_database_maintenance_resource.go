@@ -0,0 +1,251 @@
+package tfprovider
+
+import (
+	"context"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrDatabaseMaintenanceResource{}
+var _ resource.ResourceWithConfigure = &vbrDatabaseMaintenanceResource{}
+var _ resource.ResourceWithImportState = &vbrDatabaseMaintenanceResource{}
+var _ resource.ResourceWithValidateConfig = &vbrDatabaseMaintenanceResource{}
+
+// vbrDatabaseMaintenanceID is the ID of the history retention settings, of which every backup
+// server has one
+const vbrDatabaseMaintenanceID = "database_maintenance"
+
+type vbrDatabaseMaintenanceResource struct {
+	client *vc.VBRClient
+}
+
+type vbrDatabaseMaintenanceResourceModel struct {
+	ID                           types.String   `tfsdk:"id"`
+	KeepAllSessions              types.Bool     `tfsdk:"keep_all_sessions"`
+	SessionHistoryRetentionWeeks types.Int64    `tfsdk:"session_history_retention_weeks"`
+	DeletedVMsRetentionEnabled   types.Bool     `tfsdk:"deleted_vms_retention_enabled"`
+	DeletedVMsRetentionDays      types.Int64    `tfsdk:"deleted_vms_retention_days"`
+	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewVBRDatabaseMaintenanceResource() resource.Resource {
+	return &vbrDatabaseMaintenanceResource{}
+}
+
+func (r *vbrDatabaseMaintenanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_database_maintenance"
+}
+
+func (r *vbrDatabaseMaintenanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	optionalBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	optionalInt64 := func(description string, validators ...validator.Int64) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			Validators:          validators,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages how long the configuration database of Veeam Backup & Replication keeps session history and the backup data of deleted VMs. " +
+			"The backup server has a single set of these settings, so only one instance of this resource may exist per backup server. " +
+			"Only the configured settings are managed; the others keep the values set in the console.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `database_maintenance`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keep_all_sessions": optionalBool("Whether the history of every session is kept. When `false`, sessions older than `session_history_retention_weeks` are removed."),
+			"session_history_retention_weeks": optionalInt64("The number of weeks session history is kept.",
+				int64validator.AtLeast(1)),
+			"deleted_vms_retention_enabled": optionalBool("Whether the backup data of VMs that no longer exist is removed after `deleted_vms_retention_days`."),
+			"deleted_vms_retention_days": optionalInt64("The number of days the backup data of deleted VMs is kept.",
+				int64validator.AtLeast(1)),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrDatabaseMaintenanceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig rejects retention periods that would be ignored
+func (r *vbrDatabaseMaintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vbrDatabaseMaintenanceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateDatabaseMaintenance(config)...)
+}
+
+// validateDatabaseMaintenance checks that retention periods are only set when they apply
+func validateDatabaseMaintenance(config vbrDatabaseMaintenanceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if config.KeepAllSessions.ValueBool() && !config.SessionHistoryRetentionWeeks.IsNull() {
+		diags.AddAttributeError(path.Root("session_history_retention_weeks"), "Unused session history retention",
+			"session_history_retention_weeks only applies when keep_all_sessions is false.")
+	}
+	if !config.DeletedVMsRetentionEnabled.IsNull() && !config.DeletedVMsRetentionEnabled.IsUnknown() &&
+		!config.DeletedVMsRetentionEnabled.ValueBool() && !config.DeletedVMsRetentionDays.IsNull() {
+		diags.AddAttributeError(path.Root("deleted_vms_retention_days"), "Unused deleted VM retention",
+			"deleted_vms_retention_days only applies when deleted_vms_retention_enabled is true.")
+	}
+	return diags
+}
+
+func (r *vbrDatabaseMaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config vbrDatabaseMaintenanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, config, &resp.State, &resp.Diagnostics)
+}
+
+func (r *vbrDatabaseMaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrDatabaseMaintenanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	settings, err := ivbr.GetDatabaseMaintenanceSettings(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read VBR history retention settings", err.Error())
+		return
+	}
+
+	state.setFromAPI(settings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrDatabaseMaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config vbrDatabaseMaintenanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, config, &resp.State, &resp.Diagnostics)
+}
+
+// Delete only removes the settings from state: the backup server always has a history retention,
+// which keeps its last values
+func (r *vbrDatabaseMaintenanceResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ImportState imports the history retention settings of the backup server whatever the given ID
+func (r *vbrDatabaseMaintenanceResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vbrDatabaseMaintenanceID)...)
+}
+
+// apply writes the configured settings and stores the settings read back in state. Only
+// configured settings are written, so that settings left to the console are not overwritten.
+func (r *vbrDatabaseMaintenanceResource) apply(ctx context.Context, plan *vbrDatabaseMaintenanceResourceModel, config vbrDatabaseMaintenanceResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings := ivbr.DatabaseMaintenanceSettings{
+		KeepAllSessions:            optionalBoolValue(config.KeepAllSessions),
+		SessionRetentionWeeks:      optionalIntValue(config.SessionHistoryRetentionWeeks),
+		DeletedVMsRetentionEnabled: optionalBoolValue(config.DeletedVMsRetentionEnabled),
+		DeletedVMsRetentionDays:    optionalIntValue(config.DeletedVMsRetentionDays),
+	}
+	if err := ivbr.UpdateDatabaseMaintenanceSettings(ctx, r.client, settings); err != nil {
+		diags.AddError("Failed to update VBR history retention settings", err.Error())
+		return
+	}
+
+	current, err := ivbr.GetDatabaseMaintenanceSettings(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read VBR history retention settings", err.Error())
+		return
+	}
+
+	plan.setFromAPI(current)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the history retention settings returned by the API into the model
+func (m *vbrDatabaseMaintenanceResourceModel) setFromAPI(settings *ivbr.DatabaseMaintenanceSettings) {
+	m.ID = types.StringValue(vbrDatabaseMaintenanceID)
+	m.KeepAllSessions = types.BoolPointerValue(settings.KeepAllSessions)
+	m.SessionHistoryRetentionWeeks = int64PointerValue(settings.SessionRetentionWeeks)
+	m.DeletedVMsRetentionEnabled = types.BoolPointerValue(settings.DeletedVMsRetentionEnabled)
+	m.DeletedVMsRetentionDays = int64PointerValue(settings.DeletedVMsRetentionDays)
+}
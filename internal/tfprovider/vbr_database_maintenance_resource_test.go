@@ -0,0 +1,43 @@
+package tfprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateDatabaseMaintenance(t *testing.T) {
+	tests := []struct {
+		name   string
+		config vbrDatabaseMaintenanceResourceModel
+		errors int
+	}{
+		{"retention weeks", vbrDatabaseMaintenanceResourceModel{
+			KeepAllSessions:              types.BoolValue(false),
+			SessionHistoryRetentionWeeks: types.Int64Value(53),
+		}, 0},
+		{"retention weeks with all sessions kept", vbrDatabaseMaintenanceResourceModel{
+			KeepAllSessions:              types.BoolValue(true),
+			SessionHistoryRetentionWeeks: types.Int64Value(53),
+		}, 1},
+		{"deleted VM retention days", vbrDatabaseMaintenanceResourceModel{
+			DeletedVMsRetentionEnabled: types.BoolValue(true),
+			DeletedVMsRetentionDays:    types.Int64Value(14),
+		}, 0},
+		{"deleted VM retention days while disabled", vbrDatabaseMaintenanceResourceModel{
+			DeletedVMsRetentionEnabled: types.BoolValue(false),
+			DeletedVMsRetentionDays:    types.Int64Value(14),
+		}, 1},
+		{"days left to the console", vbrDatabaseMaintenanceResourceModel{
+			DeletedVMsRetentionEnabled: types.BoolNull(),
+			DeletedVMsRetentionDays:    types.Int64Value(14),
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateDatabaseMaintenance(tt.config).ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package tfprovider
+
+import (
+	"context"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrEncryptionPasswordResource{}
+var _ resource.ResourceWithConfigure = &vbrEncryptionPasswordResource{}
+var _ resource.ResourceWithImportState = &vbrEncryptionPasswordResource{}
+
+type vbrEncryptionPasswordResource struct {
+	client *vc.VBRClient
+}
+
+type vbrEncryptionPasswordResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Password          types.String   `tfsdk:"password"`
+	PasswordWO        types.String   `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64    `tfsdk:"password_wo_version"`
+	Hint              types.String   `tfsdk:"hint"`
+	Tag               types.String   `tfsdk:"tag"`
+	ModificationTime  types.String   `tfsdk:"modification_time"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewVBREncryptionPasswordResource() resource.Resource {
+	return &vbrEncryptionPasswordResource{}
+}
+
+func (r *vbrEncryptionPasswordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_encryption_password"
+}
+
+func (r *vbrEncryptionPasswordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam Backup & Replication encryption password used to encrypt backups.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the encryption password.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The encryption password. The API does not return it, and changing it creates a new encryption password. Exactly one of `password` and `password_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// An imported password has no value in state; adopt the configured one instead of replacing it
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the password creates a new encryption password.",
+						"Changing the password creates a new encryption password.",
+					),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only variant of `password` that is never stored in state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to create a new encryption password from `password_wo`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"hint": schema.StringAttribute{
+				MarkdownDescription: "The hint for the encryption password.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "The tag that identifies the encryption password. VBR generates one when it is not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modification_time": schema.StringAttribute{
+				MarkdownDescription: "The date and time the encryption password was last modified.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrEncryptionPasswordResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *vbrEncryptionPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vbrEncryptionPasswordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Write-only values are only present in the configuration, never in the plan
+	var passwordWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	secret := plan.Password.ValueString()
+	if !passwordWO.IsNull() {
+		secret = passwordWO.ValueString()
+	}
+
+	password, err := ivbr.CreateEncryptionPassword(ctx, r.client, ivbr.EncryptionPasswordSpec{
+		Password: secret,
+		Hint:     plan.Hint.ValueString(),
+		Tag:      plan.Tag.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create VBR encryption password", err.Error())
+		return
+	}
+
+	plan.setFromAPI(password)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vbrEncryptionPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrEncryptionPasswordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	password, err := ivbr.GetEncryptionPassword(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if vc.IsGone(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read VBR encryption password", err.Error())
+		return
+	}
+
+	state.setFromAPI(password)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrEncryptionPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vbrEncryptionPasswordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	password, err := ivbr.UpdateEncryptionPassword(ctx, r.client, ivbr.EncryptionPassword{
+		ID:   state.ID.ValueString(),
+		Hint: plan.Hint.ValueString(),
+		Tag:  plan.Tag.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update VBR encryption password", err.Error())
+		return
+	}
+
+	plan.setFromAPI(password)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vbrEncryptionPasswordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vbrEncryptionPasswordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := ivbr.DeleteEncryptionPassword(ctx, r.client, state.ID.ValueString()); err != nil && !vc.IsGone(err) {
+		resp.Diagnostics.AddError("Failed to delete VBR encryption password", err.Error())
+	}
+}
+
+func (r *vbrEncryptionPasswordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setFromAPI copies the attributes returned by the API into the model. The password is kept as
+// configured since the API never returns it.
+func (m *vbrEncryptionPasswordResourceModel) setFromAPI(password *ivbr.EncryptionPassword) {
+	m.ID = types.StringValue(password.ID)
+	m.Hint = types.StringValue(password.Hint)
+	m.Tag = types.StringPointerValue(password.Tag)
+	m.ModificationTime = types.StringValue(password.ModificationTime)
+}
@@ -0,0 +1,292 @@
+package tfprovider
+
+import (
+	"context"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrGuestInteractionProxyResource{}
+var _ resource.ResourceWithConfigure = &vbrGuestInteractionProxyResource{}
+var _ resource.ResourceWithImportState = &vbrGuestInteractionProxyResource{}
+var _ resource.ResourceWithValidateConfig = &vbrGuestInteractionProxyResource{}
+
+type vbrGuestInteractionProxyResource struct {
+	client *vc.VBRClient
+}
+
+type vbrGuestInteractionProxyResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	JobID              types.String   `tfsdk:"job_id"`
+	AutomaticSelection types.Bool     `tfsdk:"automatic_selection"`
+	ProxyIDs           types.Set      `tfsdk:"proxy_ids"`
+	JobName            types.String   `tfsdk:"job_name"`
+	JobType            types.String   `tfsdk:"job_type"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewVBRGuestInteractionProxyResource() resource.Resource {
+	return &vbrGuestInteractionProxyResource{}
+}
+
+func (r *vbrGuestInteractionProxyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_guest_interaction_proxy"
+}
+
+func (r *vbrGuestInteractionProxyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the guest interaction proxies of an existing Veeam Backup & Replication VM or agent backup job, which deploy the guest processing components inside the processed machines. " +
+			"Destroying the resource lets VBR select the guest interaction proxy automatically again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the job.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the backup job with guest processing settings.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"automatic_selection": schema.BoolAttribute{
+				MarkdownDescription: "Whether VBR selects a guest interaction proxy for each machine. When `false`, one of `proxy_ids` is used. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"proxy_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the managed Windows servers used as guest interaction proxies. Required when `automatic_selection` is `false` and not allowed otherwise.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"job_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the job.",
+				Computed:            true,
+			},
+			"job_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the job, e.g. `Backup`.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrGuestInteractionProxyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig checks that proxies are listed exactly when they are not selected automatically
+func (r *vbrGuestInteractionProxyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vbrGuestInteractionProxyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateGuestInteractionProxy(config)...)
+}
+
+// validateGuestInteractionProxy checks the proxy selection when it is known. automatic_selection
+// defaults to true when not set.
+func validateGuestInteractionProxy(config vbrGuestInteractionProxyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if config.AutomaticSelection.IsUnknown() || config.ProxyIDs.IsUnknown() {
+		return diags
+	}
+
+	automatic := config.AutomaticSelection.IsNull() || config.AutomaticSelection.ValueBool()
+	if automatic && !config.ProxyIDs.IsNull() {
+		diags.AddAttributeError(path.Root("proxy_ids"), "Unexpected guest interaction proxies",
+			"proxy_ids is only used when automatic_selection is false.")
+	}
+	if !automatic && config.ProxyIDs.IsNull() {
+		diags.AddAttributeError(path.Root("proxy_ids"), "Missing guest interaction proxies",
+			"proxy_ids is required when automatic_selection is false.")
+	}
+	return diags
+}
+
+func (r *vbrGuestInteractionProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vbrGuestInteractionProxyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *vbrGuestInteractionProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrGuestInteractionProxyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	settings, err := ivbr.GetGuestInteractionProxies(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if vc.IsGone(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read VBR guest interaction proxies", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromAPI(ctx, settings)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrGuestInteractionProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vbrGuestInteractionProxyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete restores the automatic selection of guest interaction proxies, which VBR uses by default.
+// A job that no longer exists has nothing to restore.
+func (r *vbrGuestInteractionProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vbrGuestInteractionProxyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := ivbr.UpdateGuestInteractionProxies(ctx, r.client, ivbr.GuestInteractionProxies{
+		JobID:              state.ID.ValueString(),
+		AutomaticSelection: true,
+	})
+	if err != nil && !vc.IsGone(err) {
+		resp.Diagnostics.AddError("Failed to reset VBR guest interaction proxies", err.Error())
+	}
+}
+
+func (r *vbrGuestInteractionProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("job_id"), req.ID)...)
+}
+
+// apply writes the planned proxy selection to the job and stores the selection read back in state
+func (r *vbrGuestInteractionProxyResource) apply(ctx context.Context, plan *vbrGuestInteractionProxyResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings := ivbr.GuestInteractionProxies{
+		JobID:              plan.JobID.ValueString(),
+		AutomaticSelection: plan.AutomaticSelection.ValueBool(),
+	}
+	if !plan.ProxyIDs.IsNull() {
+		diags.Append(plan.ProxyIDs.ElementsAs(ctx, &settings.ProxyIDs, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+	if err := ivbr.UpdateGuestInteractionProxies(ctx, r.client, settings); err != nil {
+		diags.AddError("Failed to update VBR guest interaction proxies", err.Error())
+		return
+	}
+
+	current, err := ivbr.GetGuestInteractionProxies(ctx, r.client, settings.JobID)
+	if err != nil {
+		diags.AddError("Failed to read VBR guest interaction proxies", err.Error())
+		return
+	}
+
+	diags.Append(plan.setFromAPI(ctx, current)...)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the guest interaction proxies returned by the API into the model. An empty
+// list of proxies is stored as null, as it is configured with automatic selection.
+func (m *vbrGuestInteractionProxyResourceModel) setFromAPI(ctx context.Context, settings *ivbr.GuestInteractionProxies) diag.Diagnostics {
+	m.ID = types.StringValue(settings.JobID)
+	m.JobID = types.StringValue(settings.JobID)
+	m.AutomaticSelection = types.BoolValue(settings.AutomaticSelection)
+	m.JobName = types.StringValue(settings.JobName)
+	m.JobType = types.StringValue(settings.JobType)
+	if len(settings.ProxyIDs) == 0 {
+		m.ProxyIDs = types.SetNull(types.StringType)
+		return nil
+	}
+	proxyIDs, diags := types.SetValueFrom(ctx, types.StringType, settings.ProxyIDs)
+	m.ProxyIDs = proxyIDs
+	return diags
+}
@@ -0,0 +1,50 @@
+package tfprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateGuestInteractionProxy(t *testing.T) {
+	proxies := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("server-1")})
+
+	tests := []struct {
+		name   string
+		config vbrGuestInteractionProxyResourceModel
+		errors int
+	}{
+		{"automatic by default", vbrGuestInteractionProxyResourceModel{
+			AutomaticSelection: types.BoolNull(),
+			ProxyIDs:           types.SetNull(types.StringType),
+		}, 0},
+		{"explicit servers", vbrGuestInteractionProxyResourceModel{
+			AutomaticSelection: types.BoolValue(false),
+			ProxyIDs:           proxies,
+		}, 0},
+		{"explicit without servers", vbrGuestInteractionProxyResourceModel{
+			AutomaticSelection: types.BoolValue(false),
+			ProxyIDs:           types.SetNull(types.StringType),
+		}, 1},
+		{"servers with automatic selection", vbrGuestInteractionProxyResourceModel{
+			AutomaticSelection: types.BoolValue(true),
+			ProxyIDs:           proxies,
+		}, 1},
+		{"servers with the default selection", vbrGuestInteractionProxyResourceModel{
+			AutomaticSelection: types.BoolNull(),
+			ProxyIDs:           proxies,
+		}, 1},
+		{"servers not yet known", vbrGuestInteractionProxyResourceModel{
+			AutomaticSelection: types.BoolValue(false),
+			ProxyIDs:           types.SetUnknown(types.StringType),
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateGuestInteractionProxy(tt.config).ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrHardenedRepositoryImmutabilitySettingsResource{}
+var _ resource.ResourceWithConfigure = &vbrHardenedRepositoryImmutabilitySettingsResource{}
+var _ resource.ResourceWithImportState = &vbrHardenedRepositoryImmutabilitySettingsResource{}
+var _ resource.ResourceWithModifyPlan = &vbrHardenedRepositoryImmutabilitySettingsResource{}
+
+type vbrHardenedRepositoryImmutabilitySettingsResource struct {
+	client *vc.VBRClient
+}
+
+type vbrHardenedRepositoryImmutabilitySettingsResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	RepositoryID        types.String   `tfsdk:"repository_id"`
+	ImmutabilityEnabled types.Bool     `tfsdk:"immutability_enabled"`
+	ImmutabilityDays    types.Int64    `tfsdk:"immutability_days"`
+	RepositoryName      types.String   `tfsdk:"repository_name"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewVBRHardenedRepositoryImmutabilitySettingsResource() resource.Resource {
+	return &vbrHardenedRepositoryImmutabilitySettingsResource{}
+}
+
+func (r *vbrHardenedRepositoryImmutabilitySettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_hardened_repository_immutability_settings"
+}
+
+func (r *vbrHardenedRepositoryImmutabilitySettingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the immutability settings of an existing Veeam Backup & Replication hardened Linux repository. " +
+			"Immutability can only be extended: plans that shorten the immutability period or disable immutability are rejected.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the repository.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the hardened Linux repository.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"immutability_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether recent backups are made immutable. Once enabled, it cannot be disabled through Terraform. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"immutability_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days recent backups stay immutable, between 7 and 9999. It can be increased but never decreased.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(7, 9999),
+				},
+			},
+			"repository_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the repository.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrHardenedRepositoryImmutabilitySettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan rejects plans that would weaken the immutability of a repository already in state
+func (r *vbrHardenedRepositoryImmutabilitySettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state vbrHardenedRepositoryImmutabilitySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.RepositoryID.Equal(state.RepositoryID) {
+		return
+	}
+
+	resp.Diagnostics.Append(checkImmutabilityNotDecreased(state, plan)...)
+}
+
+// checkImmutabilityNotDecreased returns an error for every setting of plan that weakens the
+// immutability of current. Unknown planned values are checked again once they are known.
+func checkImmutabilityNotDecreased(current, plan vbrHardenedRepositoryImmutabilitySettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if current.ImmutabilityEnabled.ValueBool() && !plan.ImmutabilityEnabled.IsUnknown() && !plan.ImmutabilityEnabled.ValueBool() {
+		diags.AddAttributeError(path.Root("immutability_enabled"), "Immutability cannot be disabled",
+			fmt.Sprintf("Repository %s already makes recent backups immutable. Remove the resource from state to stop managing it instead.", current.RepositoryID.ValueString()))
+	}
+	if !plan.ImmutabilityDays.IsUnknown() && plan.ImmutabilityDays.ValueInt64() < current.ImmutabilityDays.ValueInt64() {
+		diags.AddAttributeError(path.Root("immutability_days"), "Immutability period cannot be decreased",
+			fmt.Sprintf("Repository %s keeps recent backups immutable for %d days; the planned value of %d days would shorten it.",
+				current.RepositoryID.ValueString(), current.ImmutabilityDays.ValueInt64(), plan.ImmutabilityDays.ValueInt64()))
+	}
+	return diags
+}
+
+func (r *vbrHardenedRepositoryImmutabilitySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vbrHardenedRepositoryImmutabilitySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The repository exists before Terraform manages its settings, so the plan could not be checked
+	// against its current immutability
+	current, err := ivbr.GetHardenedRepositoryImmutability(ctx, r.client, plan.RepositoryID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read VBR hardened repository", err.Error())
+		return
+	}
+	if current.Type != ivbr.HardenedRepositoryType {
+		resp.Diagnostics.AddAttributeError(path.Root("repository_id"), "Repository is not hardened",
+			fmt.Sprintf("Repository %s is of type %s; immutability settings are only managed for %s repositories.", current.RepositoryID, current.Type, ivbr.HardenedRepositoryType))
+		return
+	}
+	var state vbrHardenedRepositoryImmutabilitySettingsResourceModel
+	state.setFromAPI(current)
+	resp.Diagnostics.Append(checkImmutabilityNotDecreased(state, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics, "Failed to update VBR hardened repository immutability settings")
+}
+
+func (r *vbrHardenedRepositoryImmutabilitySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrHardenedRepositoryImmutabilitySettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	settings, err := ivbr.GetHardenedRepositoryImmutability(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if vc.IsGone(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read VBR hardened repository immutability settings", err.Error())
+		return
+	}
+
+	state.setFromAPI(settings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrHardenedRepositoryImmutabilitySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vbrHardenedRepositoryImmutabilitySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics, "Failed to update VBR hardened repository immutability settings")
+}
+
+// Delete only removes the settings from state: immutability cannot be reverted, and the
+// repository itself is not managed by this resource
+func (r *vbrHardenedRepositoryImmutabilitySettingsResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (r *vbrHardenedRepositoryImmutabilitySettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_id"), req.ID)...)
+}
+
+// apply writes the planned settings to the repository and stores the settings read back in state
+func (r *vbrHardenedRepositoryImmutabilitySettingsResource) apply(ctx context.Context, plan *vbrHardenedRepositoryImmutabilitySettingsResourceModel, state *tfsdk.State, diags *diag.Diagnostics, summary string) {
+	err := ivbr.UpdateHardenedRepositoryImmutability(ctx, r.client, ivbr.HardenedRepositoryImmutability{
+		RepositoryID: plan.RepositoryID.ValueString(),
+		Enabled:      plan.ImmutabilityEnabled.ValueBool(),
+		Days:         int(plan.ImmutabilityDays.ValueInt64()),
+	})
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	settings, err := ivbr.GetHardenedRepositoryImmutability(ctx, r.client, plan.RepositoryID.ValueString())
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	plan.setFromAPI(settings)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the settings returned by the API into the model
+func (m *vbrHardenedRepositoryImmutabilitySettingsResourceModel) setFromAPI(settings *ivbr.HardenedRepositoryImmutability) {
+	m.ID = types.StringValue(settings.RepositoryID)
+	m.RepositoryID = types.StringValue(settings.RepositoryID)
+	m.ImmutabilityEnabled = types.BoolValue(settings.Enabled)
+	m.ImmutabilityDays = types.Int64Value(int64(settings.Days))
+	m.RepositoryName = types.StringValue(settings.Name)
+}
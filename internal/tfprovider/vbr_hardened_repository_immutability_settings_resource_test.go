@@ -0,0 +1,43 @@
+package tfprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckImmutabilityNotDecreased(t *testing.T) {
+	settings := func(enabled types.Bool, days types.Int64) vbrHardenedRepositoryImmutabilitySettingsResourceModel {
+		return vbrHardenedRepositoryImmutabilitySettingsResourceModel{
+			RepositoryID:        types.StringValue("repo"),
+			ImmutabilityEnabled: enabled,
+			ImmutabilityDays:    days,
+		}
+	}
+	current := settings(types.BoolValue(true), types.Int64Value(14))
+
+	tests := []struct {
+		name   string
+		plan   vbrHardenedRepositoryImmutabilitySettingsResourceModel
+		errors int
+	}{
+		{"unchanged", settings(types.BoolValue(true), types.Int64Value(14)), 0},
+		{"increased", settings(types.BoolValue(true), types.Int64Value(30)), 0},
+		{"unknown", settings(types.BoolUnknown(), types.Int64Unknown()), 0},
+		{"decreased", settings(types.BoolValue(true), types.Int64Value(7)), 1},
+		{"disabled", settings(types.BoolValue(false), types.Int64Value(14)), 1},
+		{"disabled and decreased", settings(types.BoolValue(false), types.Int64Value(7)), 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkImmutabilityNotDecreased(current, tt.plan).ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+
+	// A repository without immutability may enable it with any period
+	if diags := checkImmutabilityNotDecreased(settings(types.BoolValue(false), types.Int64Value(0)), settings(types.BoolValue(true), types.Int64Value(7))); diags.HasError() {
+		t.Errorf("enabling immutability: %v", diags)
+	}
+}
@@ -0,0 +1,238 @@
+package tfprovider
+
+import (
+	"context"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrLocationAssignmentResource{}
+var _ resource.ResourceWithConfigure = &vbrLocationAssignmentResource{}
+var _ resource.ResourceWithImportState = &vbrLocationAssignmentResource{}
+
+type vbrLocationAssignmentResource struct {
+	client *vc.VBRClient
+}
+
+type vbrLocationAssignmentResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	ObjectID   types.String   `tfsdk:"object_id"`
+	ObjectType types.String   `tfsdk:"object_type"`
+	LocationID types.String   `tfsdk:"location_id"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewVBRLocationAssignmentResource() resource.Resource {
+	return &vbrLocationAssignmentResource{}
+}
+
+func (r *vbrLocationAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_location_assignment"
+}
+
+func (r *vbrLocationAssignmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns an existing Veeam Backup & Replication infrastructure object, such as a managed server or repository, to a location. " +
+			"An object has at most one location, so only one instance of this resource may exist per object; destroying it removes the location of the object.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the infrastructure object.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the infrastructure object: `ManagedServer`, `Repository`, `ScaleOutRepository`, `Proxy`, `WANAccelerator` or `CloudCredentials`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ivbr.LocationObjectTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the location, e.g. the `id` of a `veeambackup_vbr_location` resource.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrLocationAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *vbrLocationAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vbrLocationAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *vbrLocationAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrLocationAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	assignment, err := ivbr.GetLocationAssignment(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if vc.IsGone(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read VBR location assignment", err.Error())
+		return
+	}
+	// An object whose location was removed outside of Terraform is assigned again on the next apply
+	if assignment.LocationID == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setFromAPI(assignment)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrLocationAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vbrLocationAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete removes the location of the object. An object that no longer exists has no location.
+func (r *vbrLocationAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vbrLocationAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := ivbr.DeleteLocationAssignment(ctx, r.client, state.ID.ValueString()); err != nil && !vc.IsGone(err) {
+		resp.Diagnostics.AddError("Failed to delete VBR location assignment", err.Error())
+	}
+}
+
+// ImportState imports the location assignment of the object with the given ID
+func (r *vbrLocationAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_id"), req.ID)...)
+}
+
+// apply assigns the object to the planned location and stores the assignment read back in state
+func (r *vbrLocationAssignmentResource) apply(ctx context.Context, plan *vbrLocationAssignmentResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	err := ivbr.SetLocationAssignment(ctx, r.client, ivbr.LocationAssignment{
+		ObjectID:   plan.ObjectID.ValueString(),
+		ObjectType: plan.ObjectType.ValueString(),
+		LocationID: plan.LocationID.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Failed to assign VBR location", err.Error())
+		return
+	}
+
+	assignment, err := ivbr.GetLocationAssignment(ctx, r.client, plan.ObjectID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to read VBR location assignment", err.Error())
+		return
+	}
+
+	plan.setFromAPI(assignment)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the assignment returned by the API into the model
+func (m *vbrLocationAssignmentResourceModel) setFromAPI(assignment *ivbr.LocationAssignment) {
+	m.ID = types.StringValue(assignment.ObjectID)
+	m.ObjectID = types.StringValue(assignment.ObjectID)
+	m.ObjectType = types.StringValue(assignment.ObjectType)
+	m.LocationID = types.StringValue(assignment.LocationID)
+}
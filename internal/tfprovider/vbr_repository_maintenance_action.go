@@ -0,0 +1,122 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &vbrRepositoryMaintenanceAction{}
+var _ action.ActionWithConfigure = &vbrRepositoryMaintenanceAction{}
+
+type vbrRepositoryMaintenanceAction struct {
+	client *vc.VBRClient
+}
+
+type vbrRepositoryMaintenanceActionModel struct {
+	ScaleOutRepositoryID types.String `tfsdk:"scale_out_repository_id"`
+	ExtentIDs            types.List   `tfsdk:"extent_ids"`
+	Operation            types.String `tfsdk:"operation"`
+}
+
+func NewVBRRepositoryMaintenanceAction() action.Action {
+	return &vbrRepositoryMaintenanceAction{}
+}
+
+func (a *vbrRepositoryMaintenanceAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_repository_maintenance"
+}
+
+func (a *vbrRepositoryMaintenanceAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		MarkdownDescription: "Put extents of a Veeam Backup & Replication scale-out backup repository into maintenance mode, take them out of it, or evacuate their backups to the other extents.",
+		Attributes: map[string]actionschema.Attribute{
+			"scale_out_repository_id": actionschema.StringAttribute{
+				MarkdownDescription: "The ID of the scale-out backup repository.",
+				Required:            true,
+			},
+			"extent_ids": actionschema.ListAttribute{
+				MarkdownDescription: "The IDs of the repositories that are performance extents of the scale-out backup repository.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"operation": actionschema.StringAttribute{
+				MarkdownDescription: "The operation to run. Valid values: `EnableMaintenanceMode`, `DisableMaintenanceMode`, `EvacuateBackups`. " +
+					"`EvacuateBackups` puts the extents into maintenance mode first, as VBR only evacuates extents in maintenance mode.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ivbr.RepositoryMaintenanceOperations...),
+				},
+			},
+		},
+	}
+}
+
+func (a *vbrRepositoryMaintenanceAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	a.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (a *vbrRepositoryMaintenanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data vbrRepositoryMaintenanceActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	var extentIDs []string
+	resp.Diagnostics.Append(data.ExtentIDs.ElementsAs(ctx, &extentIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryID := strings.TrimSpace(data.ScaleOutRepositoryID.ValueString())
+	extents := strings.Join(extentIDs, ", ")
+
+	switch data.Operation.ValueString() {
+	case ivbr.RepositoryMaintenanceEnable, ivbr.RepositoryMaintenanceEvacuate:
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("enabling maintenance mode for extents %s of scale-out repository %s", extents, repositoryID)})
+		if err := ivbr.SetScaleOutExtentsMaintenanceMode(ctx, a.client, repositoryID, extentIDs, true); err != nil {
+			resp.Diagnostics.AddError("Failed to enable VBR repository maintenance mode", err.Error())
+			return
+		}
+	case ivbr.RepositoryMaintenanceDisable:
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("disabling maintenance mode for extents %s of scale-out repository %s", extents, repositoryID)})
+		if err := ivbr.SetScaleOutExtentsMaintenanceMode(ctx, a.client, repositoryID, extentIDs, false); err != nil {
+			resp.Diagnostics.AddError("Failed to disable VBR repository maintenance mode", err.Error())
+			return
+		}
+	}
+
+	if data.Operation.ValueString() == ivbr.RepositoryMaintenanceEvacuate {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("evacuating backups from extents %s of scale-out repository %s", extents, repositoryID)})
+		if err := ivbr.EvacuateScaleOutExtents(ctx, a.client, repositoryID, extentIDs); err != nil {
+			resp.Diagnostics.AddError("Failed to evacuate VBR repository extents", err.Error())
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("completed %s for extents %s of scale-out repository %s", data.Operation.ValueString(), extents, repositoryID)})
+}
@@ -0,0 +1,68 @@
+package tfprovider_test
+
+import (
+	"context"
+	"reflect"
+	"terraform-provider-veeambackup/internal/acctest"
+	"terraform-provider-veeambackup/internal/tfprovider"
+	"terraform-provider-veeambackup/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestVBRRepositoryMaintenanceAction(t *testing.T) {
+	ctx := context.Background()
+	const sobrPath = "/api/v1/backupInfrastructure/scaleOutRepositories/sobr"
+
+	server := acctest.NewVBRServer(t)
+	for _, operation := range []string{"enableMaintenanceMode", "disableMaintenanceMode", "evacuate"} {
+		server.Collection(acctest.Collection{
+			Path: sobrPath + "/" + operation,
+			Respond: func(s *acctest.Server, obj acctest.Object) interface{} {
+				return s.Session("RepositoryMaintenance", obj["id"].(string))
+			},
+		})
+	}
+	p := provider.Provider()
+	acctest.ConfigureProvider(t, p, acctest.VBRProviderConfig(server))
+
+	a := tfprovider.NewVBRRepositoryMaintenanceAction()
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: p.Meta()}, &action.ConfigureResponse{})
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	for operation, want := range map[string][]string{
+		"DisableMaintenanceMode": {"POST " + sobrPath + "/disableMaintenanceMode"},
+		"EvacuateBackups":        {"POST " + sobrPath + "/enableMaintenanceMode", "POST " + sobrPath + "/evacuate"},
+	} {
+		start := len(server.Requests())
+		config := tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"scale_out_repository_id": tftypes.NewValue(tftypes.String, "sobr"),
+				"extent_ids": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "extent"),
+				}),
+				"operation": tftypes.NewValue(tftypes.String, operation),
+			}),
+		}
+		resp := action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+		a.Invoke(ctx, action.InvokeRequest{Config: config}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: %v", operation, resp.Diagnostics)
+		}
+
+		var got []string
+		for _, r := range server.Requests()[start:] {
+			if r[:4] == "POST" {
+				got = append(got, r)
+			}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s sent %q, want %q", operation, got, want)
+		}
+	}
+}
@@ -0,0 +1,399 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+	"terraform-provider-veeambackup/internal/vbr/schedule"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrSOBROffloadSettingsResource{}
+var _ resource.ResourceWithConfigure = &vbrSOBROffloadSettingsResource{}
+var _ resource.ResourceWithImportState = &vbrSOBROffloadSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &vbrSOBROffloadSettingsResource{}
+
+type vbrSOBROffloadSettingsResource struct {
+	client *vc.VBRClient
+}
+
+type vbrSOBROffloadSettingsResourceModel struct {
+	ID                                       types.String   `tfsdk:"id"`
+	ScaleOutRepositoryID                     types.String   `tfsdk:"scale_out_repository_id"`
+	ScaleOutRepositoryName                   types.String   `tfsdk:"scale_out_repository_name"`
+	CapacityTierEnabled                      types.Bool     `tfsdk:"capacity_tier_enabled"`
+	CapacityTierCopyPolicyEnabled            types.Bool     `tfsdk:"capacity_tier_copy_policy_enabled"`
+	CapacityTierMovePolicyEnabled            types.Bool     `tfsdk:"capacity_tier_move_policy_enabled"`
+	CapacityTierOperationalRestorePeriodDays types.Int64    `tfsdk:"capacity_tier_operational_restore_period_days"`
+	CapacityTierOverrideEnabled              types.Bool     `tfsdk:"capacity_tier_override_enabled"`
+	CapacityTierOverrideThresholdPercent     types.Int64    `tfsdk:"capacity_tier_override_space_threshold_percent"`
+	CapacityTierOffloadWindow                types.List     `tfsdk:"capacity_tier_offload_window"`
+	CapacityTierEncryptionPasswordID         types.String   `tfsdk:"capacity_tier_encryption_password_id"`
+	ArchiveTierEnabled                       types.Bool     `tfsdk:"archive_tier_enabled"`
+	ArchiveTierArchivePeriodDays             types.Int64    `tfsdk:"archive_tier_archive_period_days"`
+	ArchiveTierCostOptimizedEnabled          types.Bool     `tfsdk:"archive_tier_cost_optimized_enabled"`
+	ArchiveTierDeduplicationEnabled          types.Bool     `tfsdk:"archive_tier_deduplication_enabled"`
+	Timeouts                                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewVBRSOBROffloadSettingsResource() resource.Resource {
+	return &vbrSOBROffloadSettingsResource{}
+}
+
+func (r *vbrSOBROffloadSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_sobr_offload_settings"
+}
+
+func (r *vbrSOBROffloadSettingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	optionalBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	optionalInt64 := func(description string, validators ...validator.Int64) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			Validators:          validators,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the capacity and archive tier settings of an existing Veeam Backup & Replication scale-out backup repository. " +
+			"Only the configured settings are managed; the others keep the values set in the console.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the scale-out backup repository.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scale_out_repository_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the scale-out backup repository.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scale_out_repository_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the scale-out backup repository.",
+				Computed:            true,
+			},
+			"capacity_tier_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the scale-out backup repository has a capacity tier.",
+				Computed:            true,
+			},
+			"capacity_tier_copy_policy_enabled": optionalBool("Whether backups are copied to the capacity tier as soon as they are created."),
+			"capacity_tier_move_policy_enabled": optionalBool("Whether backups are moved to the capacity tier once they are older than `capacity_tier_operational_restore_period_days`."),
+			"capacity_tier_operational_restore_period_days": optionalInt64("The number of days after which backups are moved to the capacity tier.",
+				int64validator.AtLeast(0)),
+			"capacity_tier_override_enabled": optionalBool("Whether the oldest backups are moved to the capacity tier sooner when the performance tier reaches `capacity_tier_override_space_threshold_percent`."),
+			"capacity_tier_override_space_threshold_percent": optionalInt64("The used space of the performance tier, in percent, above which backups are moved sooner.",
+				int64validator.Between(1, 100)),
+			"capacity_tier_offload_window": schema.ListAttribute{
+				MarkdownDescription: "The hours during which backups may be offloaded to the capacity tier, one object with the `day` and its `hours` per day of the week, " +
+					"e.g. the `days` of a `veeambackup_vbr_backup_window_template`. Hours are 24 comma-separated 0 or 1 flags or time intervals such as `22:00-06:00`. " +
+					"An empty list allows offloading at any time.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: vbrBackupWindowDayType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"capacity_tier_encryption_password_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the encryption password capacity tier backups are encrypted with, e.g. from `veeambackup_vbr_encryption_password`. An empty string disables encryption.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"archive_tier_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the scale-out backup repository has an archive tier.",
+				Computed:            true,
+			},
+			"archive_tier_archive_period_days": optionalInt64("The number of days after which backups are moved to the archive tier.",
+				int64validator.AtLeast(0)),
+			"archive_tier_cost_optimized_enabled": optionalBool("Whether backups are stored in the archive tier as standalone full backups only when this is cheaper."),
+			"archive_tier_deduplication_enabled":  optionalBool("Whether backups in the archive tier are deduplicated."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrSOBROffloadSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig checks the days and hours of the offload window
+func (r *vbrSOBROffloadSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var window types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("capacity_tier_offload_window"), &window)...)
+	if resp.Diagnostics.HasError() || window.IsNull() || window.IsUnknown() {
+		return
+	}
+
+	var days []vbrBackupWindowDayModel
+	resp.Diagnostics.Append(window.ElementsAs(ctx, &days, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	seen := map[string]bool{}
+	for i, day := range days {
+		p := path.Root("capacity_tier_offload_window").AtListIndex(i)
+		if !day.Day.IsUnknown() {
+			if !isWeekDay(day.Day.ValueString()) {
+				resp.Diagnostics.AddAttributeError(p.AtName("day"), "Invalid offload window day",
+					fmt.Sprintf("%q is not a lowercase day of the week, e.g. monday.", day.Day.ValueString()))
+			} else if seen[day.Day.ValueString()] {
+				resp.Diagnostics.AddAttributeError(p.AtName("day"), "Duplicate offload window day",
+					fmt.Sprintf("%s is listed more than once.", day.Day.ValueString()))
+			}
+			seen[day.Day.ValueString()] = true
+		}
+		if !day.Hours.IsUnknown() {
+			if _, err := schedule.NormalizeWindowHours(day.Hours.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(p.AtName("hours"), "Invalid offload window hours", err.Error())
+			}
+		}
+	}
+}
+
+func isWeekDay(day string) bool {
+	for _, d := range schedule.WeekDays {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *vbrSOBROffloadSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config vbrSOBROffloadSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, config, &resp.State, &resp.Diagnostics)
+}
+
+func (r *vbrSOBROffloadSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrSOBROffloadSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	settings, err := ivbr.GetSOBROffloadSettings(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if vc.IsGone(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read VBR scale-out repository offload settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromAPI(ctx, settings)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrSOBROffloadSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config vbrSOBROffloadSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, config, &resp.State, &resp.Diagnostics)
+}
+
+// Delete only removes the settings from state: the scale-out backup repository keeps its tiers
+// as they are, and the repository itself is not managed by this resource
+func (r *vbrSOBROffloadSettingsResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (r *vbrSOBROffloadSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scale_out_repository_id"), req.ID)...)
+}
+
+// apply writes the configured settings to the scale-out backup repository and stores the
+// settings read back in state. Only configured settings are written, so that settings left to the
+// console are not overwritten with the values they had when Terraform last read them.
+func (r *vbrSOBROffloadSettingsResource) apply(ctx context.Context, plan *vbrSOBROffloadSettingsResourceModel, config vbrSOBROffloadSettingsResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	settings := ivbr.SOBROffloadSettings{
+		RepositoryID:                  plan.ScaleOutRepositoryID.ValueString(),
+		CopyPolicyEnabled:             optionalBoolValue(config.CapacityTierCopyPolicyEnabled),
+		MovePolicyEnabled:             optionalBoolValue(config.CapacityTierMovePolicyEnabled),
+		OperationalRestorePeriodDays:  optionalIntValue(config.CapacityTierOperationalRestorePeriodDays),
+		OverrideEnabled:               optionalBoolValue(config.CapacityTierOverrideEnabled),
+		OverrideSpaceThresholdPercent: optionalIntValue(config.CapacityTierOverrideThresholdPercent),
+		ArchivePeriodDays:             optionalIntValue(config.ArchiveTierArchivePeriodDays),
+		CostOptimizedArchiveEnabled:   optionalBoolValue(config.ArchiveTierCostOptimizedEnabled),
+		ArchiveDeduplicationEnabled:   optionalBoolValue(config.ArchiveTierDeduplicationEnabled),
+	}
+	if !config.CapacityTierEncryptionPasswordID.IsNull() {
+		v := config.CapacityTierEncryptionPasswordID.ValueString()
+		settings.EncryptionPasswordID = &v
+	}
+	if !config.CapacityTierOffloadWindow.IsNull() {
+		var days []vbrBackupWindowDayModel
+		diags.Append(config.CapacityTierOffloadWindow.ElementsAs(ctx, &days, false)...)
+		if diags.HasError() {
+			return
+		}
+		settings.OffloadWindow = []schedule.BackupWindowDay{}
+		for _, day := range days {
+			settings.OffloadWindow = append(settings.OffloadWindow, schedule.BackupWindowDay{
+				Day:   day.Day.ValueString(),
+				Hours: day.Hours.ValueString(),
+			})
+		}
+	}
+
+	if err := ivbr.UpdateSOBROffloadSettings(ctx, r.client, settings); err != nil {
+		diags.AddError("Failed to update VBR scale-out repository offload settings", err.Error())
+		return
+	}
+
+	current, err := ivbr.GetSOBROffloadSettings(ctx, r.client, settings.RepositoryID)
+	if err != nil {
+		diags.AddError("Failed to read VBR scale-out repository offload settings", err.Error())
+		return
+	}
+
+	plan.CapacityTierOffloadWindow = config.CapacityTierOffloadWindow
+	diags.Append(plan.setFromAPI(ctx, current)...)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the settings returned by the API into the model. The offload window is kept
+// as configured when the API returns an equivalent one, e.g. with the hours as flags rather than
+// intervals.
+func (m *vbrSOBROffloadSettingsResourceModel) setFromAPI(ctx context.Context, settings *ivbr.SOBROffloadSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(settings.RepositoryID)
+	m.ScaleOutRepositoryID = types.StringValue(settings.RepositoryID)
+	m.ScaleOutRepositoryName = types.StringValue(settings.Name)
+	m.CapacityTierEnabled = types.BoolValue(settings.CapacityTierEnabled)
+	m.CapacityTierCopyPolicyEnabled = types.BoolPointerValue(settings.CopyPolicyEnabled)
+	m.CapacityTierMovePolicyEnabled = types.BoolPointerValue(settings.MovePolicyEnabled)
+	m.CapacityTierOperationalRestorePeriodDays = int64PointerValue(settings.OperationalRestorePeriodDays)
+	m.CapacityTierOverrideEnabled = types.BoolPointerValue(settings.OverrideEnabled)
+	m.CapacityTierOverrideThresholdPercent = int64PointerValue(settings.OverrideSpaceThresholdPercent)
+	m.CapacityTierEncryptionPasswordID = types.StringPointerValue(settings.EncryptionPasswordID)
+	m.ArchiveTierEnabled = types.BoolValue(settings.ArchiveTierEnabled)
+	m.ArchiveTierArchivePeriodDays = int64PointerValue(settings.ArchivePeriodDays)
+	m.ArchiveTierCostOptimizedEnabled = types.BoolPointerValue(settings.CostOptimizedArchiveEnabled)
+	m.ArchiveTierDeduplicationEnabled = types.BoolPointerValue(settings.ArchiveDeduplicationEnabled)
+
+	if !m.CapacityTierOffloadWindow.IsNull() && !m.CapacityTierOffloadWindow.IsUnknown() {
+		var days []vbrBackupWindowDayModel
+		diags.Append(m.CapacityTierOffloadWindow.ElementsAs(ctx, &days, false)...)
+		prior := make([]schedule.BackupWindowDay, 0, len(days))
+		for _, day := range days {
+			prior = append(prior, schedule.BackupWindowDay{Day: day.Day.ValueString(), Hours: day.Hours.ValueString()})
+		}
+		if !diags.HasError() && schedule.EquivalentWindows(prior, settings.OffloadWindow) {
+			return diags
+		}
+	}
+
+	days := make([]vbrBackupWindowDayModel, 0, len(settings.OffloadWindow))
+	for _, day := range settings.OffloadWindow {
+		days = append(days, vbrBackupWindowDayModel{Day: types.StringValue(day.Day), Hours: types.StringValue(day.Hours)})
+	}
+	window, d := types.ListValueFrom(ctx, vbrBackupWindowDayType, days)
+	diags.Append(d...)
+	m.CapacityTierOffloadWindow = window
+	return diags
+}
+
+func optionalIntValue(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	v := int(value.ValueInt64())
+	return &v
+}
+
+func int64PointerValue(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
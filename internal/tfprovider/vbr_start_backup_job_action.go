@@ -68,13 +68,7 @@ func (a *vbrStartBackupJobAction) Configure(_ context.Context, req action.Config
 		return
 	}
 
-	client, err := vc.GetVBRClient(req.ProviderData)
-	if err != nil {
-		resp.Diagnostics.AddError("Unexpected Action Configure Type", err.Error())
-		return
-	}
-
-	a.client = client
+	a.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
 }
 
 func (a *vbrStartBackupJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
@@ -87,10 +81,7 @@ func (a *vbrStartBackupJobAction) Invoke(ctx context.Context, req action.InvokeR
 	}
 
 	if a.client == nil {
-		resp.Diagnostics.AddError(
-			"VBR Client Not Configured",
-			"The provider did not supply a configured VBR client. Configure the provider with a vbr block before invoking this action.",
-		)
+		vbrClientNotConfigured(&resp.Diagnostics)
 		return
 	}
 
@@ -0,0 +1,414 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrSureBackupApplicationGroupResource{}
+var _ resource.ResourceWithConfigure = &vbrSureBackupApplicationGroupResource{}
+var _ resource.ResourceWithImportState = &vbrSureBackupApplicationGroupResource{}
+var _ resource.ResourceWithValidateConfig = &vbrSureBackupApplicationGroupResource{}
+
+type vbrSureBackupApplicationGroupResource struct {
+	client *vc.VBRClient
+}
+
+type vbrSureBackupApplicationGroupResourceModel struct {
+	ID              types.String                 `tfsdk:"id"`
+	Name            types.String                 `tfsdk:"name"`
+	Description     types.String                 `tfsdk:"description"`
+	VirtualMachines []vbrApplicationGroupVMModel `tfsdk:"virtual_machine"`
+	Timeouts        timeouts.Value               `tfsdk:"timeouts"`
+}
+
+type vbrApplicationGroupVMModel struct {
+	HostName                      types.String `tfsdk:"host_name"`
+	Name                          types.String `tfsdk:"name"`
+	ObjectID                      types.String `tfsdk:"object_id"`
+	Roles                         types.Set    `tfsdk:"roles"`
+	MemoryPercent                 types.Int64  `tfsdk:"memory_percent"`
+	MaxBootTimeSeconds            types.Int64  `tfsdk:"max_boot_time_seconds"`
+	ApplicationInitTimeoutSeconds types.Int64  `tfsdk:"application_init_timeout_seconds"`
+	HeartbeatCheckEnabled         types.Bool   `tfsdk:"heartbeat_check_enabled"`
+	PingCheckEnabled              types.Bool   `tfsdk:"ping_check_enabled"`
+}
+
+func NewVBRSureBackupApplicationGroupResource() resource.Resource {
+	return &vbrSureBackupApplicationGroupResource{}
+}
+
+func (r *vbrSureBackupApplicationGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_sure_backup_application_group"
+}
+
+func (r *vbrSureBackupApplicationGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam Backup & Replication SureBackup application group: the VMs a SureBackup job starts, in order, in a virtual lab before the VMs it verifies, such as domain controllers and DNS servers they depend on.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the application group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the application group.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the application group.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"virtual_machine": schema.ListNestedBlock{
+				MarkdownDescription: "A VM of the application group. VMs boot in the order of the blocks, each once the previous one has passed its checks.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"host_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the vCenter Server or ESXi host the VM is on, as added to the backup infrastructure.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the VM.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"object_id": schema.StringAttribute{
+							MarkdownDescription: "The vSphere managed object reference ID of the VM, e.g. the `object_id` of a `VirtualMachine` from `veeambackup_vbr_vmware_inventory`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"roles": schema.SetAttribute{
+							MarkdownDescription: "The roles of the VM, which select the tests SureBackup runs on it: `DNSServer`, `DomainControllerAuthoritative`, `DomainControllerNonAuthoritative`, `GlobalCatalog`, `MailServer`, `SQLServer` or `WebServer`.",
+							Optional:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(ivbr.ApplicationGroupVMRoles...)),
+							},
+						},
+						"memory_percent": schema.Int64Attribute{
+							MarkdownDescription: "The memory of the VM in the virtual lab, in percent of its production memory. Defaults to `100`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(100),
+							Validators: []validator.Int64{
+								int64validator.Between(10, 200),
+							},
+						},
+						"max_boot_time_seconds": schema.Int64Attribute{
+							MarkdownDescription: "How long the VM may take to boot before the verification fails. Defaults to `600`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(600),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"application_init_timeout_seconds": schema.Int64Attribute{
+							MarkdownDescription: "How long to wait after boot for the applications of the VM to start before they are tested. Defaults to `120`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(120),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"heartbeat_check_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the VM passes its boot check only once VMware Tools report a heartbeat. Defaults to `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"ping_check_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the VM passes its boot check only once it responds to ping. Defaults to `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrSureBackupApplicationGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig checks that no VM is listed twice and that no VM is both an authoritative and a
+// non-authoritative domain controller
+func (r *vbrSureBackupApplicationGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vbrSureBackupApplicationGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateApplicationGroup(ctx, config)...)
+}
+
+// validateApplicationGroup checks the VMs whose identity and roles are known
+func validateApplicationGroup(ctx context.Context, config vbrSureBackupApplicationGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	seen := map[string]bool{}
+	for i, vm := range config.VirtualMachines {
+		p := path.Root("virtual_machine").AtListIndex(i)
+		if !vm.HostName.IsUnknown() && !vm.ObjectID.IsUnknown() {
+			key := vm.HostName.ValueString() + "/" + vm.ObjectID.ValueString()
+			if seen[key] {
+				diags.AddAttributeError(p.AtName("object_id"), "Duplicate VM",
+					fmt.Sprintf("VM %s on %s is listed more than once.", vm.ObjectID.ValueString(), vm.HostName.ValueString()))
+			}
+			seen[key] = true
+		}
+
+		if vm.Roles.IsNull() || vm.Roles.IsUnknown() {
+			continue
+		}
+		var roles []types.String
+		diags.Append(vm.Roles.ElementsAs(ctx, &roles, false)...)
+		hasRole := map[string]bool{}
+		for _, role := range roles {
+			hasRole[role.ValueString()] = true
+		}
+		if hasRole["DomainControllerAuthoritative"] && hasRole["DomainControllerNonAuthoritative"] {
+			diags.AddAttributeError(p.AtName("roles"), "Conflicting roles",
+				"A VM is restored either as an authoritative or as a non-authoritative domain controller, not both.")
+		}
+	}
+	return diags
+}
+
+func (r *vbrSureBackupApplicationGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vbrSureBackupApplicationGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	group, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	created, err := ivbr.CreateApplicationGroup(ctx, r.client, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create VBR application group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setFromAPI(ctx, created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vbrSureBackupApplicationGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrSureBackupApplicationGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	group, err := ivbr.GetApplicationGroup(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if vc.IsGone(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read VBR application group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromAPI(ctx, group)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrSureBackupApplicationGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vbrSureBackupApplicationGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	group, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	group.ID = state.ID.ValueString()
+	updated, err := ivbr.UpdateApplicationGroup(ctx, r.client, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update VBR application group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setFromAPI(ctx, updated)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vbrSureBackupApplicationGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vbrSureBackupApplicationGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := ivbr.DeleteApplicationGroup(ctx, r.client, state.ID.ValueString()); err != nil && !vc.IsGone(err) {
+		resp.Diagnostics.AddError("Failed to delete VBR application group", err.Error())
+	}
+}
+
+func (r *vbrSureBackupApplicationGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI returns the application group the model describes
+func (m *vbrSureBackupApplicationGroupResourceModel) toAPI(ctx context.Context) (ivbr.ApplicationGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	group := ivbr.ApplicationGroup{
+		Name:            m.Name.ValueString(),
+		Description:     m.Description.ValueString(),
+		Type:            "VSphere",
+		VirtualMachines: make([]ivbr.ApplicationGroupVM, 0, len(m.VirtualMachines)),
+	}
+	for _, vm := range m.VirtualMachines {
+		roles := []string{}
+		if !vm.Roles.IsNull() && !vm.Roles.IsUnknown() {
+			diags.Append(vm.Roles.ElementsAs(ctx, &roles, false)...)
+		}
+		group.VirtualMachines = append(group.VirtualMachines, ivbr.ApplicationGroupVM{
+			VMObject: ivbr.NewSureBackupObject(vm.HostName.ValueString(), "VirtualMachine", vm.ObjectID.ValueString(), vm.Name.ValueString()),
+			Roles:    roles,
+			StartupOptions: ivbr.ApplicationGroupVMStartup{
+				AllocatedMemoryPercent:              int(vm.MemoryPercent.ValueInt64()),
+				MaximumBootTimeSec:                  int(vm.MaxBootTimeSeconds.ValueInt64()),
+				ApplicationInitializationTimeoutSec: int(vm.ApplicationInitTimeoutSeconds.ValueInt64()),
+				VMHeartbeatCheckEnabled:             vm.HeartbeatCheckEnabled.ValueBool(),
+				VMPingCheckEnabled:                  vm.PingCheckEnabled.ValueBool(),
+			},
+		})
+	}
+	return group, diags
+}
+
+// setFromAPI copies the application group returned by the API into the model
+func (m *vbrSureBackupApplicationGroupResourceModel) setFromAPI(ctx context.Context, group *ivbr.ApplicationGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(group.ID)
+	m.Name = types.StringValue(group.Name)
+	m.Description = types.StringValue(group.Description)
+	m.VirtualMachines = make([]vbrApplicationGroupVMModel, 0, len(group.VirtualMachines))
+	for _, vm := range group.VirtualMachines {
+		// Roles are optional and must be set when configured, so a VM without roles has none
+		roles := types.SetNull(types.StringType)
+		if len(vm.Roles) > 0 {
+			var d diag.Diagnostics
+			roles, d = types.SetValueFrom(ctx, types.StringType, vm.Roles)
+			diags.Append(d...)
+		}
+		m.VirtualMachines = append(m.VirtualMachines, vbrApplicationGroupVMModel{
+			HostName:                      types.StringValue(vm.VMObject.HostName),
+			Name:                          types.StringValue(vm.VMObject.Name),
+			ObjectID:                      types.StringValue(vm.VMObject.ObjectID),
+			Roles:                         roles,
+			MemoryPercent:                 types.Int64Value(int64(vm.StartupOptions.AllocatedMemoryPercent)),
+			MaxBootTimeSeconds:            types.Int64Value(int64(vm.StartupOptions.MaximumBootTimeSec)),
+			ApplicationInitTimeoutSeconds: types.Int64Value(int64(vm.StartupOptions.ApplicationInitializationTimeoutSec)),
+			HeartbeatCheckEnabled:         types.BoolValue(vm.StartupOptions.VMHeartbeatCheckEnabled),
+			PingCheckEnabled:              types.BoolValue(vm.StartupOptions.VMPingCheckEnabled),
+		})
+	}
+	return diags
+}
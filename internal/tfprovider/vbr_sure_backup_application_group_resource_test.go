@@ -0,0 +1,52 @@
+package tfprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateApplicationGroup(t *testing.T) {
+	vm := func(objectID string, roles ...string) vbrApplicationGroupVMModel {
+		model := vbrApplicationGroupVMModel{
+			HostName: types.StringValue("vcenter.example.com"),
+			ObjectID: types.StringValue(objectID),
+			Roles:    types.SetNull(types.StringType),
+		}
+		if len(roles) > 0 {
+			values := make([]attr.Value, len(roles))
+			for i, role := range roles {
+				values[i] = types.StringValue(role)
+			}
+			model.Roles = types.SetValueMust(types.StringType, values)
+		}
+		return model
+	}
+
+	tests := []struct {
+		name   string
+		vms    []vbrApplicationGroupVMModel
+		errors int
+	}{
+		{"distinct VMs", []vbrApplicationGroupVMModel{vm("vm-1", "DNSServer", "DomainControllerAuthoritative"), vm("vm-2")}, 0},
+		{"duplicate VM", []vbrApplicationGroupVMModel{vm("vm-1"), vm("vm-1", "SQLServer")}, 1},
+		{"VM not yet known", []vbrApplicationGroupVMModel{
+			vm("vm-1"),
+			{HostName: types.StringValue("vcenter.example.com"), ObjectID: types.StringUnknown(), Roles: types.SetNull(types.StringType)},
+		}, 0},
+		{"both domain controller roles", []vbrApplicationGroupVMModel{vm("vm-1", "DomainControllerAuthoritative", "DomainControllerNonAuthoritative")}, 1},
+		{"roles not yet known", []vbrApplicationGroupVMModel{
+			{HostName: types.StringValue("vcenter.example.com"), ObjectID: types.StringValue("vm-1"), Roles: types.SetUnknown(types.StringType)},
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := vbrSureBackupApplicationGroupResourceModel{VirtualMachines: tt.vms}
+			if got := validateApplicationGroup(context.Background(), config).ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,513 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrSureBackupVirtualLabResource{}
+var _ resource.ResourceWithConfigure = &vbrSureBackupVirtualLabResource{}
+var _ resource.ResourceWithImportState = &vbrSureBackupVirtualLabResource{}
+var _ resource.ResourceWithValidateConfig = &vbrSureBackupVirtualLabResource{}
+
+type vbrSureBackupVirtualLabResource struct {
+	client *vc.VBRClient
+}
+
+type vbrSureBackupVirtualLabResourceModel struct {
+	ID              types.String                       `tfsdk:"id"`
+	Name            types.String                       `tfsdk:"name"`
+	Description     types.String                       `tfsdk:"description"`
+	HostName        types.String                       `tfsdk:"host_name"`
+	ESXiHostID      types.String                       `tfsdk:"esxi_host_id"`
+	DatastoreID     types.String                       `tfsdk:"datastore_id"`
+	NetworkingType  types.String                       `tfsdk:"networking_type"`
+	ProxyAppliance  []vbrVirtualLabProxyApplianceModel `tfsdk:"proxy_appliance"`
+	NetworkMappings []vbrVirtualLabNetworkMappingModel `tfsdk:"network_mapping"`
+	Timeouts        timeouts.Value                     `tfsdk:"timeouts"`
+}
+
+type vbrVirtualLabProxyApplianceModel struct {
+	ProductionNetworkID types.String `tfsdk:"production_network_id"`
+	IPAddress           types.String `tfsdk:"ip_address"`
+	SubnetMask          types.String `tfsdk:"subnet_mask"`
+	DefaultGateway      types.String `tfsdk:"default_gateway"`
+}
+
+type vbrVirtualLabNetworkMappingModel struct {
+	ProductionNetworkID types.String `tfsdk:"production_network_id"`
+	IsolatedNetworkName types.String `tfsdk:"isolated_network_name"`
+	VLANID              types.Int64  `tfsdk:"vlan_id"`
+	ApplianceIPAddress  types.String `tfsdk:"appliance_ip_address"`
+	SubnetMask          types.String `tfsdk:"subnet_mask"`
+	MasqueradeIPAddress types.String `tfsdk:"masquerade_ip_address"`
+	DHCPEnabled         types.Bool   `tfsdk:"dhcp_enabled"`
+}
+
+func NewVBRSureBackupVirtualLabResource() resource.Resource {
+	return &vbrSureBackupVirtualLabResource{}
+}
+
+func (r *vbrSureBackupVirtualLabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_sure_backup_virtual_lab"
+}
+
+func (r *vbrSureBackupVirtualLabResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ipAddress := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Required:            true,
+			Validators: []validator.String{
+				ipv4Validator{},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam Backup & Replication SureBackup virtual lab: an isolated environment on an ESXi host in which SureBackup jobs start VMs from their backups to verify them. " +
+			"VBR deploys a proxy appliance that routes between the production network and the isolated networks of the lab.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the virtual lab.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the virtual lab.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the virtual lab.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"host_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the vCenter Server or ESXi host the lab runs on, as added to the backup infrastructure. Changing it replaces the lab.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"esxi_host_id": schema.StringAttribute{
+				MarkdownDescription: "The vSphere managed object reference ID of the ESXi host the lab runs on, e.g. the `object_id` of a `Host` from `veeambackup_vbr_vmware_inventory`. Changing it replaces the lab.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"datastore_id": schema.StringAttribute{
+				MarkdownDescription: "The vSphere managed object reference ID of the datastore that holds the redo logs of the VMs started in the lab.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"networking_type": schema.StringAttribute{
+				MarkdownDescription: "`Basic` to isolate a single production network, or `Advanced` to isolate several, one per `network_mapping`. Defaults to `Basic`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Basic"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(ivbr.VirtualLabNetworkingTypes...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"proxy_appliance": schema.ListNestedBlock{
+				MarkdownDescription: "The proxy appliance that connects the lab to the production network, so that production machines can reach the VMs in the lab through their masquerade addresses. Without it, the VMs in the lab are only tested by heartbeat.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"production_network_id": schema.StringAttribute{
+							MarkdownDescription: "The vSphere managed object reference ID of the production network the appliance is connected to.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"ip_address": schema.StringAttribute{
+							MarkdownDescription: "The IPv4 address of the appliance in the production network. The appliance obtains its address by DHCP when not set.",
+							Optional:            true,
+							Validators: []validator.String{
+								ipv4Validator{},
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("subnet_mask")),
+							},
+						},
+						"subnet_mask": schema.StringAttribute{
+							MarkdownDescription: "The subnet mask of `ip_address`.",
+							Optional:            true,
+							Validators: []validator.String{
+								ipv4Validator{},
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("ip_address")),
+							},
+						},
+						"default_gateway": schema.StringAttribute{
+							MarkdownDescription: "The default gateway of the appliance in the production network.",
+							Optional:            true,
+							Validators: []validator.String{
+								ipv4Validator{},
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("ip_address")),
+							},
+						},
+					},
+				},
+			},
+			"network_mapping": schema.ListNestedBlock{
+				MarkdownDescription: "Maps a production network to an isolated network of the lab. VMs connected to the production network are connected to the isolated network when they start in the lab. `Basic` labs have exactly one.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"production_network_id": schema.StringAttribute{
+							MarkdownDescription: "The vSphere managed object reference ID of the production network.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"isolated_network_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the isolated network VBR creates on the ESXi host.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"vlan_id": schema.Int64Attribute{
+							MarkdownDescription: "The VLAN ID of the isolated network, or `0` for none. Defaults to `0`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(0),
+							Validators: []validator.Int64{
+								int64validator.Between(0, 4094),
+							},
+						},
+						"appliance_ip_address": ipAddress("The IPv4 address of the proxy appliance in the isolated network, usually the address of the production gateway so that the VMs keep their network settings."),
+						"subnet_mask":          ipAddress("The subnet mask of the isolated network."),
+						"masquerade_ip_address": schema.StringAttribute{
+							MarkdownDescription: "The network, e.g. `172.18.0.0`, through which production machines reach the VMs in the isolated network. VBR derives it from `appliance_ip_address` when not set.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								ipv4Validator{},
+							},
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"dhcp_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the proxy appliance serves DHCP in the isolated network. Defaults to `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrSureBackupVirtualLabResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig checks the network mappings against the networking type
+func (r *vbrSureBackupVirtualLabResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vbrSureBackupVirtualLabResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateVirtualLab(config)...)
+}
+
+// validateVirtualLab checks that basic labs have a single network mapping and that no production
+// network or isolated network is mapped twice. networking_type defaults to Basic when not set.
+func validateVirtualLab(config vbrSureBackupVirtualLabResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	basic := config.NetworkingType.IsNull() || config.NetworkingType.ValueString() == "Basic"
+	if basic && !config.NetworkingType.IsUnknown() && len(config.NetworkMappings) > 1 {
+		diags.AddAttributeError(path.Root("network_mapping"), "Too many network mappings",
+			fmt.Sprintf("A Basic virtual lab isolates a single production network, but %d network_mapping blocks are set. Set networking_type to Advanced to isolate several.", len(config.NetworkMappings)))
+	}
+
+	productionNetworks := map[string]bool{}
+	isolatedNetworks := map[string]bool{}
+	for i, mapping := range config.NetworkMappings {
+		p := path.Root("network_mapping").AtListIndex(i)
+		if !mapping.ProductionNetworkID.IsUnknown() {
+			if id := mapping.ProductionNetworkID.ValueString(); productionNetworks[id] {
+				diags.AddAttributeError(p.AtName("production_network_id"), "Duplicate production network",
+					fmt.Sprintf("Production network %s is mapped more than once.", id))
+			}
+			productionNetworks[mapping.ProductionNetworkID.ValueString()] = true
+		}
+		if !mapping.IsolatedNetworkName.IsUnknown() {
+			if name := mapping.IsolatedNetworkName.ValueString(); isolatedNetworks[name] {
+				diags.AddAttributeError(p.AtName("isolated_network_name"), "Duplicate isolated network",
+					fmt.Sprintf("Isolated network %q is used by more than one network_mapping.", name))
+			}
+			isolatedNetworks[mapping.IsolatedNetworkName.ValueString()] = true
+		}
+	}
+	return diags
+}
+
+func (r *vbrSureBackupVirtualLabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vbrSureBackupVirtualLabResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	lab, err := ivbr.CreateVirtualLab(ctx, r.client, plan.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create VBR virtual lab", err.Error())
+		return
+	}
+
+	plan.setFromAPI(lab)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vbrSureBackupVirtualLabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrSureBackupVirtualLabResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	lab, err := ivbr.GetVirtualLab(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if vc.IsGone(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read VBR virtual lab", err.Error())
+		return
+	}
+
+	state.setFromAPI(lab)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrSureBackupVirtualLabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vbrSureBackupVirtualLabResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	lab := plan.toAPI()
+	lab.ID = state.ID.ValueString()
+	updated, err := ivbr.UpdateVirtualLab(ctx, r.client, lab)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update VBR virtual lab", err.Error())
+		return
+	}
+
+	plan.setFromAPI(updated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vbrSureBackupVirtualLabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vbrSureBackupVirtualLabResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := ivbr.DeleteVirtualLab(ctx, r.client, state.ID.ValueString()); err != nil && !vc.IsGone(err) {
+		resp.Diagnostics.AddError("Failed to delete VBR virtual lab", err.Error())
+	}
+}
+
+func (r *vbrSureBackupVirtualLabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// toAPI returns the virtual lab the model describes
+func (m *vbrSureBackupVirtualLabResourceModel) toAPI() ivbr.VirtualLab {
+	hostName := m.HostName.ValueString()
+	lab := ivbr.VirtualLab{
+		Name:            m.Name.ValueString(),
+		Description:     m.Description.ValueString(),
+		Type:            "VSphere",
+		Host:            ivbr.NewSureBackupObject(hostName, "Host", m.ESXiHostID.ValueString(), ""),
+		Datastore:       ivbr.NewSureBackupObject(hostName, "Datastore", m.DatastoreID.ValueString(), ""),
+		NetworkingType:  m.NetworkingType.ValueString(),
+		NetworkMappings: make([]ivbr.VirtualLabNetworkMapping, 0, len(m.NetworkMappings)),
+	}
+	for _, appliance := range m.ProxyAppliance {
+		lab.ProxyAppliance = &ivbr.VirtualLabProxyAppliance{
+			ProductionNetwork: ivbr.NewSureBackupObject(hostName, "Network", appliance.ProductionNetworkID.ValueString(), ""),
+			IPAddress:         appliance.IPAddress.ValueString(),
+			SubnetMask:        appliance.SubnetMask.ValueString(),
+			DefaultGateway:    appliance.DefaultGateway.ValueString(),
+		}
+	}
+	for _, mapping := range m.NetworkMappings {
+		lab.NetworkMappings = append(lab.NetworkMappings, ivbr.VirtualLabNetworkMapping{
+			ProductionNetwork:   ivbr.NewSureBackupObject(hostName, "Network", mapping.ProductionNetworkID.ValueString(), ""),
+			IsolatedNetworkName: mapping.IsolatedNetworkName.ValueString(),
+			VLANID:              int(mapping.VLANID.ValueInt64()),
+			ApplianceIPAddress:  mapping.ApplianceIPAddress.ValueString(),
+			SubnetMask:          mapping.SubnetMask.ValueString(),
+			MasqueradeIPAddress: mapping.MasqueradeIPAddress.ValueString(),
+			DHCPEnabled:         mapping.DHCPEnabled.ValueBool(),
+		})
+	}
+	return lab
+}
+
+// setFromAPI copies the virtual lab returned by the API into the model
+func (m *vbrSureBackupVirtualLabResourceModel) setFromAPI(lab *ivbr.VirtualLab) {
+	m.ID = types.StringValue(lab.ID)
+	m.Name = types.StringValue(lab.Name)
+	m.Description = types.StringValue(lab.Description)
+	m.HostName = types.StringValue(lab.Host.HostName)
+	m.ESXiHostID = types.StringValue(lab.Host.ObjectID)
+	m.DatastoreID = types.StringValue(lab.Datastore.ObjectID)
+	m.NetworkingType = types.StringValue(lab.NetworkingType)
+
+	m.ProxyAppliance = nil
+	if appliance := lab.ProxyAppliance; appliance != nil {
+		m.ProxyAppliance = []vbrVirtualLabProxyApplianceModel{{
+			ProductionNetworkID: types.StringValue(appliance.ProductionNetwork.ObjectID),
+			IPAddress:           optionalStringAttribute(appliance.IPAddress),
+			SubnetMask:          optionalStringAttribute(appliance.SubnetMask),
+			DefaultGateway:      optionalStringAttribute(appliance.DefaultGateway),
+		}}
+	}
+
+	m.NetworkMappings = make([]vbrVirtualLabNetworkMappingModel, 0, len(lab.NetworkMappings))
+	for _, mapping := range lab.NetworkMappings {
+		m.NetworkMappings = append(m.NetworkMappings, vbrVirtualLabNetworkMappingModel{
+			ProductionNetworkID: types.StringValue(mapping.ProductionNetwork.ObjectID),
+			IsolatedNetworkName: types.StringValue(mapping.IsolatedNetworkName),
+			VLANID:              types.Int64Value(int64(mapping.VLANID)),
+			ApplianceIPAddress:  types.StringValue(mapping.ApplianceIPAddress),
+			SubnetMask:          types.StringValue(mapping.SubnetMask),
+			MasqueradeIPAddress: types.StringValue(mapping.MasqueradeIPAddress),
+			DHCPEnabled:         types.BoolValue(mapping.DHCPEnabled),
+		})
+	}
+}
+
+// optionalStringAttribute returns a string the API returns empty when it is not set as null
+func optionalStringAttribute(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// ipv4Validator checks that a string is an IPv4 address or subnet mask in dotted notation
+type ipv4Validator struct{}
+
+func (v ipv4Validator) Description(context.Context) string {
+	return "value must be an IPv4 address, e.g. 192.168.0.1"
+}
+
+func (v ipv4Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv4Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if ip := net.ParseIP(req.ConfigValue.ValueString()); ip == nil || ip.To4() == nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IPv4 address",
+			fmt.Sprintf("%q is not an IPv4 address in dotted notation, e.g. 192.168.0.1.", req.ConfigValue.ValueString()))
+	}
+}
@@ -0,0 +1,44 @@
+package tfprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateVirtualLab(t *testing.T) {
+	mapping := func(productionNetworkID, isolatedNetworkName string) vbrVirtualLabNetworkMappingModel {
+		return vbrVirtualLabNetworkMappingModel{
+			ProductionNetworkID: types.StringValue(productionNetworkID),
+			IsolatedNetworkName: types.StringValue(isolatedNetworkName),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		networkingType types.String
+		mappings       []vbrVirtualLabNetworkMappingModel
+		errors         int
+	}{
+		{"basic", types.StringValue("Basic"), []vbrVirtualLabNetworkMappingModel{mapping("network-1", "Lab A")}, 0},
+		{"basic by default", types.StringNull(), []vbrVirtualLabNetworkMappingModel{mapping("network-1", "Lab A")}, 0},
+		{"basic with several mappings", types.StringValue("Basic"), []vbrVirtualLabNetworkMappingModel{mapping("network-1", "Lab A"), mapping("network-2", "Lab B")}, 1},
+		{"default with several mappings", types.StringNull(), []vbrVirtualLabNetworkMappingModel{mapping("network-1", "Lab A"), mapping("network-2", "Lab B")}, 1},
+		{"type not yet known", types.StringUnknown(), []vbrVirtualLabNetworkMappingModel{mapping("network-1", "Lab A"), mapping("network-2", "Lab B")}, 0},
+		{"advanced", types.StringValue("Advanced"), []vbrVirtualLabNetworkMappingModel{mapping("network-1", "Lab A"), mapping("network-2", "Lab B")}, 0},
+		{"duplicate production network", types.StringValue("Advanced"), []vbrVirtualLabNetworkMappingModel{mapping("network-1", "Lab A"), mapping("network-1", "Lab B")}, 1},
+		{"duplicate isolated network", types.StringValue("Advanced"), []vbrVirtualLabNetworkMappingModel{mapping("network-1", "Lab A"), mapping("network-2", "Lab A")}, 1},
+		{"production network not yet known", types.StringValue("Advanced"), []vbrVirtualLabNetworkMappingModel{
+			mapping("network-1", "Lab A"),
+			{ProductionNetworkID: types.StringUnknown(), IsolatedNetworkName: types.StringValue("Lab B")},
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := vbrSureBackupVirtualLabResourceModel{NetworkingType: tt.networkingType, NetworkMappings: tt.mappings}
+			if got := validateVirtualLab(config).ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+	ivbr "terraform-provider-veeambackup/internal/vbr"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &vbrSyslogSettingsResource{}
+var _ resource.ResourceWithConfigure = &vbrSyslogSettingsResource{}
+var _ resource.ResourceWithImportState = &vbrSyslogSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &vbrSyslogSettingsResource{}
+
+// vbrSyslogSettingsID is the ID of the syslog settings, of which every backup server has one
+const vbrSyslogSettingsID = "syslog"
+
+type vbrSyslogSettingsResource struct {
+	client *vc.VBRClient
+}
+
+type vbrSyslogSettingsResourceModel struct {
+	ID            types.String           `tfsdk:"id"`
+	SyslogServers []vbrSyslogServerModel `tfsdk:"syslog_server"`
+	Timeouts      timeouts.Value         `tfsdk:"timeouts"`
+}
+
+type vbrSyslogServerModel struct {
+	ServerName            types.String `tfsdk:"server_name"`
+	Port                  types.Int64  `tfsdk:"port"`
+	TransportProtocol     types.String `tfsdk:"transport_protocol"`
+	CertificateThumbprint types.String `tfsdk:"certificate_thumbprint"`
+}
+
+func NewVBRSyslogSettingsResource() resource.Resource {
+	return &vbrSyslogSettingsResource{}
+}
+
+func (r *vbrSyslogSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vbr_syslog_settings"
+}
+
+func (r *vbrSyslogSettingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the syslog servers Veeam Backup & Replication forwards its events to, e.g. for SIEM integration. Requires VBR 12.1 or later. " +
+			"The backup server has a single list of syslog servers, so only one instance of this resource may exist per backup server; destroying it stops event forwarding to syslog.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `syslog`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"syslog_server": schema.ListNestedBlock{
+				MarkdownDescription: "A syslog server events are forwarded to.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"server_name": schema.StringAttribute{
+							MarkdownDescription: "The DNS name or IP address of the syslog server.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "The port of the syslog server. Defaults to 6514 for `Tls` and to 514 otherwise.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+							PlanModifiers: []planmodifier.Int64{
+								syslogDefaultPort{},
+							},
+						},
+						"transport_protocol": schema.StringAttribute{
+							MarkdownDescription: "The transport protocol: `Udp`, `Tcp` or `Tls`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(ivbr.SyslogProtocols...),
+							},
+						},
+						"certificate_thumbprint": schema.StringAttribute{
+							MarkdownDescription: "The thumbprint of the certificate of the syslog server, which VBR trusts for TLS. Required for `Tls` and not allowed otherwise.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vbrSyslogSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureVBRClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig checks that TLS servers have a certificate thumbprint and that no server is
+// listed twice
+func (r *vbrSyslogSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var servers []vbrSyslogServerModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("syslog_server"), &servers)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSyslogServers(servers)...)
+}
+
+// validateSyslogServers checks the syslog servers whose values are known
+func validateSyslogServers(servers []vbrSyslogServerModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	seen := map[string]bool{}
+	for i, server := range servers {
+		p := path.Root("syslog_server").AtListIndex(i)
+		if !server.TransportProtocol.IsUnknown() && !server.CertificateThumbprint.IsUnknown() {
+			tls := server.TransportProtocol.ValueString() == ivbr.SyslogProtocolTLS
+			if tls && server.CertificateThumbprint.IsNull() {
+				diags.AddAttributeError(p.AtName("certificate_thumbprint"), "Missing certificate thumbprint",
+					"certificate_thumbprint is required for syslog servers reached over Tls.")
+			}
+			if !tls && !server.CertificateThumbprint.IsNull() {
+				diags.AddAttributeError(p.AtName("certificate_thumbprint"), "Unexpected certificate thumbprint",
+					fmt.Sprintf("certificate_thumbprint is only used for Tls, not for %s.", server.TransportProtocol.ValueString()))
+			}
+		}
+
+		if server.ServerName.IsUnknown() || server.Port.IsUnknown() || server.TransportProtocol.IsUnknown() {
+			continue
+		}
+		port := server.Port.ValueInt64()
+		if server.Port.IsNull() {
+			port = int64(ivbr.DefaultSyslogPort(server.TransportProtocol.ValueString()))
+		}
+		key := fmt.Sprintf("%s:%d", server.ServerName.ValueString(), port)
+		if seen[key] {
+			diags.AddAttributeError(p.AtName("server_name"), "Duplicate syslog server",
+				fmt.Sprintf("%s is listed more than once.", key))
+		}
+		seen[key] = true
+	}
+	return diags
+}
+
+func (r *vbrSyslogSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vbrSyslogSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *vbrSyslogSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vbrSyslogSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	servers, err := ivbr.GetSyslogServers(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read VBR syslog servers", err.Error())
+		return
+	}
+
+	state.setFromAPI(servers)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vbrSyslogSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vbrSyslogSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete removes every syslog server, which stops event forwarding to syslog
+func (r *vbrSyslogSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vbrSyslogSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		vbrClientNotConfigured(&resp.Diagnostics)
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := ivbr.UpdateSyslogServers(ctx, r.client, nil); err != nil {
+		resp.Diagnostics.AddError("Failed to remove VBR syslog servers", err.Error())
+	}
+}
+
+// ImportState imports the syslog servers of the backup server whatever the given ID
+func (r *vbrSyslogSettingsResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vbrSyslogSettingsID)...)
+}
+
+// apply replaces the syslog servers of the backup server with the planned ones and stores the
+// servers read back in state
+func (r *vbrSyslogSettingsResource) apply(ctx context.Context, plan *vbrSyslogSettingsResourceModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	servers := make([]ivbr.SyslogServer, 0, len(plan.SyslogServers))
+	for _, server := range plan.SyslogServers {
+		servers = append(servers, ivbr.SyslogServer{
+			ServerName:            server.ServerName.ValueString(),
+			Port:                  int(server.Port.ValueInt64()),
+			TransportProtocol:     server.TransportProtocol.ValueString(),
+			CertificateThumbprint: server.CertificateThumbprint.ValueStringPointer(),
+		})
+	}
+
+	if err := ivbr.UpdateSyslogServers(ctx, r.client, servers); err != nil {
+		diags.AddError("Failed to update VBR syslog servers", err.Error())
+		return
+	}
+
+	current, err := ivbr.GetSyslogServers(ctx, r.client)
+	if err != nil {
+		diags.AddError("Failed to read VBR syslog servers", err.Error())
+		return
+	}
+
+	plan.setFromAPI(current)
+	diags.Append(state.Set(ctx, plan)...)
+}
+
+// setFromAPI copies the syslog servers returned by the API into the model
+func (m *vbrSyslogSettingsResourceModel) setFromAPI(servers []ivbr.SyslogServer) {
+	m.ID = types.StringValue(vbrSyslogSettingsID)
+	m.SyslogServers = make([]vbrSyslogServerModel, 0, len(servers))
+	for _, server := range servers {
+		m.SyslogServers = append(m.SyslogServers, vbrSyslogServerModel{
+			ServerName:            types.StringValue(server.ServerName),
+			Port:                  types.Int64Value(int64(server.Port)),
+			TransportProtocol:     types.StringValue(server.TransportProtocol),
+			CertificateThumbprint: types.StringPointerValue(server.CertificateThumbprint),
+		})
+	}
+	if len(m.SyslogServers) == 0 {
+		m.SyslogServers = nil
+	}
+}
+
+// syslogDefaultPort plans the well-known port of the transport protocol for syslog servers
+// configured without a port
+type syslogDefaultPort struct{}
+
+func (m syslogDefaultPort) Description(context.Context) string {
+	return "Defaults to 6514 for Tls and to 514 otherwise."
+}
+
+func (m syslogDefaultPort) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m syslogDefaultPort) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var protocol types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName("transport_protocol"), &protocol)...)
+	if resp.Diagnostics.HasError() || protocol.IsUnknown() || protocol.IsNull() {
+		return
+	}
+	resp.PlanValue = types.Int64Value(int64(ivbr.DefaultSyslogPort(protocol.ValueString())))
+}
@@ -0,0 +1,47 @@
+package tfprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateSyslogServers(t *testing.T) {
+	server := func(name string, port types.Int64, protocol string, thumbprint types.String) vbrSyslogServerModel {
+		return vbrSyslogServerModel{
+			ServerName:            types.StringValue(name),
+			Port:                  port,
+			TransportProtocol:     types.StringValue(protocol),
+			CertificateThumbprint: thumbprint,
+		}
+	}
+	noPort, noThumbprint := types.Int64Null(), types.StringNull()
+	thumbprint := types.StringValue("0123456789ABCDEF")
+
+	tests := []struct {
+		name    string
+		servers []vbrSyslogServerModel
+		errors  int
+	}{
+		{"udp", []vbrSyslogServerModel{server("siem", noPort, "Udp", noThumbprint)}, 0},
+		{"tls", []vbrSyslogServerModel{server("siem", noPort, "Tls", thumbprint)}, 0},
+		{"tls without thumbprint", []vbrSyslogServerModel{server("siem", noPort, "Tls", noThumbprint)}, 1},
+		{"tcp with thumbprint", []vbrSyslogServerModel{server("siem", noPort, "Tcp", thumbprint)}, 1},
+		{"unknown thumbprint", []vbrSyslogServerModel{server("siem", noPort, "Tls", types.StringUnknown())}, 0},
+		{"same server on other ports", []vbrSyslogServerModel{
+			server("siem", noPort, "Udp", noThumbprint),
+			server("siem", noPort, "Tls", thumbprint),
+		}, 0},
+		{"duplicate with default port", []vbrSyslogServerModel{
+			server("siem", noPort, "Udp", noThumbprint),
+			server("siem", types.Int64Value(514), "Tcp", noThumbprint),
+		}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateSyslogServers(tt.servers).ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d", got, tt.errors)
+			}
+		})
+	}
+}
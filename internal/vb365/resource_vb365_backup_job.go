@@ -0,0 +1,513 @@
+package vb365
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// VB365JobRequest is the body of POST /Organizations/{id}/Jobs and PUT /Jobs/{id}
+type VB365JobRequest struct {
+	Name           string               `json:"name"`
+	Description    *string              `json:"description,omitempty"`
+	BackupType     string               `json:"backupType"`
+	RepositoryID   string               `json:"repositoryId"`
+	IsEnabled      bool                 `json:"isEnabled"`
+	SelectedItems  []VB365JobItem       `json:"selectedItems,omitempty"`
+	SchedulePolicy *VB365SchedulePolicy `json:"schedulePolicy,omitempty"`
+}
+
+// VB365JobItem is an object processed by a job. A PartialOrganization item selects the data of
+// the entire organization; User, Site and Team items select single objects.
+type VB365JobItem struct {
+	Type           string        `json:"type"`
+	User           *VB365ItemRef `json:"user,omitempty"`
+	Site           *VB365ItemRef `json:"site,omitempty"`
+	Team           *VB365ItemRef `json:"team,omitempty"`
+	Mailbox        *bool         `json:"mailbox,omitempty"`
+	ArchiveMailbox *bool         `json:"archiveMailbox,omitempty"`
+	OneDrive       *bool         `json:"oneDrive,omitempty"`
+	PersonalSite   *bool         `json:"personalSite,omitempty"`
+	Sites          *bool         `json:"sites,omitempty"`
+	Teams          *bool         `json:"teams,omitempty"`
+	TeamsChats     *bool         `json:"teamsChats,omitempty"`
+}
+
+type VB365ItemRef struct {
+	ID string `json:"id"`
+}
+
+type VB365SchedulePolicy struct {
+	Type              string  `json:"type"`
+	DailyType         *string `json:"dailyType,omitempty"`
+	DailyTime         *string `json:"dailyTime,omitempty"`
+	PeriodicallyEvery *string `json:"periodicallyEvery,omitempty"`
+	RetryEnabled      bool    `json:"retryEnabled"`
+	RetryNumber       *int    `json:"retryNumber,omitempty"`
+	RetryWaitInterval *int    `json:"retryWaitInterval,omitempty"`
+}
+
+type VB365JobResponse struct {
+	ID             string `json:"id"`
+	OrganizationID string `json:"organizationId"`
+	LastStatus     string `json:"lastStatus"`
+	VB365JobRequest
+}
+
+// ResourceVB365BackupJob returns the resource for VB365 backup jobs
+func ResourceVB365BackupJob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVB365BackupJobCreate,
+		ReadContext:   resourceVB365BackupJobRead,
+		UpdateContext: resourceVB365BackupJobUpdate,
+		DeleteContext: resourceVB365BackupJobDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the organization the job backs up, e.g. the ID of a veeambackup_vb365_organization resource.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the backup job.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the backup job.",
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "ID of the backup repository that stores the backups.",
+			},
+			"is_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the job is enabled.",
+			},
+			"backup_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "EntireOrganization",
+				Description:  "Whether the job backs up the entire organization or the selected users, sites and teams. Possible values are EntireOrganization and SelectedItems.",
+				ValidateFunc: validation.StringInSlice([]string{"EntireOrganization", "SelectedItems"}, false),
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Specifies the Microsoft 365 data the job backs up.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mailbox": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether Exchange Online mailboxes are backed up.",
+						},
+						"archive_mailbox": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether Exchange Online archive mailboxes are backed up.",
+						},
+						"onedrive": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether OneDrive for Business data is backed up.",
+						},
+						"personal_site": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the personal SharePoint sites of users are backed up.",
+						},
+						"sites": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether SharePoint Online sites are backed up; applies if backup_type is EntireOrganization.",
+						},
+						"teams": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether Microsoft Teams are backed up; applies if backup_type is EntireOrganization.",
+						},
+						"teams_chats": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether Microsoft Teams chats are backed up; applies if backup_type is EntireOrganization.",
+						},
+					},
+				},
+			},
+			"users": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the users whose mailboxes, OneDrive and personal sites are backed up, as selected in scope; applies if backup_type is SelectedItems.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sites": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the SharePoint sites to back up; applies if backup_type is SelectedItems.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"teams": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the teams to back up; applies if backup_type is SelectedItems.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"schedule_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Specifies when the job runs. The job runs only on demand if omitted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Possible values are Daily and Periodically.",
+							ValidateFunc: validation.StringInSlice([]string{"Daily", "Periodically"}, false),
+						},
+						"daily_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Days the job runs on; applies if type is Daily.",
+							ValidateFunc: validation.StringInSlice([]string{"Everyday", "Workdays", "Weekends", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}, false),
+						},
+						"daily_time": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Time of day the job starts, in the hh:mm:ss format; applies if type is Daily.",
+						},
+						"periodically_every": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Interval between job runs; applies if type is Periodically.",
+							ValidateFunc: validation.StringInSlice([]string{"Minutes5", "Minutes10", "Minutes15", "Minutes30", "Hours1", "Hours2", "Hours4", "Hours8"}, false),
+						},
+						"retry_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether failed runs are retried.",
+						},
+						"retry_number": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							Description:  "Number of retries.",
+							ValidateFunc: validation.IntBetween(1, 777),
+						},
+						"retry_wait_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							Description:  "Minutes to wait between retries.",
+							ValidateFunc: validation.IntBetween(1, 999),
+						},
+					},
+				},
+			},
+			// Computed
+			"last_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the latest job run.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func buildJobRequest(d *schema.ResourceData, client *vc.VB365Client) (VB365JobRequest, error) {
+	req := VB365JobRequest{
+		Name:           d.Get("name").(string),
+		BackupType:     d.Get("backup_type").(string),
+		RepositoryID:   d.Get("repository_id").(string),
+		IsEnabled:      d.Get("is_enabled").(bool),
+		SchedulePolicy: expandSchedulePolicy(d.Get("schedule_policy").([]interface{})),
+	}
+
+	if description := client.AppendDescriptionSuffix(d.Get("description").(string)); description != "" {
+		req.Description = &description
+	}
+
+	scope := map[string]interface{}{}
+	if v := d.Get("scope").([]interface{}); len(v) > 0 && v[0] != nil {
+		scope = v[0].(map[string]interface{})
+	}
+	flag := func(key string) *bool {
+		b, _ := scope[key].(bool)
+		return boolPtr(b)
+	}
+
+	if req.BackupType == "EntireOrganization" {
+		req.SelectedItems = []VB365JobItem{{
+			Type:           "PartialOrganization",
+			Mailbox:        flag("mailbox"),
+			ArchiveMailbox: flag("archive_mailbox"),
+			OneDrive:       flag("onedrive"),
+			PersonalSite:   flag("personal_site"),
+			Sites:          flag("sites"),
+			Teams:          flag("teams"),
+			TeamsChats:     flag("teams_chats"),
+		}}
+		return req, nil
+	}
+
+	for _, id := range expandStringSet(d.Get("users")) {
+		req.SelectedItems = append(req.SelectedItems, VB365JobItem{
+			Type:           "User",
+			User:           &VB365ItemRef{ID: id},
+			Mailbox:        flag("mailbox"),
+			ArchiveMailbox: flag("archive_mailbox"),
+			OneDrive:       flag("onedrive"),
+			PersonalSite:   flag("personal_site"),
+		})
+	}
+	for _, id := range expandStringSet(d.Get("sites")) {
+		req.SelectedItems = append(req.SelectedItems, VB365JobItem{Type: "Site", Site: &VB365ItemRef{ID: id}})
+	}
+	for _, id := range expandStringSet(d.Get("teams")) {
+		req.SelectedItems = append(req.SelectedItems, VB365JobItem{Type: "Team", Team: &VB365ItemRef{ID: id}})
+	}
+	if len(req.SelectedItems) == 0 {
+		return req, fmt.Errorf("at least one of users, sites or teams is required when backup_type is SelectedItems")
+	}
+
+	return req, nil
+}
+
+func expandSchedulePolicy(input []interface{}) *VB365SchedulePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	m := input[0].(map[string]interface{})
+
+	policy := &VB365SchedulePolicy{
+		Type:         m["type"].(string),
+		RetryEnabled: m["retry_enabled"].(bool),
+	}
+	if v := m["daily_type"].(string); v != "" {
+		policy.DailyType = &v
+	}
+	if v := m["daily_time"].(string); v != "" {
+		policy.DailyTime = &v
+	}
+	if v := m["periodically_every"].(string); v != "" {
+		policy.PeriodicallyEvery = &v
+	}
+	if policy.RetryEnabled {
+		retryNumber := m["retry_number"].(int)
+		retryWaitInterval := m["retry_wait_interval"].(int)
+		policy.RetryNumber = &retryNumber
+		policy.RetryWaitInterval = &retryWaitInterval
+	}
+	return policy
+}
+
+func flattenSchedulePolicy(policy *VB365SchedulePolicy, d *schema.ResourceData) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{
+		"type":                policy.Type,
+		"daily_type":          "",
+		"daily_time":          "",
+		"periodically_every":  "",
+		"retry_enabled":       policy.RetryEnabled,
+		"retry_number":        d.Get("schedule_policy.0.retry_number"),
+		"retry_wait_interval": d.Get("schedule_policy.0.retry_wait_interval"),
+	}
+	if policy.DailyType != nil {
+		m["daily_type"] = *policy.DailyType
+	}
+	if policy.DailyTime != nil {
+		m["daily_time"] = *policy.DailyTime
+	}
+	if policy.PeriodicallyEvery != nil {
+		m["periodically_every"] = *policy.PeriodicallyEvery
+	}
+	if policy.RetryNumber != nil {
+		m["retry_number"] = *policy.RetryNumber
+	}
+	if policy.RetryWaitInterval != nil {
+		m["retry_wait_interval"] = *policy.RetryWaitInterval
+	}
+	return []interface{}{m}
+}
+
+// flattenJobItems converts the selected items of a job into the scope block and the users, sites
+// and teams sets. Scope flags that no item carries, such as sites for a job of selected users,
+// keep their configured value.
+func flattenJobItems(items []VB365JobItem, d *schema.ResourceData) map[string]interface{} {
+	scope := map[string]interface{}{}
+	if v := d.Get("scope").([]interface{}); len(v) > 0 && v[0] != nil {
+		for key, value := range v[0].(map[string]interface{}) {
+			scope[key] = value
+		}
+	}
+	setFlag := func(key string, value *bool) {
+		if value != nil {
+			scope[key] = *value
+		}
+	}
+
+	var users, sites, teams []string
+	for _, item := range items {
+		switch item.Type {
+		case "User":
+			if item.User != nil {
+				users = append(users, item.User.ID)
+			}
+		case "Site":
+			if item.Site != nil {
+				sites = append(sites, item.Site.ID)
+			}
+			continue
+		case "Team":
+			if item.Team != nil {
+				teams = append(teams, item.Team.ID)
+			}
+			continue
+		}
+		setFlag("mailbox", item.Mailbox)
+		setFlag("archive_mailbox", item.ArchiveMailbox)
+		setFlag("onedrive", item.OneDrive)
+		setFlag("personal_site", item.PersonalSite)
+		setFlag("sites", item.Sites)
+		setFlag("teams", item.Teams)
+		setFlag("teams_chats", item.TeamsChats)
+	}
+
+	return map[string]interface{}{
+		"scope": []interface{}{scope},
+		"users": users,
+		"sites": sites,
+		"teams": teams,
+	}
+}
+
+func resourceVB365BackupJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := buildJobRequest(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(req)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal backup job request: %w", err))
+	}
+
+	endpoint := vc.Endpoint("/Organizations/%s/Jobs", d.Get("organization_id").(string))
+	respBody, err := client.DoRequest(ctx, "POST", client.BuildAPIURL(endpoint), bodyBytes)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create backup job: %w", err))
+	}
+
+	var jobResp VB365JobResponse
+	if err := json.Unmarshal(respBody, &jobResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse backup job response: %w", err))
+	}
+
+	d.SetId(jobResp.ID)
+	return resourceVB365BackupJobRead(ctx, d, meta)
+}
+
+func resourceVB365BackupJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL(vc.Endpoint("/Jobs/%s", d.Id())), nil)
+	if err != nil {
+		if vc.RemoveFromStateIfGone(d, err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read backup job: %w", err))
+	}
+
+	var jobResp VB365JobResponse
+	if err := json.Unmarshal(respBody, &jobResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse backup job response: %w", err))
+	}
+
+	var description string
+	if jobResp.Description != nil {
+		description = *jobResp.Description
+	}
+
+	values := flattenJobItems(jobResp.SelectedItems, d)
+	values["organization_id"] = jobResp.OrganizationID
+	values["name"] = jobResp.Name
+	values["description"] = client.TrimDescriptionSuffix(description)
+	values["repository_id"] = jobResp.RepositoryID
+	values["is_enabled"] = jobResp.IsEnabled
+	values["backup_type"] = jobResp.BackupType
+	values["schedule_policy"] = flattenSchedulePolicy(jobResp.SchedulePolicy, d)
+	values["last_status"] = jobResp.LastStatus
+
+	return diag.FromErr(setAll(d, values))
+}
+
+func resourceVB365BackupJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := buildJobRequest(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(req)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal backup job update request: %w", err))
+	}
+
+	if _, err := client.DoRequest(ctx, "PUT", client.BuildAPIURL(vc.Endpoint("/Jobs/%s", d.Id())), bodyBytes); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update backup job: %w", err))
+	}
+
+	return resourceVB365BackupJobRead(ctx, d, meta)
+}
+
+func resourceVB365BackupJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.DoRequest(ctx, "DELETE", client.BuildAPIURL(vc.Endpoint("/Jobs/%s", d.Id())), nil); err != nil && !vc.IsGone(err) {
+		return diag.FromErr(fmt.Errorf("failed to delete backup job: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
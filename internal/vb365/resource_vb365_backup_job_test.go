@@ -0,0 +1,53 @@
+package vb365
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSchedulePolicyRoundTrip(t *testing.T) {
+	r := ResourceVB365BackupJob()
+	for name, policy := range map[string]map[string]interface{}{
+		"daily": {
+			"type":                "Daily",
+			"daily_type":          "Workdays",
+			"daily_time":          "22:00:00",
+			"retry_number":        5,
+			"retry_wait_interval": 30,
+		},
+		"periodically": {
+			"type":               "Periodically",
+			"periodically_every": "Hours4",
+		},
+		"without retries": {
+			"type":          "Daily",
+			"daily_type":    "Everyday",
+			"daily_time":    "01:30:00",
+			"retry_enabled": false,
+			"retry_number":  7,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"schedule_policy": []interface{}{policy}})
+			expanded := expandSchedulePolicy(d.Get("schedule_policy").([]interface{}))
+
+			// The API does not return retry settings of a policy without retries, so they are kept
+			// from the configuration
+			if err := d.Set("schedule_policy", flattenSchedulePolicy(expanded, d)); err != nil {
+				t.Fatalf("setting schedule_policy: %s", err)
+			}
+			if got := expandSchedulePolicy(d.Get("schedule_policy").([]interface{})); !reflect.DeepEqual(got, expanded) {
+				t.Errorf("schedule policy round trip:\n got %+v\nwant %+v", got, expanded)
+			}
+			if want, ok := policy["retry_number"]; ok && d.Get("schedule_policy.0.retry_number") != want {
+				t.Errorf("retry_number = %v, want %v", d.Get("schedule_policy.0.retry_number"), want)
+			}
+		})
+	}
+
+	if got := flattenSchedulePolicy(nil, schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})); len(got) != 0 {
+		t.Errorf("flattenSchedulePolicy(nil) = %v, want an empty list", got)
+	}
+}
@@ -0,0 +1,260 @@
+package vb365
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// VB365RepositoryRequest is the body of POST /BackupRepositories and PUT /BackupRepositories/{id}.
+// Only the retention period matching RetentionPeriodType is sent.
+type VB365RepositoryRequest struct {
+	Name                   string  `json:"name"`
+	Description            string  `json:"description"`
+	ProxyID                string  `json:"proxyId"`
+	Path                   string  `json:"path"`
+	RetentionType          string  `json:"retentionType"`
+	RetentionPeriodType    string  `json:"retentionPeriodType"`
+	DailyRetentionPeriod   *int    `json:"dailyRetentionPeriod,omitempty"`
+	MonthlyRetentionPeriod *int    `json:"monthlyRetentionPeriod,omitempty"`
+	YearlyRetentionPeriod  *string `json:"yearlyRetentionPeriod,omitempty"`
+}
+
+type VB365RepositoryResponse struct {
+	ID string `json:"id"`
+	VB365RepositoryRequest
+}
+
+// ResourceVB365BackupRepository returns the resource for VB365 backup repositories
+func ResourceVB365BackupRepository() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVB365BackupRepositoryCreate,
+		ReadContext:   resourceVB365BackupRepositoryRead,
+		UpdateContext: resourceVB365BackupRepositoryUpdate,
+		DeleteContext: resourceVB365BackupRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the backup repository.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the backup repository.",
+			},
+			"proxy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "ID of the backup proxy server the repository is attached to, e.g. the ID of a veeambackup_vb365_proxy resource.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path to the folder on the backup proxy server where backups are stored.",
+			},
+			"retention_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "SnapshotBased",
+				ForceNew:     true,
+				Description:  "Retention policy type. Possible values are SnapshotBased and ItemLevel.",
+				ValidateFunc: validation.StringInSlice([]string{"SnapshotBased", "ItemLevel"}, false),
+			},
+			"retention_period_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Yearly",
+				Description:  "Unit of the retention period. Possible values are Daily, Monthly and Yearly.",
+				ValidateFunc: validation.StringInSlice([]string{"Daily", "Monthly", "Yearly"}, false),
+			},
+			"daily_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Number of days backups are kept; required if retention_period_type is Daily.",
+				ValidateFunc: validation.IntBetween(1, 999),
+			},
+			"monthly_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Number of months backups are kept; required if retention_period_type is Monthly.",
+				ValidateFunc: validation.IntBetween(1, 999),
+			},
+			"yearly_retention_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "How long backups are kept; applies if retention_period_type is Yearly. Defaults to Years3. Possible values are Year1, Years2, Years3, Years5, Years7, Years10, Years25 and KeepForever.",
+				ValidateFunc: validation.StringInSlice([]string{"Year1", "Years2", "Years3", "Years5", "Years7", "Years10", "Years25", "KeepForever"}, false),
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func buildRepositoryRequest(d *schema.ResourceData) (VB365RepositoryRequest, error) {
+	req := VB365RepositoryRequest{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		ProxyID:             d.Get("proxy_id").(string),
+		Path:                d.Get("path").(string),
+		RetentionType:       d.Get("retention_type").(string),
+		RetentionPeriodType: d.Get("retention_period_type").(string),
+	}
+
+	switch req.RetentionPeriodType {
+	case "Daily":
+		v, ok := d.GetOk("daily_retention_period")
+		if !ok {
+			return req, fmt.Errorf("daily_retention_period is required when retention_period_type is Daily")
+		}
+		days := v.(int)
+		req.DailyRetentionPeriod = &days
+	case "Monthly":
+		v, ok := d.GetOk("monthly_retention_period")
+		if !ok {
+			return req, fmt.Errorf("monthly_retention_period is required when retention_period_type is Monthly")
+		}
+		months := v.(int)
+		req.MonthlyRetentionPeriod = &months
+	default:
+		years := "Years3"
+		if v, ok := d.GetOk("yearly_retention_period"); ok {
+			years = v.(string)
+		}
+		req.YearlyRetentionPeriod = &years
+	}
+
+	return req, nil
+}
+
+func resourceVB365BackupRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := buildRepositoryRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(req)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal backup repository request: %w", err))
+	}
+
+	respBody, err := client.DoRequest(ctx, "POST", client.BuildAPIURL("/BackupRepositories"), bodyBytes)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create backup repository: %w", err))
+	}
+
+	var repositoryResp VB365RepositoryResponse
+	if err := json.Unmarshal(respBody, &repositoryResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse backup repository response: %w", err))
+	}
+
+	d.SetId(repositoryResp.ID)
+	return resourceVB365BackupRepositoryRead(ctx, d, meta)
+}
+
+func resourceVB365BackupRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL(vc.Endpoint("/BackupRepositories/%s", d.Id())), nil)
+	if err != nil {
+		if vc.RemoveFromStateIfGone(d, err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read backup repository: %w", err))
+	}
+
+	var repositoryResp VB365RepositoryResponse
+	if err := json.Unmarshal(respBody, &repositoryResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse backup repository response: %w", err))
+	}
+
+	// The API reports only the period of the configured unit; the others are cleared
+	var dailyPeriod, monthlyPeriod int
+	var yearlyPeriod string
+	if repositoryResp.DailyRetentionPeriod != nil {
+		dailyPeriod = *repositoryResp.DailyRetentionPeriod
+	}
+	if repositoryResp.MonthlyRetentionPeriod != nil {
+		monthlyPeriod = *repositoryResp.MonthlyRetentionPeriod
+	}
+	if repositoryResp.YearlyRetentionPeriod != nil {
+		yearlyPeriod = *repositoryResp.YearlyRetentionPeriod
+	}
+
+	return diag.FromErr(setAll(d, map[string]interface{}{
+		"name":                     repositoryResp.Name,
+		"description":              repositoryResp.Description,
+		"proxy_id":                 repositoryResp.ProxyID,
+		"path":                     repositoryResp.Path,
+		"retention_type":           repositoryResp.RetentionType,
+		"retention_period_type":    repositoryResp.RetentionPeriodType,
+		"daily_retention_period":   dailyPeriod,
+		"monthly_retention_period": monthlyPeriod,
+		"yearly_retention_period":  yearlyPeriod,
+	}))
+}
+
+func resourceVB365BackupRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, err := buildRepositoryRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(req)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal backup repository update request: %w", err))
+	}
+
+	if _, err := client.DoRequest(ctx, "PUT", client.BuildAPIURL(vc.Endpoint("/BackupRepositories/%s", d.Id())), bodyBytes); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update backup repository: %w", err))
+	}
+
+	return resourceVB365BackupRepositoryRead(ctx, d, meta)
+}
+
+func resourceVB365BackupRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.DoRequest(ctx, "DELETE", client.BuildAPIURL(vc.Endpoint("/BackupRepositories/%s", d.Id())), nil); err != nil && !vc.IsGone(err) {
+		return diag.FromErr(fmt.Errorf("failed to delete backup repository: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,260 @@
+package vb365
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// VB365OrganizationRequest is the body of POST /Organizations and PUT /Organizations/{id} for a
+// Microsoft 365 organization added with modern app-only authentication
+type VB365OrganizationRequest struct {
+	Type                     string                    `json:"type"`
+	Region                   string                    `json:"region"`
+	IsExchangeOnline         bool                      `json:"isExchangeOnline"`
+	IsSharePointOnline       bool                      `json:"isSharePointOnline"`
+	IsTeamsOnline            bool                      `json:"isTeamsOnline"`
+	IsTeamsChatsOnline       bool                      `json:"isTeamsChatsOnline"`
+	ExchangeOnlineSettings   *VB365ApplicationSettings `json:"exchangeOnlineSettings,omitempty"`
+	SharePointOnlineSettings *VB365ApplicationSettings `json:"sharePointOnlineSettings,omitempty"`
+}
+
+// VB365ApplicationSettings holds the Microsoft Entra application used to access a Microsoft 365
+// service. The certificate and its password are never returned.
+type VB365ApplicationSettings struct {
+	UseApplicationOnlyAuth         bool    `json:"useApplicationOnlyAuth"`
+	OfficeOrganizationName         string  `json:"officeOrganizationName"`
+	ApplicationID                  string  `json:"applicationId"`
+	ApplicationCertificate         *string `json:"applicationCertificate,omitempty"`
+	ApplicationCertificatePassword *string `json:"applicationCertificatePassword,omitempty"`
+}
+
+type VB365OrganizationResponse struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	IsBackedUp bool   `json:"isBackedup"`
+	VB365OrganizationRequest
+}
+
+// ResourceVB365Organization returns the resource for Microsoft 365 organizations protected by VB365
+func ResourceVB365Organization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVB365OrganizationCreate,
+		ReadContext:   resourceVB365OrganizationRead,
+		UpdateContext: resourceVB365OrganizationUpdate,
+		DeleteContext: resourceVB365OrganizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"office_organization_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Microsoft 365 organization, e.g. contoso.onmicrosoft.com.",
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Worldwide",
+				ForceNew:     true,
+				Description:  "Microsoft Azure region of the organization. Possible values are Worldwide, USGovCompliance, USGovGCCHighOrganization and China.",
+				ValidateFunc: validation.StringInSlice([]string{"Worldwide", "USGovCompliance", "USGovGCCHighOrganization", "China"}, false),
+			},
+			"application_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the Microsoft Entra application used to access the organization.",
+			},
+			"application_certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Base64-encoded PFX certificate of the Microsoft Entra application. The API never returns it, so changes made outside of Terraform are not detected.",
+			},
+			"application_certificate_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the application certificate.",
+			},
+			"exchange_online": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether Exchange Online data of the organization is processed.",
+			},
+			"sharepoint_online": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether SharePoint Online and OneDrive for Business data of the organization is processed.",
+			},
+			"teams": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether Microsoft Teams data of the organization is processed.",
+			},
+			"teams_chats": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether Microsoft Teams chats are processed, using the Teams Export APIs.",
+			},
+			// Computed
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the organization in Veeam Backup for Microsoft 365.",
+			},
+			"is_backed_up": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a backup job processes the organization.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func buildOrganizationRequest(d *schema.ResourceData) VB365OrganizationRequest {
+	req := VB365OrganizationRequest{
+		Type:               "Office365",
+		Region:             d.Get("region").(string),
+		IsExchangeOnline:   d.Get("exchange_online").(bool),
+		IsSharePointOnline: d.Get("sharepoint_online").(bool),
+		IsTeamsOnline:      d.Get("teams").(bool),
+		IsTeamsChatsOnline: d.Get("teams_chats").(bool),
+	}
+
+	// Exchange Online and SharePoint Online are accessed with the same application
+	settings := &VB365ApplicationSettings{
+		UseApplicationOnlyAuth: true,
+		OfficeOrganizationName: d.Get("office_organization_name").(string),
+		ApplicationID:          d.Get("application_id").(string),
+	}
+	certificate := d.Get("application_certificate").(string)
+	settings.ApplicationCertificate = &certificate
+	if v, ok := d.GetOk("application_certificate_password"); ok {
+		password := v.(string)
+		settings.ApplicationCertificatePassword = &password
+	}
+
+	if req.IsExchangeOnline {
+		req.ExchangeOnlineSettings = settings
+	}
+	if req.IsSharePointOnline || req.IsTeamsOnline {
+		req.SharePointOnlineSettings = settings
+	}
+
+	return req
+}
+
+func resourceVB365OrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(buildOrganizationRequest(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal organization request: %w", err))
+	}
+
+	respBody, err := client.DoRequest(ctx, "POST", client.BuildAPIURL("/Organizations"), bodyBytes)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to add organization: %w", err))
+	}
+
+	var organizationResp VB365OrganizationResponse
+	if err := json.Unmarshal(respBody, &organizationResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse organization response: %w", err))
+	}
+
+	d.SetId(organizationResp.ID)
+	return resourceVB365OrganizationRead(ctx, d, meta)
+}
+
+func resourceVB365OrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL(vc.Endpoint("/Organizations/%s", d.Id())), nil)
+	if err != nil {
+		if vc.RemoveFromStateIfGone(d, err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read organization: %w", err))
+	}
+
+	var organizationResp VB365OrganizationResponse
+	if err := json.Unmarshal(respBody, &organizationResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse organization response: %w", err))
+	}
+
+	values := map[string]interface{}{
+		"name":              organizationResp.Name,
+		"region":            organizationResp.Region,
+		"exchange_online":   organizationResp.IsExchangeOnline,
+		"sharepoint_online": organizationResp.IsSharePointOnline,
+		"teams":             organizationResp.IsTeamsOnline,
+		"teams_chats":       organizationResp.IsTeamsChatsOnline,
+		"is_backed_up":      organizationResp.IsBackedUp,
+	}
+	settings := organizationResp.ExchangeOnlineSettings
+	if settings == nil {
+		settings = organizationResp.SharePointOnlineSettings
+	}
+	if settings != nil {
+		values["office_organization_name"] = settings.OfficeOrganizationName
+		values["application_id"] = settings.ApplicationID
+	}
+
+	return diag.FromErr(setAll(d, values))
+}
+
+func resourceVB365OrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(buildOrganizationRequest(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal organization update request: %w", err))
+	}
+
+	if _, err := client.DoRequest(ctx, "PUT", client.BuildAPIURL(vc.Endpoint("/Organizations/%s", d.Id())), bodyBytes); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update organization: %w", err))
+	}
+
+	return resourceVB365OrganizationRead(ctx, d, meta)
+}
+
+func resourceVB365OrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.DoRequest(ctx, "DELETE", client.BuildAPIURL(vc.Endpoint("/Organizations/%s", d.Id())), nil); err != nil && !vc.IsGone(err) {
+		return diag.FromErr(fmt.Errorf("failed to remove organization: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
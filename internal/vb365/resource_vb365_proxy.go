@@ -0,0 +1,190 @@
+package vb365
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// VB365ProxyRequest is the body of POST /Proxies and PUT /Proxies/{id}
+type VB365ProxyRequest struct {
+	HostName    string  `json:"hostName"`
+	Description string  `json:"description"`
+	Port        int     `json:"port"`
+	Username    *string `json:"username,omitempty"`
+	Password    *string `json:"password,omitempty"`
+}
+
+// VB365ProxyResponse is a backup proxy as returned by the API; the credentials are never returned
+type VB365ProxyResponse struct {
+	ID          string `json:"id"`
+	HostName    string `json:"hostName"`
+	Description string `json:"description"`
+	Port        int    `json:"port"`
+	Version     string `json:"version"`
+}
+
+// ResourceVB365Proxy returns the resource for VB365 backup proxy servers
+func ResourceVB365Proxy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVB365ProxyCreate,
+		ReadContext:   resourceVB365ProxyRead,
+		UpdateContext: resourceVB365ProxyUpdate,
+		DeleteContext: resourceVB365ProxyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "DNS name or IP address of the backup proxy server.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      9193,
+				Description:  "Port used to connect to the backup proxy server.",
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the backup proxy server.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Account used to connect to the backup proxy server. The API never returns it.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the account used to connect to the backup proxy server. The API never returns it.",
+			},
+			// Computed
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the Veeam Backup for Microsoft 365 components installed on the proxy.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func buildProxyRequest(d *schema.ResourceData) VB365ProxyRequest {
+	req := VB365ProxyRequest{
+		HostName:    d.Get("hostname").(string),
+		Description: d.Get("description").(string),
+		Port:        d.Get("port").(int),
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		username := v.(string)
+		req.Username = &username
+	}
+	if v, ok := d.GetOk("password"); ok {
+		password := v.(string)
+		req.Password = &password
+	}
+
+	return req
+}
+
+func resourceVB365ProxyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(buildProxyRequest(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal proxy request: %w", err))
+	}
+
+	respBody, err := client.DoRequest(ctx, "POST", client.BuildAPIURL("/Proxies"), bodyBytes)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to add backup proxy: %w", err))
+	}
+
+	var proxyResp VB365ProxyResponse
+	if err := json.Unmarshal(respBody, &proxyResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse proxy response: %w", err))
+	}
+
+	d.SetId(proxyResp.ID)
+	return resourceVB365ProxyRead(ctx, d, meta)
+}
+
+func resourceVB365ProxyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL(vc.Endpoint("/Proxies/%s", d.Id())), nil)
+	if err != nil {
+		if vc.RemoveFromStateIfGone(d, err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to read backup proxy: %w", err))
+	}
+
+	var proxyResp VB365ProxyResponse
+	if err := json.Unmarshal(respBody, &proxyResp); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to parse proxy response: %w", err))
+	}
+
+	return diag.FromErr(setAll(d, map[string]interface{}{
+		"hostname":    proxyResp.HostName,
+		"port":        proxyResp.Port,
+		"description": proxyResp.Description,
+		"version":     proxyResp.Version,
+	}))
+}
+
+func resourceVB365ProxyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bodyBytes, err := json.Marshal(buildProxyRequest(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to marshal proxy update request: %w", err))
+	}
+
+	if _, err := client.DoRequest(ctx, "PUT", client.BuildAPIURL(vc.Endpoint("/Proxies/%s", d.Id())), bodyBytes); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update backup proxy: %w", err))
+	}
+
+	return resourceVB365ProxyRead(ctx, d, meta)
+}
+
+func resourceVB365ProxyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := vc.GetVB365Client(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.DoRequest(ctx, "DELETE", client.BuildAPIURL(vc.Endpoint("/Proxies/%s", d.Id())), nil); err != nil && !vc.IsGone(err) {
+		return diag.FromErr(fmt.Errorf("failed to remove backup proxy: %w", err))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,39 @@
+// Package vb365 implements the resources of Veeam Backup for Microsoft 365
+package vb365
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// expandStringSet converts a set or list of strings to a slice, returning nil when it is empty
+func expandStringSet(v interface{}) []string {
+	var items []interface{}
+	switch v := v.(type) {
+	case *schema.Set:
+		items = v.List()
+	case []interface{}:
+		items = v
+	}
+
+	var result []string
+	for _, item := range items {
+		result = append(result, item.(string))
+	}
+	return result
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// setAll stores values in d, stopping at the first attribute that cannot be set
+func setAll(d *schema.ResourceData, values map[string]interface{}) error {
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package vbr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// additionalSettingsJSONSchema is the additional_settings_json attribute of the job resources, which
+// passes job settings of the REST API that the resources do not model yet
+func additionalSettingsJSONSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validateJSONObject,
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+		Description: "JSON object deep-merged into the job payload sent to the API, for job settings the resource does not model yet. " +
+			"Objects are merged key by key; any other value, including an array, replaces the value set by the resource. " +
+			"Only the keys set here are read back to detect drift.",
+	}
+}
+
+// validateJSONObject checks that a string attribute holds a JSON object
+func validateJSONObject(v interface{}, k string) ([]string, []error) {
+	if _, err := decodeJSONObject([]byte(v.(string))); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a JSON object: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// decodeJSONObject decodes a JSON object, keeping numbers as json.Number so that large integers
+// survive a round trip
+func decodeJSONObject(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var obj map[string]interface{}
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("got null")
+	}
+	return obj, nil
+}
+
+// marshalJob encodes a job payload with the additional_settings_json of d merged into it
+func marshalJob(d *schema.ResourceData, job interface{}) ([]byte, error) {
+	body, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+	additional := d.Get("additional_settings_json").(string)
+	if additional == "" {
+		return body, nil
+	}
+
+	payload, err := decodeJSONObject(body)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := decodeJSONObject([]byte(additional))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse additional_settings_json: %w", err)
+	}
+	return json.Marshal(mergeJSONObjects(payload, settings))
+}
+
+// mergeJSONObjects merges src into dst, recursing into the objects both have at the same key
+func mergeJSONObjects(dst, src map[string]interface{}) map[string]interface{} {
+	for key, value := range src {
+		if srcObj, ok := value.(map[string]interface{}); ok {
+			if dstObj, ok := dst[key].(map[string]interface{}); ok {
+				dst[key] = mergeJSONObjects(dstObj, srcObj)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+	return dst
+}
+
+// readAdditionalSettings sets additional_settings_json to the values of the job returned by the API
+// at the keys it configures. Keys the API does not return, such as write-only settings, keep
+// their configured value.
+func readAdditionalSettings(d *schema.ResourceData, job []byte) error {
+	additional := d.Get("additional_settings_json").(string)
+	if additional == "" {
+		return nil
+	}
+
+	settings, err := decodeJSONObject([]byte(additional))
+	if err != nil {
+		return fmt.Errorf("failed to parse additional_settings_json: %w", err)
+	}
+	actual, err := decodeJSONObject(job)
+	if err != nil {
+		return fmt.Errorf("failed to parse job: %w", err)
+	}
+	encoded, err := json.Marshal(projectJSONObject(actual, settings))
+	if err != nil {
+		return err
+	}
+	return d.Set("additional_settings_json", string(encoded))
+}
+
+// projectJSONObject returns the values of actual at the keys of configured, recursing into the
+// objects both have at the same key
+func projectJSONObject(actual, configured map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(configured))
+	for key, value := range configured {
+		actualValue, ok := actual[key]
+		if !ok {
+			result[key] = value
+			continue
+		}
+		if configuredObj, ok := value.(map[string]interface{}); ok {
+			if actualObj, ok := actualValue.(map[string]interface{}); ok {
+				result[key] = projectJSONObject(actualObj, configuredObj)
+				continue
+			}
+		}
+		result[key] = actualValue
+	}
+	return result
+}
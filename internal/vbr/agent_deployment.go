@@ -0,0 +1,87 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// AgentDeploymentSettings holds how the backup server distributes Veeam agents to the computers
+// of protection groups. When updating, nil fields are left unchanged.
+type AgentDeploymentSettings struct {
+	// DistributionServerID is the managed server that stores the agent packages and pushes them to
+	// protected computers
+	DistributionServerID *string
+	PackageCachePath     *string
+	// AutomaticUpgradeEnabled upgrades deployed agents when the backup server is upgraded
+	AutomaticUpgradeEnabled *bool
+	// RebootIfRequired lets automatic upgrades reboot protected computers
+	RebootIfRequired *bool
+}
+
+type agentDeploymentOptions struct {
+	DistributionServer struct {
+		ServerID         string `json:"serverId"`
+		PackageCachePath string `json:"packageCachePath"`
+	} `json:"distributionServer"`
+	AutomaticUpgrade struct {
+		IsEnabled        bool `json:"isEnabled"`
+		RebootIfRequired bool `json:"rebootIfRequired"`
+	} `json:"automaticUpgrade"`
+}
+
+// GetAgentDeploymentSettings returns the agent deployment settings of the backup server
+func GetAgentDeploymentSettings(ctx context.Context, client *vc.VBRClient) (*AgentDeploymentSettings, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, agentDeploymentOptionsURL(client), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var options agentDeploymentOptions
+	if err := json.Unmarshal(respBody, &options); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR agent deployment options response: %w", err)
+	}
+	return &AgentDeploymentSettings{
+		DistributionServerID:    &options.DistributionServer.ServerID,
+		PackageCachePath:        &options.DistributionServer.PackageCachePath,
+		AutomaticUpgradeEnabled: &options.AutomaticUpgrade.IsEnabled,
+		RebootIfRequired:        &options.AutomaticUpgrade.RebootIfRequired,
+	}, nil
+}
+
+// UpdateAgentDeploymentSettings changes the agent deployment settings of the backup server. The
+// options are read and written back with the settings left nil unchanged.
+func UpdateAgentDeploymentSettings(ctx context.Context, client *vc.VBRClient, settings AgentDeploymentSettings) error {
+	optionsURL := agentDeploymentOptionsURL(client)
+	respBody, err := client.DoRequest(ctx, http.MethodGet, optionsURL, nil)
+	if err != nil {
+		return err
+	}
+
+	var options map[string]interface{}
+	if err := json.Unmarshal(respBody, &options); err != nil {
+		return fmt.Errorf("failed to decode VBR agent deployment options response: %w", err)
+	}
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	server := childMap(options, "distributionServer")
+	setIfNotNil(server, "serverId", settings.DistributionServerID)
+	setIfNotNil(server, "packageCachePath", settings.PackageCachePath)
+	upgrade := childMap(options, "automaticUpgrade")
+	setIfNotNil(upgrade, "isEnabled", settings.AutomaticUpgradeEnabled)
+	setIfNotNil(upgrade, "rebootIfRequired", settings.RebootIfRequired)
+
+	body, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR agent deployment options request: %w", err)
+	}
+	_, err = client.DoRequest(ctx, http.MethodPut, optionsURL, body)
+	return err
+}
+
+func agentDeploymentOptionsURL(client *vc.VBRClient) string {
+	return client.BuildAPIURL("/api/v1/generalOptions/agentDeployment")
+}
@@ -0,0 +1,88 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// AntivirusScannerCustom selects the antivirus described by a custom XML configuration instead of
+// one VBR integrates with out of the box
+const AntivirusScannerCustom = "Custom"
+
+// AntivirusScanners are the antivirus software secure restore scans restored machines with
+var AntivirusScanners = []string{
+	"MicrosoftDefender",
+	"SymantecProtectionEngine",
+	"ESET",
+	"Kaspersky",
+	"Bitdefender",
+	"Trellix",
+	AntivirusScannerCustom,
+}
+
+// AntivirusIntegration selects the antivirus software the mount servers of the backup server use
+// to scan machines during secure restore. When updating, nil fields are left unchanged.
+type AntivirusIntegration struct {
+	Scanner *string
+	// CustomConfigXML describes the command line of the antivirus when Scanner is
+	// AntivirusScannerCustom, in the format of the AntivirusInfos.xml file shipped with VBR
+	CustomConfigXML *string
+}
+
+type antivirusOptions struct {
+	AntivirusName   string `json:"antivirusName"`
+	CustomConfigXML string `json:"customConfigXml"`
+}
+
+// GetAntivirusIntegration returns the antivirus secure restore scans with
+func GetAntivirusIntegration(ctx context.Context, client *vc.VBRClient) (*AntivirusIntegration, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, antivirusOptionsURL(client), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var options antivirusOptions
+	if err := json.Unmarshal(respBody, &options); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR antivirus options response: %w", err)
+	}
+	integration := &AntivirusIntegration{Scanner: &options.AntivirusName}
+	// VBR keeps the custom configuration when another antivirus is selected, but does not use it
+	if options.AntivirusName == AntivirusScannerCustom {
+		integration.CustomConfigXML = &options.CustomConfigXML
+	}
+	return integration, nil
+}
+
+// UpdateAntivirusIntegration changes the antivirus secure restore scans with. The options are read
+// and written back with the settings left nil unchanged.
+func UpdateAntivirusIntegration(ctx context.Context, client *vc.VBRClient, integration AntivirusIntegration) error {
+	optionsURL := antivirusOptionsURL(client)
+	respBody, err := client.DoRequest(ctx, http.MethodGet, optionsURL, nil)
+	if err != nil {
+		return err
+	}
+
+	var options map[string]interface{}
+	if err := json.Unmarshal(respBody, &options); err != nil {
+		return fmt.Errorf("failed to decode VBR antivirus options response: %w", err)
+	}
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	setIfNotNil(options, "antivirusName", integration.Scanner)
+	setIfNotNil(options, "customConfigXml", integration.CustomConfigXML)
+
+	body, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR antivirus options request: %w", err)
+	}
+	_, err = client.DoRequest(ctx, http.MethodPut, optionsURL, body)
+	return err
+}
+
+func antivirusOptionsURL(client *vc.VBRClient) string {
+	return client.BuildAPIURL("/api/v1/generalOptions/antivirus")
+}
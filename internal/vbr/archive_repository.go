@@ -0,0 +1,283 @@
+package vbr
+
+import (
+	"terraform-provider-veeambackup/internal/vbr/schedule"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// archiveRepositorySchema returns the archive_repository block shared by the file share and
+// object storage backup jobs
+func archiveRepositorySchema() *schema.Schema {
+	gfsPeriod := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: description,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"is_enabled": {
+						Type:        schema.TypeBool,
+						Required:    true,
+						Description: "Specifies if the archive keeps backups of this period.",
+					},
+					"keep_for_number": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "The number of periods the archive keeps the backups for.",
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The archive repository settings for the backup job.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"archive_repository_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsUUID,
+					Description:  "The ID of the archive repository.",
+				},
+				"archive_recent_file_versions": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Specifies if recent file versions are archived.",
+				},
+				"archive_previous_file_versions": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Specifies if previous file versions are archived.",
+				},
+				"archive_retention_policy": schedule.RetentionPolicySchema("The retention policy for the archive repository."),
+				"gfs_retention_policy": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "The GFS retention of the archive, overriding archive_retention_policy for the weekly, monthly and yearly backups.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"weekly":  gfsPeriod("The retention of weekly backups, in weeks."),
+							"monthly": gfsPeriod("The retention of monthly backups, in months."),
+							"yearly":  gfsPeriod("The retention of yearly backups, in years."),
+						},
+					},
+				},
+				"cost_optimization": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "The cost optimization settings of archive tiers that charge for early deletion, such as Azure Archive.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"is_enabled": {
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "Specifies if VBR only archives data that is kept for at least the minimum storage duration of the archive tier.",
+							},
+							"archive_older_than_days": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "The age in days data must reach before it is moved to the archive.",
+							},
+						},
+					},
+				},
+				"file_archive_settings": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "The file archive settings.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"archival_type": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The archival type.",
+							},
+							"inclusion_mask": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "The list of inclusion masks for file archiving.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"exclusion_mask": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "The list of exclusion masks for file archiving.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandVBRBackupJobArchiveRepository(input []interface{}) *VbrBackupJobArchiveRepository {
+	if len(input) == 0 {
+		return nil
+	}
+	m := input[0].(map[string]interface{})
+	archive := &VbrBackupJobArchiveRepository{
+		ArchiveRepositoryID: m["archive_repository_id"].(string),
+	}
+	if v, ok := m["archive_recent_file_versions"]; ok {
+		archive.ArchiveRecentFileVersions = getBoolPtr(v)
+	}
+	if v, ok := m["archive_previous_file_versions"]; ok {
+		archive.ArchivePreviousFileVersions = getBoolPtr(v)
+	}
+	if v, ok := m["archive_retention_policy"]; ok && len(v.([]interface{})) > 0 {
+		archive.ArchiveRetentionPolicy = schedule.ExpandRetentionPolicy(v.([]interface{}))
+	}
+	if v, ok := m["gfs_retention_policy"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		gfs := v.([]interface{})[0].(map[string]interface{})
+		archive.GFSRetentionPolicy = &VbrBackupJobArchiveGFSRetentionPolicy{
+			Weekly:  expandVBRBackupJobArchiveGFSPeriod(gfs["weekly"].([]interface{})),
+			Monthly: expandVBRBackupJobArchiveGFSPeriod(gfs["monthly"].([]interface{})),
+			Yearly:  expandVBRBackupJobArchiveGFSPeriod(gfs["yearly"].([]interface{})),
+		}
+	}
+	if v, ok := m["cost_optimization"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		cost := v.([]interface{})[0].(map[string]interface{})
+		archive.CostOptimization = &VbrBackupJobArchiveCostOptimization{IsEnabled: cost["is_enabled"].(bool)}
+		if days := cost["archive_older_than_days"].(int); days > 0 {
+			archive.CostOptimization.ArchiveOlderThanDays = &days
+		}
+	}
+	if v, ok := m["file_archive_settings"]; ok && len(v.([]interface{})) > 0 {
+		archive.FileArchiveSettings = expandVBRBackupJobFileArchiveSettings(v.([]interface{}))
+	}
+	return archive
+}
+
+func expandVBRBackupJobArchiveGFSPeriod(input []interface{}) *VbrBackupJobArchiveGFSPeriod {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	m := input[0].(map[string]interface{})
+	period := &VbrBackupJobArchiveGFSPeriod{IsEnabled: m["is_enabled"].(bool)}
+	if n := m["keep_for_number"].(int); n > 0 {
+		period.KeepForNumber = &n
+	}
+	return period
+}
+
+func expandVBRBackupJobFileArchiveSettings(input []interface{}) *VbrBackupJobFileArchiveSettings {
+	if len(input) == 0 {
+		return nil
+	}
+	m := input[0].(map[string]interface{})
+	settings := &VbrBackupJobFileArchiveSettings{}
+	if v, ok := m["archival_type"]; ok && v != "" {
+		settings.ArchivalType = getStringPtr(v)
+	}
+	if v, ok := m["inclusion_mask"]; ok {
+		masks := v.(*schema.Set).List()
+		if len(masks) > 0 {
+			maskStrings := make([]string, len(masks))
+			for i, mask := range masks {
+				maskStrings[i] = mask.(string)
+			}
+			settings.InclusionMask = &maskStrings
+		}
+	}
+	if v, ok := m["exclusion_mask"]; ok {
+		masks := v.(*schema.Set).List()
+		if len(masks) > 0 {
+			maskStrings := make([]string, len(masks))
+			for i, mask := range masks {
+				maskStrings[i] = mask.(string)
+			}
+			settings.ExclusionMask = &maskStrings
+		}
+	}
+	return settings
+}
+
+// readArchiveRepository sets archive_repository from the job returned by the API. It is only read
+// back when configured, and so are its nested blocks, since the server returns defaults for them
+// otherwise.
+func readArchiveRepository(d *schema.ResourceData, archive *VbrBackupJobArchiveRepository) error {
+	if _, ok := d.GetOk("archive_repository"); !ok {
+		return nil
+	}
+	if archive == nil {
+		return d.Set("archive_repository", nil)
+	}
+
+	m := map[string]interface{}{
+		"archive_repository_id":          archive.ArchiveRepositoryID,
+		"archive_recent_file_versions":   archive.ArchiveRecentFileVersions != nil && *archive.ArchiveRecentFileVersions,
+		"archive_previous_file_versions": archive.ArchivePreviousFileVersions != nil && *archive.ArchivePreviousFileVersions,
+	}
+	if _, ok := d.GetOk("archive_repository.0.archive_retention_policy"); ok {
+		m["archive_retention_policy"] = schedule.FlattenRetentionPolicy(archive.ArchiveRetentionPolicy)
+	}
+	if _, ok := d.GetOk("archive_repository.0.gfs_retention_policy"); ok && archive.GFSRetentionPolicy != nil {
+		m["gfs_retention_policy"] = []interface{}{map[string]interface{}{
+			"weekly":  flattenVBRBackupJobArchiveGFSPeriod(archive.GFSRetentionPolicy.Weekly),
+			"monthly": flattenVBRBackupJobArchiveGFSPeriod(archive.GFSRetentionPolicy.Monthly),
+			"yearly":  flattenVBRBackupJobArchiveGFSPeriod(archive.GFSRetentionPolicy.Yearly),
+		}}
+	}
+	if _, ok := d.GetOk("archive_repository.0.cost_optimization"); ok && archive.CostOptimization != nil {
+		var olderThanDays int
+		if archive.CostOptimization.ArchiveOlderThanDays != nil {
+			olderThanDays = *archive.CostOptimization.ArchiveOlderThanDays
+		}
+		m["cost_optimization"] = []interface{}{map[string]interface{}{
+			"is_enabled":              archive.CostOptimization.IsEnabled,
+			"archive_older_than_days": olderThanDays,
+		}}
+	}
+	if _, ok := d.GetOk("archive_repository.0.file_archive_settings"); ok && archive.FileArchiveSettings != nil {
+		settings := archive.FileArchiveSettings
+		var archivalType string
+		if settings.ArchivalType != nil {
+			archivalType = *settings.ArchivalType
+		}
+		var inclusionMask, exclusionMask []string
+		if settings.InclusionMask != nil {
+			inclusionMask = *settings.InclusionMask
+		}
+		if settings.ExclusionMask != nil {
+			exclusionMask = *settings.ExclusionMask
+		}
+		m["file_archive_settings"] = []interface{}{map[string]interface{}{
+			"archival_type":  archivalType,
+			"inclusion_mask": inclusionMask,
+			"exclusion_mask": exclusionMask,
+		}}
+	}
+	return d.Set("archive_repository", []interface{}{m})
+}
+
+func flattenVBRBackupJobArchiveGFSPeriod(period *VbrBackupJobArchiveGFSPeriod) []interface{} {
+	if period == nil {
+		return nil
+	}
+	var keepForNumber int
+	if period.KeepForNumber != nil {
+		keepForNumber = *period.KeepForNumber
+	}
+	return []interface{}{map[string]interface{}{
+		"is_enabled":      period.IsEnabled,
+		"keep_for_number": keepForNumber,
+	}}
+}
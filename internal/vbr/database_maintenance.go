@@ -0,0 +1,86 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// DatabaseMaintenanceSettings holds how long the configuration database of the backup server
+// keeps session history and the data of deleted VMs. When updating, nil fields are left unchanged.
+type DatabaseMaintenanceSettings struct {
+	// KeepAllSessions keeps the history of every session instead of SessionRetentionWeeks
+	KeepAllSessions       *bool
+	SessionRetentionWeeks *int
+	// DeletedVMsRetentionEnabled removes the backup data of VMs that no longer exist after
+	// DeletedVMsRetentionDays
+	DeletedVMsRetentionEnabled *bool
+	DeletedVMsRetentionDays    *int
+}
+
+type databaseMaintenanceOptions struct {
+	SessionHistory struct {
+		KeepAllSessions bool `json:"keepAllSessions"`
+		RetentionWeeks  int  `json:"retentionWeeks"`
+	} `json:"sessionHistory"`
+	DeletedVMsData struct {
+		IsEnabled     bool `json:"isEnabled"`
+		RetentionDays int  `json:"retentionDays"`
+	} `json:"deletedVmsData"`
+}
+
+// GetDatabaseMaintenanceSettings returns the history retention settings of the backup server
+func GetDatabaseMaintenanceSettings(ctx context.Context, client *vc.VBRClient) (*DatabaseMaintenanceSettings, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, historyOptionsURL(client), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var options databaseMaintenanceOptions
+	if err := json.Unmarshal(respBody, &options); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR history options response: %w", err)
+	}
+	return &DatabaseMaintenanceSettings{
+		KeepAllSessions:            &options.SessionHistory.KeepAllSessions,
+		SessionRetentionWeeks:      &options.SessionHistory.RetentionWeeks,
+		DeletedVMsRetentionEnabled: &options.DeletedVMsData.IsEnabled,
+		DeletedVMsRetentionDays:    &options.DeletedVMsData.RetentionDays,
+	}, nil
+}
+
+// UpdateDatabaseMaintenanceSettings changes the history retention settings of the backup server.
+// The options are read and written back with the settings left nil unchanged.
+func UpdateDatabaseMaintenanceSettings(ctx context.Context, client *vc.VBRClient, settings DatabaseMaintenanceSettings) error {
+	optionsURL := historyOptionsURL(client)
+	respBody, err := client.DoRequest(ctx, http.MethodGet, optionsURL, nil)
+	if err != nil {
+		return err
+	}
+
+	var options map[string]interface{}
+	if err := json.Unmarshal(respBody, &options); err != nil {
+		return fmt.Errorf("failed to decode VBR history options response: %w", err)
+	}
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	sessions := childMap(options, "sessionHistory")
+	setIfNotNil(sessions, "keepAllSessions", settings.KeepAllSessions)
+	setIfNotNil(sessions, "retentionWeeks", settings.SessionRetentionWeeks)
+	deletedVMs := childMap(options, "deletedVmsData")
+	setIfNotNil(deletedVMs, "isEnabled", settings.DeletedVMsRetentionEnabled)
+	setIfNotNil(deletedVMs, "retentionDays", settings.DeletedVMsRetentionDays)
+
+	body, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR history options request: %w", err)
+	}
+	_, err = client.DoRequest(ctx, http.MethodPut, optionsURL, body)
+	return err
+}
+
+func historyOptionsURL(client *vc.VBRClient) string {
+	return client.BuildAPIURL("/api/v1/generalOptions/history")
+}
@@ -0,0 +1,280 @@
+﻿package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Response models
+type VBRBackupsResponse struct {
+	Data       []VBRBackupModel   `json:"data"`
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+type VBRBackupModel struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	JobID          string `json:"jobId"`
+	PolicyTag      string `json:"policyTag"`
+	JobType        string `json:"jobType"`
+	CreationTime   string `json:"creationTime"`
+	PlatformName   string `json:"platformName"`
+	PlatformID     string `json:"platformId"`
+	RepositoryID   string `json:"repositoryId"`
+	RepositoryName string `json:"repositoryName"`
+}
+
+func DataSourceVbrBackups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves information about backups from Veeam Backup & Replication, e.g. to map a backup job to an existing backup with source_backup_id.",
+		ReadContext: DataSourceVbrBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"skip": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Number of items to skip for pagination.",
+			},
+			"limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of items to return. When not set, all items are returned.",
+			},
+			"order_column": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Name",
+				ValidateFunc: validation.StringInSlice([]string{"Name", "CreationTime"}, false),
+				Description:  "Column to order results by (Name, CreationTime).",
+			},
+			"order_asc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Sort in ascending order.",
+			},
+			"name_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter backups by name pattern. Backups are named after the job that created them, so this also filters by job name.",
+			},
+			"job_id_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by the ID of the job that created the backups.",
+			},
+			"job_type_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by job type, e.g. Backup, FileBackup or ObjectStorageBackup.",
+			},
+			"repository_id_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the backups stored in the repository with this ID. The API has no such filter, so it is applied to the page returned by the API.",
+			},
+			"created_after_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the backups created after this date and time, in RFC 3339 format.",
+			},
+			"created_before_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the backups created before this date and time, in RFC 3339 format.",
+			},
+			"backups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of backups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Backup ID, usable as source_backup_id of a job's backup repository.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the backup.",
+						},
+						"job_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the job that created the backup.",
+						},
+						"policy_tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Tag of the policy that created the backup.",
+						},
+						"job_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the job that created the backup.",
+						},
+						"creation_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time the backup was created.",
+						},
+						"platform_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Platform of the backed up workloads.",
+						},
+						"platform_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the platform of the backed up workloads.",
+						},
+						"repository_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the repository that stores the backup.",
+						},
+						"repository_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the repository that stores the backup.",
+						},
+					},
+				},
+			},
+			"pagination": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Pagination information.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"total": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total number of results reported by the API, before repository_id_filter is applied.",
+						},
+						"count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of returned results.",
+						},
+						"skip": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of skipped results.",
+						},
+						"limit": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The configured limit, or 0 when all results were fetched.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceVbrBackupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	apiUrl := "/api/v1/backups"
+
+	// Build query parameters dynamically
+	queryParams := url.Values{}
+
+	if v, ok := d.GetOk("order_column"); ok {
+		queryParams.Add("orderColumn", v.(string))
+	}
+	if v, ok := d.GetOk("order_asc"); ok {
+		queryParams.Add("orderAsc", fmt.Sprintf("%t", v.(bool)))
+	}
+	if v, ok := d.GetOk("name_filter"); ok {
+		queryParams.Add("nameFilter", v.(string))
+	}
+	if v, ok := d.GetOk("job_id_filter"); ok {
+		queryParams.Add("jobIdFilter", v.(string))
+	}
+	if v, ok := d.GetOk("job_type_filter"); ok {
+		queryParams.Add("jobTypeFilter", v.(string))
+	}
+	if v, ok := d.GetOk("created_after_filter"); ok {
+		queryParams.Add("createdAfterFilter", v.(string))
+	}
+	if v, ok := d.GetOk("created_before_filter"); ok {
+		queryParams.Add("createdBeforeFilter", v.(string))
+	}
+
+	// Make API requests, following pagination unless a limit is set
+	backups, err := vc.FetchPages(ctx, d.Get("skip").(int), d.Get("limit").(int), func(ctx context.Context, skip, limit int) (vc.Page[VBRBackupModel], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		fullUrl := client.BuildAPIURL(vc.WithQuery(apiUrl, queryParams))
+		respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		if err != nil {
+			return vc.Page[VBRBackupModel]{}, err
+		}
+
+		// Parse JSON response
+		var backupsResponse VBRBackupsResponse
+		if err := json.Unmarshal(respBody, &backupsResponse); err != nil {
+			return vc.Page[VBRBackupModel]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[VBRBackupModel]{Items: backupsResponse.Data, Total: backupsResponse.Pagination.Total}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set backups data
+	repositoryID := d.Get("repository_id_filter").(string)
+	backupsData := make([]map[string]interface{}, 0, len(backups.Items))
+	for _, backup := range backups.Items {
+		if repositoryID != "" && backup.RepositoryID != repositoryID {
+			continue
+		}
+		backupsData = append(backupsData, map[string]interface{}{
+			"id":              backup.ID,
+			"name":            backup.Name,
+			"job_id":          backup.JobID,
+			"policy_tag":      backup.PolicyTag,
+			"job_type":        backup.JobType,
+			"creation_time":   backup.CreationTime,
+			"platform_name":   backup.PlatformName,
+			"platform_id":     backup.PlatformID,
+			"repository_id":   backup.RepositoryID,
+			"repository_name": backup.RepositoryName,
+		})
+	}
+
+	if err := d.Set("backups", backupsData); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set pagination data
+	paginationData := []map[string]interface{}{
+		{
+			"total": backups.Total,
+			"count": len(backupsData),
+			"skip":  d.Get("skip").(int),
+			"limit": d.Get("limit").(int),
+		},
+	}
+	if err := d.Set("pagination", paginationData); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set resource ID
+	d.SetId("vbr_backups")
+
+	return diags
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -78,7 +77,7 @@ func DataSourceVbrCloudCredentialRead(ctx context.Context, d *schema.ResourceDat
 	}
 	var diags diag.Diagnostics
 	cloudCredentialID := d.Get("id").(string)
-	apiUrl := fmt.Sprintf("/api/v1/cloudCredentials/%s", url.PathEscape(cloudCredentialID))
+	apiUrl := vc.Endpoint("/api/v1/cloudCredentials/%s", cloudCredentialID)
 	// Make the API request
 	fullUrl := client.BuildAPIURL(apiUrl)
 	respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
@@ -4,7 +4,6 @@ import (
 	vc "terraform-provider-veeambackup/internal/client"
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/url"
 	"strconv"
 	"time"
@@ -41,7 +40,7 @@ func DataSourceVbrCloudCredentials() *schema.Resource {
 			"limit": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Maximum number of items to return.",
+				Description: "Maximum number of items to return. When not set, all items are returned.",
 			},
 			"order_column": {
 				Type:        schema.TypeString,
@@ -135,12 +134,6 @@ func DataSourceVbrCloudCredentialsRead(ctx context.Context, d *schema.ResourceDa
 	apiUrl := "/api/v1/cloudCredentials"
 	// Build query parameters
 	queryParams := url.Values{}
-	if v, ok := d.GetOk("skip"); ok {
-		queryParams.Add("skip", strconv.Itoa(v.(int)))
-	}
-	if v, ok := d.GetOk("limit"); ok {
-		queryParams.Add("limit", strconv.Itoa(v.(int)))
-	}
 	if v, ok := d.GetOk("order_column"); ok {
 		queryParams.Add("orderColumn", v.(string))
 	}
@@ -156,20 +149,27 @@ func DataSourceVbrCloudCredentialsRead(ctx context.Context, d *schema.ResourceDa
 			queryParams.Add("typeFilter", typeFilter.(string))
 		}
 	}
-	// Make the API request
-	fullUrl := client.BuildAPIURL(fmt.Sprintf("%s?%s", apiUrl, queryParams.Encode()))
-	respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)	
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	var cloudCredentialsResponse VBRCloudCredentialsResponse
-	err = json.Unmarshal(respBody, &cloudCredentialsResponse)
+	// Make the API requests, following pagination unless a limit is set
+	credentials, err := vc.FetchPages(ctx, d.Get("skip").(int), d.Get("limit").(int), func(ctx context.Context, skip, limit int) (vc.Page[VBRCloudCredentialsResponseData], error) {
+		queryParams.Set("skip", strconv.Itoa(skip))
+		queryParams.Set("limit", strconv.Itoa(limit))
+		fullUrl := client.BuildAPIURL(vc.WithQuery(apiUrl, queryParams))
+		respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		if err != nil {
+			return vc.Page[VBRCloudCredentialsResponseData]{}, err
+		}
+		var cloudCredentialsResponse VBRCloudCredentialsResponse
+		if err := json.Unmarshal(respBody, &cloudCredentialsResponse); err != nil {
+			return vc.Page[VBRCloudCredentialsResponseData]{}, err
+		}
+		return vc.Page[VBRCloudCredentialsResponseData]{Items: cloudCredentialsResponse.Data, Total: cloudCredentialsResponse.Pagination.Total}, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	// Set the cloud_credentials attribute
 	cloudCredentialsList := make([]map[string]interface{}, 0)
-	for _, credential := range cloudCredentialsResponse.Data {
+	for _, credential := range credentials.Items {
 		credentialMap := map[string]interface{}{
 			"id":              		credential.ID,
 			"type":            		credential.Type,
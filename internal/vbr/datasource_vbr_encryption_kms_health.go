@@ -0,0 +1,211 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// defaultKMIPPort is the port of KMS servers registered without one
+const defaultKMIPPort = 5696
+
+type vbrKMSServersResponse struct {
+	Data       []vbrKMSServerModel `json:"data"`
+	Pagination PaginationResponse  `json:"pagination"`
+}
+
+type vbrKMSServerModel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"` // DNS name or IP address of the KMS server
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Port        int    `json:"port"`
+}
+
+func DataSourceVbrEncryptionKMSHealth() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the KMS servers registered in Veeam Backup & Replication and checks that they accept connections. " +
+			"Check all_reachable in a precondition so that changes to jobs encrypted with KMS fail at plan time rather than while the job runs.",
+		ReadContext: DataSourceVbrEncryptionKMSHealthRead,
+		Schema: map[string]*schema.Schema{
+			"kms_server_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the KMS servers to check, e.g. the kms_server_id of an encrypted job. All KMS servers are checked when not set.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+			"timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "5s",
+				ValidateFunc: vc.ValidateDuration,
+				Description:  "How long to wait for each KMS server to accept a connection, e.g. 10s.",
+			},
+			"kms_servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "KMS servers, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the KMS server.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS name or IP address of the KMS server.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the KMS server.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the KMS server, e.g. KMIP.",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Port the KMS server listens on.",
+						},
+						"reachable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the KMS server accepted a connection within timeout.",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Why the KMS server could not be reached; empty when reachable.",
+						},
+					},
+				},
+			},
+			"unreachable_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the KMS servers that could not be reached, including requested ones that are not registered.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"all_reachable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether at least one KMS server is checked and every checked one is reachable.",
+			},
+		},
+	}
+}
+
+func DataSourceVbrEncryptionKMSHealthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	timeout, _ := time.ParseDuration(d.Get("timeout").(string))
+
+	queryParams := url.Values{}
+	servers, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[vbrKMSServerModel], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL(vc.WithQuery("/api/v1/kmsServers", queryParams)), nil)
+		if err != nil {
+			return vc.Page[vbrKMSServerModel]{}, err
+		}
+
+		var response vbrKMSServersResponse
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return vc.Page[vbrKMSServerModel]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[vbrKMSServerModel]{Items: response.Data, Total: response.Pagination.Total}, nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list VBR KMS servers: %w", err))
+	}
+
+	var selected map[string]bool
+	if v, ok := d.GetOk("kms_server_ids"); ok {
+		selected = map[string]bool{}
+		for _, id := range v.(*schema.Set).List() {
+			selected[id.(string)] = true
+		}
+	}
+	items := make([]vbrKMSServerModel, 0, len(servers.Items))
+	found := map[string]bool{}
+	for _, server := range servers.Items {
+		if selected == nil || selected[server.ID] {
+			items = append(items, server)
+			found[server.ID] = true
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+
+	serversData := make([]map[string]interface{}, 0, len(items))
+	unreachable := []string{}
+	for _, server := range items {
+		if server.Port == 0 {
+			server.Port = defaultKMIPPort
+		}
+		errText := ""
+		if err := dialKMSServer(ctx, server, timeout); err != nil {
+			errText = err.Error()
+			unreachable = append(unreachable, server.ID)
+		}
+		serversData = append(serversData, map[string]interface{}{
+			"id":          server.ID,
+			"name":        server.Name,
+			"description": server.Description,
+			"type":        server.Type,
+			"port":        server.Port,
+			"reachable":   errText == "",
+			"error":       errText,
+		})
+	}
+	// A requested KMS server that is not registered cannot decrypt anything either
+	missing := []string{}
+	for id := range selected {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	sort.Strings(missing)
+	unreachable = append(unreachable, missing...)
+
+	if err := d.Set("kms_servers", serversData); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("unreachable_ids", unreachable); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("all_reachable", len(items) > 0 && len(unreachable) == 0)
+
+	d.SetId("vbr_encryption_kms_health")
+	return nil
+}
+
+// dialKMSServer opens and closes a TCP connection to a KMS server. The connection is made from
+// where Terraform runs, since the VBR API does not report whether it can reach the server.
+func dialKMSServer(ctx context.Context, server vbrKMSServerModel, timeout time.Duration) error {
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(server.Name, strconv.Itoa(server.Port)))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
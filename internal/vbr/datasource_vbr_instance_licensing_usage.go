@@ -0,0 +1,269 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Response models
+type VBRLicenseModel struct {
+	Status                 string `json:"status"`
+	Type                   string `json:"type"`
+	Edition                string `json:"edition"`
+	ExpirationDate         string `json:"expirationDate"`
+	InstanceLicenseSummary struct {
+		LicensedInstancesNumber float64 `json:"licensedInstancesNumber"`
+		UsedInstancesNumber     float64 `json:"usedInstancesNumber"`
+		NewInstancesNumber      float64 `json:"newInstancesNumber"`
+		RentalInstancesNumber   float64 `json:"rentalInstancesNumber"`
+		Objects                 []struct {
+			Type                string  `json:"type"`
+			Count               int     `json:"count"`
+			Multiplier          float64 `json:"multiplier"`
+			UsedInstancesNumber float64 `json:"usedInstancesNumber"`
+		} `json:"objects"`
+	} `json:"instanceLicenseSummary"`
+	CapacityLicenseSummary struct {
+		LicensedCapacityTB float64 `json:"licensedCapacityTb"`
+		UsedCapacityTB     float64 `json:"usedCapacityTb"`
+	} `json:"capacityLicenseSummary"`
+}
+
+type VBRLicensedInstancesResponse struct {
+	Data       []VBRLicensedInstanceModel `json:"data"`
+	Pagination PaginationResponse         `json:"pagination"`
+}
+
+type VBRLicensedInstanceModel struct {
+	InstanceID          string  `json:"instanceId"`
+	Name                string  `json:"name"`
+	HostName            string  `json:"hostName"`
+	Type                string  `json:"type"`
+	UsedInstancesNumber float64 `json:"usedInstancesNumber"`
+}
+
+func DataSourceVbrInstanceLicensingUsage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the license consumption of Veeam Backup & Replication, in total and per workload type, e.g. to alert when the licensed instances are nearly used up.",
+		ReadContext: DataSourceVbrInstanceLicensingUsageRead,
+		Schema: map[string]*schema.Schema{
+			"include_workloads": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to list every licensed workload in workloads. Large environments have many workloads, so they are only listed when requested.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the license, e.g. Valid or Expired.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the license, e.g. Subscription, Perpetual or Rental.",
+			},
+			"edition": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Edition of the license.",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time the license expires.",
+			},
+			"licensed_instances": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Number of instances the license covers.",
+			},
+			"used_instances": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Number of instances in use.",
+			},
+			"new_instances": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Number of instances used by workloads added in the current license period.",
+			},
+			"rental_instances": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Number of rental instances in use.",
+			},
+			"used_instances_percent": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Used instances in percent of the licensed instances, or 0 without instance licenses.",
+			},
+			"licensed_capacity_tb": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Capacity in TB the license covers for capacity-licensed workloads, such as file shares.",
+			},
+			"used_capacity_tb": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Capacity in TB in use by capacity-licensed workloads.",
+			},
+			"workload_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "License consumption per workload type.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of workload, e.g. VirtualMachine, Server or Workstation.",
+						},
+						"count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of workloads of this type.",
+						},
+						"multiplier": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Number of instances each workload of this type uses.",
+						},
+						"used_instances": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Number of instances used by workloads of this type.",
+						},
+					},
+				},
+			},
+			"workloads": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Licensed workloads, when include_workloads is true.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the licensed instance.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the workload.",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the host of the workload.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the workload.",
+						},
+						"used_instances": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Number of instances the workload uses.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceVbrInstanceLicensingUsageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL("/api/v1/license"), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var license VBRLicenseModel
+	if err := json.Unmarshal(respBody, &license); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing response: %w", err))
+	}
+
+	instances := license.InstanceLicenseSummary
+	usedPercent := 0.0
+	if instances.LicensedInstancesNumber > 0 {
+		usedPercent = instances.UsedInstancesNumber / instances.LicensedInstancesNumber * 100
+	}
+	d.Set("status", license.Status)
+	d.Set("type", license.Type)
+	d.Set("edition", license.Edition)
+	d.Set("expiration_date", license.ExpirationDate)
+	d.Set("licensed_instances", instances.LicensedInstancesNumber)
+	d.Set("used_instances", instances.UsedInstancesNumber)
+	d.Set("new_instances", instances.NewInstancesNumber)
+	d.Set("rental_instances", instances.RentalInstancesNumber)
+	d.Set("used_instances_percent", usedPercent)
+	d.Set("licensed_capacity_tb", license.CapacityLicenseSummary.LicensedCapacityTB)
+	d.Set("used_capacity_tb", license.CapacityLicenseSummary.UsedCapacityTB)
+
+	workloadTypes := make([]map[string]interface{}, 0, len(instances.Objects))
+	for _, object := range instances.Objects {
+		workloadTypes = append(workloadTypes, map[string]interface{}{
+			"type":           object.Type,
+			"count":          object.Count,
+			"multiplier":     object.Multiplier,
+			"used_instances": object.UsedInstancesNumber,
+		})
+	}
+	if err := d.Set("workload_types", workloadTypes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	workloadsData := []map[string]interface{}{}
+	if d.Get("include_workloads").(bool) {
+		queryParams := url.Values{}
+		workloads, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[VBRLicensedInstanceModel], error) {
+			queryParams.Set("skip", fmt.Sprintf("%d", skip))
+			queryParams.Set("limit", fmt.Sprintf("%d", limit))
+			fullUrl := client.BuildAPIURL(vc.WithQuery("/api/v1/license/instances", queryParams))
+			respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+			if err != nil {
+				return vc.Page[VBRLicensedInstanceModel]{}, err
+			}
+
+			var instancesResponse VBRLicensedInstancesResponse
+			if err := json.Unmarshal(respBody, &instancesResponse); err != nil {
+				return vc.Page[VBRLicensedInstanceModel]{}, fmt.Errorf("error parsing response: %w", err)
+			}
+			return vc.Page[VBRLicensedInstanceModel]{Items: instancesResponse.Data, Total: instancesResponse.Pagination.Total}, nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, workload := range workloads.Items {
+			workloadsData = append(workloadsData, map[string]interface{}{
+				"instance_id":    workload.InstanceID,
+				"name":           workload.Name,
+				"host_name":      workload.HostName,
+				"type":           workload.Type,
+				"used_instances": workload.UsedInstancesNumber,
+			})
+		}
+	}
+	if err := d.Set("workloads", workloadsData); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set resource ID
+	d.SetId("vbr_instance_licensing_usage")
+
+	return diags
+}
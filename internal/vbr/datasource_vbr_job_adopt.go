@@ -0,0 +1,304 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// adoptableJob is a resource that manages VBR jobs of one type
+type adoptableJob struct {
+	resourceType string
+	// newModel returns the job model the resource reads and writes
+	newModel func() interface{}
+	// importIssue explains why the resource cannot import jobs; empty when it can
+	importIssue string
+}
+
+// adoptableJobs are the resources that manage VBR jobs, by job type
+var adoptableJobs = map[string]adoptableJob{
+	cloudDirectorJobType: {
+		resourceType: "veeambackup_vbr_vmware_cloud_director_job",
+		newModel:     func() interface{} { return &VbrCloudDirectorBackupJob{} },
+	},
+	jobSetFileBackup: {
+		resourceType: "veeambackup_vbr_file_share_backup_job",
+		newModel:     func() interface{} { return &VbrFileShareBackupJobResponse{} },
+		importIssue:  "veeambackup_vbr_file_share_backup_job does not support import, since it does not read back objects and backup_repository",
+	},
+	jobSetObjectStorageBackup: {
+		resourceType: "veeambackup_vbr_object_storage_backup_job",
+		newModel:     func() interface{} { return &VbrObjectStorageBackupJobResponse{} },
+		importIssue:  "veeambackup_vbr_object_storage_backup_job does not support import, since it does not read back objects and backup_repository",
+	},
+}
+
+// adoptableJobResourceTypes returns the resource types of adoptableJobs, sorted
+func adoptableJobResourceTypes() []string {
+	resourceTypes := make([]string, 0, len(adoptableJobs))
+	for _, job := range adoptableJobs {
+		resourceTypes = append(resourceTypes, job.resourceType)
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
+
+type vbrJobListResponse struct {
+	Data       []vbrJobListItem   `json:"data"`
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+type vbrJobListItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func DataSourceVbrJobAdopt() *schema.Resource {
+	return &schema.Resource{
+		Description: "Looks up an existing Veeam Backup & Replication job by name and checks that a resource of this provider can import it, to bring jobs created outside of Terraform under management. " +
+			"Lists the job settings the resource does not manage, which would be left as they are or need additional_settings_json.",
+		ReadContext: DataSourceVbrJobAdoptRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Exact name of the job.",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(adoptableJobResourceTypes(), false),
+				Description:  "Resource type the job is to be imported into, e.g. veeambackup_vbr_vmware_cloud_director_job. Defaults to the resource that manages jobs of the type of the job.",
+			},
+			"job_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the job, e.g. CloudDirectorBackup.",
+			},
+			"importable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the job can be imported into resource_type.",
+			},
+			"issues": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Why the job cannot be imported into resource_type; empty when importable.",
+			},
+			"unmanaged_settings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Paths of the job settings resource_type does not manage, e.g. storage.advancedSettings.backupModeType.",
+			},
+			"unmanaged_settings_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON object of the job settings resource_type does not manage, with their current values. Settings that should be managed can be copied to additional_settings_json after review.",
+			},
+		},
+	}
+}
+
+func DataSourceVbrJobAdoptRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	name := d.Get("name").(string)
+
+	jobID, err := findJobByName(ctx, client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	job, err := client.DoRequest(ctx, "GET", client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s", jobID)), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read VBR job %q: %w", name, err))
+	}
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(job, &header); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to decode VBR job response: %w", err))
+	}
+
+	resourceType := d.Get("resource_type").(string)
+	adoptable, known := adoptableJobs[header.Type]
+	var issues []string
+	switch {
+	case !known:
+		issues = append(issues, fmt.Sprintf("no resource of this provider manages %s jobs", header.Type))
+	case resourceType != "" && resourceType != adoptable.resourceType:
+		issues = append(issues, fmt.Sprintf("%s jobs are managed by %s, not %s", header.Type, adoptable.resourceType, resourceType))
+	default:
+		resourceType = adoptable.resourceType
+		if adoptable.importIssue != "" {
+			issues = append(issues, adoptable.importIssue)
+		}
+	}
+
+	unmanaged := map[string]interface{}{}
+	var unmanagedPaths []string
+	if known {
+		unmanaged, unmanagedPaths, err = unmodeledJobSettings(job, adoptable.newModel())
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to compare VBR job %q with %s: %w", name, adoptable.resourceType, err))
+		}
+	}
+	unmanagedJSON, err := json.Marshal(unmanaged)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(jobID)
+	d.Set("resource_type", resourceType)
+	d.Set("job_type", header.Type)
+	d.Set("importable", len(issues) == 0)
+	if err := d.Set("issues", issues); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("unmanaged_settings", unmanagedPaths); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("unmanaged_settings_json", string(unmanagedJSON))
+
+	var diags diag.Diagnostics
+	if len(issues) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("VBR job %q cannot be imported", name),
+			Detail:   strings.Join(issues, "\n"),
+		})
+	}
+	return diags
+}
+
+// findJobByName returns the ID of the only job named name
+func findJobByName(ctx context.Context, client *vc.VBRClient, name string) (string, error) {
+	queryParams := url.Values{}
+	queryParams.Set("nameFilter", name)
+	jobs, err := listJobs(ctx, client, queryParams)
+	if err != nil {
+		return "", err
+	}
+
+	// The name filter matches substrings, so jobs with longer names are skipped
+	var ids []string
+	for _, job := range jobs {
+		if job.Name == name {
+			ids = append(ids, job.ID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no VBR job is named %q", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d VBR jobs are named %q (IDs %s); rename them so that each name is unique", len(ids), name, strings.Join(ids, ", "))
+	}
+}
+
+// listJobs returns the jobs matching the filters of queryParams, e.g. nameFilter and typeFilter
+func listJobs(ctx context.Context, client *vc.VBRClient, queryParams url.Values) ([]vbrJobListItem, error) {
+	jobs, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[vbrJobListItem], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		respBody, err := client.DoRequest(ctx, "GET", client.BuildAPIURL(vc.WithQuery("/api/v1/jobs", queryParams)), nil)
+		if err != nil {
+			return vc.Page[vbrJobListItem]{}, err
+		}
+
+		var response vbrJobListResponse
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return vc.Page[vbrJobListItem]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[vbrJobListItem]{Items: response.Data, Total: response.Pagination.Total}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VBR jobs: %w", err)
+	}
+	return jobs.Items, nil
+}
+
+// unmodeledJobSettings returns the settings of job, as returned by the API, that are lost when it
+// is decoded into model and encoded again, i.e. the settings the resource of model does not
+// manage. They are returned as an object and as the sorted paths of the differing values.
+func unmodeledJobSettings(job []byte, model interface{}) (map[string]interface{}, []string, error) {
+	actual, err := decodeJSONObject(job)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := json.Unmarshal(job, model); err != nil {
+		return nil, nil, err
+	}
+	encoded, err := json.Marshal(model)
+	if err != nil {
+		return nil, nil, err
+	}
+	modeled, err := decodeJSONObject(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var paths []string
+	unmodeled := diffJSONObjects(actual, modeled, "", &paths)
+	sort.Strings(paths)
+	return unmodeled, paths, nil
+}
+
+// diffJSONObjects returns the values of actual that modeled lacks or holds differently, recursing
+// into the objects both have at the same key, and adds their paths to paths
+func diffJSONObjects(actual, modeled map[string]interface{}, prefix string, paths *[]string) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, value := range actual {
+		modeledValue, ok := modeled[key]
+		if !ok {
+			// Settings the API returns empty are lost without a difference in the job
+			if !isEmptyJSON(value) {
+				result[key] = value
+				*paths = append(*paths, prefix+key)
+			}
+			continue
+		}
+		if actualObj, ok := value.(map[string]interface{}); ok {
+			if modeledObj, ok := modeledValue.(map[string]interface{}); ok {
+				if diff := diffJSONObjects(actualObj, modeledObj, prefix+key+".", paths); len(diff) > 0 {
+					result[key] = diff
+				}
+				continue
+			}
+		}
+		if !reflect.DeepEqual(value, modeledValue) && !(isEmptyJSON(value) && isEmptyJSON(modeledValue)) {
+			result[key] = value
+			*paths = append(*paths, prefix+key)
+		}
+	}
+	return result
+}
+
+// isEmptyJSON reports whether a decoded JSON value is null, an empty array or an empty object
+func isEmptyJSON(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
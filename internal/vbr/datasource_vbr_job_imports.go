@@ -0,0 +1,67 @@
+package vbr
+
+import (
+	"context"
+	"net/url"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceVbrJobImports() *schema.Resource {
+	s := vc.ImportCandidatesSchema("job")
+	s["name_filter"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filter jobs by name pattern.",
+	}
+	s["type_filter"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filter by job type, e.g. CloudDirectorBackup.",
+	}
+
+	return &schema.Resource{
+		Description: "Lists the jobs of Veeam Backup & Replication with the resource addresses to import them to, and renders Terraform import blocks for the jobs whose resource supports import, for mass migrations of existing jobs.",
+		ReadContext: DataSourceVbrJobImportsRead,
+		Schema:      s,
+	}
+}
+
+func DataSourceVbrJobImportsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	queryParams := url.Values{}
+	if v, ok := d.GetOk("name_filter"); ok {
+		queryParams.Set("nameFilter", v.(string))
+	}
+	if v, ok := d.GetOk("type_filter"); ok {
+		queryParams.Set("typeFilter", v.(string))
+	}
+	jobs, err := listJobs(ctx, client, queryParams)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	candidates := make([]vc.ImportCandidate, len(jobs))
+	for i, job := range jobs {
+		adoptable, known := adoptableJobs[job.Type]
+		candidates[i] = vc.ImportCandidate{
+			ID:           job.ID,
+			Name:         job.Name,
+			Type:         job.Type,
+			ResourceType: adoptable.resourceType,
+			Importable:   known && adoptable.importIssue == "",
+		}
+	}
+	if err := vc.SetImportCandidates(d, candidates); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("vbr_job_imports")
+	return nil
+}
@@ -0,0 +1,320 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	vc "terraform-provider-veeambackup/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Response models
+type VBRJobStatesResponse struct {
+	Data       []VBRJobStateModel `json:"data"`
+	Pagination PaginationResponse `json:"pagination"`
+}
+
+type VBRJobStateModel struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Description    string `json:"description"`
+	Status         string `json:"status"`
+	LastRun        string `json:"lastRun"`
+	LastResult     string `json:"lastResult"`
+	NextRun        string `json:"nextRun"`
+	RepositoryID   string `json:"repositoryId"`
+	RepositoryName string `json:"repositoryName"`
+	ObjectsCount   int    `json:"objectsCount"`
+	SessionID      string `json:"sessionId"`
+}
+
+type vbrJobSessionModel struct {
+	CreationTime string `json:"creationTime"`
+	EndTime      string `json:"endTime"`
+}
+
+type vbrTaskSessionsResponse struct {
+	Data []struct {
+		Progress struct {
+			ProcessedSize int64 `json:"processedSize"`
+		} `json:"progress"`
+	} `json:"data"`
+}
+
+// vbrJobSessionDetails are the details of the last session of a job that the job state lacks
+type vbrJobSessionDetails struct {
+	Duration      int
+	ProcessedSize int64
+}
+
+func DataSourceVbrJobStates() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the state and last result of the jobs in Veeam Backup & Replication, e.g. to report whether every job succeeded recently.",
+		ReadContext: DataSourceVbrJobStatesRead,
+		Schema: map[string]*schema.Schema{
+			"name_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter jobs by name pattern.",
+			},
+			"type_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by job type, e.g. Backup, FileBackup or ObjectStorageBackup.",
+			},
+			"last_run_within": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: vc.ValidateDuration,
+				Description:  "Maximum age of the last run of a job, as a duration such as `24h`, for all_succeeded to be true.",
+			},
+			"jobs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of job states.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the job.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the job.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the job.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the job.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the job, e.g. Running, Inactive or Disabled.",
+						},
+						"last_run": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time the job last ran.",
+						},
+						"last_result": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Result of the last run: None, Success, Warning or Failed.",
+						},
+						"next_run": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time the job runs next.",
+						},
+						"repository_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the repository the job stores backups in.",
+						},
+						"repository_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the repository the job stores backups in.",
+						},
+						"objects_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of objects the job processes.",
+						},
+						"session_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the last session of the job.",
+						},
+						"last_run_duration": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Duration of the last run in seconds, or 0 while it is still running.",
+						},
+						"processed_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Bytes processed by the last run.",
+						},
+					},
+				},
+			},
+			"result_counts": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Number of jobs by last result, e.g. `{ Success = 12, Failed = 1 }`.",
+			},
+			"all_succeeded": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the last run of every job succeeded, and when last_run_within is set, ran within it. False when no job matches the filters.",
+			},
+		},
+	}
+}
+
+func DataSourceVbrJobStatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	apiUrl := "/api/v1/jobs/states"
+
+	// Build query parameters dynamically
+	queryParams := url.Values{}
+	if v, ok := d.GetOk("name_filter"); ok {
+		queryParams.Add("nameFilter", v.(string))
+	}
+	if v, ok := d.GetOk("type_filter"); ok {
+		queryParams.Add("typeFilter", v.(string))
+	}
+
+	states, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[VBRJobStateModel], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		fullUrl := client.BuildAPIURL(vc.WithQuery(apiUrl, queryParams))
+		respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		if err != nil {
+			return vc.Page[VBRJobStateModel]{}, err
+		}
+
+		var statesResponse VBRJobStatesResponse
+		if err := json.Unmarshal(respBody, &statesResponse); err != nil {
+			return vc.Page[VBRJobStateModel]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[VBRJobStateModel]{Items: statesResponse.Data, Total: statesResponse.Pagination.Total}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The duration and processed size of the last run are only reported by its session
+	details := make([]vbrJobSessionDetails, len(states.Items))
+	errs := client.RunConcurrently(ctx, len(states.Items), func(ctx context.Context, i int) error {
+		if states.Items[i].SessionID == "" {
+			return nil
+		}
+		var err error
+		details[i], err = getVbrJobSessionDetails(ctx, client, states.Items[i].SessionID)
+		return err
+	})
+	for i, err := range errs {
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to read the last session of job %s", states.Items[i].Name),
+				Detail:   fmt.Sprintf("last_run_duration and processed_size are 0: %s", err),
+			})
+		}
+	}
+
+	var maxAge time.Duration
+	if v, ok := d.GetOk("last_run_within"); ok {
+		maxAge, _ = time.ParseDuration(v.(string))
+	}
+	jobsData := make([]map[string]interface{}, 0, len(states.Items))
+	resultCounts := map[string]interface{}{}
+	for i, state := range states.Items {
+		jobsData = append(jobsData, map[string]interface{}{
+			"id":                state.ID,
+			"name":              state.Name,
+			"type":              state.Type,
+			"description":       state.Description,
+			"status":            state.Status,
+			"last_run":          state.LastRun,
+			"last_result":       state.LastResult,
+			"next_run":          state.NextRun,
+			"repository_id":     state.RepositoryID,
+			"repository_name":   state.RepositoryName,
+			"objects_count":     state.ObjectsCount,
+			"session_id":        state.SessionID,
+			"last_run_duration": details[i].Duration,
+			"processed_size":    int(details[i].ProcessedSize),
+		})
+		count, _ := resultCounts[state.LastResult].(int)
+		resultCounts[state.LastResult] = count + 1
+	}
+
+	if err := d.Set("jobs", jobsData); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("result_counts", resultCounts); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("all_succeeded", allJobsSucceeded(states.Items, maxAge, time.Now())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Set resource ID
+	d.SetId("vbr_job_states")
+
+	return diags
+}
+
+// getVbrJobSessionDetails reads the duration of a job session and the bytes its tasks processed
+func getVbrJobSessionDetails(ctx context.Context, client *vc.VBRClient, sessionID string) (vbrJobSessionDetails, error) {
+	var details vbrJobSessionDetails
+	sessionURL := client.BuildAPIURL(vc.Endpoint("/api/v1/sessions/%s", sessionID))
+	respBody, err := client.DoRequest(ctx, "GET", sessionURL, nil)
+	if err != nil {
+		return details, err
+	}
+	var session vbrJobSessionModel
+	if err := json.Unmarshal(respBody, &session); err != nil {
+		return details, fmt.Errorf("error parsing session: %w", err)
+	}
+	start, startErr := time.Parse(time.RFC3339, session.CreationTime)
+	end, endErr := time.Parse(time.RFC3339, session.EndTime)
+	if startErr == nil && endErr == nil && end.After(start) {
+		details.Duration = int(end.Sub(start).Seconds())
+	}
+
+	respBody, err = client.DoRequest(ctx, "GET", sessionURL+"/taskSessions", nil)
+	if err != nil {
+		return details, err
+	}
+	var tasks vbrTaskSessionsResponse
+	if err := json.Unmarshal(respBody, &tasks); err != nil {
+		return details, fmt.Errorf("error parsing task sessions: %w", err)
+	}
+	for _, task := range tasks.Data {
+		details.ProcessedSize += task.Progress.ProcessedSize
+	}
+	return details, nil
+}
+
+// allJobsSucceeded reports whether there are jobs and the last run of each succeeded, no longer
+// than maxAge before now unless maxAge is 0
+func allJobsSucceeded(states []VBRJobStateModel, maxAge time.Duration, now time.Time) bool {
+	if len(states) == 0 {
+		return false
+	}
+	for _, state := range states {
+		if state.LastResult != "Success" {
+			return false
+		}
+		if maxAge == 0 {
+			continue
+		}
+		lastRun, err := time.Parse(time.RFC3339, state.LastRun)
+		if err != nil || now.Sub(lastRun) > maxAge {
+			return false
+		}
+	}
+	return true
+}
@@ -45,8 +45,7 @@ func DataSourceVbrProxies() *schema.Resource {
 			"limit": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Default:     200,
-				Description: "Maximum number of items to return.",
+				Description: "Maximum number of items to return. When not set, all items are returned.",
 			},
 			"order_column": {
 				Type:        schema.TypeString,
@@ -153,7 +152,7 @@ func DataSourceVbrProxies() *schema.Resource {
 						"limit": {
 							Type:        schema.TypeInt,
 							Computed:    true,
-							Description: "Maximum number of results to return.",
+							Description: "The configured limit, or 0 when all results were fetched.",
 						},
 					},
 				},
@@ -173,12 +172,6 @@ func DataSourceVbrProxiesRead(ctx context.Context, d *schema.ResourceData, m int
 	// Build query parameters dynamically
 	queryParams := url.Values{}
 
-	if v, ok := d.GetOk("skip"); ok {
-		queryParams.Add("skip", fmt.Sprintf("%d", v.(int)))
-	}
-	if v, ok := d.GetOk("limit"); ok {
-		queryParams.Add("limit", fmt.Sprintf("%d", v.(int)))
-	}
 	if v, ok := d.GetOk("order_column"); ok {
 		queryParams.Add("orderColumn", v.(string))
 	}
@@ -195,25 +188,30 @@ func DataSourceVbrProxiesRead(ctx context.Context, d *schema.ResourceData, m int
 		queryParams.Add("hostIdFilter", v.(string))
 	}
 
-	// Build full URL
-	fullUrl := client.BuildAPIURL(fmt.Sprintf("%s?%s", apiUrl, queryParams.Encode()))
+	// Make API requests, following pagination unless a limit is set
+	proxies, err := vc.FetchPages(ctx, d.Get("skip").(int), d.Get("limit").(int), func(ctx context.Context, skip, limit int) (vc.Page[VBRProxyModel], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		fullUrl := client.BuildAPIURL(vc.WithQuery(apiUrl, queryParams))
+		respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		if err != nil {
+			return vc.Page[VBRProxyModel]{}, err
+		}
 
-	// Make API request
-	respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		// Parse JSON response
+		var proxiesResponse VBRProxiesResponse
+		if err := json.Unmarshal(respBody, &proxiesResponse); err != nil {
+			return vc.Page[VBRProxyModel]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[VBRProxyModel]{Items: proxiesResponse.Data, Total: proxiesResponse.Pagination.Total}, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	// Parse JSON response
-	var proxiesResponse VBRProxiesResponse
-	err = json.Unmarshal(respBody, &proxiesResponse)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing response: %w", err))
-	}
-
 	// Set proxies data
-	proxiesData := make([]map[string]interface{}, 0, len(proxiesResponse.Data))
-	for _, proxy := range proxiesResponse.Data {
+	proxiesData := make([]map[string]interface{}, 0, len(proxies.Items))
+	for _, proxy := range proxies.Items {
 		proxyMap := map[string]interface{}{
 			"id":          proxy.ID,
 			"name":        proxy.Name,
@@ -245,10 +243,10 @@ func DataSourceVbrProxiesRead(ctx context.Context, d *schema.ResourceData, m int
 	// Set pagination data
 	paginationData := []map[string]interface{}{
 		{
-			"total": proxiesResponse.Pagination.Total,
-			"count": proxiesResponse.Pagination.Count,
-			"skip":  proxiesResponse.Pagination.Skip,
-			"limit": proxiesResponse.Pagination.Limit,
+			"total": proxies.Total,
+			"count": len(proxies.Items),
+			"skip":  d.Get("skip").(int),
+			"limit": d.Get("limit").(int),
 		},
 	}
 	if err := d.Set("pagination", paginationData); err != nil {
@@ -0,0 +1,260 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Response models
+type VBRProxyStatesResponse struct {
+	Data       []VBRProxyStateModel `json:"data"`
+	Pagination PaginationResponse   `json:"pagination"`
+}
+
+type VBRProxyStateModel struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Type              string `json:"type"`
+	HostName          string `json:"hostName"`
+	IsOnline          bool   `json:"isOnline"`
+	IsDisabled        bool   `json:"isDisabled"`
+	RunningTasksCount int    `json:"runningTasksCount"`
+}
+
+// vbrProxyLoad is the task load of a proxy, from its state and the task limit of its settings
+type vbrProxyLoad struct {
+	VBRProxyStateModel
+	MaxTaskCount int
+}
+
+// freeTaskSlots returns the number of tasks the proxy can start before reaching its limit
+func (p vbrProxyLoad) freeTaskSlots() int {
+	if p.RunningTasksCount >= p.MaxTaskCount {
+		return 0
+	}
+	return p.MaxTaskCount - p.RunningTasksCount
+}
+
+// loadPercent returns the running tasks of the proxy in percent of its limit, or 100 when the
+// limit is unknown
+func (p vbrProxyLoad) loadPercent() float64 {
+	if p.MaxTaskCount <= 0 {
+		return 100
+	}
+	return float64(p.RunningTasksCount) / float64(p.MaxTaskCount) * 100
+}
+
+// available reports whether jobs can be assigned to the proxy
+func (p vbrProxyLoad) available() bool {
+	return p.IsOnline && !p.IsDisabled && p.freeTaskSlots() > 0
+}
+
+func DataSourceVbrProxiesLoad() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the current task load of the backup proxies in Veeam Backup & Replication. " +
+			"Use least_loaded_proxy_id to assign a proxy to a job statically instead of letting Veeam select it automatically.",
+		ReadContext: DataSourceVbrProxiesLoadRead,
+		Schema: map[string]*schema.Schema{
+			"name_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter proxies by name pattern.",
+			},
+			"type_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ViProxy", "HvOffHostProxy", "CdpProxy"}, false),
+				Description:  "Filter by proxy type (ViProxy, HvOffHostProxy, CdpProxy).",
+			},
+			"proxies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Proxies, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Backup proxy ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the backup proxy.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of backup proxy.",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the server the proxy runs on.",
+						},
+						"is_online": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the backup server can connect to the proxy.",
+						},
+						"is_disabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the proxy is disabled.",
+						},
+						"max_task_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum number of concurrent tasks.",
+						},
+						"running_tasks": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of tasks the proxy is processing.",
+						},
+						"free_task_slots": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of tasks the proxy can start before reaching max_task_count.",
+						},
+						"load_percent": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Running tasks in percent of max_task_count, or 100 when max_task_count is unknown.",
+						},
+					},
+				},
+			},
+			"total_running_tasks": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of tasks all proxies are processing.",
+			},
+			"total_free_task_slots": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of tasks the online, enabled proxies can start.",
+			},
+			"least_loaded_proxy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the online, enabled proxy with the lowest load_percent and free task slots, or empty when there is none.",
+			},
+		},
+	}
+}
+
+func DataSourceVbrProxiesLoadRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	queryParams := url.Values{}
+	if v, ok := d.GetOk("name_filter"); ok {
+		queryParams.Add("nameFilter", v.(string))
+	}
+	if v, ok := d.GetOk("type_filter"); ok {
+		queryParams.Add("typeFilter", v.(string))
+	}
+
+	states, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[VBRProxyStateModel], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		fullUrl := client.BuildAPIURL(vc.WithQuery("/api/v1/backupInfrastructure/proxies/states", queryParams))
+		respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		if err != nil {
+			return vc.Page[VBRProxyStateModel]{}, err
+		}
+
+		var statesResponse VBRProxyStatesResponse
+		if err := json.Unmarshal(respBody, &statesResponse); err != nil {
+			return vc.Page[VBRProxyStateModel]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[VBRProxyStateModel]{Items: statesResponse.Data, Total: statesResponse.Pagination.Total}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The proxy states lack the task limit, which only the proxy settings report
+	proxies, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[VBRProxyModel], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		fullUrl := client.BuildAPIURL(vc.WithQuery("/api/v1/backupInfrastructure/proxies", queryParams))
+		respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		if err != nil {
+			return vc.Page[VBRProxyModel]{}, err
+		}
+
+		var proxiesResponse VBRProxiesResponse
+		if err := json.Unmarshal(respBody, &proxiesResponse); err != nil {
+			return vc.Page[VBRProxyModel]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[VBRProxyModel]{Items: proxiesResponse.Data, Total: proxiesResponse.Pagination.Total}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	maxTaskCounts := make(map[string]int, len(proxies.Items))
+	for _, proxy := range proxies.Items {
+		if proxy.Server != nil && proxy.Server.MaxTaskCount != nil {
+			maxTaskCounts[proxy.ID] = *proxy.Server.MaxTaskCount
+		}
+	}
+
+	loads := make([]vbrProxyLoad, 0, len(states.Items))
+	for _, state := range states.Items {
+		loads = append(loads, vbrProxyLoad{VBRProxyStateModel: state, MaxTaskCount: maxTaskCounts[state.ID]})
+	}
+	sort.SliceStable(loads, func(i, j int) bool { return loads[i].Name < loads[j].Name })
+
+	proxiesData := make([]map[string]interface{}, 0, len(loads))
+	totalRunning, totalFree := 0, 0
+	var leastLoaded *vbrProxyLoad
+	for i, load := range loads {
+		proxiesData = append(proxiesData, map[string]interface{}{
+			"id":              load.ID,
+			"name":            load.Name,
+			"type":            load.Type,
+			"host_name":       load.HostName,
+			"is_online":       load.IsOnline,
+			"is_disabled":     load.IsDisabled,
+			"max_task_count":  load.MaxTaskCount,
+			"running_tasks":   load.RunningTasksCount,
+			"free_task_slots": load.freeTaskSlots(),
+			"load_percent":    load.loadPercent(),
+		})
+		totalRunning += load.RunningTasksCount
+		if !load.available() {
+			continue
+		}
+		totalFree += load.freeTaskSlots()
+		// Proxies are sorted by name, so ties go to the first one by name
+		if leastLoaded == nil || load.loadPercent() < leastLoaded.loadPercent() {
+			leastLoaded = &loads[i]
+		}
+	}
+
+	if err := d.Set("proxies", proxiesData); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("total_running_tasks", totalRunning)
+	d.Set("total_free_task_slots", totalFree)
+	if leastLoaded != nil {
+		d.Set("least_loaded_proxy_id", leastLoaded.ID)
+	} else {
+		d.Set("least_loaded_proxy_id", "")
+	}
+
+	d.SetId("vbr_proxies_load")
+	return nil
+}
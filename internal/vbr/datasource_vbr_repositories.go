@@ -57,8 +57,9 @@ func DataSourceVBRRepositories() *schema.Resource {
 				Optional: true,
 			},
 			"limit": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of items to return. When not set, all items are returned.",
 			},
 			"order_column": {
 				Type:     schema.TypeString,
@@ -536,7 +537,7 @@ func DataSourceVBRRepositories() *schema.Resource {
 						"limit": {
 							Type:        schema.TypeInt,
 							Computed:    true,
-							Description: "Limit of items per page.",
+							Description: "The configured limit, or 0 when all items were fetched.",
 						},
 					},
 				},
@@ -555,12 +556,6 @@ func DataSourceVBRRepositoriesRead(ctx context.Context, d *schema.ResourceData,
 	// Build query parameters
 	queryParams := url.Values{}
 
-	if v, ok := d.GetOk("skip"); ok {
-		queryParams.Add("skip", fmt.Sprintf("%d", v.(int)))
-	}
-	if v, ok := d.GetOk("limit"); ok {
-		queryParams.Add("limit", fmt.Sprintf("%d", v.(int)))
-	}
 	if v, ok := d.GetOk("order_column"); ok {
 		queryParams.Add("orderColumn", v.(string))
 	}
@@ -593,23 +588,30 @@ func DataSourceVBRRepositoriesRead(ctx context.Context, d *schema.ResourceData,
 		queryParams.Add("excludeExtents", fmt.Sprintf("%t", v.(bool)))
 	}
 
-	// Make the API request
-	fullUrl := client.BuildAPIURL(fmt.Sprintf("%s?%s", apiUrl, queryParams.Encode()))
-	respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	// Make the API requests, following pagination unless a limit is set
+	repositories, err := vc.FetchPages(ctx, d.Get("skip").(int), d.Get("limit").(int), func(ctx context.Context, skip, limit int) (vc.Page[VBRRepositoriesResponseData], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		fullUrl := client.BuildAPIURL(vc.WithQuery(apiUrl, queryParams))
+		respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		if err != nil {
+			return vc.Page[VBRRepositoriesResponseData]{}, err
+		}
 
-	// Parse response
-	var repositoriesResponse VBRRepositoriesResponse
-	err = json.Unmarshal(respBody, &repositoriesResponse)
+		// Parse response
+		var repositoriesResponse VBRRepositoriesResponse
+		if err := json.Unmarshal(respBody, &repositoriesResponse); err != nil {
+			return vc.Page[VBRRepositoriesResponseData]{}, err
+		}
+		return vc.Page[VBRRepositoriesResponseData]{Items: repositoriesResponse.Data, Total: repositoriesResponse.Pagination.Total}, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	// Set repositories data
-	repositoriesData := make([]map[string]interface{}, 0, len(repositoriesResponse.Data))
-	for _, repo := range repositoriesResponse.Data {
+	repositoriesData := make([]map[string]interface{}, 0, len(repositories.Items))
+	for _, repo := range repositories.Items {
 		repoMap := map[string]interface{}{
 			"id":                 repo.ID,
 			"name":               repo.Name,
@@ -629,9 +631,9 @@ func DataSourceVBRRepositoriesRead(ctx context.Context, d *schema.ResourceData,
 	// Set pagination data
 	paginationData := []map[string]interface{}{
 		{
-			"total": repositoriesResponse.Pagination.Total,
-			"skip":  repositoriesResponse.Pagination.Skip,
-			"limit": repositoriesResponse.Pagination.Limit,
+			"total": repositories.Total,
+			"skip":  d.Get("skip").(int),
+			"limit": d.Get("limit").(int),
 		},
 	}
 	if err := d.Set("pagination", paginationData); err != nil {
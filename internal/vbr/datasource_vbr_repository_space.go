@@ -0,0 +1,290 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Response models
+type VBRRepositoryStatesResponse struct {
+	Data       []VBRRepositoryStateModel `json:"data"`
+	Pagination PaginationResponse        `json:"pagination"`
+}
+
+type VBRRepositoryStateModel struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	HostName    string  `json:"hostName"`
+	Path        string  `json:"path"`
+	CapacityGB  float64 `json:"capacityGB"`
+	FreeGB      float64 `json:"freeGB"`
+	UsedSpaceGB float64 `json:"usedSpaceGB"`
+}
+
+// vbrRepositoryImmutabilityModel is the part of a repository returned by the VBR API that holds its
+// immutability settings: hardened Linux repositories set them on repository, object storage
+// repositories on their bucket or container
+type vbrRepositoryImmutabilityModel struct {
+	ID         string `json:"id"`
+	Repository *struct {
+		UseImmutableBackups            bool `json:"useImmutableBackups"`
+		MakeRecentBackupsImmutableDays int  `json:"makeRecentBackupsImmutableDays"`
+	} `json:"repository,omitempty"`
+	Bucket *struct {
+		Immutability *vbrImmutabilityModel `json:"immutability,omitempty"`
+	} `json:"bucket,omitempty"`
+	Container *struct {
+		Immutability *vbrImmutabilityModel `json:"immutability,omitempty"`
+	} `json:"container,omitempty"`
+}
+
+type vbrImmutabilityModel struct {
+	IsEnabled bool `json:"isEnabled"`
+	DaysCount int  `json:"daysCount"`
+}
+
+type vbrRepositoryImmutabilityResponse struct {
+	Data       []vbrRepositoryImmutabilityModel `json:"data"`
+	Pagination PaginationResponse               `json:"pagination"`
+}
+
+// immutability returns whether backups in the repository are immutable, and for how many days
+func (r vbrRepositoryImmutabilityModel) immutability() (bool, int) {
+	switch {
+	case r.Repository != nil && r.Repository.UseImmutableBackups:
+		return true, r.Repository.MakeRecentBackupsImmutableDays
+	case r.Bucket != nil && r.Bucket.Immutability != nil && r.Bucket.Immutability.IsEnabled:
+		return true, r.Bucket.Immutability.DaysCount
+	case r.Container != nil && r.Container.Immutability != nil && r.Container.Immutability.IsEnabled:
+		return true, r.Container.Immutability.DaysCount
+	}
+	return false, 0
+}
+
+func DataSourceVbrRepositorySpace() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves the capacity, free space and immutability of the backup repositories in Veeam Backup & Replication. " +
+			"Set max_used_percent and check all_within_threshold in a precondition to fail the plan when a target repository is nearly full.",
+		ReadContext: DataSourceVbrRepositorySpaceRead,
+		Schema: map[string]*schema.Schema{
+			"repository_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "IDs of the repositories to return. All repositories are returned when not set.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+			"name_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter repositories by name pattern.",
+			},
+			"max_used_percent": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(0, 100),
+				Description:  "Maximum used space of a repository, in percent of its capacity, for all_within_threshold to be true.",
+			},
+			"repositories": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Repositories, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the repository.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the repository.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the repository, e.g. WinLocal, LinuxHardened or AmazonS3.",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the server the repository resides on.",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Path to the folder backups are stored in.",
+						},
+						"capacity_gb": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Capacity of the repository in GB, or 0 when the storage does not report it, as for most object storage.",
+						},
+						"free_gb": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Free space of the repository in GB.",
+						},
+						"used_space_gb": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Space used by backups in the repository in GB.",
+						},
+						"used_percent": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Space that is not free, in percent of the capacity, or 0 when the capacity is unknown.",
+						},
+						"immutability_enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether backups in the repository are made immutable.",
+						},
+						"immutability_days": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of days backups stay immutable, or 0 when immutability is disabled.",
+						},
+					},
+				},
+			},
+			"over_threshold_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the repositories whose used_percent exceeds max_used_percent.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"all_within_threshold": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether at least one repository matches the filters and none exceeds max_used_percent.",
+			},
+		},
+	}
+}
+
+func DataSourceVbrRepositorySpaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	queryParams := url.Values{}
+	if v, ok := d.GetOk("name_filter"); ok {
+		queryParams.Add("nameFilter", v.(string))
+	}
+
+	states, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[VBRRepositoryStateModel], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		fullUrl := client.BuildAPIURL(vc.WithQuery("/api/v1/backupInfrastructure/repositories/states", queryParams))
+		respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		if err != nil {
+			return vc.Page[VBRRepositoryStateModel]{}, err
+		}
+
+		var statesResponse VBRRepositoryStatesResponse
+		if err := json.Unmarshal(respBody, &statesResponse); err != nil {
+			return vc.Page[VBRRepositoryStateModel]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[VBRRepositoryStateModel]{Items: statesResponse.Data, Total: statesResponse.Pagination.Total}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The repository states lack the immutability settings, which only the repositories report
+	repositories, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[vbrRepositoryImmutabilityModel], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		fullUrl := client.BuildAPIURL(vc.WithQuery("/api/v1/backupInfrastructure/repositories", queryParams))
+		respBody, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		if err != nil {
+			return vc.Page[vbrRepositoryImmutabilityModel]{}, err
+		}
+
+		var repositoriesResponse vbrRepositoryImmutabilityResponse
+		if err := json.Unmarshal(respBody, &repositoriesResponse); err != nil {
+			return vc.Page[vbrRepositoryImmutabilityModel]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[vbrRepositoryImmutabilityModel]{Items: repositoriesResponse.Data, Total: repositoriesResponse.Pagination.Total}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	immutability := make(map[string]vbrRepositoryImmutabilityModel, len(repositories.Items))
+	for _, repository := range repositories.Items {
+		immutability[repository.ID] = repository
+	}
+
+	var selected map[string]bool
+	if v, ok := d.GetOk("repository_ids"); ok {
+		selected = map[string]bool{}
+		for _, id := range v.(*schema.Set).List() {
+			selected[id.(string)] = true
+		}
+	}
+	items := make([]VBRRepositoryStateModel, 0, len(states.Items))
+	for _, state := range states.Items {
+		if selected == nil || selected[state.ID] {
+			items = append(items, state)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+
+	maxUsedPercent, limited := d.GetOk("max_used_percent")
+	repositoriesData := make([]map[string]interface{}, 0, len(items))
+	overThreshold := []string{}
+	for _, state := range items {
+		usedPercent := repositoryUsedPercent(state)
+		immutable, days := immutability[state.ID].immutability()
+		repositoriesData = append(repositoriesData, map[string]interface{}{
+			"id":                   state.ID,
+			"name":                 state.Name,
+			"type":                 state.Type,
+			"host_name":            state.HostName,
+			"path":                 state.Path,
+			"capacity_gb":          state.CapacityGB,
+			"free_gb":              state.FreeGB,
+			"used_space_gb":        state.UsedSpaceGB,
+			"used_percent":         usedPercent,
+			"immutability_enabled": immutable,
+			"immutability_days":    days,
+		})
+		if limited && usedPercent > maxUsedPercent.(float64) {
+			overThreshold = append(overThreshold, state.ID)
+		}
+	}
+
+	if err := d.Set("repositories", repositoriesData); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("over_threshold_ids", overThreshold); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("all_within_threshold", len(items) > 0 && len(overThreshold) == 0)
+
+	d.SetId("vbr_repository_space")
+	return nil
+}
+
+// repositoryUsedPercent returns the space of a repository that is not free, in percent of its
+// capacity, or 0 when the capacity is unknown
+func repositoryUsedPercent(state VBRRepositoryStateModel) float64 {
+	if state.CapacityGB <= 0 {
+		return 0
+	}
+	return (state.CapacityGB - state.FreeGB) / state.CapacityGB * 100
+}
@@ -4,7 +4,6 @@ import (
 	vc "terraform-provider-veeambackup/internal/client"
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/url"
 	"strconv"
 
@@ -62,7 +61,7 @@ func DataSourceVbrUnstructuredDataServers() *schema.Resource {
 			"limit": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Maximum number of items to return.",
+				Description: "Maximum number of items to return. When not set, all items are returned.",
 			},
 			"order_column": {
 				Type:        schema.TypeString,
@@ -192,12 +191,6 @@ func DataSourceVbrUnstructuredDataServers() *schema.Resource {
 	queryParams := url.Values{}
 	apiUrl := "/api/v1/inventory/unstructuredDataServers"
 
-	if v, ok := d.GetOk("skip"); ok {
-		queryParams.Add("skip", strconv.Itoa(v.(int)))
-	}
-	if v, ok := d.GetOk("limit"); ok {
-		queryParams.Add("limit", strconv.Itoa(v.(int)))
-	}
 	if v, ok := d.GetOk("order_column"); ok {
 		queryParams.Add("orderColumn", v.(string))
 	}
@@ -214,20 +207,27 @@ func DataSourceVbrUnstructuredDataServers() *schema.Resource {
 		}
 	}
 
-	// Make the API request
-	fullUrl := client.BuildAPIURL(fmt.Sprintf("%s?%s", apiUrl, queryParams.Encode()))
-	body, err := client.DoRequest(ctx, "GET", fullUrl, nil)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	var unstructuredDataServersResponse UnstructuredDataServersResponse
-	err = json.Unmarshal(body, &unstructuredDataServersResponse)
+	// Make the API requests, following pagination unless a limit is set
+	servers, err := vc.FetchPages(ctx, d.Get("skip").(int), d.Get("limit").(int), func(ctx context.Context, skip, limit int) (vc.Page[UnstructuredDataServersResponseData], error) {
+		queryParams.Set("skip", strconv.Itoa(skip))
+		queryParams.Set("limit", strconv.Itoa(limit))
+		fullUrl := client.BuildAPIURL(vc.WithQuery(apiUrl, queryParams))
+		body, err := client.DoRequest(ctx, "GET", fullUrl, nil)
+		if err != nil {
+			return vc.Page[UnstructuredDataServersResponseData]{}, err
+		}
+		var unstructuredDataServersResponse UnstructuredDataServersResponse
+		if err := json.Unmarshal(body, &unstructuredDataServersResponse); err != nil {
+			return vc.Page[UnstructuredDataServersResponseData]{}, err
+		}
+		return vc.Page[UnstructuredDataServersResponseData]{Items: unstructuredDataServersResponse.Data, Total: unstructuredDataServersResponse.Pagination.Total}, nil
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	// Map response data to schema
 	unstructuredDataServersList := make([]map[string]interface{}, 0)
-	for _, uds := range unstructuredDataServersResponse.Data {
+	for _, uds := range servers.Items {
 		udsMap := map[string]interface{}{
 			"id":                          uds.ID,
 			"type":                        "", // Type not in response struct
@@ -0,0 +1,204 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// VMware inventory hierarchies, which decide the parents objects are listed under
+var vmwareInventoryHierarchies = []string{"HostsAndClusters", "VmsAndTemplates", "DatastoresAndVms", "HostsAndDatastores", "VmsAndTags"}
+
+// VMware inventory object types that backup jobs can include
+var vmwareInventoryTypes = []string{
+	"VirtualMachine", "vCenterServer", "Datacenter", "Cluster", "Host", "ResourcePool", "Folder",
+	"Datastore", "DatastoreCluster", "StoragePolicy", "Template", "VirtualApp", "Tag", "Category",
+}
+
+// VBRInventoryBrowserSpec is the request body of the VMware inventory browser
+type VBRInventoryBrowserSpec struct {
+	Pagination    VBRInventoryPagination `json:"pagination"`
+	Filter        *VBRInventoryFilter    `json:"filter,omitempty"`
+	HierarchyType string                 `json:"hierarchyType"`
+}
+
+type VBRInventoryPagination struct {
+	Skip  int `json:"skip"`
+	Limit int `json:"limit"`
+}
+
+// VBRInventoryFilter is a PredicateExpression on a property, or a GroupExpression of Items
+type VBRInventoryFilter struct {
+	Type      string               `json:"type"`
+	Property  string               `json:"property,omitempty"`
+	Operation string               `json:"operation"`
+	Value     string               `json:"value,omitempty"`
+	Items     []VBRInventoryFilter `json:"items,omitempty"`
+}
+
+type VBRInventoryResponse struct {
+	Data       []VBRInventoryObject `json:"data"`
+	Pagination PaginationResponse   `json:"pagination"`
+}
+
+// VBRInventoryObject is a VMware object as jobs include or exclude it
+type VBRInventoryObject struct {
+	Platform string `json:"platform"`
+	HostName string `json:"hostName"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	ObjectID string `json:"objectId"`
+	URN      string `json:"urn"`
+}
+
+func DataSourceVbrVmwareInventory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Browses the inventory of a VMware vSphere server added to Veeam Backup & Replication, e.g. to resolve the tags, categories or folders that define the scope of a VM backup job.",
+		ReadContext: DataSourceVbrVmwareInventoryRead,
+		Schema: map[string]*schema.Schema{
+			"host_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the vCenter Server or ESXi host, as added to the backup infrastructure.",
+			},
+			"hierarchy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HostsAndClusters",
+				ValidateFunc: validation.StringInSlice(vmwareInventoryHierarchies, false),
+				Description:  "Inventory hierarchy to browse (HostsAndClusters, VmsAndTemplates, DatastoresAndVms, HostsAndDatastores, VmsAndTags). Tags and categories are only listed in VmsAndTags, and VM folders in VmsAndTemplates.",
+			},
+			"type_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(vmwareInventoryTypes, false),
+				Description:  "Return only objects of this type, e.g. VirtualMachine, Folder, Tag or Category.",
+			},
+			"name_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only objects whose name contains this string.",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of inventory objects.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"platform": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Platform of the object.",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the server the object belongs to.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the object.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the object.",
+						},
+						"object_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "vSphere managed object reference ID of the object, or the URN of tags and categories.",
+						},
+						"urn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URN of the object, which identifies tags and categories across vCenter Servers.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceVbrVmwareInventoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hostName := d.Get("host_name").(string)
+	apiUrl := client.BuildAPIURL(vc.Endpoint("/api/v1/inventory/vmware/hosts/%s", hostName))
+
+	spec := VBRInventoryBrowserSpec{
+		HierarchyType: d.Get("hierarchy_type").(string),
+		Filter:        vmwareInventoryFilter(d.Get("type_filter").(string), d.Get("name_filter").(string)),
+	}
+
+	// The inventory browser is paged through the request body instead of query parameters
+	objects, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[VBRInventoryObject], error) {
+		pageSpec := spec
+		pageSpec.Pagination = VBRInventoryPagination{Skip: skip, Limit: limit}
+		reqBody, err := json.Marshal(pageSpec)
+		if err != nil {
+			return vc.Page[VBRInventoryObject]{}, err
+		}
+		respBody, err := client.DoRequest(ctx, "POST", apiUrl, reqBody)
+		if err != nil {
+			return vc.Page[VBRInventoryObject]{}, err
+		}
+
+		var inventoryResponse VBRInventoryResponse
+		if err := json.Unmarshal(respBody, &inventoryResponse); err != nil {
+			return vc.Page[VBRInventoryObject]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[VBRInventoryObject]{Items: inventoryResponse.Data, Total: inventoryResponse.Pagination.Total}, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	objectsData := make([]map[string]interface{}, 0, len(objects.Items))
+	for _, object := range objects.Items {
+		objectsData = append(objectsData, map[string]interface{}{
+			"platform":  object.Platform,
+			"host_name": object.HostName,
+			"name":      object.Name,
+			"type":      object.Type,
+			"object_id": object.ObjectID,
+			"urn":       object.URN,
+		})
+	}
+	if err := d.Set("objects", objectsData); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("vbr_vmware_inventory/%s/%s", hostName, spec.HierarchyType))
+
+	return diags
+}
+
+// vmwareInventoryFilter builds the inventory browser filter for the given type and name, or nil
+// when neither is set
+func vmwareInventoryFilter(objectType, name string) *VBRInventoryFilter {
+	var predicates []VBRInventoryFilter
+	if objectType != "" {
+		predicates = append(predicates, VBRInventoryFilter{Type: "PredicateExpression", Property: "Type", Operation: "equals", Value: objectType})
+	}
+	if name != "" {
+		predicates = append(predicates, VBRInventoryFilter{Type: "PredicateExpression", Property: "Name", Operation: "contains", Value: name})
+	}
+	switch len(predicates) {
+	case 0:
+		return nil
+	case 1:
+		return &predicates[0]
+	}
+	return &VBRInventoryFilter{Type: "GroupExpression", Operation: "and", Items: predicates}
+}
@@ -0,0 +1,70 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// EncryptionPasswordSpec is the request body used to create an encryption password
+type EncryptionPasswordSpec struct {
+	Password string  `json:"password"`
+	Hint     string  `json:"hint"`
+	Tag      *string `json:"tag,omitempty"`
+}
+
+// EncryptionPassword is an encryption password as returned by the VBR API. The password itself is never returned.
+type EncryptionPassword struct {
+	ID               string  `json:"id"`
+	Hint             string  `json:"hint"`
+	ModificationTime string  `json:"modificationTime,omitempty"`
+	Tag              *string `json:"tag,omitempty"`
+}
+
+func CreateEncryptionPassword(ctx context.Context, client *vc.VBRClient, spec EncryptionPasswordSpec) (*EncryptionPassword, error) {
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VBR encryption password request: %w", err)
+	}
+	respBody, err := client.DoRequest(ctx, http.MethodPost, client.BuildAPIURL("/api/v1/encryptionPasswords"), body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeEncryptionPassword(respBody)
+}
+
+func GetEncryptionPassword(ctx context.Context, client *vc.VBRClient, id string) (*EncryptionPassword, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, client.BuildAPIURL(vc.Endpoint("/api/v1/encryptionPasswords/%s", id)), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeEncryptionPassword(respBody)
+}
+
+// UpdateEncryptionPassword changes the hint and tag of an encryption password
+func UpdateEncryptionPassword(ctx context.Context, client *vc.VBRClient, password EncryptionPassword) (*EncryptionPassword, error) {
+	body, err := json.Marshal(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VBR encryption password request: %w", err)
+	}
+	respBody, err := client.DoRequest(ctx, http.MethodPut, client.BuildAPIURL(vc.Endpoint("/api/v1/encryptionPasswords/%s", password.ID)), body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeEncryptionPassword(respBody)
+}
+
+func DeleteEncryptionPassword(ctx context.Context, client *vc.VBRClient, id string) error {
+	_, err := client.DoRequest(ctx, http.MethodDelete, client.BuildAPIURL(vc.Endpoint("/api/v1/encryptionPasswords/%s", id)), nil)
+	return err
+}
+
+func decodeEncryptionPassword(body []byte) (*EncryptionPassword, error) {
+	var password EncryptionPassword
+	if err := json.Unmarshal(body, &password); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR encryption password response: %w", err)
+	}
+	return &password, nil
+}
@@ -0,0 +1,116 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// EncryptedJob is a job that encrypts its backups with a given encryption password
+type EncryptedJob struct {
+	ID   string
+	Name string
+	Type string
+}
+
+// ListJobsUsingEncryptionPassword returns the jobs any of whose settings reference the encryption
+// password with the given ID, e.g. the storage encryption of a VM backup job or backup copy job.
+// The job list does not include settings, so every job is read.
+func ListJobsUsingEncryptionPassword(ctx context.Context, client *vc.VBRClient, passwordID string) ([]EncryptedJob, error) {
+	jobs, err := listJobs(ctx, client, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var encrypted []EncryptedJob
+	for _, job := range jobs {
+		respBody, err := client.DoRequest(ctx, http.MethodGet, jobURL(client, job.ID), nil)
+		if err != nil {
+			if vc.IsGone(err) {
+				// Deleted since it was listed
+				continue
+			}
+			return nil, fmt.Errorf("failed to read VBR job %q: %w", job.Name, err)
+		}
+		settings, err := decodeJSONObject(respBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode VBR job response: %w", err)
+		}
+		if replaceEncryptionPasswordID(settings, passwordID, passwordID) > 0 {
+			encrypted = append(encrypted, EncryptedJob{ID: job.ID, Name: job.Name, Type: job.Type})
+		}
+	}
+	return encrypted, nil
+}
+
+// ReplaceJobEncryptionPassword changes every setting of a job that references the encryption
+// password oldPasswordID to newPasswordID and reports whether the job referenced it. The API only
+// updates complete jobs, so the job is read and written back with every other setting unchanged.
+// Backups already written stay encrypted with the old password until the next active full.
+func ReplaceJobEncryptionPassword(ctx context.Context, client *vc.VBRClient, jobID, oldPasswordID, newPasswordID string) (bool, error) {
+	if client == nil {
+		return false, fmt.Errorf("vbr client is required")
+	}
+	jobID = strings.TrimSpace(jobID)
+	if jobID == "" {
+		return false, fmt.Errorf("job ID cannot be empty")
+	}
+
+	client.LockJob(jobID)
+	defer client.UnlockJob(jobID)
+
+	respBody, err := client.DoRequest(ctx, http.MethodGet, jobURL(client, jobID), nil)
+	if err != nil {
+		return false, err
+	}
+	job, err := decodeJSONObject(respBody)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode VBR job response: %w", err)
+	}
+	replaced := replaceEncryptionPasswordID(job, oldPasswordID, newPasswordID)
+	if replaced == 0 {
+		return false, nil
+	}
+
+	tflog.Trace(ctx, "replacing VBR job encryption password", map[string]interface{}{
+		"job_id":   jobID,
+		"settings": replaced,
+	})
+
+	body, err := json.Marshal(job)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal VBR job request: %w", err)
+	}
+	if _, err := client.DoRequest(ctx, http.MethodPut, jobURL(client, jobID), body); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// replaceEncryptionPasswordID sets every encryptionPasswordId of value, a decoded JSON value, that
+// is oldID to newID and returns how many it found
+func replaceEncryptionPasswordID(value interface{}, oldID, newID string) int {
+	count := 0
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if id, ok := child.(string); ok && key == "encryptionPasswordId" && strings.EqualFold(id, oldID) {
+				v[key] = newID
+				count++
+				continue
+			}
+			count += replaceEncryptionPasswordID(child, oldID, newID)
+		}
+	case []interface{}:
+		for _, child := range v {
+			count += replaceEncryptionPasswordID(child, oldID, newID)
+		}
+	}
+	return count
+}
@@ -0,0 +1,95 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// GuestInteractionProxies holds the servers that deploy the guest processing components of a VM or
+// agent backup job inside the processed machines
+type GuestInteractionProxies struct {
+	JobID   string
+	JobName string
+	JobType string
+	// AutomaticSelection lets VBR pick a guest interaction proxy for each machine, instead of one
+	// of ProxyIDs
+	AutomaticSelection bool
+	ProxyIDs           []string
+}
+
+// guestProcessingJob is the part of a job returned by the VBR API that holds its guest
+// interaction proxies
+type guestProcessingJob struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	GuestProcessing *struct {
+		GuestInteractionProxies struct {
+			AutomaticSelection bool     `json:"automaticSelection"`
+			Proxies            []string `json:"proxies"`
+		} `json:"guestInteractionProxies"`
+	} `json:"guestProcessing"`
+}
+
+// GetGuestInteractionProxies returns the guest interaction proxies of the job with the given ID
+func GetGuestInteractionProxies(ctx context.Context, client *vc.VBRClient, jobID string) (*GuestInteractionProxies, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, guestProcessingJobURL(client, jobID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var job guestProcessingJob
+	if err := json.Unmarshal(respBody, &job); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR job response: %w", err)
+	}
+	if job.GuestProcessing == nil {
+		return nil, fmt.Errorf("job %s is of type %s, which has no guest processing settings", jobID, job.Type)
+	}
+	return &GuestInteractionProxies{
+		JobID:              job.ID,
+		JobName:            job.Name,
+		JobType:            job.Type,
+		AutomaticSelection: job.GuestProcessing.GuestInteractionProxies.AutomaticSelection,
+		ProxyIDs:           job.GuestProcessing.GuestInteractionProxies.Proxies,
+	}, nil
+}
+
+// UpdateGuestInteractionProxies changes the guest interaction proxies of a job. The API only
+// updates complete jobs, so the job is read and written back with every other setting unchanged.
+func UpdateGuestInteractionProxies(ctx context.Context, client *vc.VBRClient, settings GuestInteractionProxies) error {
+	jobURL := guestProcessingJobURL(client, settings.JobID)
+	respBody, err := client.DoRequest(ctx, http.MethodGet, jobURL, nil)
+	if err != nil {
+		return err
+	}
+
+	var job map[string]interface{}
+	if err := json.Unmarshal(respBody, &job); err != nil {
+		return fmt.Errorf("failed to decode VBR job response: %w", err)
+	}
+	guestProcessing, _ := job["guestProcessing"].(map[string]interface{})
+	if guestProcessing == nil {
+		return fmt.Errorf("job %s is of type %v, which has no guest processing settings", settings.JobID, job["type"])
+	}
+
+	proxies := childMap(guestProcessing, "guestInteractionProxies")
+	proxies["automaticSelection"] = settings.AutomaticSelection
+	if settings.ProxyIDs == nil {
+		settings.ProxyIDs = []string{}
+	}
+	proxies["proxies"] = settings.ProxyIDs
+
+	body, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR job request: %w", err)
+	}
+	_, err = client.DoRequest(ctx, http.MethodPut, jobURL, body)
+	return err
+}
+
+func guestProcessingJobURL(client *vc.VBRClient, id string) string {
+	return client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s", id))
+}
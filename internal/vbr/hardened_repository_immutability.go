@@ -0,0 +1,90 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// HardenedRepositoryType is the VBR type of a hardened Linux repository
+const HardenedRepositoryType = "LinuxHardened"
+
+// HardenedRepositoryImmutability holds the immutability settings of a hardened Linux repository
+type HardenedRepositoryImmutability struct {
+	RepositoryID string
+	Name         string
+	Type         string
+	Enabled      bool
+	Days         int
+}
+
+// hardenedRepository is the part of a repository returned by the VBR API that holds its immutability settings
+type hardenedRepository struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Repository struct {
+		UseImmutableBackups            bool `json:"useImmutableBackups"`
+		MakeRecentBackupsImmutableDays int  `json:"makeRecentBackupsImmutableDays"`
+	} `json:"repository"`
+}
+
+// GetHardenedRepositoryImmutability returns the immutability settings of the repository with the given ID
+func GetHardenedRepositoryImmutability(ctx context.Context, client *vc.VBRClient, id string) (*HardenedRepositoryImmutability, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, hardenedRepositoryURL(client, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var repository hardenedRepository
+	if err := json.Unmarshal(respBody, &repository); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR repository response: %w", err)
+	}
+	return &HardenedRepositoryImmutability{
+		RepositoryID: repository.ID,
+		Name:         repository.Name,
+		Type:         repository.Type,
+		Enabled:      repository.Repository.UseImmutableBackups,
+		Days:         repository.Repository.MakeRecentBackupsImmutableDays,
+	}, nil
+}
+
+// UpdateHardenedRepositoryImmutability changes the immutability settings of a hardened Linux
+// repository. The API only updates complete repositories, so the repository is read and written
+// back with every other setting unchanged.
+func UpdateHardenedRepositoryImmutability(ctx context.Context, client *vc.VBRClient, settings HardenedRepositoryImmutability) error {
+	repoURL := hardenedRepositoryURL(client, settings.RepositoryID)
+	respBody, err := client.DoRequest(ctx, http.MethodGet, repoURL, nil)
+	if err != nil {
+		return err
+	}
+
+	var repository map[string]interface{}
+	if err := json.Unmarshal(respBody, &repository); err != nil {
+		return fmt.Errorf("failed to decode VBR repository response: %w", err)
+	}
+	if repository["type"] != HardenedRepositoryType {
+		return fmt.Errorf("repository %s is of type %v, not %s", settings.RepositoryID, repository["type"], HardenedRepositoryType)
+	}
+
+	storage, _ := repository["repository"].(map[string]interface{})
+	if storage == nil {
+		storage = map[string]interface{}{}
+		repository["repository"] = storage
+	}
+	storage["useImmutableBackups"] = settings.Enabled
+	storage["makeRecentBackupsImmutableDays"] = settings.Days
+
+	body, err := json.Marshal(repository)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR repository request: %w", err)
+	}
+	_, err = client.DoRequest(ctx, http.MethodPut, repoURL, body)
+	return err
+}
+
+func hardenedRepositoryURL(client *vc.VBRClient, id string) string {
+	return client.BuildAPIURL(vc.Endpoint("/api/v1/backupInfrastructure/repositories/%s", id))
+}
@@ -0,0 +1,61 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+	"terraform-provider-veeambackup/internal/vbr/schedule"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resolveAfterThisJob sets the name of the job a schedule runs after from its job ID, since the
+// API chains jobs by name only
+func resolveAfterThisJob(ctx context.Context, client *vc.VBRClient, s *schedule.Schedule) error {
+	if s == nil || s.AfterThisJob == nil || s.AfterThisJob.JobID == nil {
+		return nil
+	}
+
+	name, err := getJobName(ctx, client, *s.AfterThisJob.JobID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve after_this_job.job_id %s: %w", *s.AfterThisJob.JobID, err)
+	}
+	s.AfterThisJob.JobName = &name
+	return nil
+}
+
+// readAfterThisJobID keeps the job ID of the after this job schedule in state while the job with
+// that ID is still the one the API returns by name. It is cleared when the jobs were chained
+// differently outside of Terraform, so that the next apply chains them again.
+func readAfterThisJobID(ctx context.Context, client *vc.VBRClient, d *schema.ResourceData, s *schedule.Schedule) error {
+	jobID, _ := d.Get("schedule.0.after_this_job.0.job_id").(string)
+	if jobID == "" || s == nil || s.AfterThisJob == nil || s.AfterThisJob.JobName == nil {
+		return nil
+	}
+
+	name, err := getJobName(ctx, client, jobID)
+	if err != nil && !vc.IsGone(err) {
+		return fmt.Errorf("failed to read the job after_this_job.job_id %s: %w", jobID, err)
+	}
+	if err == nil && name == *s.AfterThisJob.JobName {
+		s.AfterThisJob.JobID = &jobID
+	}
+	return nil
+}
+
+func getJobName(ctx context.Context, client *vc.VBRClient, jobID string) (string, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s", jobID)), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var job struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(respBody, &job); err != nil {
+		return "", fmt.Errorf("failed to decode VBR job response: %w", err)
+	}
+	return job.Name, nil
+}
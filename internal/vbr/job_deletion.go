@@ -0,0 +1,138 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// jobDeletionKeys are the attributes of the job resources that only control what destroying a job
+// does, so changing them sends no request
+var jobDeletionKeys = []string{"delete_backups", "prevent_destroy_data"}
+
+// deleteBackupsSchema is the delete_backups attribute of the job resources
+func deleteBackupsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Whether deleting the job also deletes its backups from the repositories. " +
+			"Defaults to false, which keeps the backups as orphaned backups that can still be restored from. " +
+			"Refused while prevent_destroy_data is true.",
+	}
+}
+
+// preventDestroyDataSchema is the prevent_destroy_data attribute of the job resources
+func preventDestroyDataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+		Description: "Refuse to delete the job while delete_backups is true, so that backups are only deleted after this is set to false and applied. " +
+			"Has no effect while delete_backups is false. Defaults to true.",
+	}
+}
+
+// readJobDeletionSettings sets delete_backups and prevent_destroy_data to their defaults when the
+// state has no value for them, i.e. after an import or an upgrade from a provider version without
+// them, since the API does not return them
+func readJobDeletionSettings(d *schema.ResourceData) {
+	if _, ok := d.GetOkExists("delete_backups"); !ok {
+		d.Set("delete_backups", false)
+	}
+	if _, ok := d.GetOkExists("prevent_destroy_data"); !ok {
+		d.Set("prevent_destroy_data", true)
+	}
+}
+
+// onlyJobDeletionSettingsChanged reports whether an update only changes delete_backups or
+// prevent_destroy_data, which are not sent to the API
+func onlyJobDeletionSettingsChanged(d *schema.ResourceData) bool {
+	return !d.HasChangesExcept(jobDeletionKeys...)
+}
+
+// checkJobDeletion returns an error when deleting the jobs with the given names would delete their
+// backups while prevent_destroy_data is true
+func checkJobDeletion(d *schema.ResourceData, names ...string) error {
+	if !d.Get("delete_backups").(bool) || !d.Get("prevent_destroy_data").(bool) {
+		return nil
+	}
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = strconv.Quote(name)
+	}
+	jobs := "job " + strings.Join(quoted, "")
+	if len(names) > 1 {
+		sort.Strings(quoted)
+		jobs = "jobs " + strings.Join(quoted, ", ")
+	}
+	return fmt.Errorf("refusing to delete %s with its backups, since prevent_destroy_data is true. "+
+		"Set prevent_destroy_data = false and apply to confirm that the backups are to be deleted, or set delete_backups = false to keep them", jobs)
+}
+
+// deleteJob deletes a job, and its backups first when delete_backups is true. A job that no longer
+// exists is not an error, and its backups are still deleted.
+func deleteJob(ctx context.Context, client *vc.VBRClient, d *schema.ResourceData, jobID string) error {
+	client.LockJob(jobID)
+	defer client.UnlockJob(jobID)
+
+	if d.Get("delete_backups").(bool) {
+		if err := deleteJobBackups(ctx, client, jobID); err != nil {
+			return err
+		}
+	}
+	if _, err := client.DoRequest(ctx, http.MethodDelete, jobURL(client, jobID), nil); err != nil && !vc.IsGone(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteJobBackups deletes the backups the job with the given ID created from the repositories and
+// waits for each deletion to finish
+func deleteJobBackups(ctx context.Context, client *vc.VBRClient, jobID string) error {
+	queryParams := url.Values{}
+	queryParams.Set("jobIdFilter", jobID)
+	backups, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[VBRBackupModel], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		respBody, err := client.DoRequest(ctx, http.MethodGet, client.BuildAPIURL(vc.WithQuery("/api/v1/backups", queryParams)), nil)
+		if err != nil {
+			return vc.Page[VBRBackupModel]{}, err
+		}
+
+		var response VBRBackupsResponse
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return vc.Page[VBRBackupModel]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[VBRBackupModel]{Items: response.Data, Total: response.Pagination.Total}, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the backups of VBR job %s: %w", jobID, err)
+	}
+
+	for _, backup := range backups.Items {
+		// The filter is applied by the server; a backup of another job is never deleted
+		if !strings.EqualFold(backup.JobID, jobID) {
+			continue
+		}
+		respBody, err := client.DoRequest(ctx, http.MethodDelete, client.BuildAPIURL(vc.Endpoint("/api/v1/backups/%s", backup.ID)), nil)
+		if err != nil {
+			if vc.IsGone(err) {
+				continue
+			}
+			return fmt.Errorf("failed to delete backup %q of VBR job %s: %w", backup.Name, jobID, err)
+		}
+		if _, err := waitForResponseSession(ctx, client, respBody); err != nil {
+			return fmt.Errorf("failed to delete backup %q of VBR job %s: %w", backup.Name, jobID, err)
+		}
+	}
+	return nil
+}
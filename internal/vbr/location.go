@@ -0,0 +1,119 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// LocationObjectTypes are the types of infrastructure objects a location can be assigned to
+var LocationObjectTypes = []string{
+	"ManagedServer",
+	"Repository",
+	"ScaleOutRepository",
+	"Proxy",
+	"WANAccelerator",
+	"CloudCredentials",
+}
+
+// Location is a geographic location VBR warns about when data would be moved out of it, as
+// returned by the VBR API
+type Location struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// LocationAssignment assigns an infrastructure object to a location
+type LocationAssignment struct {
+	ObjectID   string `json:"objectId"`
+	ObjectType string `json:"objectType"`
+	LocationID string `json:"locationId"`
+}
+
+func CreateLocation(ctx context.Context, client *vc.VBRClient, location Location) (*Location, error) {
+	body, err := json.Marshal(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VBR location request: %w", err)
+	}
+	respBody, err := client.DoRequest(ctx, http.MethodPost, client.BuildAPIURL("/api/v1/locations"), body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeLocation(respBody)
+}
+
+func GetLocation(ctx context.Context, client *vc.VBRClient, id string) (*Location, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, locationURL(client, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodeLocation(respBody)
+}
+
+// UpdateLocation renames a location
+func UpdateLocation(ctx context.Context, client *vc.VBRClient, location Location) (*Location, error) {
+	body, err := json.Marshal(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VBR location request: %w", err)
+	}
+	respBody, err := client.DoRequest(ctx, http.MethodPut, locationURL(client, location.ID), body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeLocation(respBody)
+}
+
+// DeleteLocation deletes a location. VBR rejects the deletion while objects are assigned to it.
+func DeleteLocation(ctx context.Context, client *vc.VBRClient, id string) error {
+	_, err := client.DoRequest(ctx, http.MethodDelete, locationURL(client, id), nil)
+	return err
+}
+
+// GetLocationAssignment returns the location the object with the given ID is assigned to. The
+// location ID is empty when the object has no location.
+func GetLocationAssignment(ctx context.Context, client *vc.VBRClient, objectID string) (*LocationAssignment, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, locationAssignmentURL(client, objectID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignment LocationAssignment
+	if err := json.Unmarshal(respBody, &assignment); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR location assignment response: %w", err)
+	}
+	return &assignment, nil
+}
+
+// SetLocationAssignment assigns an object to a location, replacing the location it had
+func SetLocationAssignment(ctx context.Context, client *vc.VBRClient, assignment LocationAssignment) error {
+	body, err := json.Marshal(assignment)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR location assignment request: %w", err)
+	}
+	_, err = client.DoRequest(ctx, http.MethodPut, locationAssignmentURL(client, assignment.ObjectID), body)
+	return err
+}
+
+// DeleteLocationAssignment removes the location of an object
+func DeleteLocationAssignment(ctx context.Context, client *vc.VBRClient, objectID string) error {
+	_, err := client.DoRequest(ctx, http.MethodDelete, locationAssignmentURL(client, objectID), nil)
+	return err
+}
+
+func decodeLocation(body []byte) (*Location, error) {
+	var location Location
+	if err := json.Unmarshal(body, &location); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR location response: %w", err)
+	}
+	return &location, nil
+}
+
+func locationURL(client *vc.VBRClient, id string) string {
+	return client.BuildAPIURL(vc.Endpoint("/api/v1/locations/%s", id))
+}
+
+func locationAssignmentURL(client *vc.VBRClient, objectID string) string {
+	return client.BuildAPIURL(vc.Endpoint("/api/v1/locations/assignments/%s", objectID))
+}
@@ -0,0 +1,131 @@
+package vbr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// jobReference is an attribute of a job resource that holds the IDs of other objects of the
+// backup server
+type jobReference struct {
+	// attribute is the path of the attribute, with * for every element of a list
+	attribute string
+	kind      string
+	// paths are the API paths the object may be at, each with %s for its ID
+	paths []string
+}
+
+func repositoryReference(attribute string) jobReference {
+	return jobReference{
+		attribute: attribute,
+		kind:      "repository",
+		paths:     []string{"/api/v1/backupInfrastructure/repositories/%s", "/api/v1/backupInfrastructure/scaleOutRepositories/%s"},
+	}
+}
+
+func proxyReference(attribute string) jobReference {
+	return jobReference{attribute: attribute, kind: "backup proxy", paths: []string{"/api/v1/backupInfrastructure/proxies/%s"}}
+}
+
+func unstructuredDataServerReference(attribute, kind string) jobReference {
+	return jobReference{attribute: attribute, kind: kind, paths: []string{"/api/v1/inventory/unstructuredDataServers/%s"}}
+}
+
+// encryptionReferences are the encryption password and KMS server of the storage encryption of a
+// job, under the given backup_repository block
+func encryptionReferences(backupRepository string) []jobReference {
+	encryption := backupRepository + ".0.advanced_settings.0.storage_data.0.encryption.0."
+	return []jobReference{
+		{attribute: encryption + "encryption_password_id", kind: "encryption password", paths: []string{"/api/v1/encryptionPasswords/%s"}},
+		{attribute: encryption + "kms_server_id", kind: "KMS server", paths: []string{"/api/v1/kmsServers/%s"}},
+	}
+}
+
+// validateJobReferences returns a CustomizeDiff function that looks up the objects a job references
+// when the provider is configured with validate_references = true, so that IDs that do not exist
+// fail the plan instead of the create or update. Jobs without changes are not checked. Lookups
+// that fail for another reason than a missing object are only logged, as the apply may still
+// succeed.
+func validateJobReferences(references ...jobReference) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		client, err := vc.GetVBRClient(m)
+		if err != nil || !client.ValidatesReferences() {
+			return nil
+		}
+		if d.Id() != "" && len(d.GetChangedKeysPrefix("")) == 0 {
+			return nil
+		}
+
+		var errs []error
+		for _, reference := range references {
+			for attribute, id := range referencedIDs(d, reference.attribute) {
+				paths := make([]string, len(reference.paths))
+				for i, path := range reference.paths {
+					paths[i] = vc.Endpoint(path, id)
+				}
+				exists, err := client.ReferenceExists(ctx, paths...)
+				if err != nil {
+					tflog.Warn(ctx, "could not validate VBR job reference", map[string]interface{}{
+						"attribute": attribute,
+						"id":        id,
+						"error":     err.Error(),
+					})
+					continue
+				}
+				if !exists {
+					errs = append(errs, fmt.Errorf("%s: no %s with ID %s exists on the backup server", attribute, reference.kind, id))
+				}
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// referencedIDs returns the known, non-empty IDs of the attribute, which may be a string or a set
+// of strings, keyed by the path of each value
+func referencedIDs(d *schema.ResourceDiff, attribute string) map[string]string {
+	ids := map[string]string{}
+	for _, path := range expandReferenceAttribute(d, attribute) {
+		if !d.NewValueKnown(path) {
+			continue
+		}
+		switch v := d.Get(path).(type) {
+		case string:
+			if v != "" {
+				ids[path] = v
+			}
+		case *schema.Set:
+			for _, element := range v.List() {
+				if id, _ := element.(string); id != "" {
+					ids[fmt.Sprintf("%s[%q]", path, id)] = id
+				}
+			}
+		}
+	}
+	return ids
+}
+
+// expandReferenceAttribute replaces every * of attribute with the index of each element of the
+// list, e.g. objects.*.file_server_id with objects.0.file_server_id and objects.1.file_server_id.
+// Lists that are not known yet have no elements.
+func expandReferenceAttribute(d *schema.ResourceDiff, attribute string) []string {
+	list, rest, found := strings.Cut(attribute, ".*.")
+	if !found {
+		return []string{attribute}
+	}
+	if !d.NewValueKnown(list) {
+		return nil
+	}
+	var paths []string
+	n, _ := d.Get(list + ".#").(int)
+	for i := 0; i < n; i++ {
+		paths = append(paths, expandReferenceAttribute(d, fmt.Sprintf("%s.%d.%s", list, i, rest))...)
+	}
+	return paths
+}
@@ -0,0 +1,97 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Operations of the repository maintenance action
+const (
+	RepositoryMaintenanceEnable   = "EnableMaintenanceMode"
+	RepositoryMaintenanceDisable  = "DisableMaintenanceMode"
+	RepositoryMaintenanceEvacuate = "EvacuateBackups"
+)
+
+// RepositoryMaintenanceOperations are the operations of the repository maintenance action
+var RepositoryMaintenanceOperations = []string{
+	RepositoryMaintenanceEnable,
+	RepositoryMaintenanceDisable,
+	RepositoryMaintenanceEvacuate,
+}
+
+// scaleOutExtentsSpec selects the performance extents of a scale-out backup repository
+type scaleOutExtentsSpec struct {
+	RepositoryIDs []string `json:"repositoryIds"`
+}
+
+// SetScaleOutExtentsMaintenanceMode puts the given performance extents of a scale-out backup
+// repository into maintenance mode, or takes them out of it, and waits for the session to finish
+func SetScaleOutExtentsMaintenanceMode(ctx context.Context, client *vc.VBRClient, repositoryID string, extentIDs []string, enabled bool) error {
+	operation := "disableMaintenanceMode"
+	if enabled {
+		operation = "enableMaintenanceMode"
+	}
+	return runScaleOutExtentsOperation(ctx, client, repositoryID, extentIDs, operation)
+}
+
+// EvacuateScaleOutExtents moves the backups stored on the given performance extents to the other
+// extents of the scale-out backup repository and waits for the evacuation to finish. VBR only
+// evacuates extents in maintenance mode.
+func EvacuateScaleOutExtents(ctx context.Context, client *vc.VBRClient, repositoryID string, extentIDs []string) error {
+	return runScaleOutExtentsOperation(ctx, client, repositoryID, extentIDs, "evacuate")
+}
+
+func runScaleOutExtentsOperation(ctx context.Context, client *vc.VBRClient, repositoryID string, extentIDs []string, operation string) error {
+	if client == nil {
+		return fmt.Errorf("vbr client is required")
+	}
+
+	repositoryID = strings.TrimSpace(repositoryID)
+	if repositoryID == "" {
+		return fmt.Errorf("scale_out_repository_id cannot be empty")
+	}
+	if len(extentIDs) == 0 {
+		return fmt.Errorf("at least one extent ID is required")
+	}
+
+	tflog.Trace(ctx, "running VBR scale-out repository extent operation", map[string]interface{}{
+		"scale_out_repository_id": repositoryID,
+		"extent_ids":              extentIDs,
+		"operation":               operation,
+	})
+
+	requestBody, err := json.Marshal(scaleOutExtentsSpec{RepositoryIDs: extentIDs})
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR %s request: %w", operation, err)
+	}
+
+	// Operations on the same scale-out repository run one at a time, as VBR rejects evacuating
+	// extents whose maintenance mode is still being changed
+	client.LockJob(repositoryID)
+	defer client.UnlockJob(repositoryID)
+
+	endpoint := scaleOutRepositoryURL(client, repositoryID) + "/" + operation
+	respBody, err := client.DoRequest(ctx, http.MethodPost, endpoint, requestBody)
+	if err != nil {
+		return err
+	}
+
+	var session struct {
+		ID string `json:"id"`
+	}
+	if len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, &session); err != nil {
+			return fmt.Errorf("failed to decode VBR %s response: %w", operation, err)
+		}
+	}
+	if session.ID == "" {
+		return nil
+	}
+	return waitForVbrSession(ctx, client, session.ID)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -76,6 +77,7 @@ func ResourceVbrAzureCloudCredential() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"AzureStorage", "AzureCompute"}, false),
 				Description:  "Type of the Azure Cloud Credential. Valid values are 'AzureStorage' and 'AzureCompute'.",
 			},
@@ -85,9 +87,24 @@ func ResourceVbrAzureCloudCredential() *schema.Resource {
 				Description: "Azure Storage account name. Required when type is 'AzureStorage'.",
 			},
 			"shared_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"shared_key_wo"},
+				Description:   "Azure Storage account shared key. Required when type is 'AzureStorage' unless shared_key_wo is set. The API never returns it, so it is kept as configured.",
+			},
+			"shared_key_wo": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Azure Storage account shared key. Required when type is 'AzureStorage'.",
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only variant of shared_key that is never stored in state. Requires Terraform 1.11 or later.",
+			},
+			"shared_key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"shared_key_wo"},
+				Description:  "Change this value to send an updated shared_key_wo to VBR.",
 			},
 			"connection_name": {
 				Type:        schema.TypeString,
@@ -167,6 +184,7 @@ func ResourceVbrAzureCloudCredential() *schema.Resource {
 												"certificate": {
 													Type:        schema.TypeString,
 													Required:    true,
+													Sensitive:   true,
 													Description: "Certificate content for the existing Azure account.",
 												},
 												"format_type": {
@@ -205,6 +223,7 @@ func ResourceVbrAzureCloudCredential() *schema.Resource {
 						"verification_code": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
 							Description: "Verification code for the new Azure account.",
 						},
 					},
@@ -216,6 +235,12 @@ func ResourceVbrAzureCloudCredential() *schema.Resource {
 				Description: "Unique ID that identifies the cloud credentials record.",
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
@@ -235,15 +260,8 @@ func ResourceVbrAzureCloudCredentialCreate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	// Debug: Log the actual JSON being sent
-	fmt.Printf("[DEBUG] Sending JSON payload: %s\n", string(reqBodyBytes))
-
 	respBodyBytes, err := client.DoRequest(ctx, "POST", apiUrl, reqBodyBytes)
 	if err != nil {
-		// Debug: Log the response body if available
-		if len(respBodyBytes) > 0 {
-			fmt.Printf("[DEBUG] Error response body: %s\n", string(respBodyBytes))
-		}
 		return diag.FromErr(err)
 	}
 	// Parse the response
@@ -269,8 +287,11 @@ func buildVbrAzureCloudCredentialPayload(d *schema.ResourceData) (*VbrCloudCrede
 			account := v.(string)
 			azureCloudCredential.Account = &account
 		}
-		if v, ok := d.GetOk("shared_key"); ok {
-			sharedKey := v.(string)
+		sharedKey, diags := vc.GetSecretString(d, "shared_key")
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to read shared_key: %v", diags)
+		}
+		if sharedKey != "" {
 			azureCloudCredential.SharedKey = &sharedKey
 		}
 	case "AzureCompute":
@@ -393,9 +414,12 @@ func ResourceVbrAzureCloudCredentialRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 	var diags diag.Diagnostics
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/api/v1/cloudCredentials/%s", d.Id()))
+	apiUrl := client.BuildAPIURL(vc.Endpoint("/api/v1/cloudCredentials/%s", d.Id()))
 	respBodyBytes, err := client.DoRequest(ctx, "GET", apiUrl, nil)
 	if err != nil {
+		if vc.RemoveFromStateIfGone(d, err) {
+			return diags
+		}
 		return diag.FromErr(err)
 	}
 	// Parse the response
@@ -426,7 +450,9 @@ func ResourceVbrAzureCloudCredentialRead(ctx context.Context, d *schema.Resource
 		subscriptionMap := make(map[string]interface{})
 		subscriptionMap["tenant_id"] = respData.Subscription.TenantID
 		subscriptionMap["application_id"] = respData.Subscription.ApplicationID
-		if respData.Subscription.Secret != nil {
+		// The API does not return secrets, so keep the configured values to avoid a perpetual diff
+		subscriptionMap["secret"] = d.Get("existing_account.0.subscription.0.secret").(string)
+		if respData.Subscription.Secret != nil && *respData.Subscription.Secret != "" {
 			subscriptionMap["secret"] = *respData.Subscription.Secret
 		}
 		if respData.Subscription.Certificate != nil {
@@ -434,7 +460,8 @@ func ResourceVbrAzureCloudCredentialRead(ctx context.Context, d *schema.Resource
 			certificateMap := make(map[string]interface{})
 			certificateMap["certificate"] = respData.Subscription.Certificate.Certificate
 			certificateMap["format_type"] = respData.Subscription.Certificate.FormatType
-			if respData.Subscription.Certificate.Password != nil {
+			certificateMap["password"] = d.Get("existing_account.0.subscription.0.certificate.0.password").(string)
+			if respData.Subscription.Certificate.Password != nil && *respData.Subscription.Certificate.Password != "" {
 				certificateMap["password"] = *respData.Subscription.Certificate.Password
 			}
 			certificateList = append(certificateList, certificateMap)
@@ -465,8 +492,11 @@ func buildVbrAzureCloudCredentialUpdatePayload(d *schema.ResourceData) (*VbrClou
 			account := v.(string)
 			updatePayload.Account = &account
 		}
-		if v, ok := d.GetOk("shared_key"); ok {
-			sharedKey := v.(string)
+		sharedKey, diags := vc.GetSecretString(d, "shared_key")
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to read shared_key: %v", diags)
+		}
+		if sharedKey != "" {
 			updatePayload.SharedKey = &sharedKey
 		}
 	case "AzureCompute":
@@ -556,7 +586,7 @@ func ResourceVbrAzureCloudCredentialUpdate(ctx context.Context, d *schema.Resour
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/api/v1/cloudCredentials/%s", d.Id()))
+	apiUrl := client.BuildAPIURL(vc.Endpoint("/api/v1/cloudCredentials/%s", d.Id()))
 
 	// Build the update-specific payload
 	azureCloudCredential, err := buildVbrAzureCloudCredentialUpdatePayload(d)
@@ -569,15 +599,8 @@ func ResourceVbrAzureCloudCredentialUpdate(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	// Debug: Log the actual JSON being sent
-	fmt.Printf("[DEBUG] UPDATE - Sending JSON payload: %s\n", string(reqBodyBytes))
-
-	respBodyBytes, err := client.DoRequest(ctx, "PUT", apiUrl, reqBodyBytes)
+	_, err = client.DoRequest(ctx, "PUT", apiUrl, reqBodyBytes)
 	if err != nil {
-		// Debug: Log the response body if available
-		if len(respBodyBytes) > 0 {
-			fmt.Printf("[DEBUG] UPDATE Error response body: %s\n", string(respBodyBytes))
-		}
 		return diag.FromErr(err)
 	}
 
@@ -590,9 +613,9 @@ func ResourceVbrAzureCloudCredentialDelete(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	var diags diag.Diagnostics
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/api/v1/cloudCredentials/%s", d.Id()))
+	apiUrl := client.BuildAPIURL(vc.Endpoint("/api/v1/cloudCredentials/%s", d.Id()))
 	_, err = client.DoRequest(ctx, "DELETE", apiUrl, nil)
-	if err != nil {
+	if err != nil && !vc.IsGone(err) {
 		return diag.FromErr(err)
 	}
 	d.SetId("")
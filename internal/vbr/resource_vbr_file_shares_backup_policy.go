@@ -2,26 +2,28 @@
 
 import (
 	vc "terraform-provider-veeambackup/internal/client"
+	"terraform-provider-veeambackup/internal/vbr/schedule"
 	"context"
 	"encoding/json"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ---------- Request -----------------------------------------------------
 type VbrFileShareBackupJob struct {
-	Name              string                                    `json:"name"`
-	Type              string                                    `json:"type"`
-	Objects           []VbrFileShareBackupJobObjects            `json:"objects"`
-	BackupRepository  VbrFileShareBackupJobBackupRepository     `json:"backupRepository"`
-	Description       *string                                   `json:"description,omitempty"`
-	IsHighPriority    *bool                                     `json:"isHighPriority,omitempty"`
-	IsDisabled        *bool                                     `json:"isDisabled,omitempty"` // Used for update operations
-	ArchiveRepository *VbrBackupJobArchiveRepository            `json:"archiveRepository,omitempty"`
-	Schedule          *VbrBackupJobSchedule                     `json:"schedule,omitempty"`
-	ID                *string                                   `json:"id,omitempty"` // Used for update operations
+	Name              string                                `json:"name"`
+	Type              string                                `json:"type"`
+	Objects           []VbrFileShareBackupJobObjects        `json:"objects"`
+	BackupRepository  VbrFileShareBackupJobBackupRepository `json:"backupRepository"`
+	Description       *string                               `json:"description,omitempty"`
+	IsHighPriority    *bool                                 `json:"isHighPriority,omitempty"`
+	IsDisabled        *bool                                 `json:"isDisabled,omitempty"` // Used for update operations
+	ArchiveRepository *VbrBackupJobArchiveRepository        `json:"archiveRepository,omitempty"`
+	Schedule          *schedule.Schedule                    `json:"schedule,omitempty"`
+	ID                *string                               `json:"id,omitempty"` // Used for update operations
 }
 
 type VbrFileShareBackupJobObjects struct {
@@ -32,19 +34,19 @@ type VbrFileShareBackupJobObjects struct {
 }
 
 type VbrFileShareBackupJobBackupRepository struct {
-	BackupRepositoryID string                                     `json:"backupRepositoryId"`
-	SourceBackupId     *string                                    `json:"sourceBackupId,omitempty"`
-	RetentionPolicy    *VbrBackupJobRetentionPolicy               `json:"retentionPolicy,omitempty"`
-	AdvancedSettings   *VbrFileShareBackupJobAdvancedSettings     `json:"advancedSettings,omitempty"`
+	BackupRepositoryID string                                 `json:"backupRepositoryId"`
+	SourceBackupId     *string                                `json:"sourceBackupId,omitempty"`
+	RetentionPolicy    *schedule.RetentionPolicy              `json:"retentionPolicy,omitempty"`
+	AdvancedSettings   *VbrFileShareBackupJobAdvancedSettings `json:"advancedSettings,omitempty"`
 }
 
 type VbrFileShareBackupJobAdvancedSettings struct {
-	FileVersions  *VbrFileShareBackupJobAdvancedSettingsFileVersions      `json:"fileVersions,omitempty"`
-	AclHandling   *VbrFileShareBackupJobAdvancedSettingsAclHandling       `json:"aclHandling,omitempty"`
-	StorageData   *VBRObjectStorageBackupJobAdvancedSettingsStorageData   `json:"storageData,omitempty"`
-	BackupHealth  *VBRObjectStorageBackupJobAdvancedSettingsBackupHealth  `json:"backupHealth,omitempty"`
-	Scripts       *VBRObjectStorageBackupJobAdvancedSettingsScripts       `json:"scripts,omitempty"`
-	Notifications *VBRObjectStorageBackupJobAdvancedSettingsNotifications `json:"notifications,omitempty"`
+	FileVersions  *VbrFileShareBackupJobAdvancedSettingsFileVersions     `json:"fileVersions,omitempty"`
+	AclHandling   *VbrFileShareBackupJobAdvancedSettingsAclHandling      `json:"aclHandling,omitempty"`
+	StorageData   *VBRObjectStorageBackupJobAdvancedSettingsStorageData  `json:"storageData,omitempty"`
+	BackupHealth  *VBRObjectStorageBackupJobAdvancedSettingsBackupHealth `json:"backupHealth,omitempty"`
+	Scripts       *VBRObjectStorageBackupJobAdvancedSettingsScripts      `json:"scripts,omitempty"`
+	Notifications *schedule.Notifications                                `json:"notifications,omitempty"`
 }
 
 type VbrFileShareBackupJobAdvancedSettingsFileVersions struct {
@@ -68,7 +70,7 @@ type VbrFileShareBackupJobResponse struct {
 	Objects           []VbrFileShareBackupJobObjects        `json:"objects"`
 	BackupRepository  VbrFileShareBackupJobBackupRepository `json:"backupRepository"`
 	ArchiveRepository *VbrBackupJobArchiveRepository        `json:"archiveRepository,omitempty"`
-	Schedule          *VbrBackupJobSchedule                 `json:"schedule,omitempty"`
+	Schedule          *schedule.Schedule                    `json:"schedule,omitempty"`
 }
 
 // ---------- Schema -----------------------------------------------------
@@ -79,6 +81,11 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 		ReadContext:   resourceVBRFileShareBackupJobRead,
 		UpdateContext: resourceVBRFileShareBackupJobUpdate,
 		DeleteContext: resourceVBRFileShareBackupJobDelete,
+		CustomizeDiff: validateJobReferences(append([]jobReference{
+			unstructuredDataServerReference("objects.*.file_server_id", "file server"),
+			repositoryReference("backup_repository.0.backup_repository_id"),
+			repositoryReference("archive_repository.0.archive_repository_id"),
+		}, encryptionReferences("backup_repository")...)...),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -107,9 +114,10 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"file_server_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The ID of the file server.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "The ID of the file server.",
 						},
 						"path": {
 							Type:        schema.TypeString,
@@ -117,7 +125,7 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 							Description: "The path within the file share.",
 						},
 						"inclusion_mask": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "The list of inclusion masks.",
 							Elem: &schema.Schema{
@@ -125,7 +133,7 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 							},
 						},
 						"exclusion_mask": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "The list of exclusion masks.",
 							Elem: &schema.Schema{
@@ -143,35 +151,17 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"backup_repository_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The ID of the backup repository.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "The ID of the backup repository.",
 						},
 						"source_backup_id": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "The source backup ID for the backup repository.",
-						},
-						"retention_policy": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The retention policy for the backup repository.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The type of the retention policy.",
-									},
-									"quantity": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: "The quantity for the retention policy.",
-									},
-								},
-							},
+							Description: "The ID of an existing backup to map the job to, e.g. from the veeambackup_vbr_backups data source. The job continues that backup chain instead of starting a new one.",
 						},
+						"retention_policy": schedule.RetentionPolicySchema("The retention policy for the backup repository."),
 						"advanced_settings": {
 							Type:        schema.TypeList,
 							Optional:    true,
@@ -220,15 +210,17 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 										},
 									},
 									"storage_data": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "The storage data settings.",
+										Type:             schema.TypeList,
+										Optional:         true,
+										MaxItems:         1,
+										Description:      "The storage data settings.",
+										DiffSuppressFunc: vc.SuppressMissingOptionalBlock,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"compression_level": {
 													Type:        schema.TypeString,
 													Optional:    true,
+													Computed:    true,
 													Description: "The compression level.",
 												},
 												"encryption": {
@@ -251,7 +243,8 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 															"encryption_password": {
 																Type:        schema.TypeString,
 																Optional:    true,
-																Description: "The encryption password.",
+																Sensitive:   true,
+																Description: "The encryption password. Prefer encryption_password_id with a veeambackup_vbr_encryption_password resource.",
 															},
 															"encryption_password_id": {
 																Type:        schema.TypeString,
@@ -259,9 +252,10 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 																Description: "The ID of the encryption password.",
 															},
 															"kms_server_id": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: "The ID of the KMS server.",
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.IsUUID,
+																Description:  "The ID of the KMS server.",
 															},
 														},
 													},
@@ -294,7 +288,7 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 																Description: "Specifies if weekly backup health is enabled.",
 															},
 															"days": {
-																Type:        schema.TypeList,
+																Type:        schema.TypeSet,
 																Optional:    true,
 																Description: "The days for weekly backup health.",
 																Elem: &schema.Schema{
@@ -302,9 +296,10 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 																},
 															},
 															"local_time": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: "The local time for weekly backup health.",
+																Type:             schema.TypeString,
+																Optional:         true,
+																Description:      "The local time for weekly backup health.",
+																DiffSuppressFunc: vc.SuppressEquivalentTime,
 															},
 														},
 													},
@@ -337,7 +332,7 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 																Description: "The day of month for monthly backup health.",
 															},
 															"months": {
-																Type:        schema.TypeList,
+																Type:        schema.TypeSet,
 																Optional:    true,
 																Description: "The months for monthly backup health.",
 																Elem: &schema.Schema{
@@ -345,9 +340,10 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 																},
 															},
 															"local_time": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: "The local time for monthly backup health.",
+																Type:             schema.TypeString,
+																Optional:         true,
+																Description:      "The local time for monthly backup health.",
+																DiffSuppressFunc: vc.SuppressEquivalentTime,
 															},
 															"is_last_day_of_month": {
 																Type:        schema.TypeBool,
@@ -418,7 +414,7 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 													Description: "The frequency to run the script.",
 												},
 												"day_of_week": {
-													Type:        schema.TypeList,
+													Type:        schema.TypeSet,
 													Optional:    true,
 													Description: "The days of the week to run the script.",
 													Elem: &schema.Schema{
@@ -428,464 +424,27 @@ func ResourceVbrFileShareBackupJob() *schema.Resource {
 											},
 										},
 									},
-									"notifications": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "The notifications settings.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"send_snmp_notifications": {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Description: "Specifies if SNMP notifications are sent.",
-												},
-												"email_notifications": {
-													Type:        schema.TypeList,
-													Optional:    true,
-													MaxItems:    1,
-													Description: "The email notifications settings.",
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"is_enabled": {
-																Type:        schema.TypeBool,
-																Required:    true,
-																Description: "Specifies if email notifications are enabled.",
-															},
-															"recipients": {
-																Type:        schema.TypeList,
-																Optional:    true,
-																Description: "The list of email recipients.",
-																Elem: &schema.Schema{
-																	Type: schema.TypeString,
-																},
-															},
-															"notification_type": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: "The type of email notification.",
-															},
-															"custom_notification_settings": {
-																Type:        schema.TypeList,
-																Optional:    true,
-																MaxItems:    1,
-																Description: "The custom notification settings.",
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"subject": {
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																			Description: "The subject of the email notification.",
-																		},
-																		"notify_on_success": {
-																			Type:        schema.TypeBool,
-																			Optional:    true,
-																			Description: "Specifies if notification is sent on success.",
-																		},
-																		"notify_on_warning": {
-																			Type:        schema.TypeBool,
-																			Optional:    true,
-																			Description: "Specifies if notification is sent on warning.",
-																		},
-																		"notify_on_error": {
-																			Type:        schema.TypeBool,
-																			Optional:    true,
-																			Description: "Specifies if notification is sent on error.",
-																		},
-																		"suppress_notification_until_last_retry": {
-																			Type:        schema.TypeBool,
-																			Optional:    true,
-																			Description: "Specifies if notification is suppressed until the last retry.",
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-												"trigger_issue_job_warning": {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Description: "Specifies if job warning issues trigger notifications.",
-												},
-												"trigger_attribute_issue_job_warning": {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Description: "Specifies if attribute issue job warnings trigger notifications.",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			"archive_repository": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				MaxItems:    1,
-				Description: "The archive repository settings for the backup job.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"archive_repository_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The ID of the archive repository.",
-						},
-						"archive_recent_file_versions": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Specifies if recent file versions are archived.",
-						},
-						"archive_previous_file_versions": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Specifies if previous file versions are archived.",
-						},
-						"archive_retention_policy": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The retention policy for the archive repository.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The type of the retention policy.",
-									},
-									"quantity": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: "The quantity for the retention policy.",
-									},
-								},
-							},
-						},
-						"file_archive_settings": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The file archive settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"archival_type": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The archival type.",
-									},
-									"inclusion_mask": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										Description: "The list of inclusion masks for file archiving.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-									"exclusion_mask": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										Description: "The list of exclusion masks for file archiving.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			"schedule": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				MaxItems:    1,
-				Description: "The schedule settings for the backup job.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"run_automatically": {
-							Type:        schema.TypeBool,
-							Required:    true,
-							Description: "Specifies if the job runs automatically.",
-						},
-						"daily": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The daily schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if daily schedule is enabled.",
-									},
-									"local_time": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The local time for daily schedule.",
-									},
-									"daily_kind": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The kind of daily schedule.",
-									},
-									"days": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										Description: "The days for daily schedule.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-								},
-							},
-						},
-						"monthly": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The monthly schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if monthly schedule is enabled.",
-									},
-									"day_of_week": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The day of the week for monthly schedule.",
-									},
-									"day_number_in_month": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The day number in month for monthly schedule.",
-									},
-									"day_of_month": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "The day of month for monthly schedule.",
-									},
-									"months": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										Description: "The months for monthly schedule.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-									"local_time": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The local time for monthly schedule.",
-									},
-									"is_last_day_of_month": {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Description: "Specifies if it is the last day of the month for monthly schedule.",
-									},
-								},
-							},
-						},
-						"periodically": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The periodically schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if periodically schedule is enabled.",
-									},
-									"periodically_kind": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The kind of periodically schedule.",
-									},
-									"frequency": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "The frequency for periodically schedule.",
-									},
-									"backup_window": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "The backup window for periodically schedule.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"days": {
-													Type:        schema.TypeList,
-													Required:    true,
-													Description: "The backup window days.",
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"day": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The day of the week.",
-															},
-															"hours": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The hours for the day.",
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-									"start_time_within_hour": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "The start time within hour for periodically schedule.",
-									},
-								},
-							},
-						},
-						"continuously": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The continuously schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if continuously schedule is enabled.",
-									},
-									"backup_window": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "The backup window for continuously schedule.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"days": {
-													Type:        schema.TypeList,
-													Required:    true,
-													Description: "The backup window days.",
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"day": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The day of the week.",
-															},
-															"hours": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The hours for the day.",
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						"after_this_job": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The after this job schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if after this job schedule is enabled.",
-									},
-									"job_name": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The name of the job to run after.",
-									},
-								},
-							},
-						},
-						"retry": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The retry schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if retry is enabled.",
-									},
-									"retry_count": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "The number of retries.",
-									},
-									"await_minutes": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "The number of minutes to await between retries.",
-									},
-								},
-							},
-						},
-						"backup_window": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The backup window schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if backup window is enabled.",
-									},
-									"backup_window": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "The backup window.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"days": {
-													Type:        schema.TypeList,
-													Required:    true,
-													Description: "The backup window days.",
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"day": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The day of the week.",
-															},
-															"hours": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The hours for the day.",
-															},
-														},
-													},
-												},
-											},
-										},
-									},
+									"notifications": schedule.NotificationsSchema(),
 								},
 							},
 						},
 					},
 				},
 			},
+			"archive_repository":       archiveRepositorySchema(),
+			"schedule":                 schedule.Schema(),
+			"additional_settings_json": additionalSettingsJSONSchema(),
+			"delete_backups":           deleteBackupsSchema(),
+			"prevent_destroy_data":     preventDestroyDataSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			schedule.WarnDisabledSchedule,
 		},
 	}
 }
@@ -905,7 +464,7 @@ func resourceVBRFileShareBackupJobCreate(ctx context.Context, d *schema.Resource
 	job := VbrFileShareBackupJob{
 		Name:             d.Get("name").(string),
 		Type:             "FileBackup",
-		Description:      getStringPtr(d.Get("description")),
+		Description:      getStringPtr(client.AppendDescriptionSuffix(d.Get("description").(string))),
 		IsHighPriority:   getBoolPtr(d.Get("is_high_priority")),
 		Objects:          expandVBRFileShareBackupJobObjects(d.Get("objects").([]interface{})),
 		BackupRepository: expandVBRFileShareBackupJobBackupRepository(d.Get("backup_repository").([]interface{})),
@@ -916,11 +475,14 @@ func resourceVBRFileShareBackupJobCreate(ctx context.Context, d *schema.Resource
 	}
 
 	if v, ok := d.GetOk("schedule"); ok {
-		job.Schedule = expandVBRBackupJobSchedule(v.([]interface{}))
+		job.Schedule = schedule.Expand(v.([]interface{}))
+	}
+	if err := resolveAfterThisJob(ctx, client, job.Schedule); err != nil {
+		return diag.FromErr(err)
 	}
 
 	url := client.BuildAPIURL("/api/v1/jobs")
-	reqBodyBytes, err := json.Marshal(job)
+	reqBodyBytes, err := marshalJob(d, job)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -948,11 +510,10 @@ func resourceVBRFileShareBackupJobRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 	jobID := d.Id()
-	url := client.BuildAPIURL("/api/v1/jobs/" + jobID)
+	url := client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s", jobID))
 	respBodyBytes, err := client.DoRequest(ctx, "GET", url, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
-			d.SetId("")
+		if vc.RemoveFromStateIfGone(d, err) {
 			return diags
 		}
 		return diag.FromErr(err)
@@ -965,30 +526,54 @@ func resourceVBRFileShareBackupJobRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	d.Set("name", resp.Name)
-	d.Set("description", resp.Description)
+	var description string
+	if resp.Description != nil {
+		description = *resp.Description
+	}
+	d.Set("description", client.TrimDescriptionSuffix(description))
 	d.Set("is_high_priority", resp.IsHighPriority)
 	d.Set("is_disabled", resp.IsDisabled)
-	// Note: objects, backup_repository, archive_repository, and schedule
-	// would need flatten functions to properly set nested data
-	// For now, we'll rely on the user's configuration
+	// The schedule is only read back when configured, since the server returns a default schedule otherwise
+	if _, ok := d.GetOk("schedule"); ok {
+		if err := readAfterThisJobID(ctx, client, d, resp.Schedule); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("schedule", schedule.Flatten(resp.Schedule)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := readArchiveRepository(d, resp.ArchiveRepository); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := readAdditionalSettings(d, respBodyBytes); err != nil {
+		return diag.FromErr(err)
+	}
+	readJobDeletionSettings(d)
+	// Note: objects and backup_repository would need flatten functions
+	// to properly set nested data. For now, we'll rely on the user's configuration
 
 	return diags
 }
 
 // CRUD function (Update)
 func resourceVBRFileShareBackupJobUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if onlyJobDeletionSettingsChanged(d) {
+		return nil
+	}
 	client, err := vc.GetVBRClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	jobID := d.Id()
+	client.LockJob(jobID)
+	defer client.UnlockJob(jobID)
 
 	// Build the job payload
 	job := VbrFileShareBackupJob{
 		ID:               &jobID,
 		Name:             d.Get("name").(string),
-		Type:             "FileShareBackup",
-		Description:      getStringPtr(d.Get("description")),
+		Type:             "FileBackup",
+		Description:      getStringPtr(client.AppendDescriptionSuffix(d.Get("description").(string))),
 		IsDisabled:       getBoolPtr(d.Get("is_disabled")),
 		IsHighPriority:   getBoolPtr(d.Get("is_high_priority")),
 		Objects:          expandVBRFileShareBackupJobObjects(d.Get("objects").([]interface{})),
@@ -1000,11 +585,14 @@ func resourceVBRFileShareBackupJobUpdate(ctx context.Context, d *schema.Resource
 	}
 
 	if v, ok := d.GetOk("schedule"); ok {
-		job.Schedule = expandVBRBackupJobSchedule(v.([]interface{}))
+		job.Schedule = schedule.Expand(v.([]interface{}))
+	}
+	if err := resolveAfterThisJob(ctx, client, job.Schedule); err != nil {
+		return diag.FromErr(err)
 	}
 
-	url := client.BuildAPIURL("/api/v1/jobs/" + jobID)
-	reqBodyBytes, err := json.Marshal(job)
+	url := client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s", jobID))
+	reqBodyBytes, err := marshalJob(d, job)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1020,17 +608,15 @@ func resourceVBRFileShareBackupJobUpdate(ctx context.Context, d *schema.Resource
 // CRUD function (Delete)
 func resourceVBRFileShareBackupJobDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	if err := checkJobDeletion(d, d.Get("name").(string)); err != nil {
+		return diag.FromErr(err)
+	}
 	client, err := vc.GetVBRClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	jobID := d.Id()
-	url := client.BuildAPIURL("/api/v1/jobs/" + jobID)
-	_, err = client.DoRequest(ctx, "DELETE", url, nil)
-	if err != nil {
-		if !strings.Contains(err.Error(), "404") {
-			return diag.FromErr(err)
-		}
+	if err := deleteJob(ctx, client, d, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return diags
@@ -1054,17 +640,17 @@ func expandVBRFileShareBackupJobObjects(input []interface{}) []VbrFileShareBacku
 			pathStr := path.(string)
 			obj.Path = &pathStr
 		}
-		if inclusionMask, ok := m["inclusion_mask"]; ok && len(inclusionMask.([]interface{})) > 0 {
-			masks := make([]string, len(inclusionMask.([]interface{})))
-			for j, mask := range inclusionMask.([]interface{}) {
-				masks[j] = mask.(string)
+		if inclusionMask, ok := m["inclusion_mask"]; ok && inclusionMask.(*schema.Set).Len() > 0 {
+			masks := make([]string, 0, inclusionMask.(*schema.Set).Len())
+			for _, mask := range inclusionMask.(*schema.Set).List() {
+				masks = append(masks, mask.(string))
 			}
 			obj.InclusionMask = &masks
 		}
-		if exclusionMask, ok := m["exclusion_mask"]; ok && len(exclusionMask.([]interface{})) > 0 {
-			masks := make([]string, len(exclusionMask.([]interface{})))
-			for j, mask := range exclusionMask.([]interface{}) {
-				masks[j] = mask.(string)
+		if exclusionMask, ok := m["exclusion_mask"]; ok && exclusionMask.(*schema.Set).Len() > 0 {
+			masks := make([]string, 0, exclusionMask.(*schema.Set).Len())
+			for _, mask := range exclusionMask.(*schema.Set).List() {
+				masks = append(masks, mask.(string))
 			}
 			obj.ExclusionMask = &masks
 		}
@@ -1086,7 +672,7 @@ func expandVBRFileShareBackupJobBackupRepository(input []interface{}) VbrFileSha
 		repo.SourceBackupId = &sourceBackupID
 	}
 	if v, ok := m["retention_policy"]; ok && len(v.([]interface{})) > 0 {
-		repo.RetentionPolicy = expandVBRBackupJobRetentionPolicy(v.([]interface{}))
+		repo.RetentionPolicy = schedule.ExpandRetentionPolicy(v.([]interface{}))
 	}
 	if v, ok := m["advanced_settings"]; ok && len(v.([]interface{})) > 0 {
 		repo.AdvancedSettings = expandVBRFileShareBackupJobAdvancedSettings(v.([]interface{}))
@@ -1117,7 +703,7 @@ func expandVBRFileShareBackupJobAdvancedSettings(input []interface{}) *VbrFileSh
 		settings.Scripts = expandVBRObjectStorageBackupJobScripts(v.([]interface{}))
 	}
 	if v, ok := m["notifications"]; ok && len(v.([]interface{})) > 0 {
-		settings.Notifications = expandVBRObjectStorageBackupJobNotifications(v.([]interface{}))
+		settings.Notifications = schedule.ExpandNotifications(v.([]interface{}))
 	}
 	return settings
 }
@@ -0,0 +1,581 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	vc "terraform-provider-veeambackup/internal/client"
+	"terraform-provider-veeambackup/internal/vbr/schedule"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Job types supported by the job set
+const (
+	jobSetFileBackup          = "FileBackup"
+	jobSetObjectStorageBackup = "ObjectStorageBackup"
+)
+
+// jobSetTemplateKeys are the attributes shared by every job of a job set
+var jobSetTemplateKeys = []string{"description", "is_high_priority", "backup_repository", "archive_repository", "schedule"}
+
+// ResourceVbrJobSet manages many file share or object storage backup jobs that share their
+// repository, schedule and advanced settings and only differ in name and objects
+func ResourceVbrJobSet() *schema.Resource {
+	fileShareJob := ResourceVbrFileShareBackupJob().Schema
+	objectStorageJob := ResourceVbrObjectStorageBackupJob().Schema
+
+	// The backup repository serves both job types: object storage jobs keep object versions where
+	// file share jobs keep file versions and ACLs
+	backupRepository := fileShareJob["backup_repository"]
+	advancedSettings := backupRepository.Elem.(*schema.Resource).Schema["advanced_settings"].Elem.(*schema.Resource).Schema
+	advancedSettings["object_versions"] = objectStorageJob["backup_repository"].Elem.(*schema.Resource).Schema["advanced_settings"].Elem.(*schema.Resource).Schema["object_versions"]
+	advancedSettings["file_versions"].Description = "The file versions settings. File share jobs only."
+	advancedSettings["acl_handling"].Description = "The ACL handling settings. File share jobs only."
+	advancedSettings["object_versions"].Description = "The object versions settings. Object storage jobs only."
+
+	fileShareObjects := fileShareJob["objects"]
+	fileShareObjects.Required = false
+	fileShareObjects.Optional = true
+	fileShareObjects.Description = "The file share objects of the job. Required for FileBackup job sets."
+	objectStorageObjects := objectStorageJob["objects"]
+	objectStorageObjects.Required = false
+	objectStorageObjects.Optional = true
+	objectStorageObjects.Description = "The object storage objects of the job. Required for ObjectStorageBackup job sets."
+
+	return &schema.Resource{
+		Description:   "Manages many Veeam Backup and Replication file share or object storage backup jobs that share a template and only differ in name and objects.",
+		CreateContext: resourceVBRJobSetCreate,
+		ReadContext:   resourceVBRJobSetRead,
+		UpdateContext: resourceVBRJobSetUpdate,
+		DeleteContext: resourceVBRJobSetDelete,
+		CustomizeDiff: customdiff.Sequence(
+			resourceVBRJobSetCustomizeDiff,
+			validateJobReferences(append([]jobReference{
+				unstructuredDataServerReference("job.*.file_share_objects.*.file_server_id", "file server"),
+				unstructuredDataServerReference("job.*.object_storage_objects.*.object_storage_server_id", "object storage server"),
+				repositoryReference("backup_repository.0.backup_repository_id"),
+				repositoryReference("archive_repository.0.archive_repository_id"),
+			}, encryptionReferences("backup_repository")...)...),
+		),
+		Schema: map[string]*schema.Schema{
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{jobSetFileBackup, jobSetObjectStorageBackup}, false),
+				Description:  "The type of the jobs: FileBackup or ObjectStorageBackup.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the jobs, unless a job sets its own.",
+			},
+			"is_high_priority": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies if the jobs are high priority.",
+			},
+			"backup_repository":  backupRepository,
+			"archive_repository": fileShareJob["archive_repository"],
+			"schedule":           schedule.Schema(),
+			"job": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The jobs of the set. Each job is created with the template settings of the set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "The name of the job, unique within the set.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The description of the job. Defaults to the description of the set.",
+						},
+						"file_share_objects":     fileShareObjects,
+						"object_storage_objects": objectStorageObjects,
+					},
+				},
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  "The number of jobs created, updated or deleted at the same time. Defaults to the max_concurrent_requests of the provider.",
+			},
+			"job_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the jobs of the set, keyed by job name.",
+			},
+			"delete_backups":       deleteBackupsSchema(),
+			"prevent_destroy_data": preventDestroyDataSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			schedule.WarnDisabledSchedule,
+		},
+	}
+}
+
+// resourceVBRJobSetCustomizeDiff checks the jobs against the job type, and plans the creation of
+// jobs that were deleted outside of Terraform
+func resourceVBRJobSetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("job") || !d.NewValueKnown("job_type") {
+		return nil
+	}
+	jobType := d.Get("job_type").(string)
+	objectsKey, otherKey := "file_share_objects", "object_storage_objects"
+	if jobType == jobSetObjectStorageBackup {
+		objectsKey, otherKey = otherKey, objectsKey
+	}
+
+	names := map[string]bool{}
+	for i, raw := range d.Get("job").([]interface{}) {
+		job, _ := raw.(map[string]interface{})
+		name, _ := job["name"].(string)
+		if names[name] {
+			return fmt.Errorf("job.%d: job name %q is used more than once", i, name)
+		}
+		names[name] = true
+		if objects, _ := job[objectsKey].([]interface{}); len(objects) == 0 {
+			return fmt.Errorf("job.%d (%s): %s is required for %s jobs", i, name, objectsKey, jobType)
+		}
+		if objects, _ := job[otherKey].([]interface{}); len(objects) > 0 {
+			return fmt.Errorf("job.%d (%s): %s cannot be set for %s jobs", i, name, otherKey, jobType)
+		}
+	}
+
+	if d.NewValueKnown("backup_repository") {
+		for _, key := range []string{"file_versions", "acl_handling", "object_versions"} {
+			objectStorageOnly := key == "object_versions"
+			if v, _ := d.Get("backup_repository.0.advanced_settings.0." + key).([]interface{}); len(v) > 0 && objectStorageOnly != (jobType == jobSetObjectStorageBackup) {
+				return fmt.Errorf("backup_repository.0.advanced_settings.0.%s cannot be set for %s jobs", key, jobType)
+			}
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	jobIDs := d.Get("job_ids").(map[string]interface{})
+	for name := range names {
+		if _, ok := jobIDs[name]; !ok {
+			return d.SetNewComputed("job_ids")
+		}
+	}
+	if len(jobIDs) != len(names) {
+		return d.SetNewComputed("job_ids")
+	}
+	return nil
+}
+
+// jobSetOperation is the creation, update or deletion of one job of a job set
+type jobSetOperation struct {
+	name   string
+	id     string
+	method string
+	job    map[string]interface{}
+	err    error
+}
+
+func resourceVBRJobSetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ops []*jobSetOperation
+	for _, raw := range d.Get("job").([]interface{}) {
+		job := raw.(map[string]interface{})
+		ops = append(ops, &jobSetOperation{name: job["name"].(string), method: http.MethodPost, job: job})
+	}
+
+	diags := runJobSetOperations(ctx, client, d, ops)
+	applyJobSetOperations(d, map[string]interface{}{}, nil, ops)
+	if len(d.Get("job_ids").(map[string]interface{})) == 0 {
+		// Nothing was created, so the job set is not stored
+		return diags
+	}
+
+	// Errors would taint the job set, whose replacement deletes the jobs that were created. The
+	// failed jobs are left out of state instead, so that the next apply creates them.
+	d.SetId(id.UniqueId())
+	for i := range diags {
+		diags[i].Severity = diag.Warning
+	}
+	return append(diags, resourceVBRJobSetRead(ctx, d, m)...)
+}
+
+// resourceVBRJobSetRead removes jobs that no longer exist from job_ids, so that the next apply
+// creates them again. The settings of the jobs are not read back.
+func resourceVBRJobSetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	readJobDeletionSettings(d)
+
+	jobIDs := d.Get("job_ids").(map[string]interface{})
+	var ops []*jobSetOperation
+	for name, jobID := range jobIDs {
+		ops = append(ops, &jobSetOperation{name: name, id: jobID.(string), method: http.MethodGet})
+	}
+	runParallel(ctx, client, d, ops, func(op *jobSetOperation) {
+		_, op.err = client.DoRequest(ctx, http.MethodGet, jobURL(client, op.id), nil)
+	})
+
+	var diags diag.Diagnostics
+	for _, op := range ops {
+		switch {
+		case op.err == nil:
+		case vc.IsGone(op.err):
+			delete(jobIDs, op.name)
+		default:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Failed to read VBR job %q", op.name),
+				Detail:   op.err.Error(),
+			})
+		}
+	}
+	if len(ops) > 0 && len(jobIDs) == 0 {
+		// Every job was deleted outside of Terraform, so the whole set is recreated
+		d.SetId("")
+		return diags
+	}
+	if err := d.Set("job_ids", jobIDs); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+func resourceVBRJobSetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldJobs, newJobs := d.GetChange("job")
+	previous := map[string]map[string]interface{}{}
+	for _, raw := range oldJobs.([]interface{}) {
+		job := raw.(map[string]interface{})
+		previous[job["name"].(string)] = job
+	}
+	// job_ids is planned as computed when jobs are added, so the jobs that exist are the old value
+	oldIDs, _ := d.GetChange("job_ids")
+	jobIDs := map[string]interface{}{}
+	for name, jobID := range oldIDs.(map[string]interface{}) {
+		jobIDs[name] = jobID
+	}
+	templateChanged := d.HasChanges(jobSetTemplateKeys...)
+
+	var ops []*jobSetOperation
+	configured := map[string]bool{}
+	for _, raw := range newJobs.([]interface{}) {
+		job := raw.(map[string]interface{})
+		name := job["name"].(string)
+		configured[name] = true
+		jobID, exists := jobIDs[name].(string)
+		switch {
+		case !exists:
+			ops = append(ops, &jobSetOperation{name: name, method: http.MethodPost, job: job})
+		case templateChanged || !sameJobSetJob(previous[name], job):
+			ops = append(ops, &jobSetOperation{name: name, id: jobID, method: http.MethodPut, job: job})
+		}
+	}
+	for name, jobID := range jobIDs {
+		if !configured[name] {
+			ops = append(ops, &jobSetOperation{name: name, id: jobID.(string), method: http.MethodDelete, job: previous[name]})
+		}
+	}
+
+	diags := runJobSetOperations(ctx, client, d, ops)
+	applyJobSetOperations(d, jobIDs, oldJobs.([]interface{}), ops)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, resourceVBRJobSetRead(ctx, d, m)...)
+}
+
+func resourceVBRJobSetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ops []*jobSetOperation
+	for name, jobID := range d.Get("job_ids").(map[string]interface{}) {
+		ops = append(ops, &jobSetOperation{name: name, id: jobID.(string), method: http.MethodDelete})
+	}
+	diags := runJobSetOperations(ctx, client, d, ops)
+	if diags.HasError() {
+		applyJobSetOperations(d, d.Get("job_ids").(map[string]interface{}), d.Get("job").([]interface{}), ops)
+		return diags
+	}
+	d.SetId("")
+	return diags
+}
+
+// runJobSetOperations runs the operations in parallel and returns one error per failed operation
+func runJobSetOperations(ctx context.Context, client *vc.VBRClient, d *schema.ResourceData, ops []*jobSetOperation) diag.Diagnostics {
+	// None of the operations runs when they cannot be prepared, so every one of them failed
+	failAll := func(err error) diag.Diagnostics {
+		for _, op := range ops {
+			op.err = err
+		}
+		return diag.FromErr(err)
+	}
+
+	jobType := d.Get("job_type").(string)
+	template := jobSetTemplate(d)
+	if err := resolveAfterThisJob(ctx, client, template.Schedule); err != nil {
+		return failAll(err)
+	}
+	var deleted []string
+	for _, op := range ops {
+		if op.method == http.MethodDelete {
+			deleted = append(deleted, op.name)
+		}
+	}
+	if len(deleted) > 0 {
+		if err := checkJobDeletion(d, deleted...); err != nil {
+			return failAll(err)
+		}
+	}
+
+	runParallel(ctx, client, d, ops, func(op *jobSetOperation) {
+		if op.method == http.MethodDelete {
+			op.err = deleteJob(ctx, client, d, op.id)
+			return
+		}
+
+		body, err := json.Marshal(template.job(client, jobType, op))
+		if err != nil {
+			op.err = fmt.Errorf("failed to marshal VBR job request: %w", err)
+			return
+		}
+		if op.method == http.MethodPut {
+			client.LockJob(op.id)
+			defer client.UnlockJob(op.id)
+			_, op.err = client.DoRequest(ctx, http.MethodPut, jobURL(client, op.id), body)
+			return
+		}
+
+		respBody, err := client.DoRequest(ctx, http.MethodPost, client.BuildAPIURL("/api/v1/jobs"), body)
+		if err != nil {
+			op.err = err
+			return
+		}
+		var created struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(respBody, &created); err != nil {
+			op.err = fmt.Errorf("failed to decode VBR job response: %w", err)
+			return
+		}
+		op.id = created.ID
+	})
+
+	var diags diag.Diagnostics
+	verbs := map[string]string{http.MethodPost: "create", http.MethodPut: "update", http.MethodDelete: "delete"}
+	for _, op := range ops {
+		if op.err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Failed to %s VBR job %q", verbs[op.method], op.name),
+				Detail:   op.err.Error(),
+			})
+		}
+	}
+	if len(diags) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%d of %d VBR job operations failed", len(diags), len(ops)),
+			Detail:   "The jobs that succeeded are kept in state; the failed ones are retried by the next apply.",
+		})
+	}
+	return diags
+}
+
+// applyJobSetOperations records the outcome of the operations in state, so that failed ones stay
+// planned: jobs that failed to be created are left out of state, and jobs that failed to be
+// updated or deleted keep their previous settings. A failed update of every job resets the
+// template to its previous settings, so that the template change is planned again.
+func applyJobSetOperations(d *schema.ResourceData, jobIDs map[string]interface{}, oldJobs []interface{}, ops []*jobSetOperation) {
+	previous := map[string]interface{}{}
+	for _, raw := range oldJobs {
+		previous[raw.(map[string]interface{})["name"].(string)] = raw
+	}
+	failed := map[string]*jobSetOperation{}
+	updateFailed := false
+	for _, op := range ops {
+		switch {
+		case op.err != nil:
+			failed[op.name] = op
+			updateFailed = updateFailed || op.method == http.MethodPut
+		case op.method == http.MethodDelete:
+			delete(jobIDs, op.name)
+		case op.method == http.MethodPost:
+			jobIDs[op.name] = op.id
+		}
+	}
+
+	var jobs []interface{}
+	for _, raw := range d.Get("job").([]interface{}) {
+		name := raw.(map[string]interface{})["name"].(string)
+		op, ok := failed[name]
+		switch {
+		case !ok:
+			jobs = append(jobs, raw)
+		case op.method == http.MethodPut && previous[name] != nil:
+			jobs = append(jobs, previous[name])
+		}
+	}
+	for _, op := range failed {
+		if op.method == http.MethodDelete && previous[op.name] != nil {
+			jobs = append(jobs, previous[op.name])
+		}
+	}
+
+	d.Set("job", jobs)
+	d.Set("job_ids", jobIDs)
+	if updateFailed {
+		for _, key := range jobSetTemplateKeys {
+			old, _ := d.GetChange(key)
+			d.Set(key, old)
+		}
+	}
+}
+
+// sameJobSetJob reports whether a job of the set has the same name, description and objects
+func sameJobSetJob(a, b map[string]interface{}) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	ja, erra := json.Marshal(expandJobSetJobFields(a))
+	jb, errb := json.Marshal(expandJobSetJobFields(b))
+	return erra == nil && errb == nil && string(ja) == string(jb)
+}
+
+// expandJobSetJobFields returns the settings of a job that are not part of the template
+func expandJobSetJobFields(job map[string]interface{}) interface{} {
+	return struct {
+		Name                 string
+		Description          string
+		FileShareObjects     []VbrFileShareBackupJobObjects
+		ObjectStorageObjects []VbrObjectStorageBackupJobObjects
+	}{
+		Name:                 job["name"].(string),
+		Description:          job["description"].(string),
+		FileShareObjects:     expandVBRFileShareBackupJobObjects(job["file_share_objects"].([]interface{})),
+		ObjectStorageObjects: expandVBRObjectStorageBackupJobObjects(job["object_storage_objects"].([]interface{})),
+	}
+}
+
+// vbrJobSetTemplate holds the settings shared by the jobs of a job set
+type vbrJobSetTemplate struct {
+	Description       string
+	IsHighPriority    *bool
+	BackupRepository  []interface{}
+	ArchiveRepository *VbrBackupJobArchiveRepository
+	Schedule          *schedule.Schedule
+}
+
+func jobSetTemplate(d *schema.ResourceData) vbrJobSetTemplate {
+	t := vbrJobSetTemplate{
+		Description:      d.Get("description").(string),
+		IsHighPriority:   getBoolPtr(d.Get("is_high_priority")),
+		BackupRepository: d.Get("backup_repository").([]interface{}),
+	}
+	if v, ok := d.GetOk("archive_repository"); ok {
+		t.ArchiveRepository = expandVBRBackupJobArchiveRepository(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("schedule"); ok {
+		t.Schedule = schedule.Expand(v.([]interface{}))
+	}
+	return t
+}
+
+// job returns the request body that creates or updates the job of op
+func (t vbrJobSetTemplate) job(client *vc.VBRClient, jobType string, op *jobSetOperation) interface{} {
+	description := t.Description
+	if v, _ := op.job["description"].(string); v != "" {
+		description = v
+	}
+	var jobID *string
+	if op.method == http.MethodPut {
+		jobID = &op.id
+	}
+
+	if jobType == jobSetObjectStorageBackup {
+		return VbrObjectStorageBackupJob{
+			ID:                jobID,
+			Name:              op.name,
+			Type:              jobSetObjectStorageBackup,
+			Description:       getStringPtr(client.AppendDescriptionSuffix(description)),
+			IsHighPriority:    t.IsHighPriority,
+			Objects:           expandVBRObjectStorageBackupJobObjects(op.job["object_storage_objects"].([]interface{})),
+			BackupRepository:  expandVBRObjectStorageBackupJobBackupRepository(t.BackupRepository),
+			ArchiveRepository: t.ArchiveRepository,
+			Schedule:          t.Schedule,
+		}
+	}
+	return VbrFileShareBackupJob{
+		ID:                jobID,
+		Name:              op.name,
+		Type:              jobSetFileBackup,
+		Description:       getStringPtr(client.AppendDescriptionSuffix(description)),
+		IsHighPriority:    t.IsHighPriority,
+		Objects:           expandVBRFileShareBackupJobObjects(op.job["file_share_objects"].([]interface{})),
+		BackupRepository:  expandVBRFileShareBackupJobBackupRepository(t.BackupRepository),
+		ArchiveRepository: t.ArchiveRepository,
+		Schedule:          t.Schedule,
+	}
+}
+
+// runParallel calls fn for every operation, at most parallelism at a time or the provider's
+// max_concurrent_requests when parallelism is not set. Operations are started in a stable order so
+// that failures are reported consistently.
+func runParallel(ctx context.Context, client *vc.VBRClient, d *schema.ResourceData, ops []*jobSetOperation, fn func(op *jobSetOperation)) {
+	sort.Slice(ops, func(i, j int) bool { return ops[i].name < ops[j].name })
+
+	run := func(_ context.Context, i int) error {
+		fn(ops[i])
+		return nil
+	}
+	var errs []error
+	if parallelism, ok := d.GetOk("parallelism"); ok {
+		errs = vc.RunConcurrently(ctx, parallelism.(int), len(ops), run)
+	} else {
+		errs = client.RunConcurrently(ctx, len(ops), run)
+	}
+	for i, err := range errs {
+		if err != nil {
+			ops[i].err = err
+		}
+	}
+}
+
+func jobURL(client *vc.VBRClient, jobID string) string {
+	return client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s", jobID))
+}
@@ -2,12 +2,14 @@
 
 import (
 	vc "terraform-provider-veeambackup/internal/client"
+	"terraform-provider-veeambackup/internal/vbr/schedule"
 	"context"
 	"encoding/json"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type VbrObjectStorageBackupJob struct {
@@ -17,9 +19,9 @@ type VbrObjectStorageBackupJob struct {
 	BackupRepository  VbrObjectStorageBackupJobBackupRepository `json:"backupRepository"`
 	Description       *string                                   `json:"description,omitempty"`
 	IsHighPriority    *bool                                     `json:"isHighPriority,omitempty"`
-	IsDisabled		  *bool                                     `json:"isDisabled,omitempty"`  // Used for update operations
+	IsDisabled        *bool                                     `json:"isDisabled,omitempty"` // Used for update operations
 	ArchiveRepository *VbrBackupJobArchiveRepository            `json:"archiveRepository,omitempty"`
-	Schedule          *VbrBackupJobSchedule                     `json:"schedule,omitempty"`
+	Schedule          *schedule.Schedule                        `json:"schedule,omitempty"`
 	ID                *string                                   `json:"id,omitempty"` // Used for update operations
 }
 
@@ -47,7 +49,7 @@ type VbrObjectStorageBackupJobExclusionTagMask struct {
 type VbrObjectStorageBackupJobBackupRepository struct {
 	BackupRepositoryID string                                     `json:"backupRepositoryId"`
 	SourceBackupId     *string                                    `json:"sourceBackupId,omitempty"`
-	RetentionPolicy    *VbrBackupJobRetentionPolicy               `json:"retentionPolicy,omitempty"`
+	RetentionPolicy    *schedule.RetentionPolicy                  `json:"retentionPolicy,omitempty"`
 	AdvancedSettings   *VbrObjectStorageBackupJobAdvancedSettings `json:"advancedSettings,omitempty"`
 }
 
@@ -56,12 +58,12 @@ type VbrObjectStorageBackupJobAdvancedSettings struct {
 	StorageData    *VBRObjectStorageBackupJobAdvancedSettingsStorageData    `json:"storageData,omitempty"`
 	BackupHealth   *VBRObjectStorageBackupJobAdvancedSettingsBackupHealth   `json:"backupHealth,omitempty"`
 	Scripts        *VBRObjectStorageBackupJobAdvancedSettingsScripts        `json:"scripts,omitempty"`
-	Notifications  *VBRObjectStorageBackupJobAdvancedSettingsNotifications  `json:"notifications,omitempty"`
+	Notifications  *schedule.Notifications                                  `json:"notifications,omitempty"`
 }
 
 type VBRObjectStorageBackupJobAdvancedSettingsObjectVersions struct {
 	VersionRetentionType   *string `json:"versionRetentionType,omitempty"`
-	ActionVersionRention   *int    `json:"actionVersionRention,omitempty"`
+	ActionVersionRetention *int    `json:"actionVersionRetention,omitempty"`
 	DeleteVersionRetention *int    `json:"deleteVersionRetention,omitempty"`
 }
 
@@ -116,28 +118,6 @@ type VBRObjectStorageBackupJobAdvancedSettingsScriptsPostCommand struct {
 	Command   *string `json:"command,omitempty"`
 }
 
-type VBRObjectStorageBackupJobAdvancedSettingsNotifications struct {
-	SendSNMPNotifications           *bool                                                                     `json:"sendSNMPNotifications,omitempty"`
-	EmailNotifications              *VBRObjectStorageBackupJobAdvancedSettingsNotificationsEmailNotifications `json:"emailNotifications,omitempty"`
-	TriggerIssueJobWarning          *bool                                                                     `json:"triggerIssueJobWarning,omitempty"`
-	TriggerAttributeIssueJobWarning *bool                                                                     `json:"triggerAttributeIssueJobWarning,omitempty"`
-}
-
-type VBRObjectStorageBackupJobAdvancedSettingsNotificationsEmailNotifications struct {
-	IsEnabled                  bool                                                                                                `json:"isEnabled"`
-	Recipients                 *[]string                                                                                           `json:"recipients,omitempty"`
-	NotificationType           *string                                                                                             `json:"notificationType,omitempty"`
-	CustomNotificationSettings *VBRObjectStorageBackupJobAdvancedSettingsNotificationsEmailNotificationsCustomNotificationSettings `json:"customNotificationSettings,omitempty"`
-}
-
-type VBRObjectStorageBackupJobAdvancedSettingsNotificationsEmailNotificationsCustomNotificationSettings struct {
-	Subject                            *string `json:"subject,omitempty"`
-	NotifyOnSuccess                    *bool   `json:"notifyOnSuccess,omitempty"`
-	NotifyOnWarning                    *bool   `json:"notifyOnWarning,omitempty"`
-	NotifyOnError                      *bool   `json:"notifyOnError,omitempty"`
-	SuppressNotificationUntilLastRetry *bool   `json:"suppressNotificationUntilLastRetry,omitempty"`
-}
-
 // response struct
 type VbrObjectStorageBackupJobResponse struct {
 	ID                string                                    `json:"id"`
@@ -149,18 +129,24 @@ type VbrObjectStorageBackupJobResponse struct {
 	Objects           []VbrObjectStorageBackupJobObjects        `json:"objects"`
 	BackupRepository  VbrObjectStorageBackupJobBackupRepository `json:"backupRepository"`
 	ArchiveRepository *VbrBackupJobArchiveRepository            `json:"archiveRepository,omitempty"`
-	Schedule          *VbrBackupJobSchedule                     `json:"schedule,omitempty"`
+	Schedule          *schedule.Schedule                        `json:"schedule,omitempty"`
 }
 
 // Schema
 
 func ResourceVbrObjectStorageBackupJob() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Description:   "Schema for VBR Object Storage Backup Job.",
 		CreateContext: resourceVBRObjectStorageBackupJobCreate,
 		ReadContext:   resourceVBRObjectStorageBackupJobRead,
 		UpdateContext: resourceVBRObjectStorageBackupJobUpdate,
 		DeleteContext: resourceVBRObjectStorageBackupJobDelete,
+		CustomizeDiff: validateJobReferences(append([]jobReference{
+			unstructuredDataServerReference("objects.*.object_storage_server_id", "object storage server"),
+			repositoryReference("backup_repository.0.backup_repository_id"),
+			repositoryReference("archive_repository.0.archive_repository_id"),
+		}, encryptionReferences("backup_repository")...)...),
+		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -189,9 +175,10 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"object_storage_server_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The ID of the object storage server.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "The ID of the object storage server.",
 						},
 						"container": {
 							Type:        schema.TypeString,
@@ -204,7 +191,7 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 							Description: "The path within the container.",
 						},
 						"inclusion_tag_mask": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "The list of inclusion tag masks.",
 							Elem: &schema.Resource{
@@ -228,7 +215,7 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 							},
 						},
 						"exclusion_tag_mask": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "The list of exclusion tag masks.",
 							Elem: &schema.Resource{
@@ -252,7 +239,7 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 							},
 						},
 						"exclusion_path_mask": {
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Description: "The list of exclusion path masks.",
 							Elem: &schema.Schema{
@@ -270,35 +257,17 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"backup_repository_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The ID of the backup repository.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "The ID of the backup repository.",
 						},
 						"source_backup_id": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "The source backup ID for the backup repository.",
-						},
-						"retention_policy": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The retention policy for the backup repository.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The type of the retention policy.",
-									},
-									"quantity": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: "The quantity for the retention policy.",
-									},
-								},
-							},
+							Description: "The ID of an existing backup to map the job to, e.g. from the veeambackup_vbr_backups data source. The job continues that backup chain instead of starting a new one.",
 						},
+						"retention_policy": schedule.RetentionPolicySchema("The retention policy for the backup repository."),
 						"advanced_settings": {
 							Type:        schema.TypeList,
 							Optional:    true,
@@ -318,10 +287,18 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 													Optional:    true,
 													Description: "The version retention type.",
 												},
+												"action_version_retention": {
+													Type:          schema.TypeInt,
+													Optional:      true,
+													ConflictsWith: []string{"backup_repository.0.advanced_settings.0.object_versions.0.action_version_rention"},
+													Description:   "The action version retention.",
+												},
 												"action_version_rention": {
-													Type:        schema.TypeInt,
-													Optional:    true,
-													Description: "The action version retention.",
+													Type:          schema.TypeInt,
+													Optional:      true,
+													Deprecated:    "Use action_version_retention instead.",
+													ConflictsWith: []string{"backup_repository.0.advanced_settings.0.object_versions.0.action_version_retention"},
+													Description:   "Deprecated misspelling of action_version_retention, accepted for configurations written for earlier versions.",
 												},
 												"delete_version_retention": {
 													Type:        schema.TypeInt,
@@ -332,15 +309,17 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 										},
 									},
 									"storage_data": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "The storage data settings.",
+										Type:             schema.TypeList,
+										Optional:         true,
+										MaxItems:         1,
+										Description:      "The storage data settings.",
+										DiffSuppressFunc: vc.SuppressMissingOptionalBlock,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"compression_level": {
 													Type:        schema.TypeString,
 													Optional:    true,
+													Computed:    true,
 													Description: "The compression level.",
 												},
 												"encryption": {
@@ -363,7 +342,8 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 															"encryption_password": {
 																Type:        schema.TypeString,
 																Optional:    true,
-																Description: "The encryption password.",
+																Sensitive:   true,
+																Description: "The encryption password. Prefer encryption_password_id with a veeambackup_vbr_encryption_password resource.",
 															},
 															"encryption_password_id": {
 																Type:        schema.TypeString,
@@ -371,9 +351,10 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 																Description: "The ID of the encryption password.",
 															},
 															"kms_server_id": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: "The ID of the KMS server.",
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.IsUUID,
+																Description:  "The ID of the KMS server.",
 															},
 														},
 													},
@@ -406,7 +387,7 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 																Description: "Specifies if weekly backup health is enabled.",
 															},
 															"days": {
-																Type:        schema.TypeList,
+																Type:        schema.TypeSet,
 																Optional:    true,
 																Description: "The days for weekly backup health.",
 																Elem: &schema.Schema{
@@ -414,9 +395,10 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 																},
 															},
 															"local_time": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: "The local time for weekly backup health.",
+																Type:             schema.TypeString,
+																Optional:         true,
+																Description:      "The local time for weekly backup health.",
+																DiffSuppressFunc: vc.SuppressEquivalentTime,
 															},
 														},
 													},
@@ -449,7 +431,7 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 																Description: "The day of month for monthly backup health.",
 															},
 															"months": {
-																Type:        schema.TypeList,
+																Type:        schema.TypeSet,
 																Optional:    true,
 																Description: "The months for monthly backup health.",
 																Elem: &schema.Schema{
@@ -457,9 +439,10 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 																},
 															},
 															"local_time": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: "The local time for monthly backup health.",
+																Type:             schema.TypeString,
+																Optional:         true,
+																Description:      "The local time for monthly backup health.",
+																DiffSuppressFunc: vc.SuppressEquivalentTime,
 															},
 															"is_last_day_of_month": {
 																Type:        schema.TypeBool,
@@ -493,513 +476,82 @@ func ResourceVbrObjectStorageBackupJob() *schema.Resource {
 															},
 															"command": {
 																Type:        schema.TypeString,
-																Optional:    true,
-																Description: "The pre-command to execute.",
-															},
-														},
-													},
-												},
-												"post_command": {
-													Type:        schema.TypeList,
-													Optional:    true,
-													MaxItems:    1,
-													Description: "The post-command settings.",
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"is_enabled": {
-																Type:        schema.TypeBool,
-																Required:    true,
-																Description: "Specifies if post-command is enabled.",
-															},
-															"command": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: "The post-command to execute.",
-															},
-														},
-													},
-												},
-												"periodicity_type": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: "The periodicity type for scripts.",
-												},
-												"run_script_every": {
-													Type:        schema.TypeInt,
-													Optional:    true,
-													Description: "The frequency to run the script.",
-												},
-												"day_of_week": {
-													Type:        schema.TypeList,
-													Optional:    true,
-													Description: "The days of the week to run the script.",
-													Elem: &schema.Schema{
-														Type: schema.TypeString,
-													},
-												},
-											},
-										},
-									},
-									"notifications": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "The notifications settings.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"send_snmp_notifications": {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Description: "Specifies if SNMP notifications are sent.",
-												},
-												"email_notifications": {
-													Type:        schema.TypeList,
-													Optional:    true,
-													MaxItems:    1,
-													Description: "The email notifications settings.",
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"is_enabled": {
-																Type:        schema.TypeBool,
-																Required:    true,
-																Description: "Specifies if email notifications are enabled.",
-															},
-															"recipients": {
-																Type:        schema.TypeList,
-																Optional:    true,
-																Description: "The list of email recipients.",
-																Elem: &schema.Schema{
-																	Type: schema.TypeString,
-																},
-															},
-															"notification_type": {
-																Type:        schema.TypeString,
-																Optional:    true,
-																Description: "The type of email notification.",
-															},
-															"custom_notification_settings": {
-																Type:        schema.TypeList,
-																Optional:    true,
-																MaxItems:    1,
-																Description: "The custom notification settings.",
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		"subject": {
-																			Type:        schema.TypeString,
-																			Optional:    true,
-																			Description: "The subject of the email notification.",
-																		},
-																		"notify_on_success": {
-																			Type:        schema.TypeBool,
-																			Optional:    true,
-																			Description: "Specifies if notification is sent on success.",
-																		},
-																		"notify_on_warning": {
-																			Type:        schema.TypeBool,
-																			Optional:    true,
-																			Description: "Specifies if notification is sent on warning.",
-																		},
-																		"notify_on_error": {
-																			Type:        schema.TypeBool,
-																			Optional:    true,
-																			Description: "Specifies if notification is sent on error.",
-																		},
-																		"suppress_notification_until_last_retry": {
-																			Type:        schema.TypeBool,
-																			Optional:    true,
-																			Description: "Specifies if notification is suppressed until the last retry.",
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-												"trigger_issue_job_warning": {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Description: "Specifies if job warning issues trigger notifications.",
-												},
-												"trigger_attribute_issue_job_warning": {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Description: "Specifies if attribute issue job warnings trigger notifications.",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			"archive_repository": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				MaxItems:    1,
-				Description: "The archive repository settings for the backup job.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"archive_repository_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The ID of the archive repository.",
-						},
-						"archive_recent_file_versions": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Specifies if recent file versions are archived.",
-						},
-						"archive_previous_file_versions": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Specifies if previous file versions are archived.",
-						},
-						"archive_retention_policy": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The retention policy for the archive repository.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "The type of the retention policy.",
-									},
-									"quantity": {
-										Type:        schema.TypeInt,
-										Required:    true,
-										Description: "The quantity for the retention policy.",
-									},
-								},
-							},
-						},
-						"file_archive_settings": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The file archive settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"archival_type": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The archival type.",
-									},
-									"inclusion_mask": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										Description: "The list of inclusion masks for file archiving.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-									"exclusion_mask": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										Description: "The list of exclusion masks for file archiving.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			"schedule": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				MaxItems:    1,
-				Description: "The schedule settings for the backup job.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"run_automatically": {
-							Type:        schema.TypeBool,
-							Required:    true,
-							Description: "Specifies if the job runs automatically.",
-						},
-						"daily": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The daily schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if daily schedule is enabled.",
-									},
-									"local_time": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The local time for daily schedule.",
-									},
-									"daily_kind": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The kind of daily schedule.",
-									},
-									"days": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										Description: "The days for daily schedule.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-								},
-							},
-						},
-						"monthly": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The monthly schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if monthly schedule is enabled.",
-									},
-									"day_of_week": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The day of the week for monthly schedule.",
-									},
-									"day_number_in_month": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The day number in month for monthly schedule.",
-									},
-									"day_of_month": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "The day of month for monthly schedule.",
-									},
-									"months": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										Description: "The months for monthly schedule.",
-										Elem: &schema.Schema{
-											Type: schema.TypeString,
-										},
-									},
-									"local_time": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The local time for monthly schedule.",
-									},
-									"is_last_day_of_month": {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Description: "Specifies if it is the last day of the month for monthly schedule.",
-									},
-								},
-							},
-						},
-						"periodically": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The periodically schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if periodically schedule is enabled.",
-									},
-									"periodically_kind": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The kind of periodically schedule.",
-									},
-									"frequency": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "The frequency for periodically schedule.",
-									},
-									"backup_window": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "The backup window for periodically schedule.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"days": {
-													Type:        schema.TypeList,
-													Required:    true,
-													Description: "The backup window days.",
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"day": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The day of the week.",
-															},
-															"hours": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The hours for the day.",
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-									"start_time_within_hour": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "The start time within hour for periodically schedule.",
-									},
-								},
-							},
-						},
-						"continuously": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The continuously schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if continuously schedule is enabled.",
-									},
-									"backup_window": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "The backup window for continuously schedule.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"days": {
-													Type:        schema.TypeList,
-													Required:    true,
-													Description: "The backup window days.",
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"day": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The day of the week.",
-															},
-															"hours": {
-																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The hours for the day.",
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						"after_this_job": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The after this job schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if after this job schedule is enabled.",
-									},
-									"job_name": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The name of the job to run after.",
-									},
-								},
-							},
-						},
-						"retry": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The retry schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if retry is enabled.",
-									},
-									"retry_count": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "The number of retries.",
-									},
-									"await_minutes": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "The number of minutes to await between retries.",
-									},
-								},
-							},
-						},
-						"backup_window": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "The backup window schedule settings.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"is_enabled": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "Specifies if backup window is enabled.",
-									},
-									"backup_window": {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "The backup window.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"days": {
+																Optional:    true,
+																Description: "The pre-command to execute.",
+															},
+														},
+													},
+												},
+												"post_command": {
 													Type:        schema.TypeList,
-													Required:    true,
-													Description: "The backup window days.",
+													Optional:    true,
+													MaxItems:    1,
+													Description: "The post-command settings.",
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
-															"day": {
-																Type:        schema.TypeString,
+															"is_enabled": {
+																Type:        schema.TypeBool,
 																Required:    true,
-																Description: "The day of the week.",
+																Description: "Specifies if post-command is enabled.",
 															},
-															"hours": {
+															"command": {
 																Type:        schema.TypeString,
-																Required:    true,
-																Description: "The hours for the day.",
+																Optional:    true,
+																Description: "The post-command to execute.",
 															},
 														},
 													},
 												},
+												"periodicity_type": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The periodicity type for scripts.",
+												},
+												"run_script_every": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: "The frequency to run the script.",
+												},
+												"day_of_week": {
+													Type:        schema.TypeSet,
+													Optional:    true,
+													Description: "The days of the week to run the script.",
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
 											},
 										},
 									},
+									"notifications": schedule.NotificationsSchema(),
 								},
 							},
 						},
 					},
 				},
 			},
+			"archive_repository":       archiveRepositorySchema(),
+			"schedule":                 schedule.Schema(),
+			"additional_settings_json": additionalSettingsJSONSchema(),
+			"delete_backups":           deleteBackupsSchema(),
+			"prevent_destroy_data":     preventDestroyDataSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			schedule.WarnDisabledSchedule,
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		// action_version_retention was misspelled action_version_rention in version 0. Configurations
+		// that still use the deprecated alias plan a one-time update after the upgrade, which the API ignores.
+		vc.RenameAttributeUpgrader(r, 0, "backup_repository.advanced_settings.object_versions", "action_version_rention", "action_version_retention"),
+	}
+	return r
 }
 
 // CRUD function (Create)
@@ -1013,7 +565,7 @@ func resourceVBRObjectStorageBackupJobCreate(ctx context.Context, d *schema.Reso
 	job := VbrObjectStorageBackupJob{
 		Name:             d.Get("name").(string),
 		Type:             "ObjectStorageBackup",
-		Description:      getStringPtr(d.Get("description")),
+		Description:      getStringPtr(client.AppendDescriptionSuffix(d.Get("description").(string))),
 		IsHighPriority:   getBoolPtr(d.Get("is_high_priority")),
 		Objects:          expandVBRObjectStorageBackupJobObjects(d.Get("objects").([]interface{})),
 		BackupRepository: expandVBRObjectStorageBackupJobBackupRepository(d.Get("backup_repository").([]interface{})),
@@ -1024,11 +576,14 @@ func resourceVBRObjectStorageBackupJobCreate(ctx context.Context, d *schema.Reso
 	}
 
 	if v, ok := d.GetOk("schedule"); ok {
-		job.Schedule = expandVBRBackupJobSchedule(v.([]interface{}))
+		job.Schedule = schedule.Expand(v.([]interface{}))
+	}
+	if err := resolveAfterThisJob(ctx, client, job.Schedule); err != nil {
+		return diag.FromErr(err)
 	}
 
 	url := client.BuildAPIURL("/api/v1/jobs")
-	reqBodyBytes, err := json.Marshal(job)
+	reqBodyBytes, err := marshalJob(d, job)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1056,11 +611,10 @@ func resourceVBRObjectStorageBackupJobRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	jobID := d.Id()
-	url := client.BuildAPIURL("/api/v1/jobs/" + jobID)
+	url := client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s", jobID))
 	respBodyBytes, err := client.DoRequest(ctx, "GET", url, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
-			d.SetId("")
+		if vc.RemoveFromStateIfGone(d, err) {
 			return diags
 		}
 		return diag.FromErr(err)
@@ -1073,29 +627,53 @@ func resourceVBRObjectStorageBackupJobRead(ctx context.Context, d *schema.Resour
 	}
 
 	d.Set("name", resp.Name)
-	d.Set("description", resp.Description)
+	var description string
+	if resp.Description != nil {
+		description = *resp.Description
+	}
+	d.Set("description", client.TrimDescriptionSuffix(description))
 	d.Set("is_high_priority", resp.IsHighPriority)
-	// Note: objects, backup_repository, archive_repository, and schedule
-	// would need flatten functions to properly set nested data
-	// For now, we'll rely on the user's configuration
+	// The schedule is only read back when configured, since the server returns a default schedule otherwise
+	if _, ok := d.GetOk("schedule"); ok {
+		if err := readAfterThisJobID(ctx, client, d, resp.Schedule); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("schedule", schedule.Flatten(resp.Schedule)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := readArchiveRepository(d, resp.ArchiveRepository); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := readAdditionalSettings(d, respBodyBytes); err != nil {
+		return diag.FromErr(err)
+	}
+	readJobDeletionSettings(d)
+	// Note: objects and backup_repository would need flatten functions
+	// to properly set nested data. For now, we'll rely on the user's configuration
 
 	return diags
 }
 
 // CRUD function (Update)
 func resourceVBRObjectStorageBackupJobUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if onlyJobDeletionSettingsChanged(d) {
+		return nil
+	}
 	client, err := vc.GetVBRClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	jobID := d.Id()
+	client.LockJob(jobID)
+	defer client.UnlockJob(jobID)
 
 	// Build the job payload
 	job := VbrObjectStorageBackupJob{
 		ID:               &jobID,
 		Name:             d.Get("name").(string),
 		Type:             "ObjectStorageBackup",
-		Description:      getStringPtr(d.Get("description")),
+		Description:      getStringPtr(client.AppendDescriptionSuffix(d.Get("description").(string))),
 		IsDisabled:       getBoolPtr(d.Get("is_disabled")),
 		IsHighPriority:   getBoolPtr(d.Get("is_high_priority")),
 		Objects:          expandVBRObjectStorageBackupJobObjects(d.Get("objects").([]interface{})),
@@ -1107,11 +685,14 @@ func resourceVBRObjectStorageBackupJobUpdate(ctx context.Context, d *schema.Reso
 	}
 
 	if v, ok := d.GetOk("schedule"); ok {
-		job.Schedule = expandVBRBackupJobSchedule(v.([]interface{}))
+		job.Schedule = schedule.Expand(v.([]interface{}))
+	}
+	if err := resolveAfterThisJob(ctx, client, job.Schedule); err != nil {
+		return diag.FromErr(err)
 	}
 
-	url := client.BuildAPIURL("/api/v1/jobs/" + jobID)
-	reqBodyBytes, err := json.Marshal(job)
+	url := client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s", jobID))
+	reqBodyBytes, err := marshalJob(d, job)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1127,17 +708,15 @@ func resourceVBRObjectStorageBackupJobUpdate(ctx context.Context, d *schema.Reso
 // CRUD function (Delete)
 func resourceVBRObjectStorageBackupJobDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	if err := checkJobDeletion(d, d.Get("name").(string)); err != nil {
+		return diag.FromErr(err)
+	}
 	client, err := vc.GetVBRClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	jobID := d.Id()
-	url := client.BuildAPIURL("/api/v1/jobs/" + jobID)
-	_, err = client.DoRequest(ctx, "DELETE", url, nil)
-	if err != nil {
-		if !strings.Contains(err.Error(), "404") {
-			return diag.FromErr(err)
-		}
+	if err := deleteJob(ctx, client, d, d.Id()); err != nil {
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return diags
@@ -1164,13 +743,13 @@ func expandVBRObjectStorageBackupJobObjects(input []interface{}) []VbrObjectStor
 			obj.Path = getStringPtr(v)
 		}
 		if v, ok := m["inclusion_tag_mask"]; ok {
-			obj.InclusionTagMask = expandVBRObjectStorageBackupJobTagMasks(v.([]interface{}))
+			obj.InclusionTagMask = expandVBRObjectStorageBackupJobTagMasks(v.(*schema.Set).List())
 		}
 		if v, ok := m["exclusion_tag_mask"]; ok {
-			obj.ExclusionTagMask = expandVBRObjectStorageBackupJobExclusionTagMasks(v.([]interface{}))
+			obj.ExclusionTagMask = expandVBRObjectStorageBackupJobExclusionTagMasks(v.(*schema.Set).List())
 		}
 		if v, ok := m["exclusion_path_mask"]; ok {
-			masks := v.([]interface{})
+			masks := v.(*schema.Set).List()
 			if len(masks) > 0 {
 				paths := make([]string, len(masks))
 				for i, mask := range masks {
@@ -1228,7 +807,7 @@ func expandVBRObjectStorageBackupJobBackupRepository(input []interface{}) VbrObj
 		repo.SourceBackupId = getStringPtr(v)
 	}
 	if v, ok := m["retention_policy"]; ok && len(v.([]interface{})) > 0 {
-		repo.RetentionPolicy = expandVBRBackupJobRetentionPolicy(v.([]interface{}))
+		repo.RetentionPolicy = schedule.ExpandRetentionPolicy(v.([]interface{}))
 	}
 	if v, ok := m["advanced_settings"]; ok && len(v.([]interface{})) > 0 {
 		repo.AdvancedSettings = expandVBRObjectStorageBackupJobAdvancedSettings(v.([]interface{}))
@@ -1236,17 +815,6 @@ func expandVBRObjectStorageBackupJobBackupRepository(input []interface{}) VbrObj
 	return repo
 }
 
-func expandVBRBackupJobRetentionPolicy(input []interface{}) *VbrBackupJobRetentionPolicy {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	return &VbrBackupJobRetentionPolicy{
-		Type:     m["type"].(string),
-		Quantity: m["quantity"].(int),
-	}
-}
-
 func expandVBRObjectStorageBackupJobAdvancedSettings(input []interface{}) *VbrObjectStorageBackupJobAdvancedSettings {
 	if len(input) == 0 {
 		return nil
@@ -1267,7 +835,7 @@ func expandVBRObjectStorageBackupJobAdvancedSettings(input []interface{}) *VbrOb
 		settings.Scripts = expandVBRObjectStorageBackupJobScripts(v.([]interface{}))
 	}
 	if v, ok := m["notifications"]; ok && len(v.([]interface{})) > 0 {
-		settings.Notifications = expandVBRObjectStorageBackupJobNotifications(v.([]interface{}))
+		settings.Notifications = schedule.ExpandNotifications(v.([]interface{}))
 	}
 	return settings
 }
@@ -1281,8 +849,12 @@ func expandVBRObjectStorageBackupJobObjectVersions(input []interface{}) *VBRObje
 	if v, ok := m["version_retention_type"]; ok && v != "" {
 		versions.VersionRetentionType = getStringPtr(v)
 	}
-	if v, ok := m["action_version_rention"]; ok {
-		versions.ActionVersionRention = getIntPtr(v)
+	if v, ok := m["action_version_retention"]; ok {
+		versions.ActionVersionRetention = getIntPtr(v)
+	}
+	// action_version_rention is the deprecated misspelling of action_version_retention
+	if v, ok := m["action_version_rention"].(int); ok && v != 0 && m["action_version_retention"] == 0 {
+		versions.ActionVersionRetention = &v
 	}
 	if v, ok := m["delete_version_retention"]; ok {
 		versions.DeleteVersionRetention = getIntPtr(v)
@@ -1355,7 +927,7 @@ func expandVBRObjectStorageBackupJobBackupHealthWeekly(input []interface{}) *VBR
 		IsEnabled: m["is_enabled"].(bool),
 	}
 	if v, ok := m["days"]; ok {
-		days := v.([]interface{})
+		days := v.(*schema.Set).List()
 		if len(days) > 0 {
 			dayStrings := make([]string, len(days))
 			for i, day := range days {
@@ -1388,7 +960,7 @@ func expandVBRObjectStorageBackupJobBackupHealthMonthly(input []interface{}) *VB
 		monthly.DayOfMonth = getIntPtr(v)
 	}
 	if v, ok := m["months"]; ok {
-		months := v.([]interface{})
+		months := v.(*schema.Set).List()
 		if len(months) > 0 {
 			monthStrings := make([]string, len(months))
 			for i, month := range months {
@@ -1426,7 +998,7 @@ func expandVBRObjectStorageBackupJobScripts(input []interface{}) *VBRObjectStora
 		scripts.RunScriptEvery = getIntPtr(v)
 	}
 	if v, ok := m["day_of_week"]; ok {
-		days := v.([]interface{})
+		days := v.(*schema.Set).List()
 		if len(days) > 0 {
 			dayStrings := make([]string, len(days))
 			for i, day := range days {
@@ -1466,332 +1038,4 @@ func expandVBRObjectStorageBackupJobScriptPostCommand(input []interface{}) *VBRO
 	return cmd
 }
 
-func expandVBRObjectStorageBackupJobNotifications(input []interface{}) *VBRObjectStorageBackupJobAdvancedSettingsNotifications {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	notifications := &VBRObjectStorageBackupJobAdvancedSettingsNotifications{}
-
-	if v, ok := m["send_snmp_notifications"]; ok {
-		notifications.SendSNMPNotifications = getBoolPtr(v)
-	}
-	if v, ok := m["email_notifications"]; ok && len(v.([]interface{})) > 0 {
-		notifications.EmailNotifications = expandVBRObjectStorageBackupJobEmailNotifications(v.([]interface{}))
-	}
-	if v, ok := m["trigger_issue_job_warning"]; ok {
-		notifications.TriggerIssueJobWarning = getBoolPtr(v)
-	}
-	if v, ok := m["trigger_attribute_issue_job_warning"]; ok {
-		notifications.TriggerAttributeIssueJobWarning = getBoolPtr(v)
-	}
-	return notifications
-}
-
-func expandVBRObjectStorageBackupJobEmailNotifications(input []interface{}) *VBRObjectStorageBackupJobAdvancedSettingsNotificationsEmailNotifications {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	email := &VBRObjectStorageBackupJobAdvancedSettingsNotificationsEmailNotifications{
-		IsEnabled: m["is_enabled"].(bool),
-	}
-	if v, ok := m["recipients"]; ok {
-		recipients := v.([]interface{})
-		if len(recipients) > 0 {
-			recipientStrings := make([]string, len(recipients))
-			for i, recipient := range recipients {
-				recipientStrings[i] = recipient.(string)
-			}
-			email.Recipients = &recipientStrings
-		}
-	}
-	if v, ok := m["notification_type"]; ok && v != "" {
-		email.NotificationType = getStringPtr(v)
-	}
-	if v, ok := m["custom_notification_settings"]; ok && len(v.([]interface{})) > 0 {
-		email.CustomNotificationSettings = expandVBRObjectStorageBackupJobCustomNotificationSettings(v.([]interface{}))
-	}
-	return email
-}
-
-func expandVBRObjectStorageBackupJobCustomNotificationSettings(input []interface{}) *VBRObjectStorageBackupJobAdvancedSettingsNotificationsEmailNotificationsCustomNotificationSettings {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	custom := &VBRObjectStorageBackupJobAdvancedSettingsNotificationsEmailNotificationsCustomNotificationSettings{}
-	if v, ok := m["subject"]; ok && v != "" {
-		custom.Subject = getStringPtr(v)
-	}
-	if v, ok := m["notify_on_success"]; ok {
-		custom.NotifyOnSuccess = getBoolPtr(v)
-	}
-	if v, ok := m["notify_on_warning"]; ok {
-		custom.NotifyOnWarning = getBoolPtr(v)
-	}
-	if v, ok := m["notify_on_error"]; ok {
-		custom.NotifyOnError = getBoolPtr(v)
-	}
-	if v, ok := m["suppress_notification_until_last_retry"]; ok {
-		custom.SuppressNotificationUntilLastRetry = getBoolPtr(v)
-	}
-	return custom
-}
-
-func expandVBRBackupJobArchiveRepository(input []interface{}) *VbrBackupJobArchiveRepository {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	archive := &VbrBackupJobArchiveRepository{
-		ArchiveRepositoryID: m["archive_repository_id"].(string),
-	}
-	if v, ok := m["archive_recent_file_versions"]; ok {
-		archive.ArchiveRecentFileVersions = getBoolPtr(v)
-	}
-	if v, ok := m["archive_previous_file_versions"]; ok {
-		archive.ArchivePreviousFileVersions = getBoolPtr(v)
-	}
-	if v, ok := m["archive_retention_policy"]; ok && len(v.([]interface{})) > 0 {
-		archive.ArchiveRetentionPolicy = expandVBRBackupJobRetentionPolicy(v.([]interface{}))
-	}
-	if v, ok := m["file_archive_settings"]; ok && len(v.([]interface{})) > 0 {
-		archive.FileArchiveSettings = expandVBRBackupJobFileArchiveSettings(v.([]interface{}))
-	}
-	return archive
-}
-
-func expandVBRBackupJobFileArchiveSettings(input []interface{}) *VbrBackupJobFileArchiveSettings {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	settings := &VbrBackupJobFileArchiveSettings{}
-	if v, ok := m["archival_type"]; ok && v != "" {
-		settings.ArchivalType = getStringPtr(v)
-	}
-	if v, ok := m["inclusion_mask"]; ok {
-		masks := v.([]interface{})
-		if len(masks) > 0 {
-			maskStrings := make([]string, len(masks))
-			for i, mask := range masks {
-				maskStrings[i] = mask.(string)
-			}
-			settings.InclusionMask = &maskStrings
-		}
-	}
-	if v, ok := m["exclusion_mask"]; ok {
-		masks := v.([]interface{})
-		if len(masks) > 0 {
-			maskStrings := make([]string, len(masks))
-			for i, mask := range masks {
-				maskStrings[i] = mask.(string)
-			}
-			settings.ExclusionMask = &maskStrings
-		}
-	}
-	return settings
-}
-
-func expandVBRBackupJobSchedule(input []interface{}) *VbrBackupJobSchedule {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	schedule := &VbrBackupJobSchedule{
-		RunAutomatically: m["run_automatically"].(bool),
-	}
-	if v, ok := m["daily"]; ok && len(v.([]interface{})) > 0 {
-		schedule.Daily = expandVBRBackupJobScheduleDaily(v.([]interface{}))
-	}
-	if v, ok := m["monthly"]; ok && len(v.([]interface{})) > 0 {
-		schedule.Monthly = expandVBRBackupJobScheduleMonthly(v.([]interface{}))
-	}
-	if v, ok := m["periodically"]; ok && len(v.([]interface{})) > 0 {
-		schedule.Periodically = expandVBRBackupJobSchedulePeriodically(v.([]interface{}))
-	}
-	if v, ok := m["continuously"]; ok && len(v.([]interface{})) > 0 {
-		schedule.Continuously = expandVBRBackupJobScheduleContinuously(v.([]interface{}))
-	}
-	if v, ok := m["after_this_job"]; ok && len(v.([]interface{})) > 0 {
-		schedule.AfterThisJob = expandVBRBackupJobScheduleAfterThisJob(v.([]interface{}))
-	}
-	if v, ok := m["retry"]; ok && len(v.([]interface{})) > 0 {
-		schedule.Retry = expandVBRBackupJobScheduleRetry(v.([]interface{}))
-	}
-	if v, ok := m["backup_window"]; ok && len(v.([]interface{})) > 0 {
-		schedule.BackupWindow = expandVBRBackupJobScheduleBackupWindows(v.([]interface{}))
-	}
-	return schedule
-}
-
-func expandVBRBackupJobScheduleDaily(input []interface{}) *VbrBackupJobScheduleDaily {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	daily := &VbrBackupJobScheduleDaily{
-		IsEnabled: m["is_enabled"].(bool),
-	}
-	if v, ok := m["local_time"]; ok && v != "" {
-		daily.LocalTime = getStringPtr(v)
-	}
-	if v, ok := m["daily_kind"]; ok && v != "" {
-		daily.DailyKind = getStringPtr(v)
-	}
-	if v, ok := m["days"]; ok {
-		days := v.([]interface{})
-		if len(days) > 0 {
-			dayStrings := make([]string, len(days))
-			for i, day := range days {
-				dayStrings[i] = day.(string)
-			}
-			daily.Days = &dayStrings
-		}
-	}
-	return daily
-}
-
-func expandVBRBackupJobScheduleMonthly(input []interface{}) *VbrBackupJobScheduleMonthly {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	monthly := &VbrBackupJobScheduleMonthly{
-		IsEnabled: m["is_enabled"].(bool),
-	}
-	if v, ok := m["day_of_week"]; ok && v != "" {
-		monthly.DayOfWeek = getStringPtr(v)
-	}
-	if v, ok := m["day_number_in_month"]; ok && v != "" {
-		monthly.DayNumberInMonth = getStringPtr(v)
-	}
-	if v, ok := m["day_of_month"]; ok {
-		monthly.DayOfMonth = getIntPtr(v)
-	}
-	if v, ok := m["months"]; ok {
-		months := v.([]interface{})
-		if len(months) > 0 {
-			monthStrings := make([]string, len(months))
-			for i, month := range months {
-				monthStrings[i] = month.(string)
-			}
-			monthly.Months = &monthStrings
-		}
-	}
-	if v, ok := m["local_time"]; ok && v != "" {
-		monthly.LocalTime = getStringPtr(v)
-	}
-	if v, ok := m["is_last_day_of_month"]; ok {
-		monthly.IsLastDayOfMonth = getBoolPtr(v)
-	}
-	return monthly
-}
-
-func expandVBRBackupJobSchedulePeriodically(input []interface{}) *VbrBackupJobSchedulePeriodically {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	periodically := &VbrBackupJobSchedulePeriodically{
-		IsEnabled: m["is_enabled"].(bool),
-	}
-	if v, ok := m["periodically_kind"]; ok && v != "" {
-		periodically.PeriodicallyKind = getStringPtr(v)
-	}
-	if v, ok := m["frequency"]; ok {
-		periodically.Frequency = getIntPtr(v)
-	}
-	if v, ok := m["backup_window"]; ok && len(v.([]interface{})) > 0 {
-		periodically.BackupWindow = expandVBRBackupJobScheduleBackupWindow(v.([]interface{}))
-	}
-	if v, ok := m["start_time_within_hour"]; ok {
-		periodically.StartTimeWithinHour = getIntPtr(v)
-	}
-	return periodically
-}
-
-func expandVBRBackupJobScheduleContinuously(input []interface{}) *VbrBackupJobScheduleContinuously {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	continuously := &VbrBackupJobScheduleContinuously{
-		IsEnabled: m["is_enabled"].(bool),
-	}
-	if v, ok := m["backup_window"]; ok && len(v.([]interface{})) > 0 {
-		continuously.BackupWindow = expandVBRBackupJobScheduleBackupWindow(v.([]interface{}))
-	}
-	return continuously
-}
-
-func expandVBRBackupJobScheduleAfterThisJob(input []interface{}) *VbrBackupJobScheduleAfterThisJob {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	afterThisJob := &VbrBackupJobScheduleAfterThisJob{
-		IsEnabled: m["is_enabled"].(bool),
-	}
-	if v, ok := m["job_name"]; ok && v != "" {
-		afterThisJob.JobName = getStringPtr(v)
-	}
-	return afterThisJob
-}
-
-func expandVBRBackupJobScheduleRetry(input []interface{}) *VbrBackupJobScheduleRetry {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	retry := &VbrBackupJobScheduleRetry{
-		IsEnabled: m["is_enabled"].(bool),
-	}
-	if v, ok := m["retry_count"]; ok {
-		retry.RetryCount = getIntPtr(v)
-	}
-	if v, ok := m["await_minutes"]; ok {
-		retry.AwaitMinutes = getIntPtr(v)
-	}
-	return retry
-}
-
-func expandVBRBackupJobScheduleBackupWindows(input []interface{}) *VbrBackupJobScheduleBackupWindows {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	backupWindows := &VbrBackupJobScheduleBackupWindows{
-		IsEnabled: m["is_enabled"].(bool),
-	}
-	if v, ok := m["backup_window"]; ok && len(v.([]interface{})) > 0 {
-		backupWindows.BackupWindow = expandVBRBackupJobScheduleBackupWindow(v.([]interface{}))
-	}
-	return backupWindows
-}
-
-func expandVBRBackupJobScheduleBackupWindow(input []interface{}) *VbrBackupJobScheduleBackupWindow {
-	if len(input) == 0 {
-		return nil
-	}
-	m := input[0].(map[string]interface{})
-	backupWindow := &VbrBackupJobScheduleBackupWindow{}
-	if v, ok := m["days"]; ok {
-		daysList := v.([]interface{})
-		if len(daysList) > 0 {
-			days := make([]VbrBackupJobScheduleBackupWindowDays, len(daysList))
-			for i, d := range daysList {
-				dayMap := d.(map[string]interface{})
-				days[i] = VbrBackupJobScheduleBackupWindowDays{
-					Day:   dayMap["day"].(string),
-					Hours: dayMap["hours"].(string),
-				}
-			}
-			backupWindow.Days = days
-		}
-	}
-	return backupWindow
-}
-
 // ============================================================================
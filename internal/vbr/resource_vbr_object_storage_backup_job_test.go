@@ -0,0 +1,127 @@
+package vbr
+
+import (
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestExpandVBRObjectStorageBackupJobAdvancedSettings checks the request body of the advanced
+// settings of the backup repository. The job is read back without them, so there are no flatten
+// functions to round trip through.
+func TestExpandVBRObjectStorageBackupJobAdvancedSettings(t *testing.T) {
+	for name, tc := range map[string]struct {
+		settings map[string]interface{}
+		want     string
+	}{
+		"object versions": {
+			settings: map[string]interface{}{
+				"object_versions": []interface{}{map[string]interface{}{
+					"version_retention_type":   "KeepLastVersions",
+					"action_version_retention": 10,
+					"delete_version_retention": 3,
+				}},
+			},
+			want: `{"objectVersions": {"versionRetentionType": "KeepLastVersions", "actionVersionRetention": 10, "deleteVersionRetention": 3}}`,
+		},
+		"deprecated object versions alias": {
+			settings: map[string]interface{}{
+				"object_versions": []interface{}{map[string]interface{}{
+					"version_retention_type": "KeepLastVersions",
+					"action_version_rention": 5,
+				}},
+			},
+			want: `{"objectVersions": {"versionRetentionType": "KeepLastVersions", "actionVersionRetention": 5, "deleteVersionRetention": 0}}`,
+		},
+		"encrypted storage data": {
+			settings: map[string]interface{}{
+				"storage_data": []interface{}{map[string]interface{}{
+					"compression_level": "Optimal",
+					"encryption": []interface{}{map[string]interface{}{
+						"is_enabled":             true,
+						"encryption_type":        "ByUserPassword",
+						"encryption_password_id": "password-1",
+					}},
+				}},
+			},
+			want: `{"storageData": {"compressionLevel": "Optimal",
+				"encryption": {"isEnabled": true, "encryptionType": "ByUserPassword", "encryptionPasswordId": "password-1"}}}`,
+		},
+		"backup health": {
+			settings: map[string]interface{}{
+				"backup_health": []interface{}{map[string]interface{}{
+					"is_enabled": true,
+					"weekly": []interface{}{map[string]interface{}{
+						"is_enabled": true,
+						"days":       []interface{}{"saturday"},
+						"local_time": "23:00",
+					}},
+					"monthly": []interface{}{map[string]interface{}{
+						"is_enabled":           false,
+						"day_of_week":          "sunday",
+						"day_number_in_month":  "Last",
+						"months":               []interface{}{"December"},
+						"local_time":           "02:00",
+						"is_last_day_of_month": true,
+					}},
+				}},
+			},
+			want: `{"backupHealth": {"isEnabled": true,
+				"weekly": {"isEnabled": true, "days": ["saturday"], "localTime": "23:00"},
+				"monthly": {"isEnabled": false, "dayOfWeek": "sunday", "dayNumberInMonth": "Last", "dayOfMonth": 0, "months": ["December"],
+					"localTime": "02:00", "isLastDayOfMonth": true}}}`,
+		},
+		"scripts": {
+			settings: map[string]interface{}{
+				"scripts": []interface{}{map[string]interface{}{
+					"pre_command":      []interface{}{map[string]interface{}{"is_enabled": true, "command": "/opt/pre.sh"}},
+					"post_command":     []interface{}{map[string]interface{}{"is_enabled": false}},
+					"periodicity_type": "Days",
+					"day_of_week":      []interface{}{"monday"},
+				}},
+			},
+			want: `{"scripts": {"preCommand": {"isEnabled": true, "command": "/opt/pre.sh"}, "postCommand": {"isEnabled": false},
+				"periodicityType": "Days", "runScriptEvery": 0, "dayOfWeek": ["monday"]}}`,
+		},
+		"notifications": {
+			settings: map[string]interface{}{
+				"notifications": []interface{}{map[string]interface{}{"send_snmp_notifications": true}},
+			},
+			want: `{"notifications": {"sendSNMPNotifications": true, "triggerIssueJobWarning": false, "triggerAttributeIssueJobWarning": false}}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceVbrObjectStorageBackupJob().Schema, map[string]interface{}{
+				"backup_repository": []interface{}{map[string]interface{}{
+					"backup_repository_id": "00000000-0000-0000-0000-000000000001",
+					"advanced_settings":    []interface{}{tc.settings},
+				}},
+			})
+			acctest.CheckJSON(t, expandVBRObjectStorageBackupJobAdvancedSettings(d.Get("backup_repository.0.advanced_settings").([]interface{})), tc.want)
+		})
+	}
+}
+
+func TestExpandVBRObjectStorageBackupJobObjects(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceVbrObjectStorageBackupJob().Schema, map[string]interface{}{
+		"objects": []interface{}{
+			map[string]interface{}{
+				"object_storage_server_id": "00000000-0000-0000-0000-000000000002",
+				"container":                "invoices",
+				"path":                     "2024/",
+				"inclusion_tag_mask":       []interface{}{map[string]interface{}{"name": "retain", "value": "yes", "is_object_tag": true}},
+				"exclusion_tag_mask":       []interface{}{map[string]interface{}{"name": "tmp", "value": "*", "is_object_tag": false}},
+				"exclusion_path_mask":      []interface{}{"*.tmp"},
+			},
+			map[string]interface{}{"object_storage_server_id": "00000000-0000-0000-0000-000000000003"},
+		},
+	})
+	acctest.CheckJSON(t, expandVBRObjectStorageBackupJobObjects(d.Get("objects").([]interface{})), `[
+		{"objectStorageServerId": "00000000-0000-0000-0000-000000000002", "container": "invoices", "path": "2024/",
+			"inclusionTagMask": [{"name": "retain", "value": "yes", "isObjectTag": true}],
+			"exclusionTagMask": [{"name": "tmp", "value": "*", "isObjectTag": false}],
+			"exclusionPathMask": ["*.tmp"]},
+		{"objectStorageServerId": "00000000-0000-0000-0000-000000000003"}]`)
+}
@@ -1,10 +1,11 @@
-﻿package vbr
+package vbr
 
 import (
 	vc "terraform-provider-veeambackup/internal/client"
 	"context"
 	"encoding/json"
-	"strings"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,7 +19,8 @@ type VBRRepository struct {
 	Account          *VBRRepositoryAccount            `json:"account,omitempty"`     //Used for type AzureBlob,AzureArchive,AmazonS3
 	Bucket           *VBRRepositoryAmazonS3Bucket     `json:"bucket,omitempty"`      //Used for type AmazonS3,AmazonGlacier
 	Container        *VBRRepositoryAzureBlobContainer `json:"container,omitempty"`   //Used for type AzureBlob,AzureArchive
-	MountServer      *VBRRepositoryMountServer        `json:"mountServer,omitempty"` //Used for type AzureBlob,AzureArchive,AmazonS3
+	MountServer      *VBRRepositoryMountServer        `json:"mountServer,omitempty"` //Used for type AzureBlob,AzureArchive,AmazonS3,Smb,Nfs
+	Share            *VBRRepositoryShare              `json:"share,omitempty"`       //Used for type Smb,Nfs
 	UniqueID         *string                          `json:"uniqueId,omitempty"`
 	ImportBackup     *bool                            `json:"importBackup,omitempty"`
 	ImportIndex      *bool                            `json:"importIndex,omitempty"`
@@ -54,6 +56,7 @@ func ResourceVbrRepository() *schema.Resource {
 		ReadContext:   resourceVBRRepositoryRead,
 		UpdateContext: resourceVBRRepositoryUpdate,
 		DeleteContext: resourceVBRRepositoryDelete,
+		CustomizeDiff: resourceVBRRepositoryCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -70,6 +73,7 @@ func ResourceVbrRepository() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"AmazonS3", "AmazonGlacier", "AzureBlob", "AzureArchive", "Nfs", "Smb"}, false),
 				Description:  "Specifies the type of the repository. Valid values are AmazonS3, AmazonGlacier, AzureBlob, AzureArchive, Nfs, Smb.",
 			},
@@ -102,14 +106,14 @@ func ResourceVbrRepository() *schema.Resource {
 										Type:         schema.TypeString,
 										Required:     true,
 										ValidateFunc: validation.StringInSlice([]string{"Direct", "SelectedGateway"}, false),
-										Description:  "Specifies the connection type for the account. Valid values are Direct, SelectedGateway.",
+										Description:  "Specifies the connection type for the account. Valid values are Direct, SelectedGateway. SelectedGateway requires gateway_server_ids.",
 									},
 									"gateway_server_ids": {
 										Type:        schema.TypeSet,
 										Elem:        &schema.Schema{Type: schema.TypeString},
 										MinItems:    1,
 										Optional:    true,
-										Description: "Specifies the IDs of the gateway servers to use for the repository.",
+										Description: "Specifies the IDs of the gateway servers to use for the repository when connection_type is SelectedGateway.",
 									},
 								},
 							},
@@ -267,11 +271,53 @@ func ResourceVbrRepository() *schema.Resource {
 					},
 				},
 			},
+			"share": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Share settings for the repository. Required for types Smb, Nfs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"share_path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Specifies the path of the share, e.g. \\\\server\\share for Smb or server:/export for Nfs.",
+						},
+						"credentials_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Specifies the ID of the credentials used to access the share. Used for type Smb.",
+						},
+						"gateway_server": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Gateway server settings for the share. When not set, the gateway server is selected automatically.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_select_enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     true,
+										Description: "Specifies whether Veeam Backup & Replication selects the gateway server automatically.",
+									},
+									"gateway_server_ids": {
+										Type:        schema.TypeSet,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Optional:    true,
+										Description: "Specifies the IDs of the gateway servers to use for the share. Required when auto_select_enabled is false.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"mount_server": {
 				Type:        schema.TypeList,
 				Optional:    true,
 				MaxItems:    1,
-				Description: "Mount server settings for the repository. Required for type AzureBlob, AzureArchive, AmazonS3.",
+				Description: "Mount server settings for the repository. Required for types AzureBlob, AzureArchive, AmazonS3, Smb, Nfs.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"mount_server_settings_type": {
@@ -288,9 +334,10 @@ func ResourceVbrRepository() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"mount_server_id": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Specifies the ID of the Windows mount server.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the ID of the Windows mount server.",
 									},
 									"v_power_nfs_enabled": {
 										Type:        schema.TypeBool,
@@ -333,9 +380,10 @@ func ResourceVbrRepository() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"mount_server_id": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Specifies the ID of the Linux mount server.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+										Description:  "Specifies the ID of the Linux mount server.",
 									},
 									"v_power_nfs_enabled": {
 										Type:        schema.TypeBool,
@@ -568,7 +616,58 @@ func ResourceVbrRepository() *schema.Resource {
 				Description: "The related session ID of the repository.",
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+// resourceVBRRepositoryCustomizeDiff checks the settings each repository type requires and the
+// gateway server selection
+func resourceVBRRepositoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	repositoryType := d.Get("type").(string)
+	shareRepository := repositoryType == "Smb" || repositoryType == "Nfs"
+
+	if d.NewValueKnown("share") {
+		_, ok := d.GetOk("share")
+		if shareRepository && !ok {
+			return fmt.Errorf("share is required when type is %s", repositoryType)
+		}
+		if !shareRepository && ok {
+			return fmt.Errorf("share is only used for types Smb and Nfs, not %s", repositoryType)
+		}
 	}
+	if shareRepository && d.NewValueKnown("mount_server") {
+		if _, ok := d.GetOk("mount_server"); !ok {
+			return fmt.Errorf("mount_server is required when type is %s", repositoryType)
+		}
+	}
+	if repositoryType == "Nfs" && d.NewValueKnown("share.0.credentials_id") && d.Get("share.0.credentials_id").(string) != "" {
+		return fmt.Errorf("share.0.credentials_id is only used for type Smb")
+	}
+
+	if d.NewValueKnown("share.0.gateway_server.0.gateway_server_ids") {
+		if _, ok := d.GetOk("share.0.gateway_server"); ok {
+			ids := d.Get("share.0.gateway_server.0.gateway_server_ids").(*schema.Set).Len()
+			autoSelect := d.Get("share.0.gateway_server.0.auto_select_enabled").(bool)
+			if !autoSelect && ids == 0 {
+				return fmt.Errorf("share.0.gateway_server.0.gateway_server_ids is required when auto_select_enabled is false")
+			}
+			if autoSelect && ids > 0 {
+				return fmt.Errorf("share.0.gateway_server.0.gateway_server_ids is only used when auto_select_enabled is false")
+			}
+		}
+	}
+
+	if d.NewValueKnown("account.0.connection_settings.0.gateway_server_ids") &&
+		d.Get("account.0.connection_settings.0.connection_type").(string) == "SelectedGateway" &&
+		d.Get("account.0.connection_settings.0.gateway_server_ids").(*schema.Set).Len() == 0 {
+		return fmt.Errorf("account.0.connection_settings.0.gateway_server_ids is required when connection_type is SelectedGateway")
+	}
+	return nil
 }
 
 // CRUD function (Create)
@@ -607,6 +706,10 @@ func resourceVBRRepositoryCreate(ctx context.Context, d *schema.ResourceData, m
 		repository.MountServer = expandVBRRepositoryMountServer(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("share"); ok {
+		repository.Share = expandVBRRepositoryShare(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("proxy_appliance"); ok {
 		repository.ProxyAppliance = expandVBRRepositoryProxyAppliance(v.([]interface{}))
 	}
@@ -638,8 +741,7 @@ func resourceVBRRepositoryCreate(ctx context.Context, d *schema.ResourceData, m
 	d.Set("usn", resp.USN)
 	d.Set("end_time", resp.EndTime)
 	d.Set("progress_percent", resp.ProgreessPercent)
-	d.Set("result_message", resp.Result.Message)
-	d.Set("result_is_cancelled", resp.Result.IsCancelled)
+	d.Set("result", flattenVBRRepositoryResult(resp.Result))
 	d.Set("resource_reference", resp.ResourceReference)
 	d.Set("parent_session_id", resp.ParentSessionID)
 	d.Set("platform_name", resp.PlatformName)
@@ -659,11 +761,10 @@ func resourceVBRRepositoryRead(ctx context.Context, d *schema.ResourceData, m in
 	}
 	repositoryID := d.Id()
 
-	url := client.BuildAPIURL("/api/v1/backupInfrastructure/repositories/" + repositoryID)
+	url := client.BuildAPIURL(vc.Endpoint("/api/v1/backupInfrastructure/repositories/%s", repositoryID))
 	respBodyBytes, err := client.DoRequest(ctx, "GET", url, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
-			d.SetId("")
+		if vc.RemoveFromStateIfGone(d, err) {
 			return diags
 		}
 		return diag.FromErr(err)
@@ -683,8 +784,7 @@ func resourceVBRRepositoryRead(ctx context.Context, d *schema.ResourceData, m in
 	d.Set("usn", resp.USN)
 	d.Set("end_time", resp.EndTime)
 	d.Set("progress_percent", resp.ProgreessPercent)
-	d.Set("result_message", resp.Result.Message)
-	d.Set("result_is_cancelled", resp.Result.IsCancelled)
+	d.Set("result", flattenVBRRepositoryResult(resp.Result))
 	d.Set("resource_reference", resp.ResourceReference)
 	d.Set("parent_session_id", resp.ParentSessionID)
 	d.Set("platform_name", resp.PlatformName)
@@ -731,11 +831,15 @@ func resourceVBRRepositoryUpdate(ctx context.Context, d *schema.ResourceData, m
 		repository.MountServer = expandVBRRepositoryMountServer(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("share"); ok {
+		repository.Share = expandVBRRepositoryShare(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("proxy_appliance"); ok {
 		repository.ProxyAppliance = expandVBRRepositoryProxyAppliance(v.([]interface{}))
 	}
 
-	url := client.BuildAPIURL("/api/v1/backupInfrastructure/repositories/" + repositoryID)
+	url := client.BuildAPIURL(vc.Endpoint("/api/v1/backupInfrastructure/repositories/%s", repositoryID))
 	reqBodyBytes, err := json.Marshal(repository)
 	if err != nil {
 		return diag.FromErr(err)
@@ -760,8 +864,7 @@ func resourceVBRRepositoryUpdate(ctx context.Context, d *schema.ResourceData, m
 	d.Set("usn", resp.USN)
 	d.Set("end_time", resp.EndTime)
 	d.Set("progress_percent", resp.ProgreessPercent)
-	d.Set("result_message", resp.Result.Message)
-	d.Set("result_is_cancelled", resp.Result.IsCancelled)
+	d.Set("result", flattenVBRRepositoryResult(resp.Result))
 	d.Set("resource_reference", resp.ResourceReference)
 	d.Set("parent_session_id", resp.ParentSessionID)
 	d.Set("platform_name", resp.PlatformName)
@@ -781,10 +884,10 @@ func resourceVBRRepositoryDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 	repositoryID := d.Id()
 
-	url := client.BuildAPIURL("/api/v1/backupInfrastructure/repositories/" + repositoryID)
+	url := client.BuildAPIURL(vc.Endpoint("/api/v1/backupInfrastructure/repositories/%s", repositoryID))
 	_, err = client.DoRequest(ctx, "DELETE", url, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if vc.IsGone(err) {
 			d.SetId("")
 			return diags
 		}
@@ -795,6 +898,19 @@ func resourceVBRRepositoryDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func flattenVBRRepositoryResult(result VBRRepositoryResult) []interface{} {
+	m := map[string]interface{}{
+		"result": result.Result,
+	}
+	if result.Message != nil {
+		m["message"] = *result.Message
+	}
+	if result.IsCancelled != nil {
+		m["is_cancelled"] = *result.IsCancelled
+	}
+	return []interface{}{m}
+}
+
 // Expand functions
 
 func expandVBRRepositoryAccount(input []interface{}) *VBRRepositoryAccount {
@@ -815,8 +931,9 @@ func expandVBRRepositoryConnectionSettings(input []interface{}) VBRRepositoryCon
 		ConnectionType: m["connection_type"].(string),
 	}
 	if v, ok := m["gateway_server_ids"]; ok && v != nil {
-		gatewayIDs := make([]string, len(v.([]interface{})))
-		for i, id := range v.([]interface{}) {
+		ids := v.(*schema.Set).List()
+		gatewayIDs := make([]string, len(ids))
+		for i, id := range ids {
 			gatewayIDs[i] = id.(string)
 		}
 		settings.GatewayServerIDs = &gatewayIDs
@@ -928,9 +1045,9 @@ func expandVBRRepositoryMountServerSettings(input []interface{}) *VBRRepositoryM
 	}
 	m := input[0].(map[string]interface{})
 	settings := &VBRRepositoryMountServerSettings{
-		MountServerID:     m["mount_server_id"].(string),
-		VPowerNFSEnabled:  getBoolPtr(m["v_power_nfs_enabled"]),
-		WriteCacheEnabled: getBoolPtr(m["write_cache_enabled"]),
+		MountServerID:    m["mount_server_id"].(string),
+		VPowerNFSEnabled: getBoolPtr(m["v_power_nfs_enabled"]),
+		WriteCacheFolder: getStringPtr(m["write_cache_folder"]),
 	}
 	if v, ok := m["v_power_nfs_port_settings"]; ok {
 		settings.VPowerNFSPortSettings = expandVBRRepositoryMountServerVPowerNFSPortSettings(v.([]interface{}))
@@ -949,6 +1066,26 @@ func expandVBRRepositoryMountServerVPowerNFSPortSettings(input []interface{}) *V
 	}
 }
 
+func expandVBRRepositoryShare(input []interface{}) *VBRRepositoryShare {
+	if len(input) == 0 {
+		return nil
+	}
+	m := input[0].(map[string]interface{})
+	share := &VBRRepositoryShare{
+		SharePath:     m["share_path"].(string),
+		CredentialsID: getStringPtr(m["credentials_id"]),
+		GatewayServer: &VBRRepositoryGatewayServer{AutoSelectEnabled: true},
+	}
+	if v, ok := m["gateway_server"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		gateway := v[0].(map[string]interface{})
+		share.GatewayServer.AutoSelectEnabled = gateway["auto_select_enabled"].(bool)
+		for _, id := range gateway["gateway_server_ids"].(*schema.Set).List() {
+			share.GatewayServer.GatewayServerIDs = append(share.GatewayServer.GatewayServerIDs, id.(string))
+		}
+	}
+	return share
+}
+
 func expandVBRRepositoryProxyAppliance(input []interface{}) *VBRRepositoryProxyAppliance {
 	if len(input) == 0 {
 		return nil
@@ -0,0 +1,133 @@
+package vbr
+
+import (
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestExpandVBRRepository checks the request body of each settings block of the repository. The
+// API does not return the settings, so there are no flatten functions to round trip through.
+func TestExpandVBRRepository(t *testing.T) {
+	for name, tc := range map[string]struct {
+		block  string
+		raw    map[string]interface{}
+		expand func(input []interface{}) interface{}
+		want   string
+	}{
+		"account through gateways": {
+			block: "account",
+			raw: map[string]interface{}{
+				"credential_id": "credential-1",
+				"region_type":   "Global",
+				"connection_settings": []interface{}{map[string]interface{}{
+					"connection_type":    "SelectedGateway",
+					"gateway_server_ids": []interface{}{"gateway-1"},
+				}},
+			},
+			expand: func(input []interface{}) interface{} { return expandVBRRepositoryAccount(input) },
+			want: `{"credentialId": "credential-1", "regionType": "Global",
+				"connectionSettings": {"connectionType": "SelectedGateway", "gatewayServerIds": ["gateway-1"]}}`,
+		},
+		"Amazon S3 bucket": {
+			block: "bucket",
+			raw: map[string]interface{}{
+				"region_id":   "eu-west-1",
+				"bucket_name": "backups",
+				"folder_name": "vbr",
+				"storage_consumption_limit": []interface{}{map[string]interface{}{
+					"is_enabled":              true,
+					"consumption_limit_count": 10,
+					"consumption_limit_kind":  "TB",
+				}},
+				"immutability": []interface{}{map[string]interface{}{
+					"is_enabled":        true,
+					"days_count":        30,
+					"immutability_mode": "RepositorySettings",
+				}},
+			},
+			expand: func(input []interface{}) interface{} { return expandVBRRepositoryAmazonS3Bucket(input) },
+			want: `{"regionId": "eu-west-1", "bucketName": "backups", "folderName": "vbr",
+				"storageConsumptionLimit": {"isEnabled": true, "consumptionLimitCount": 10, "consumptionLimitKind": "TB"},
+				"immutability": {"isEnabled": true, "daysCount": 30, "immutabilityMode": "RepositorySettings"}}`,
+		},
+		"Azure blob container": {
+			block: "container",
+			raw: map[string]interface{}{
+				"container_name": "backups",
+				"folder_name":    "vbr",
+				"immutability": []interface{}{map[string]interface{}{
+					"days_count":        14,
+					"immutability_mode": "RetentionSettings",
+				}},
+			},
+			expand: func(input []interface{}) interface{} { return expandVBRRepositoryAzureBlobContainer(input) },
+			want: `{"containerName": "backups", "folderName": "vbr",
+				"immutability": {"isEnabled": true, "daysCount": 14, "immutabilityMode": "RetentionSettings"}}`,
+		},
+		"mount servers": {
+			block: "mount_server",
+			raw: map[string]interface{}{
+				"mount_server_settings_type": "Both",
+				"windows": []interface{}{map[string]interface{}{
+					"mount_server_id":     "00000000-0000-0000-0000-000000000001",
+					"v_power_nfs_enabled": true,
+					"write_cache_folder":  `C:\ProgramData\Veeam`,
+					"v_power_nfs_port_settings": []interface{}{map[string]interface{}{
+						"mount_port":       1058,
+						"v_power_nfs_port": 1063,
+					}},
+				}},
+				"linux": []interface{}{map[string]interface{}{
+					"mount_server_id":     "00000000-0000-0000-0000-000000000002",
+					"v_power_nfs_enabled": false,
+					"write_cache_folder":  "/var/veeam",
+				}},
+			},
+			expand: func(input []interface{}) interface{} { return expandVBRRepositoryMountServer(input) },
+			want: `{"mountServerSettingsType": "Both",
+				"windows": {"mountServerId": "00000000-0000-0000-0000-000000000001", "vPowerNfsEnabled": true, "writeCacheFolder": "C:\\ProgramData\\Veeam",
+					"vPowerNfsPortSettings": {"mountPort": 1058, "vPowerNfsPort": 1063}},
+				"linux": {"mountServerId": "00000000-0000-0000-0000-000000000002", "vPowerNfsEnabled": false, "writeCacheFolder": "/var/veeam"}}`,
+		},
+		"share with automatic gateway": {
+			block:  "share",
+			raw:    map[string]interface{}{"share_path": `\\nas\backups`, "credentials_id": "credential-2"},
+			expand: func(input []interface{}) interface{} { return expandVBRRepositoryShare(input) },
+			want:   `{"sharePath": "\\\\nas\\backups", "credentialsId": "credential-2", "gatewayServer": {"autoSelectEnabled": true}}`,
+		},
+		"share with selected gateways": {
+			block: "share",
+			raw: map[string]interface{}{
+				"share_path": "nas:/backups",
+				"gateway_server": []interface{}{map[string]interface{}{
+					"auto_select_enabled": false,
+					"gateway_server_ids":  []interface{}{"gateway-1"},
+				}},
+			},
+			expand: func(input []interface{}) interface{} { return expandVBRRepositoryShare(input) },
+			want:   `{"sharePath": "nas:/backups", "gatewayServer": {"autoSelectEnabled": false, "gatewayServerIds": ["gateway-1"]}}`,
+		},
+		"proxy appliance": {
+			block: "proxy_appliance",
+			raw: map[string]interface{}{
+				"subscription_id": "sub-1",
+				"instance_size":   "Standard_D2s_v3",
+				"resource_group":  "veeam",
+				"virtual_network": "vnet",
+				"subnet":          "default",
+				"redirector_port": 443,
+			},
+			expand: func(input []interface{}) interface{} { return expandVBRRepositoryProxyAppliance(input) },
+			want: `{"subscriptionId": "sub-1", "instanceSize": "Standard_D2s_v3", "resourceGroup": "veeam", "virtualNetwork": "vnet",
+				"subnet": "default", "redirectorPort": 443}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceVbrRepository().Schema, map[string]interface{}{tc.block: []interface{}{tc.raw}})
+			acctest.CheckJSON(t, tc.expand(d.Get(tc.block).([]interface{})), tc.want)
+		})
+	}
+}
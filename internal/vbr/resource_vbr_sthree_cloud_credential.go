@@ -3,7 +3,7 @@ package vbr
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"time"
 
 	vc "terraform-provider-veeambackup/internal/client"
 
@@ -45,10 +45,24 @@ func ResourceVbrAmazonCloudCredential() *schema.Resource {
 				Description: "The Access Key of the IAM user used to authenticate to AWS.",
 			},
 			"secret_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"secret_key", "secret_key_wo"},
+				Description:  "The Secret Key of the IAM user used to authenticate to AWS. The API never returns it, so it is kept as configured.",
+			},
+			"secret_key_wo": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "The Secret Key of the IAM user used to authenticate to AWS.",
+				WriteOnly:   true,
+				Description: "Write-only variant of secret_key that is never stored in state. Requires Terraform 1.11 or later.",
+			},
+			"secret_key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"secret_key_wo"},
+				Description:  "Change this value to send an updated secret_key_wo to VBR.",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -61,6 +75,12 @@ func ResourceVbrAmazonCloudCredential() *schema.Resource {
 				Description: "Unique ID that identifies the cloud credentials record.",
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
@@ -70,10 +90,15 @@ func ResourceVbrAmazonCloudCredentialCreate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
+	secretKey, diags := vc.GetSecretString(d, "secret_key")
+	if diags.HasError() {
+		return diags
+	}
+
 	req := VbrAmazonCloudCredentialRequest{
 		Type:      "Amazon",
 		AccessKey: d.Get("access_key").(string),
-		SecretKey: d.Get("secret_key").(string),
+		SecretKey: secretKey,
 	}
 	if v, ok := d.GetOk("description"); ok {
 		s := v.(string)
@@ -89,15 +114,9 @@ func ResourceVbrAmazonCloudCredentialCreate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	// Debug: Log the actual JSON being sent
-	fmt.Printf("[DEBUG] Sending JSON payload: %s\n", string(reqBodyBytes))
-
 	apiUrl := client.BuildAPIURL("/api/v1/cloudCredentials")
 	respBodyBytes, err := client.DoRequest(ctx, "POST", apiUrl, reqBodyBytes)
 	if err != nil {
-		if len(respBodyBytes) > 0 {
-			fmt.Printf("[DEBUG] Error response body: %s\n", string(respBodyBytes))
-		}
 		return diag.FromErr(err)
 	}
 
@@ -120,9 +139,12 @@ func ResourceVbrAmazonCloudCredentialRead(ctx context.Context, d *schema.Resourc
 
 	var diags diag.Diagnostics
 
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/api/v1/cloudCredentials/%s", d.Id()))
+	apiUrl := client.BuildAPIURL(vc.Endpoint("/api/v1/cloudCredentials/%s", d.Id()))
 	respBodyBytes, err := client.DoRequest(ctx, "GET", apiUrl, nil)
 	if err != nil {
+		if vc.RemoveFromStateIfGone(d, err) {
+			return diags
+		}
 		return diag.FromErr(err)
 	}
 
@@ -149,10 +171,15 @@ func ResourceVbrAmazonCloudCredentialUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
+	secretKey, diags := vc.GetSecretString(d, "secret_key")
+	if diags.HasError() {
+		return diags
+	}
+
 	req := VbrAmazonCloudCredentialRequest{
 		Type:      "Amazon",
 		AccessKey: d.Get("access_key").(string),
-		SecretKey: d.Get("secret_key").(string),
+		SecretKey: secretKey,
 	}
 	if v, ok := d.GetOk("description"); ok {
 		s := v.(string)
@@ -168,14 +195,9 @@ func ResourceVbrAmazonCloudCredentialUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	fmt.Printf("[DEBUG] UPDATE - Sending JSON payload: %s\n", string(reqBodyBytes))
-
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/api/v1/cloudCredentials/%s", d.Id()))
-	respBodyBytes, err := client.DoRequest(ctx, "PUT", apiUrl, reqBodyBytes)
+	apiUrl := client.BuildAPIURL(vc.Endpoint("/api/v1/cloudCredentials/%s", d.Id()))
+	_, err = client.DoRequest(ctx, "PUT", apiUrl, reqBodyBytes)
 	if err != nil {
-		if len(respBodyBytes) > 0 {
-			fmt.Printf("[DEBUG] UPDATE Error response body: %s\n", string(respBodyBytes))
-		}
 		return diag.FromErr(err)
 	}
 
@@ -190,9 +212,9 @@ func ResourceVbrAmazonCloudCredentialDelete(ctx context.Context, d *schema.Resou
 
 	var diags diag.Diagnostics
 
-	apiUrl := client.BuildAPIURL(fmt.Sprintf("/api/v1/cloudCredentials/%s", d.Id()))
+	apiUrl := client.BuildAPIURL(vc.Endpoint("/api/v1/cloudCredentials/%s", d.Id()))
 	_, err = client.DoRequest(ctx, "DELETE", apiUrl, nil)
-	if err != nil {
+	if err != nil && !vc.IsGone(err) {
 		return diag.FromErr(err)
 	}
 
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"strings"
 	vc "terraform-provider-veeambackup/internal/client"
 	"time"
 
@@ -34,7 +33,7 @@ type VbrUnstructuredDataServerSthreeAccountSettings struct {
 	FriendlyName  string  `json:"friendlyName"`
 	CredentialsID string  `json:"credentialsId"`
 	RegionType    *string `json:"regionType,omitempty"`
-	RegionId      *string `json:regionId,omitempty`
+	RegionId      *string `json:"regionId,omitempty"`
 }
 
 type VbrBackupProxies struct {
@@ -113,9 +112,10 @@ func ResourceVbrUnstructuredDataServer() *schema.Resource {
 							},
 						},
 						"cache_repository_id": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "ID of the cache repository.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "ID of the cache repository.",
 						},
 						"backup_io_control_level": {
 							Type:        schema.TypeString,
@@ -126,10 +126,11 @@ func ResourceVbrUnstructuredDataServer() *schema.Resource {
 				},
 			},
 			"host_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Host ID for File Server type. Note: Only required if type is 'FileServer'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "Host ID for File Server type. Note: Only required if type is 'FileServer'.",
 			},
 			"path": {
 				Type:        schema.TypeString,
@@ -361,6 +362,12 @@ func ResourceVbrUnstructuredDataServer() *schema.Resource {
 				return nil
 			},
 		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
@@ -382,9 +389,6 @@ func ResourceVbrUnstructuredDataServerCreate(ctx context.Context, d *schema.Reso
 	}
 	respBody, err := client.DoRequest(ctx, "POST", url, reqBodyBytes)
 	if err != nil {
-		if respBody != nil {
-			return diag.FromErr(fmt.Errorf("API error: %v, response: %s", err, string(respBody)))
-		}
 		return diag.FromErr(err)
 	}
 	var VbrUnstructuredDataServerResponse VbrUnstructuredDataServerResponse
@@ -408,6 +412,13 @@ func ResourceVbrUnstructuredDataServerCreate(ctx context.Context, d *schema.Reso
 
 	d.SetId(resourceID)
 	d.Set("job_id", VbrUnstructuredDataServerResponse.JobID)
+	d.Set("result", []interface{}{
+		map[string]interface{}{
+			"result":      VbrUnstructuredDataServerResponse.Result.Result,
+			"message":     VbrUnstructuredDataServerResponse.Result.Message,
+			"is_canceled": VbrUnstructuredDataServerResponse.Result.IsCanceled,
+		},
+	})
 
 	return diags
 }
@@ -420,16 +431,12 @@ func ResourceVbrUnstructuredDataServerRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 	var diags diag.Diagnostics
-	url := client.BuildAPIURL(fmt.Sprintf("/api/v1/inventory/unstructuredDataServers/%s", url.PathEscape(d.Id())))
+	url := client.BuildAPIURL(vc.Endpoint("/api/v1/inventory/unstructuredDataServers/%s", d.Id()))
 	respBody, err := client.DoRequest(ctx, "GET", url, nil)
 	if err != nil {
-		if isNotFoundError(err) {
-			d.SetId("")
+		if vc.RemoveFromStateIfGone(d, err) {
 			return diags
 		}
-		if respBody != nil {
-			return diag.FromErr(fmt.Errorf("API error: %v, response: %s", err, string(respBody)))
-		}
 		return diag.FromErr(err)
 	}
 
@@ -493,7 +500,7 @@ func ResourceVbrUnstructuredDataServerUpdate(ctx context.Context, d *schema.Reso
 	}
 	resourceID := d.Id()
 	unstructuredDataServer.ID = &resourceID
-	url := client.BuildAPIURL(fmt.Sprintf("/api/v1/inventory/unstructuredDataServers/%s", url.PathEscape(d.Id())))
+	url := client.BuildAPIURL(vc.Endpoint("/api/v1/inventory/unstructuredDataServers/%s", d.Id()))
 	reqBodyBytes, err := json.Marshal(unstructuredDataServer)
 	if err != nil {
 		return diag.FromErr(err)
@@ -526,9 +533,9 @@ func ResourceVbrUnstructuredDataServerDelete(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 	var diags diag.Diagnostics
-	url := client.BuildAPIURL(fmt.Sprintf("/api/v1/inventory/unstructuredDataServers/%s", url.PathEscape(d.Id())))
+	url := client.BuildAPIURL(vc.Endpoint("/api/v1/inventory/unstructuredDataServers/%s", d.Id()))
 	_, err = client.DoRequest(ctx, "DELETE", url, nil)
-	if err != nil {
+	if err != nil && !vc.IsGone(err) {
 		return diag.FromErr(err)
 	}
 	d.SetId("")
@@ -536,14 +543,6 @@ func ResourceVbrUnstructuredDataServerDelete(ctx context.Context, d *schema.Reso
 }
 
 // Helper function to expand resource data into VbrUnstructuredDataServer struct
-// isNotFoundError checks if an error is a 404 Not Found error
-func isNotFoundError(err error) bool {
-	if err == nil {
-		return false
-	}
-	return strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found")
-}
-
 func expandVbrUnstructuredDataServer(d *schema.ResourceData) (*VbrUnstructuredDataServer, error) {
 	unstructuredDataServer := &VbrUnstructuredDataServer{
 		Type: d.Get("type").(string),
@@ -656,14 +655,31 @@ func expandVbrUnstructuredDataServer(d *schema.ResourceData) (*VbrUnstructuredDa
 	return unstructuredDataServer, nil
 }
 
+// responseSession is the part of a response that tells a session, which VBR returns for changes
+// it applies in the background, from the object itself
+type responseSession struct {
+	ID          string `json:"id"`
+	SessionType string `json:"sessionType"`
+}
+
+// waitForResponseSession waits for the session respBody describes, if it describes one, and
+// reports whether it did
+func waitForResponseSession(ctx context.Context, client *vc.VBRClient, respBody []byte) (bool, error) {
+	var session responseSession
+	if len(respBody) == 0 || json.Unmarshal(respBody, &session) != nil || session.SessionType == "" || session.ID == "" {
+		return false, nil
+	}
+	return true, waitForVbrSession(ctx, client, session.ID)
+}
+
 // waitForVbrSession polls a VBR session until it completes
 func waitForVbrSession(ctx context.Context, client *vc.VBRClient, sessionID string) error {
-	sessionURL := client.BuildAPIURL(fmt.Sprintf("/api/v1/sessions/%s", url.PathEscape(sessionID)))
+	sessionURL := client.BuildAPIURL(vc.Endpoint("/api/v1/sessions/%s", sessionID))
 
 	for {
 		select {
 		case <-ctx.Done():
-			return fmt.Errorf("session polling cancelled by context")
+			return fmt.Errorf("timed out waiting for session %s: %w", sessionID, ctx.Err())
 		default:
 		}
 
@@ -687,7 +703,10 @@ func waitForVbrSession(ctx context.Context, client *vc.VBRClient, sessionID stri
 			return fmt.Errorf("session failed: %s", session.Result.Message)
 		case "Working":
 			// Continue polling
-			time.Sleep(5 * time.Second)
+			select {
+			case <-ctx.Done():
+			case <-time.After(5 * time.Second):
+			}
 			continue
 		default:
 			return fmt.Errorf("unknown session state: %s", session.State)
@@ -719,7 +738,7 @@ func findUnstructuredDataServer(ctx context.Context, client *vc.VBRClient, serve
 		}
 	}
 
-	listURL := client.BuildAPIURL(fmt.Sprintf("/api/v1/inventory/unstructuredDataServers?%s", queryParams.Encode()))
+	listURL := client.BuildAPIURL(vc.WithQuery("/api/v1/inventory/unstructuredDataServers", queryParams))
 	respBody, err := client.DoRequest(ctx, "GET", listURL, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to list unstructured data servers: %w", err)
@@ -0,0 +1,430 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	vc "terraform-provider-veeambackup/internal/client"
+	"terraform-provider-veeambackup/internal/vbr/schedule"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cloudDirectorJobType is the VBR type of VMware Cloud Director backup jobs
+const cloudDirectorJobType = "CloudDirectorBackup"
+
+// Cloud Director object types that backup jobs can include
+var cloudDirectorObjectTypes = []string{"CloudDirectorServer", "Organization", "OrganizationVDC", "vApp", "VirtualMachine", "StoragePolicy"}
+
+type VbrCloudDirectorBackupJob struct {
+	ID              *string                          `json:"id,omitempty"` // Used for update operations
+	Name            string                           `json:"name"`
+	Type            string                           `json:"type"`
+	Description     *string                          `json:"description,omitempty"`
+	IsHighPriority  *bool                            `json:"isHighPriority,omitempty"`
+	IsDisabled      *bool                            `json:"isDisabled,omitempty"` // Used for update operations
+	VirtualMachines VbrCloudDirectorBackupJobVMs     `json:"virtualMachines"`
+	Storage         VbrCloudDirectorBackupJobStorage `json:"storage"`
+	Schedule        *schedule.Schedule               `json:"schedule,omitempty"`
+}
+
+type VbrCloudDirectorBackupJobVMs struct {
+	Includes []VbrCloudDirectorObject             `json:"includes"`
+	Excludes *VbrCloudDirectorBackupJobExclusions `json:"excludes,omitempty"`
+}
+
+type VbrCloudDirectorBackupJobExclusions struct {
+	VMs []VbrCloudDirectorObject `json:"vms,omitempty"`
+}
+
+// VbrCloudDirectorObject is a Cloud Director object as jobs include or exclude it
+type VbrCloudDirectorObject struct {
+	Platform string  `json:"platform"`
+	HostName string  `json:"hostName"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	ObjectID *string `json:"objectId,omitempty"`
+}
+
+type VbrCloudDirectorBackupJobStorage struct {
+	BackupRepositoryID string                     `json:"backupRepositoryId"`
+	BackupProxies      *VbrBackupJobBackupProxies `json:"backupProxies,omitempty"`
+	RetentionPolicy    *schedule.RetentionPolicy  `json:"retentionPolicy,omitempty"`
+}
+
+type VbrBackupJobBackupProxies struct {
+	AutoSelection bool     `json:"autoSelection"`
+	ProxyIDs      []string `json:"proxyIds,omitempty"`
+}
+
+func ResourceVbrVmwareCloudDirectorJob() *schema.Resource {
+	cloudDirectorObject := func(types []string) *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Cloud Director server the object belongs to, as added to VBR.",
+				},
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the object.",
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(types, false),
+					Description:  "The type of the object.",
+				},
+				"object_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "The ID of the object in Cloud Director. VBR resolves it from the name when not set.",
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Description:   "Manages a Veeam Backup & Replication backup job for VMware Cloud Director, which protects the VMs of Cloud Director organizations, organization VDCs or vApps.",
+		CreateContext: resourceVBRCloudDirectorJobCreate,
+		ReadContext:   resourceVBRCloudDirectorJobRead,
+		UpdateContext: resourceVBRCloudDirectorJobUpdate,
+		DeleteContext: resourceVBRCloudDirectorJobDelete,
+		CustomizeDiff: validateJobReferences(
+			repositoryReference("storage.0.backup_repository_id"),
+			proxyReference("storage.0.backup_proxies.0.proxy_ids"),
+		),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the backup job.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the backup job.",
+			},
+			"is_high_priority": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies if the backup job is high priority.",
+			},
+			"is_disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies if the backup job is disabled. Only applied when updating an existing job.",
+			},
+			"includes": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The Cloud Director objects the job backs up the VMs of: CloudDirectorServer, Organization, OrganizationVDC, vApp, VirtualMachine or StoragePolicy.",
+				Elem:        cloudDirectorObject(cloudDirectorObjectTypes),
+			},
+			"excluded_vms": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The VMs of the included objects that the job does not back up.",
+				Elem:        cloudDirectorObject([]string{"VirtualMachine"}),
+			},
+			"storage": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The storage settings of the backup job.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_repository_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+							Description:  "The ID of the backup repository.",
+						},
+						"backup_proxies": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The backup proxies that process the VMs. VBR selects them automatically when not set.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_selection": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     true,
+										Description: "Whether VBR selects the backup proxies automatically. When false, proxy_ids are used.",
+									},
+									"proxy_ids": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Description: "The IDs of the backup proxies used when auto_selection is false, e.g. the least_loaded_proxy_id of the veeambackup_vbr_proxies_load data source.",
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.IsUUID,
+										},
+									},
+								},
+							},
+						},
+						"retention_policy": schedule.RetentionPolicySchema("The retention policy of the backups."),
+					},
+				},
+			},
+			"schedule":                 schedule.Schema(),
+			"additional_settings_json": additionalSettingsJSONSchema(),
+			"delete_backups":           deleteBackupsSchema(),
+			"prevent_destroy_data":     preventDestroyDataSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			schedule.WarnDisabledSchedule,
+		},
+	}
+}
+
+// expandVBRCloudDirectorJob builds the job payload from the configuration
+func expandVBRCloudDirectorJob(ctx context.Context, client *vc.VBRClient, d *schema.ResourceData) (*VbrCloudDirectorBackupJob, error) {
+	job := &VbrCloudDirectorBackupJob{
+		Name:           d.Get("name").(string),
+		Type:           cloudDirectorJobType,
+		Description:    getStringPtr(client.AppendDescriptionSuffix(d.Get("description").(string))),
+		IsHighPriority: getBoolPtr(d.Get("is_high_priority")),
+		VirtualMachines: VbrCloudDirectorBackupJobVMs{
+			Includes: expandVBRCloudDirectorObjects(d.Get("includes").([]interface{})),
+		},
+		Storage: expandVBRCloudDirectorJobStorage(d.Get("storage").([]interface{})),
+	}
+	if excluded := expandVBRCloudDirectorObjects(d.Get("excluded_vms").([]interface{})); len(excluded) > 0 {
+		job.VirtualMachines.Excludes = &VbrCloudDirectorBackupJobExclusions{VMs: excluded}
+	}
+
+	if v, ok := d.GetOk("schedule"); ok {
+		job.Schedule = schedule.Expand(v.([]interface{}))
+	}
+	if err := resolveAfterThisJob(ctx, client, job.Schedule); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func resourceVBRCloudDirectorJobCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	job, err := expandVBRCloudDirectorJob(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	reqBodyBytes, err := marshalJob(d, job)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respBodyBytes, err := client.DoRequest(ctx, "POST", client.BuildAPIURL("/api/v1/jobs"), reqBodyBytes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resp VbrCloudDirectorBackupJob
+	if err := json.Unmarshal(respBodyBytes, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.ID != nil {
+		d.SetId(*resp.ID)
+	}
+	return resourceVBRCloudDirectorJobRead(ctx, d, m)
+}
+
+func resourceVBRCloudDirectorJobRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	respBodyBytes, err := client.DoRequest(ctx, "GET", client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s", d.Id())), nil)
+	if err != nil {
+		if vc.RemoveFromStateIfGone(d, err) {
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	var resp VbrCloudDirectorBackupJob
+	if err := json.Unmarshal(respBodyBytes, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", resp.Name)
+	var description string
+	if resp.Description != nil {
+		description = *resp.Description
+	}
+	d.Set("description", client.TrimDescriptionSuffix(description))
+	d.Set("is_high_priority", resp.IsHighPriority)
+	if err := d.Set("includes", flattenVBRCloudDirectorObjects(resp.VirtualMachines.Includes)); err != nil {
+		return diag.FromErr(err)
+	}
+	var excluded []VbrCloudDirectorObject
+	if resp.VirtualMachines.Excludes != nil {
+		excluded = resp.VirtualMachines.Excludes.VMs
+	}
+	if err := d.Set("excluded_vms", flattenVBRCloudDirectorObjects(excluded)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("storage", flattenVBRCloudDirectorJobStorage(resp.Storage, d)); err != nil {
+		return diag.FromErr(err)
+	}
+	// The schedule is only read back when configured, since the server returns a default schedule otherwise
+	if _, ok := d.GetOk("schedule"); ok {
+		if err := readAfterThisJobID(ctx, client, d, resp.Schedule); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("schedule", schedule.Flatten(resp.Schedule)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := readAdditionalSettings(d, respBodyBytes); err != nil {
+		return diag.FromErr(err)
+	}
+	readJobDeletionSettings(d)
+
+	return diags
+}
+
+func resourceVBRCloudDirectorJobUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if onlyJobDeletionSettingsChanged(d) {
+		return nil
+	}
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	jobID := d.Id()
+	client.LockJob(jobID)
+	defer client.UnlockJob(jobID)
+
+	job, err := expandVBRCloudDirectorJob(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	job.ID = &jobID
+	job.IsDisabled = getBoolPtr(d.Get("is_disabled"))
+	reqBodyBytes, err := marshalJob(d, job)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.DoRequest(ctx, "PUT", client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s", jobID)), reqBodyBytes); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceVBRCloudDirectorJobRead(ctx, d, m)
+}
+
+func resourceVBRCloudDirectorJobDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err := checkJobDeletion(d, d.Get("name").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := vc.GetVBRClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := deleteJob(ctx, client, d, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
+
+func expandVBRCloudDirectorObjects(input []interface{}) []VbrCloudDirectorObject {
+	result := make([]VbrCloudDirectorObject, 0, len(input))
+	for _, v := range input {
+		m := v.(map[string]interface{})
+		result = append(result, VbrCloudDirectorObject{
+			Platform: "CloudDirector",
+			HostName: m["host_name"].(string),
+			Name:     m["name"].(string),
+			Type:     m["type"].(string),
+			ObjectID: getStringPtr(m["object_id"]),
+		})
+	}
+	return result
+}
+
+func flattenVBRCloudDirectorObjects(objects []VbrCloudDirectorObject) []interface{} {
+	result := make([]interface{}, 0, len(objects))
+	for _, object := range objects {
+		var objectID string
+		if object.ObjectID != nil {
+			objectID = *object.ObjectID
+		}
+		result = append(result, map[string]interface{}{
+			"host_name": object.HostName,
+			"name":      object.Name,
+			"type":      object.Type,
+			"object_id": objectID,
+		})
+	}
+	return result
+}
+
+func expandVBRCloudDirectorJobStorage(input []interface{}) VbrCloudDirectorBackupJobStorage {
+	var storage VbrCloudDirectorBackupJobStorage
+	if len(input) == 0 || input[0] == nil {
+		return storage
+	}
+	m := input[0].(map[string]interface{})
+	storage.BackupRepositoryID = m["backup_repository_id"].(string)
+	if proxies, ok := m["backup_proxies"].([]interface{}); ok && len(proxies) > 0 && proxies[0] != nil {
+		p := proxies[0].(map[string]interface{})
+		storage.BackupProxies = &VbrBackupJobBackupProxies{AutoSelection: p["auto_selection"].(bool)}
+		if ids, ok := p["proxy_ids"].(*schema.Set); ok {
+			for _, id := range ids.List() {
+				storage.BackupProxies.ProxyIDs = append(storage.BackupProxies.ProxyIDs, id.(string))
+			}
+		}
+	}
+	if v, ok := m["retention_policy"].([]interface{}); ok {
+		storage.RetentionPolicy = schedule.ExpandRetentionPolicy(v)
+	}
+	return storage
+}
+
+// flattenVBRCloudDirectorJobStorage returns the storage settings of a job. The backup proxies and
+// retention policy are only read back when configured, since the server returns defaults otherwise.
+func flattenVBRCloudDirectorJobStorage(storage VbrCloudDirectorBackupJobStorage, d *schema.ResourceData) []interface{} {
+	m := map[string]interface{}{
+		"backup_repository_id": storage.BackupRepositoryID,
+	}
+	if _, ok := d.GetOk("storage.0.backup_proxies"); ok && storage.BackupProxies != nil {
+		proxyIDs := make([]interface{}, 0, len(storage.BackupProxies.ProxyIDs))
+		for _, id := range storage.BackupProxies.ProxyIDs {
+			proxyIDs = append(proxyIDs, id)
+		}
+		m["backup_proxies"] = []interface{}{map[string]interface{}{
+			"auto_selection": storage.BackupProxies.AutoSelection,
+			"proxy_ids":      proxyIDs,
+		}}
+	}
+	if _, ok := d.GetOk("storage.0.retention_policy"); ok {
+		m["retention_policy"] = schedule.FlattenRetentionPolicy(storage.RetentionPolicy)
+	}
+	return []interface{}{m}
+}
@@ -0,0 +1,74 @@
+package vbr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCloudDirectorJobRoundTrip(t *testing.T) {
+	r := ResourceVbrVmwareCloudDirectorJob()
+	vApp := map[string]interface{}{"host_name": "vcd.example.com", "name": "web", "type": "vApp", "object_id": "urn:vcloud:vapp:1"}
+	vm := map[string]interface{}{"host_name": "vcd.example.com", "name": "web-01", "type": "VirtualMachine"}
+
+	for name, raw := range map[string]map[string]interface{}{
+		"repository only": {
+			"includes": []interface{}{vApp},
+			"storage":  []interface{}{map[string]interface{}{"backup_repository_id": "00000000-0000-0000-0000-000000000001"}},
+		},
+		"exclusions, proxies and retention": {
+			"includes":     []interface{}{vApp},
+			"excluded_vms": []interface{}{vm},
+			"storage": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-000000000001",
+				"backup_proxies": []interface{}{map[string]interface{}{
+					"auto_selection": false,
+					"proxy_ids":      []interface{}{"00000000-0000-0000-0000-000000000002"},
+				}},
+				"retention_policy": []interface{}{map[string]interface{}{"type": "RestorePoints", "quantity": 14}},
+			}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, r.Schema, raw)
+			includes := expandVBRCloudDirectorObjects(d.Get("includes").([]interface{}))
+			excluded := expandVBRCloudDirectorObjects(d.Get("excluded_vms").([]interface{}))
+			storage := expandVBRCloudDirectorJobStorage(d.Get("storage").([]interface{}))
+
+			// The job is read back from the API, so the expanded job also passes through JSON
+			body, err := json.Marshal(VbrCloudDirectorBackupJob{
+				VirtualMachines: VbrCloudDirectorBackupJobVMs{Includes: includes, Excludes: &VbrCloudDirectorBackupJobExclusions{VMs: excluded}},
+				Storage:         storage,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var job VbrCloudDirectorBackupJob
+			if err := json.Unmarshal(body, &job); err != nil {
+				t.Fatal(err)
+			}
+
+			for key, value := range map[string]interface{}{
+				"includes":     flattenVBRCloudDirectorObjects(job.VirtualMachines.Includes),
+				"excluded_vms": flattenVBRCloudDirectorObjects(job.VirtualMachines.Excludes.VMs),
+				"storage":      flattenVBRCloudDirectorJobStorage(job.Storage, d),
+			} {
+				if err := d.Set(key, value); err != nil {
+					t.Fatalf("setting %s: %s", key, err)
+				}
+			}
+
+			if got := expandVBRCloudDirectorObjects(d.Get("includes").([]interface{})); !reflect.DeepEqual(got, includes) {
+				t.Errorf("includes round trip:\n got %+v\nwant %+v", got, includes)
+			}
+			if got := expandVBRCloudDirectorObjects(d.Get("excluded_vms").([]interface{})); !reflect.DeepEqual(got, excluded) {
+				t.Errorf("excluded_vms round trip:\n got %+v\nwant %+v", got, excluded)
+			}
+			if got := expandVBRCloudDirectorJobStorage(d.Get("storage").([]interface{})); !reflect.DeepEqual(got, storage) {
+				t.Errorf("storage round trip:\n got %+v\nwant %+v", got, storage)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package schedule
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// expandBlock expands the single item of a MaxItems: 1 list block with fn. It returns nil when
+// the block is not set.
+func expandBlock[T any](input []interface{}, fn func(m map[string]interface{}) *T) *T {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	return fn(input[0].(map[string]interface{}))
+}
+
+// nestedBlock expands the block stored under key in m
+func nestedBlock[T any](m map[string]interface{}, key string, fn func(m map[string]interface{}) *T) *T {
+	v, _ := m[key].([]interface{})
+	return expandBlock(v, fn)
+}
+
+func stringPtr(m map[string]interface{}, key string) *string {
+	if s, ok := m[key].(string); ok && s != "" {
+		return &s
+	}
+	return nil
+}
+
+func intPtr(m map[string]interface{}, key string) *int {
+	if i, ok := m[key].(int); ok {
+		return &i
+	}
+	return nil
+}
+
+func boolPtr(m map[string]interface{}, key string) *bool {
+	if b, ok := m[key].(bool); ok {
+		return &b
+	}
+	return nil
+}
+
+// listItems returns the elements of a list or set attribute value
+func listItems(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+func stringList(m map[string]interface{}, key string) *[]string {
+	items := listItems(m[key])
+	if len(items) == 0 {
+		return nil
+	}
+	result := make([]string, len(items))
+	for i, item := range items {
+		result[i], _ = item.(string)
+	}
+	return &result
+}
+
+// Expand converts the schedule block into the API model
+func Expand(input []interface{}) *Schedule {
+	return expandBlock(input, func(m map[string]interface{}) *Schedule {
+		return &Schedule{
+			RunAutomatically: m["run_automatically"].(bool),
+			Daily:            nestedBlock(m, "daily", expandDaily),
+			Monthly:          nestedBlock(m, "monthly", expandMonthly),
+			Periodically:     nestedBlock(m, "periodically", expandPeriodically),
+			Continuously:     nestedBlock(m, "continuously", expandContinuously),
+			AfterThisJob:     nestedBlock(m, "after_this_job", expandAfterThisJob),
+			Retry:            nestedBlock(m, "retry", expandRetry),
+			BackupWindow:     nestedBlock(m, "backup_window", expandWindowOption),
+		}
+	})
+}
+
+func expandDaily(m map[string]interface{}) *Daily {
+	return &Daily{
+		IsEnabled: m["is_enabled"].(bool),
+		LocalTime: stringPtr(m, "local_time"),
+		DailyKind: stringPtr(m, "daily_kind"),
+		Days:      stringList(m, "days"),
+	}
+}
+
+func expandMonthly(m map[string]interface{}) *Monthly {
+	return &Monthly{
+		IsEnabled:        m["is_enabled"].(bool),
+		DayOfWeek:        stringPtr(m, "day_of_week"),
+		DayNumberInMonth: stringPtr(m, "day_number_in_month"),
+		DayOfMonth:       intPtr(m, "day_of_month"),
+		Months:           stringList(m, "months"),
+		LocalTime:        stringPtr(m, "local_time"),
+		IsLastDayOfMonth: boolPtr(m, "is_last_day_of_month"),
+	}
+}
+
+func expandPeriodically(m map[string]interface{}) *Periodically {
+	return &Periodically{
+		IsEnabled:           m["is_enabled"].(bool),
+		PeriodicallyKind:    stringPtr(m, "periodically_kind"),
+		Frequency:           intPtr(m, "frequency"),
+		BackupWindow:        nestedBlock(m, "backup_window", expandBackupWindow),
+		StartTimeWithinHour: intPtr(m, "start_time_within_hour"),
+	}
+}
+
+func expandContinuously(m map[string]interface{}) *Continuously {
+	return &Continuously{
+		IsEnabled:    m["is_enabled"].(bool),
+		BackupWindow: nestedBlock(m, "backup_window", expandBackupWindow),
+	}
+}
+
+func expandAfterThisJob(m map[string]interface{}) *AfterThisJob {
+	return &AfterThisJob{
+		IsEnabled: m["is_enabled"].(bool),
+		JobName:   stringPtr(m, "job_name"),
+		JobID:     stringPtr(m, "job_id"),
+	}
+}
+
+func expandRetry(m map[string]interface{}) *Retry {
+	return &Retry{
+		IsEnabled:    m["is_enabled"].(bool),
+		RetryCount:   intPtr(m, "retry_count"),
+		AwaitMinutes: intPtr(m, "await_minutes"),
+	}
+}
+
+func expandWindowOption(m map[string]interface{}) *WindowOption {
+	return &WindowOption{
+		IsEnabled:    m["is_enabled"].(bool),
+		BackupWindow: nestedBlock(m, "backup_window", expandBackupWindow),
+	}
+}
+
+func expandBackupWindow(m map[string]interface{}) *BackupWindow {
+	window := &BackupWindow{}
+	days := listItems(m["days"])
+	for _, d := range days {
+		day := d.(map[string]interface{})
+		hours := day["hours"].(string)
+		if normalized, err := NormalizeWindowHours(hours); err == nil {
+			hours = normalized
+		}
+		window.Days = append(window.Days, BackupWindowDay{
+			Day:   day["day"].(string),
+			Hours: hours,
+		})
+	}
+	return window
+}
+
+// ExpandRetentionPolicy converts a retention policy block into the API model
+func ExpandRetentionPolicy(input []interface{}) *RetentionPolicy {
+	return expandBlock(input, func(m map[string]interface{}) *RetentionPolicy {
+		return &RetentionPolicy{
+			Type:     m["type"].(string),
+			Quantity: m["quantity"].(int),
+		}
+	})
+}
+
+// ExpandNotifications converts the notifications block into the API model
+func ExpandNotifications(input []interface{}) *Notifications {
+	return expandBlock(input, func(m map[string]interface{}) *Notifications {
+		return &Notifications{
+			SendSNMPNotifications:           boolPtr(m, "send_snmp_notifications"),
+			EmailNotifications:              nestedBlock(m, "email_notifications", expandEmailNotifications),
+			TriggerIssueJobWarning:          boolPtr(m, "trigger_issue_job_warning"),
+			TriggerAttributeIssueJobWarning: boolPtr(m, "trigger_attribute_issue_job_warning"),
+		}
+	})
+}
+
+func expandEmailNotifications(m map[string]interface{}) *EmailNotifications {
+	return &EmailNotifications{
+		IsEnabled:                  m["is_enabled"].(bool),
+		Recipients:                 stringList(m, "recipients"),
+		NotificationType:           stringPtr(m, "notification_type"),
+		CustomNotificationSettings: nestedBlock(m, "custom_notification_settings", expandCustomNotificationSettings),
+	}
+}
+
+func expandCustomNotificationSettings(m map[string]interface{}) *CustomNotificationSettings {
+	return &CustomNotificationSettings{
+		Subject:                            stringPtr(m, "subject"),
+		NotifyOnSuccess:                    boolPtr(m, "notify_on_success"),
+		NotifyOnWarning:                    boolPtr(m, "notify_on_warning"),
+		NotifyOnError:                      boolPtr(m, "notify_on_error"),
+		SuppressNotificationUntilLastRetry: boolPtr(m, "suppress_notification_until_last_retry"),
+	}
+}
@@ -0,0 +1,163 @@
+package schedule
+
+// flattenBlock converts v into the single-item list stored for a MaxItems: 1 block. It returns an
+// empty list when v is nil so that the block is cleared from state.
+func flattenBlock[T any](v *T, fn func(v *T) map[string]interface{}) []interface{} {
+	if v == nil {
+		return []interface{}{}
+	}
+	return []interface{}{fn(v)}
+}
+
+// deref returns the value p points to, or the zero value when p is nil
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+func flattenStringList(p *[]string) []interface{} {
+	if p == nil {
+		return []interface{}{}
+	}
+	result := make([]interface{}, len(*p))
+	for i, s := range *p {
+		result[i] = s
+	}
+	return result
+}
+
+// Flatten converts the API schedule into the value stored for the schedule block
+func Flatten(s *Schedule) []interface{} {
+	return flattenBlock(s, func(s *Schedule) map[string]interface{} {
+		return map[string]interface{}{
+			"run_automatically": s.RunAutomatically,
+			"daily":             flattenBlock(s.Daily, flattenDaily),
+			"monthly":           flattenBlock(s.Monthly, flattenMonthly),
+			"periodically":      flattenBlock(s.Periodically, flattenPeriodically),
+			"continuously":      flattenBlock(s.Continuously, flattenContinuously),
+			"after_this_job":    flattenBlock(s.AfterThisJob, flattenAfterThisJob),
+			"retry":             flattenBlock(s.Retry, flattenRetry),
+			"backup_window":     flattenBlock(s.BackupWindow, flattenWindowOption),
+		}
+	})
+}
+
+func flattenDaily(d *Daily) map[string]interface{} {
+	return map[string]interface{}{
+		"is_enabled": d.IsEnabled,
+		"local_time": deref(d.LocalTime),
+		"daily_kind": deref(d.DailyKind),
+		"days":       flattenStringList(d.Days),
+	}
+}
+
+func flattenMonthly(m *Monthly) map[string]interface{} {
+	return map[string]interface{}{
+		"is_enabled":           m.IsEnabled,
+		"day_of_week":          deref(m.DayOfWeek),
+		"day_number_in_month":  deref(m.DayNumberInMonth),
+		"day_of_month":         deref(m.DayOfMonth),
+		"months":               flattenStringList(m.Months),
+		"local_time":           deref(m.LocalTime),
+		"is_last_day_of_month": deref(m.IsLastDayOfMonth),
+	}
+}
+
+func flattenPeriodically(p *Periodically) map[string]interface{} {
+	return map[string]interface{}{
+		"is_enabled":             p.IsEnabled,
+		"periodically_kind":      deref(p.PeriodicallyKind),
+		"frequency":              deref(p.Frequency),
+		"backup_window":          flattenBlock(p.BackupWindow, flattenBackupWindow),
+		"start_time_within_hour": deref(p.StartTimeWithinHour),
+	}
+}
+
+func flattenContinuously(c *Continuously) map[string]interface{} {
+	return map[string]interface{}{
+		"is_enabled":    c.IsEnabled,
+		"backup_window": flattenBlock(c.BackupWindow, flattenBackupWindow),
+	}
+}
+
+func flattenAfterThisJob(a *AfterThisJob) map[string]interface{} {
+	return map[string]interface{}{
+		"is_enabled": a.IsEnabled,
+		"job_name":   deref(a.JobName),
+		"job_id":     deref(a.JobID),
+	}
+}
+
+func flattenRetry(r *Retry) map[string]interface{} {
+	return map[string]interface{}{
+		"is_enabled":    r.IsEnabled,
+		"retry_count":   deref(r.RetryCount),
+		"await_minutes": deref(r.AwaitMinutes),
+	}
+}
+
+func flattenWindowOption(w *WindowOption) map[string]interface{} {
+	return map[string]interface{}{
+		"is_enabled":    w.IsEnabled,
+		"backup_window": flattenBlock(w.BackupWindow, flattenBackupWindow),
+	}
+}
+
+func flattenBackupWindow(w *BackupWindow) map[string]interface{} {
+	days := make([]interface{}, len(w.Days))
+	for i, d := range w.Days {
+		days[i] = map[string]interface{}{
+			"day":   d.Day,
+			"hours": d.Hours,
+		}
+	}
+	return map[string]interface{}{
+		"days": days,
+	}
+}
+
+// FlattenRetentionPolicy converts the API retention policy into the value stored for a retention
+// policy block
+func FlattenRetentionPolicy(r *RetentionPolicy) []interface{} {
+	return flattenBlock(r, func(r *RetentionPolicy) map[string]interface{} {
+		return map[string]interface{}{
+			"type":     r.Type,
+			"quantity": r.Quantity,
+		}
+	})
+}
+
+// FlattenNotifications converts the API notification settings into the value stored for the
+// notifications block
+func FlattenNotifications(n *Notifications) []interface{} {
+	return flattenBlock(n, func(n *Notifications) map[string]interface{} {
+		return map[string]interface{}{
+			"send_snmp_notifications":             deref(n.SendSNMPNotifications),
+			"email_notifications":                 flattenBlock(n.EmailNotifications, flattenEmailNotifications),
+			"trigger_issue_job_warning":           deref(n.TriggerIssueJobWarning),
+			"trigger_attribute_issue_job_warning": deref(n.TriggerAttributeIssueJobWarning),
+		}
+	})
+}
+
+func flattenEmailNotifications(e *EmailNotifications) map[string]interface{} {
+	return map[string]interface{}{
+		"is_enabled":                   e.IsEnabled,
+		"recipients":                   flattenStringList(e.Recipients),
+		"notification_type":            deref(e.NotificationType),
+		"custom_notification_settings": flattenBlock(e.CustomNotificationSettings, flattenCustomNotificationSettings),
+	}
+}
+
+func flattenCustomNotificationSettings(c *CustomNotificationSettings) map[string]interface{} {
+	return map[string]interface{}{
+		"subject":                                deref(c.Subject),
+		"notify_on_success":                      deref(c.NotifyOnSuccess),
+		"notify_on_warning":                      deref(c.NotifyOnWarning),
+		"notify_on_error":                        deref(c.NotifyOnError),
+		"suppress_notification_until_last_retry": deref(c.SuppressNotificationUntilLastRetry),
+	}
+}
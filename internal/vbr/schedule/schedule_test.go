@@ -0,0 +1,433 @@
+package schedule
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"schedule":         Schema(),
+		"retention_policy": RetentionPolicySchema("The retention policy."),
+		"notifications":    NotificationsSchema(),
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	raw := map[string]interface{}{
+		"schedule": []interface{}{
+			map[string]interface{}{
+				"run_automatically": true,
+				"daily": []interface{}{
+					map[string]interface{}{
+						"is_enabled": true,
+						"local_time": "22:00",
+						"daily_kind": "SelectedDays",
+						"days":       []interface{}{"monday", "friday"},
+					},
+				},
+				"periodically": []interface{}{
+					map[string]interface{}{
+						"is_enabled":        false,
+						"periodically_kind": "Hours",
+						"frequency":         4,
+						"backup_window": []interface{}{
+							map[string]interface{}{
+								"days": []interface{}{
+									map[string]interface{}{"day": "sunday", "hours": "1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1"},
+								},
+							},
+						},
+					},
+				},
+				"retry": []interface{}{
+					map[string]interface{}{
+						"is_enabled":    true,
+						"retry_count":   3,
+						"await_minutes": 10,
+					},
+				},
+			},
+		},
+		"retention_policy": []interface{}{
+			map[string]interface{}{"type": "Days", "quantity": 30},
+		},
+		"notifications": []interface{}{
+			map[string]interface{}{
+				"send_snmp_notifications": true,
+				"email_notifications": []interface{}{
+					map[string]interface{}{
+						"is_enabled":        true,
+						"recipients":        []interface{}{"ops@example.com"},
+						"notification_type": "UseCustomNotificationSettings",
+						"custom_notification_settings": []interface{}{
+							map[string]interface{}{"subject": "[%JobResult%] %JobName%", "notify_on_error": true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, testSchema(), raw)
+	s := Expand(d.Get("schedule").([]interface{}))
+	r := ExpandRetentionPolicy(d.Get("retention_policy").([]interface{}))
+	n := ExpandNotifications(d.Get("notifications").([]interface{}))
+
+	if s == nil || s.Daily == nil || s.Daily.Days == nil || len(*s.Daily.Days) != 2 {
+		t.Fatalf("daily schedule not expanded: %+v", s)
+	}
+	if s.Monthly != nil {
+		t.Errorf("monthly = %+v, want nil when the block is not set", s.Monthly)
+	}
+	if s.Periodically.BackupWindow == nil || len(s.Periodically.BackupWindow.Days) != 1 {
+		t.Errorf("periodically backup window not expanded: %+v", s.Periodically)
+	}
+	if s.Daily.LocalTime == nil || *s.Daily.LocalTime != "22:00" || s.AfterThisJob != nil {
+		t.Errorf("unexpected schedule: %+v", s)
+	}
+	if n.EmailNotifications.NotificationType == nil || n.EmailNotifications.CustomNotificationSettings.Subject == nil {
+		t.Errorf("notifications not expanded: %+v", n.EmailNotifications)
+	}
+
+	flattened := schema.TestResourceDataRaw(t, testSchema(), map[string]interface{}{})
+	if err := flattened.Set("schedule", Flatten(s)); err != nil {
+		t.Fatalf("setting schedule: %s", err)
+	}
+	if err := flattened.Set("retention_policy", FlattenRetentionPolicy(r)); err != nil {
+		t.Fatalf("setting retention_policy: %s", err)
+	}
+	if err := flattened.Set("notifications", FlattenNotifications(n)); err != nil {
+		t.Fatalf("setting notifications: %s", err)
+	}
+
+	if got := Expand(flattened.Get("schedule").([]interface{})); !reflect.DeepEqual(got, s) {
+		t.Errorf("schedule round trip:\n got %+v\nwant %+v", got, s)
+	}
+	if got := ExpandRetentionPolicy(flattened.Get("retention_policy").([]interface{})); !reflect.DeepEqual(got, r) {
+		t.Errorf("retention policy round trip: got %+v, want %+v", got, r)
+	}
+	if got := ExpandNotifications(flattened.Get("notifications").([]interface{})); !reflect.DeepEqual(got, n) {
+		t.Errorf("notifications round trip:\n got %+v\nwant %+v", got, n)
+	}
+}
+
+// TestRoundTripScheduleKinds checks that flattening an expanded schedule and expanding it again is
+// lossless for the schedule kinds TestRoundTrip does not set
+func TestRoundTripScheduleKinds(t *testing.T) {
+	window := []interface{}{
+		map[string]interface{}{
+			"days": []interface{}{
+				map[string]interface{}{"day": "saturday", "hours": "0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,0,0,0,0,0,0"},
+			},
+		},
+	}
+	for name, schedule := range map[string]map[string]interface{}{
+		"monthly on a weekday": {
+			"monthly": []interface{}{
+				map[string]interface{}{
+					"is_enabled":          true,
+					"day_of_week":         "sunday",
+					"day_number_in_month": "Last",
+					"months":              []interface{}{"January", "July"},
+					"local_time":          "03:00",
+				},
+			},
+		},
+		"monthly on the last day": {
+			"monthly": []interface{}{
+				map[string]interface{}{"is_enabled": true, "is_last_day_of_month": true, "day_of_month": 1},
+			},
+		},
+		"periodically within the hour": {
+			"periodically": []interface{}{
+				map[string]interface{}{
+					"is_enabled":             true,
+					"periodically_kind":      "Minutes",
+					"frequency":              30,
+					"start_time_within_hour": 15,
+				},
+			},
+		},
+		"continuously": {
+			"continuously": []interface{}{
+				map[string]interface{}{"is_enabled": true, "backup_window": window},
+			},
+		},
+		"after this job": {
+			"after_this_job": []interface{}{
+				map[string]interface{}{"is_enabled": true, "job_name": "Nightly", "job_id": "job-1"},
+			},
+		},
+		"backup window": {
+			"run_automatically": true,
+			"daily": []interface{}{
+				map[string]interface{}{"is_enabled": true, "local_time": "20:00", "daily_kind": "Everyday"},
+			},
+			"backup_window": []interface{}{
+				map[string]interface{}{"is_enabled": true, "backup_window": window},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, testSchema(), map[string]interface{}{"schedule": []interface{}{schedule}})
+			s := Expand(d.Get("schedule").([]interface{}))
+
+			flattened := schema.TestResourceDataRaw(t, testSchema(), map[string]interface{}{})
+			if err := flattened.Set("schedule", Flatten(s)); err != nil {
+				t.Fatalf("setting schedule: %s", err)
+			}
+			if got := Expand(flattened.Get("schedule").([]interface{})); !reflect.DeepEqual(got, s) {
+				t.Errorf("schedule round trip:\n got %+v\nwant %+v", got, s)
+			}
+		})
+	}
+}
+
+func TestExpandUnset(t *testing.T) {
+	if s := Expand(nil); s != nil {
+		t.Errorf("Expand(nil) = %+v, want nil", s)
+	}
+	if r := ExpandRetentionPolicy([]interface{}{}); r != nil {
+		t.Errorf("ExpandRetentionPolicy([]) = %+v, want nil", r)
+	}
+	if got := Flatten(nil); len(got) != 0 {
+		t.Errorf("Flatten(nil) = %v, want an empty list", got)
+	}
+}
+
+func TestSetOrderIgnored(t *testing.T) {
+	config := func(days ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"schedule": []interface{}{
+				map[string]interface{}{
+					"daily": []interface{}{
+						map[string]interface{}{"is_enabled": true, "days": days},
+					},
+				},
+			},
+		}
+	}
+
+	a := schema.TestResourceDataRaw(t, testSchema(), config("monday", "friday"))
+	b := schema.TestResourceDataRaw(t, testSchema(), config("friday", "monday"))
+	if got, want := Expand(b.Get("schedule").([]interface{})), Expand(a.Get("schedule").([]interface{})); !reflect.DeepEqual(got, want) {
+		t.Errorf("reordered days expanded differently:\n got %+v\nwant %+v", got.Daily, want.Daily)
+	}
+}
+
+func TestHourRangesToWindow(t *testing.T) {
+	tests := []struct {
+		ranges string
+		want   string
+	}{
+		{"", "0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0"},
+		{"0-23", "1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1"},
+		{"0-5, 22-23", "1,1,1,1,1,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1"},
+		{"12,3-4,4", "0,0,0,1,1,0,0,0,0,0,0,0,1,0,0,0,0,0,0,0,0,0,0,0"},
+	}
+	for _, tt := range tests {
+		got, err := HourRangesToWindow(tt.ranges)
+		if err != nil {
+			t.Errorf("HourRangesToWindow(%q): %s", tt.ranges, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("HourRangesToWindow(%q) = %s, want %s", tt.ranges, got, tt.want)
+		}
+	}
+
+	for _, ranges := range []string{"22-5", "24", "-1", "a-b", "1-2-3"} {
+		if _, err := HourRangesToWindow(ranges); err == nil {
+			t.Errorf("HourRangesToWindow(%q) succeeded, want an error", ranges)
+		}
+	}
+}
+
+func TestNormalizeWindowHours(t *testing.T) {
+	tests := []struct {
+		hours string
+		want  string
+	}{
+		{"1,1,1,1,1,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1", "1,1,1,1,1,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1"},
+		{"08:00-18:00", "0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,0,0,0,0,0,0"},
+		{"22:00-06:00", "1,1,1,1,1,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1"},
+		{"00:00-23:59", "1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1"},
+		{"00:00-24:00", "1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1"},
+		{"08:00-12:00, 13:00-13:59", "0,0,0,0,0,0,0,0,1,1,1,1,0,1,0,0,0,0,0,0,0,0,0,0"},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeWindowHours(tt.hours)
+		if err != nil {
+			t.Errorf("NormalizeWindowHours(%q): %s", tt.hours, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeWindowHours(%q) = %s, want %s", tt.hours, got, tt.want)
+		}
+	}
+
+	for _, hours := range []string{"", "1,1,1", "1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,2", "08:00", "08:30-18:00", "25:00-26:00", "08:00-08:00", "8-18"} {
+		if _, errs := ValidateWindowHours(hours, "hours"); len(errs) == 0 {
+			t.Errorf("ValidateWindowHours(%q) succeeded, want an error", hours)
+		}
+	}
+}
+
+func TestWindowFromSpecs(t *testing.T) {
+	none := "0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0"
+	evening := "0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1"
+	morning := "1,1,1,1,1,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0"
+	overnight := "1,1,1,1,1,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1,1,1"
+	allDay := "1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1,1"
+
+	tests := []struct {
+		specs []string
+		want  map[string]string
+	}{
+		{
+			specs: []string{"Mon-Fri 20:00-06:00"},
+			want: map[string]string{
+				"sunday": none, "monday": evening, "tuesday": overnight, "wednesday": overnight,
+				"thursday": overnight, "friday": overnight, "saturday": morning,
+			},
+		},
+		{
+			specs: []string{"weekdays 20:00-24:00", "Sat,Sun 00:00-23:59"},
+			want: map[string]string{
+				"sunday": allDay, "monday": evening, "tuesday": evening, "wednesday": evening,
+				"thursday": evening, "friday": evening, "saturday": allDay,
+			},
+		},
+		{
+			specs: []string{"Sat-Sun 20:00-06:00"},
+			want: map[string]string{
+				"sunday": overnight, "monday": morning, "tuesday": none, "wednesday": none,
+				"thursday": none, "friday": none, "saturday": evening,
+			},
+		},
+		{
+			specs: []string{"daily 00:00-06:00, 20:00-24:00"},
+			want: map[string]string{
+				"sunday": overnight, "monday": overnight, "tuesday": overnight, "wednesday": overnight,
+				"thursday": overnight, "friday": overnight, "saturday": overnight,
+			},
+		},
+	}
+	for _, tt := range tests {
+		window, err := WindowFromSpecs(tt.specs)
+		if err != nil {
+			t.Errorf("WindowFromSpecs(%q): %s", tt.specs, err)
+			continue
+		}
+		if len(window) != len(WeekDays) {
+			t.Fatalf("WindowFromSpecs(%q) returned %d days, want %d", tt.specs, len(window), len(WeekDays))
+		}
+		for i, day := range window {
+			if day.Day != WeekDays[i] {
+				t.Errorf("WindowFromSpecs(%q) day %d = %s, want %s", tt.specs, i, day.Day, WeekDays[i])
+			}
+			if day.Hours != tt.want[day.Day] {
+				t.Errorf("WindowFromSpecs(%q) %s = %s, want %s", tt.specs, day.Day, day.Hours, tt.want[day.Day])
+			}
+		}
+	}
+
+	for _, spec := range []string{"", "Mon-Fri", "20:00-06:00", "Mo 20:00-06:00", "Mon-Fri 20:00", "Mon-Fri 20:30-06:00", "Mon 08:00-08:00"} {
+		if _, err := WindowFromSpecs([]string{spec}); err == nil {
+			t.Errorf("WindowFromSpecs(%q) succeeded, want an error", spec)
+		}
+	}
+}
+
+func TestWindowIntervalsMatchAPIFlags(t *testing.T) {
+	window := func(hours string) map[string]interface{} {
+		return map[string]interface{}{
+			"schedule": []interface{}{
+				map[string]interface{}{
+					"backup_window": []interface{}{
+						map[string]interface{}{
+							"is_enabled": true,
+							"backup_window": []interface{}{
+								map[string]interface{}{
+									"days": []interface{}{
+										map[string]interface{}{"day": "monday", "hours": hours},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	configured := schema.TestResourceDataRaw(t, testSchema(), window("22:00-06:00"))
+	fromAPI := schema.TestResourceDataRaw(t, testSchema(), window("1,1,1,1,1,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1"))
+	got, want := Expand(configured.Get("schedule").([]interface{})), Expand(fromAPI.Get("schedule").([]interface{}))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("intervals expanded differently from API flags:\n got %+v\nwant %+v", got.BackupWindow.BackupWindow, want.BackupWindow.BackupWindow)
+	}
+}
+
+func TestEquivalentWindows(t *testing.T) {
+	flags := "0,0,0,0,0,0,0,0,1,1,1,1,1,1,1,1,1,1,0,0,0,0,0,0"
+	window := []BackupWindowDay{{Day: "monday", Hours: flags}, {Day: "tuesday", Hours: flags}}
+
+	if !EquivalentWindows(window, []BackupWindowDay{{Day: "tuesday", Hours: "08:00-18:00"}, {Day: "monday", Hours: flags}}) {
+		t.Errorf("reordered window with intervals is not equivalent")
+	}
+	if EquivalentWindows(window, []BackupWindowDay{{Day: "monday", Hours: flags}}) {
+		t.Errorf("window without tuesday is equivalent")
+	}
+	if EquivalentWindows(window, []BackupWindowDay{{Day: "monday", Hours: flags}, {Day: "tuesday", Hours: "08:00-17:00"}}) {
+		t.Errorf("window with shorter tuesday is equivalent")
+	}
+}
+
+func TestWarnDisabledSchedule(t *testing.T) {
+	r := &schema.Resource{Schema: testSchema()}
+	config := func(runAutomatically bool, daily bool) cty.Value {
+		s := map[string]cty.Value{"run_automatically": cty.BoolVal(runAutomatically)}
+		if daily {
+			s["daily"] = cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"is_enabled": cty.True,
+				"local_time": cty.StringVal("22:00"),
+			})})
+		}
+		v, err := r.CoreConfigSchema().CoerceValue(cty.ObjectVal(map[string]cty.Value{
+			"schedule": cty.ListVal([]cty.Value{cty.ObjectVal(s)}),
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	for _, tc := range []struct {
+		name             string
+		runAutomatically bool
+		daily            bool
+		wantWarning      bool
+	}{
+		{"disabled with daily", false, true, true},
+		{"disabled without blocks", false, false, false},
+		{"enabled with daily", true, true, false},
+	} {
+		var resp schema.ValidateResourceConfigFuncResponse
+		WarnDisabledSchedule(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: config(tc.runAutomatically, tc.daily)}, &resp)
+		if got := len(resp.Diagnostics) > 0; got != tc.wantWarning {
+			t.Errorf("%s: warning = %v, want %v (%v)", tc.name, got, tc.wantWarning, resp.Diagnostics)
+		}
+		for _, d := range resp.Diagnostics {
+			if d.Severity != diag.Warning {
+				t.Errorf("%s: diagnostic %q is not a warning", tc.name, d.Summary)
+			}
+		}
+	}
+}
@@ -0,0 +1,383 @@
+package schedule
+
+import (
+	vc "terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Schema returns the optional schedule block of a VBR job
+func Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The schedule settings for the backup job.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"run_automatically": {
+					Type:        schema.TypeBool,
+					Required:    true,
+					Description: "Specifies if the job runs automatically.",
+				},
+				"daily": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					MaxItems:         1,
+					Description:      "The daily schedule settings.",
+					DiffSuppressFunc: vc.SuppressMissingOptionalBlock,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"is_enabled": {
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "Specifies if daily schedule is enabled.",
+							},
+							"local_time": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The local time for daily schedule.",
+								DiffSuppressFunc: vc.SuppressEquivalentTime,
+							},
+							"daily_kind": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The kind of daily schedule.",
+							},
+							"days": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "The days for daily schedule.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+				"monthly": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					MaxItems:         1,
+					Description:      "The monthly schedule settings.",
+					DiffSuppressFunc: vc.SuppressMissingOptionalBlock,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"is_enabled": {
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "Specifies if monthly schedule is enabled.",
+							},
+							"day_of_week": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The day of the week for monthly schedule.",
+							},
+							"day_number_in_month": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The day number in month for monthly schedule.",
+							},
+							"day_of_month": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "The day of month for monthly schedule.",
+							},
+							"months": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "The months for monthly schedule.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"local_time": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The local time for monthly schedule.",
+								DiffSuppressFunc: vc.SuppressEquivalentTime,
+							},
+							"is_last_day_of_month": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Specifies if it is the last day of the month for monthly schedule.",
+							},
+						},
+					},
+				},
+				"periodically": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					MaxItems:         1,
+					Description:      "The periodically schedule settings.",
+					DiffSuppressFunc: vc.SuppressMissingOptionalBlock,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"is_enabled": {
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "Specifies if periodically schedule is enabled.",
+							},
+							"periodically_kind": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The kind of periodically schedule.",
+							},
+							"frequency": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "The frequency for periodically schedule.",
+							},
+							"backup_window": backupWindowSchema("The backup window for periodically schedule."),
+							"start_time_within_hour": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "The start time within hour for periodically schedule.",
+							},
+						},
+					},
+				},
+				"continuously": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					MaxItems:         1,
+					Description:      "The continuously schedule settings.",
+					DiffSuppressFunc: vc.SuppressMissingOptionalBlock,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"is_enabled": {
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "Specifies if continuously schedule is enabled.",
+							},
+							"backup_window": backupWindowSchema("The backup window for continuously schedule."),
+						},
+					},
+				},
+				"after_this_job": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					MaxItems:         1,
+					Description:      "The after this job schedule settings.",
+					DiffSuppressFunc: vc.SuppressMissingOptionalBlock,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"is_enabled": {
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "Specifies if after this job schedule is enabled.",
+							},
+							"job_name": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								Computed:      true,
+								ConflictsWith: []string{"schedule.0.after_this_job.0.job_id"},
+								Description:   "The name of the job to run after. When job_id is set, the name of that job.",
+							},
+							"job_id": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								ConflictsWith: []string{"schedule.0.after_this_job.0.job_name"},
+								Description:   "The ID of the job to run after. Unlike job_name, it keeps the jobs chained when the job is renamed.",
+							},
+						},
+					},
+				},
+				"retry": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					MaxItems:         1,
+					Description:      "The retry schedule settings.",
+					DiffSuppressFunc: vc.SuppressMissingOptionalBlock,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"is_enabled": {
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "Specifies if retry is enabled.",
+							},
+							"retry_count": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "The number of retries.",
+							},
+							"await_minutes": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "The number of minutes to await between retries.",
+							},
+						},
+					},
+				},
+				"backup_window": {
+					Type:             schema.TypeList,
+					Optional:         true,
+					MaxItems:         1,
+					Description:      "The backup window schedule settings.",
+					DiffSuppressFunc: vc.SuppressMissingOptionalBlock,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"is_enabled": {
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "Specifies if backup window is enabled.",
+							},
+							"backup_window": backupWindowSchema("The backup window."),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func backupWindowSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"days": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					Description: "The backup window days.",
+					Set:         hashWindowDay,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"day": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The day of the week.",
+							},
+							"hours": {
+								Type:             schema.TypeString,
+								Required:         true,
+								Description:      "The hours for the day: 24 comma-separated 0 or 1 flags, one per hour, or time intervals such as 08:00-18:00.",
+								ValidateFunc:     ValidateWindowHours,
+								DiffSuppressFunc: suppressEquivalentWindowHours,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// RetentionPolicySchema returns an optional retention policy block with the given description
+func RetentionPolicySchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The type of the retention policy.",
+				},
+				"quantity": {
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "The quantity for the retention policy.",
+				},
+			},
+		},
+	}
+}
+
+// NotificationsSchema returns the optional notifications block of a job's advanced settings
+func NotificationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The notifications settings.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"send_snmp_notifications": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Specifies if SNMP notifications are sent.",
+				},
+				"email_notifications": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "The email notifications settings.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"is_enabled": {
+								Type:        schema.TypeBool,
+								Required:    true,
+								Description: "Specifies if email notifications are enabled.",
+							},
+							"recipients": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "The list of email recipients.",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							"notification_type": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The type of email notification.",
+							},
+							"custom_notification_settings": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								MaxItems:    1,
+								Description: "The custom notification settings.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"subject": {
+											Type:        schema.TypeString,
+											Optional:    true,
+											Description: "The subject of the email notification.",
+										},
+										"notify_on_success": {
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Description: "Specifies if notification is sent on success.",
+										},
+										"notify_on_warning": {
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Description: "Specifies if notification is sent on warning.",
+										},
+										"notify_on_error": {
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Description: "Specifies if notification is sent on error.",
+										},
+										"suppress_notification_until_last_retry": {
+											Type:        schema.TypeBool,
+											Optional:    true,
+											Description: "Specifies if notification is suppressed until the last retry.",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"trigger_issue_job_warning": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Specifies if job warning issues trigger notifications.",
+				},
+				"trigger_attribute_issue_job_warning": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Specifies if attribute issue job warnings trigger notifications.",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,104 @@
+// Package schedule holds the schedule, backup window, retention and notification structures that
+// are shared by the VBR job resources, together with their schemas, expanders and flatteners.
+package schedule
+
+// Schedule is the job schedule sent to and returned by the VBR jobs endpoint
+type Schedule struct {
+	RunAutomatically bool          `json:"runAutomatically"`
+	Daily            *Daily        `json:"daily,omitempty"`
+	Monthly          *Monthly      `json:"monthly,omitempty"`
+	Periodically     *Periodically `json:"periodically,omitempty"`
+	Continuously     *Continuously `json:"continuously,omitempty"`
+	AfterThisJob     *AfterThisJob `json:"afterThisJob,omitempty"`
+	Retry            *Retry        `json:"retry,omitempty"`
+	BackupWindow     *WindowOption `json:"backupWindow,omitempty"`
+}
+
+type Daily struct {
+	IsEnabled bool      `json:"isEnabled"`
+	LocalTime *string   `json:"localTime,omitempty"`
+	DailyKind *string   `json:"dailyKind,omitempty"`
+	Days      *[]string `json:"days,omitempty"`
+}
+
+type Monthly struct {
+	IsEnabled        bool      `json:"isEnabled"`
+	DayOfWeek        *string   `json:"dayOfWeek,omitempty"`
+	DayNumberInMonth *string   `json:"dayNumberInMonth,omitempty"`
+	DayOfMonth       *int      `json:"dayOfMonth,omitempty"`
+	Months           *[]string `json:"months,omitempty"`
+	LocalTime        *string   `json:"localTime,omitempty"`
+	IsLastDayOfMonth *bool     `json:"isLastDayOfMonth,omitempty"`
+}
+
+type Periodically struct {
+	IsEnabled           bool          `json:"isEnabled"`
+	PeriodicallyKind    *string       `json:"periodicallyKind,omitempty"`
+	Frequency           *int          `json:"frequency,omitempty"`
+	BackupWindow        *BackupWindow `json:"backupWindow,omitempty"`
+	StartTimeWithinHour *int          `json:"startTimeWithinHour,omitempty"`
+}
+
+type Continuously struct {
+	IsEnabled    bool          `json:"isEnabled"`
+	BackupWindow *BackupWindow `json:"backupWindow,omitempty"`
+}
+
+type AfterThisJob struct {
+	IsEnabled bool    `json:"isEnabled"`
+	JobName   *string `json:"jobName,omitempty"`
+	// JobID is the ID of the job to run after. The API chains jobs by name only, so the resources
+	// resolve it to JobName before sending the schedule.
+	JobID *string `json:"-"`
+}
+
+type Retry struct {
+	IsEnabled    bool `json:"isEnabled"`
+	RetryCount   *int `json:"retryCount,omitempty"`
+	AwaitMinutes *int `json:"awaitMinutes,omitempty"`
+}
+
+// WindowOption enables or disables a backup window on the schedule
+type WindowOption struct {
+	IsEnabled    bool          `json:"isEnabled"`
+	BackupWindow *BackupWindow `json:"backupWindow,omitempty"`
+}
+
+// BackupWindow lists the hours during which a job may run, one entry per day of the week
+type BackupWindow struct {
+	Days []BackupWindowDay `json:"days"`
+}
+
+type BackupWindowDay struct {
+	Day   string `json:"day"`
+	Hours string `json:"hours"`
+}
+
+// RetentionPolicy is the retention of a backup or archive repository
+type RetentionPolicy struct {
+	Type     string `json:"type"`
+	Quantity int    `json:"quantity"`
+}
+
+// Notifications holds the job notification settings
+type Notifications struct {
+	SendSNMPNotifications           *bool               `json:"sendSNMPNotifications,omitempty"`
+	EmailNotifications              *EmailNotifications `json:"emailNotifications,omitempty"`
+	TriggerIssueJobWarning          *bool               `json:"triggerIssueJobWarning,omitempty"`
+	TriggerAttributeIssueJobWarning *bool               `json:"triggerAttributeIssueJobWarning,omitempty"`
+}
+
+type EmailNotifications struct {
+	IsEnabled                  bool                        `json:"isEnabled"`
+	Recipients                 *[]string                   `json:"recipients,omitempty"`
+	NotificationType           *string                     `json:"notificationType,omitempty"`
+	CustomNotificationSettings *CustomNotificationSettings `json:"customNotificationSettings,omitempty"`
+}
+
+type CustomNotificationSettings struct {
+	Subject                            *string `json:"subject,omitempty"`
+	NotifyOnSuccess                    *bool   `json:"notifyOnSuccess,omitempty"`
+	NotifyOnWarning                    *bool   `json:"notifyOnWarning,omitempty"`
+	NotifyOnError                      *bool   `json:"notifyOnError,omitempty"`
+	SuppressNotificationUntilLastRetry *bool   `json:"suppressNotificationUntilLastRetry,omitempty"`
+}
@@ -0,0 +1,57 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// scheduleBlocks are the blocks of a schedule that only take effect when the job runs automatically
+var scheduleBlocks = []string{"daily", "monthly", "periodically", "continuously", "after_this_job", "retry", "backup_window"}
+
+// WarnDisabledSchedule warns about schedule blocks configured while run_automatically is false.
+// VBR ignores them then, and nothing runs the job although a schedule is configured.
+func WarnDisabledSchedule(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	config := req.RawConfig
+	if !config.IsKnown() || config.IsNull() || !config.Type().IsObjectType() || !config.Type().HasAttribute("schedule") {
+		return
+	}
+	schedules := config.GetAttr("schedule")
+	if !schedules.IsKnown() || schedules.IsNull() || schedules.LengthInt() == 0 {
+		return
+	}
+	s := schedules.Index(cty.NumberIntVal(0))
+	if !s.IsKnown() || s.IsNull() {
+		return
+	}
+	runAutomatically := s.GetAttr("run_automatically")
+	if !runAutomatically.IsKnown() || runAutomatically.IsNull() || runAutomatically.True() {
+		return
+	}
+
+	var configured []string
+	for _, block := range scheduleBlocks {
+		if !s.Type().HasAttribute(block) {
+			continue
+		}
+		v := s.GetAttr(block)
+		if !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0) {
+			configured = append(configured, block)
+		}
+	}
+	if len(configured) == 0 {
+		return
+	}
+
+	resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Schedule is configured but disabled",
+		Detail: fmt.Sprintf("run_automatically is false, so VBR ignores the %s settings of the schedule and the job only runs when started manually. "+
+			"Set run_automatically to true to run the job on this schedule, or remove the settings.", strings.Join(configured, ", ")),
+		AttributePath: cty.GetAttrPath("schedule").IndexInt(0).GetAttr("run_automatically"),
+	})
+}
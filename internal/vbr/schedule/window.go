@@ -0,0 +1,313 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WeekDays are the days of a backup window in the order VBR lists them
+var WeekDays = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+// HourRangesToWindow converts hour ranges such as "0-6,20-23" into the hours string of a backup
+// window day: 24 comma-separated flags, one per hour of the day, set to 1 when a job may run
+// during that hour. Ranges are inclusive, so "22-23" allows jobs from 22:00 to midnight. An empty
+// string allows no hours.
+func HourRangesToWindow(ranges string) (string, error) {
+	var hours [24]bool
+	for _, part := range strings.Split(ranges, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		from, to, isRange := strings.Cut(part, "-")
+		start, err := parseHour(from)
+		if err != nil {
+			return "", fmt.Errorf("invalid hour range %q: %w", part, err)
+		}
+		end := start
+		if isRange {
+			if end, err = parseHour(to); err != nil {
+				return "", fmt.Errorf("invalid hour range %q: %w", part, err)
+			}
+		}
+		if end < start {
+			return "", fmt.Errorf("invalid hour range %q: the range ends before it starts; split ranges that cross midnight, e.g. 22-23,0-5", part)
+		}
+
+		for h := start; h <= end; h++ {
+			hours[h] = true
+		}
+	}
+
+	flags := make([]string, len(hours))
+	for h, allowed := range hours {
+		flags[h] = "0"
+		if allowed {
+			flags[h] = "1"
+		}
+	}
+	return strings.Join(flags, ","), nil
+}
+
+func parseHour(s string) (int, error) {
+	h, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || h < 0 || h > 23 {
+		return 0, fmt.Errorf("%q is not an hour between 0 and 23", strings.TrimSpace(s))
+	}
+	return h, nil
+}
+
+// NormalizeWindowHours returns the hours of a backup window day in the format of the VBR API. The
+// hours are either already in that format, 24 comma-separated 0 or 1 flags, or given as
+// comma-separated time intervals such as "08:00-12:00,13:00-18:00". Intervals end at the full hour
+// they name, or at the next one for hh:59, and intervals such as "22:00-06:00" wrap around
+// midnight within the same day.
+func NormalizeWindowHours(hours string) (string, error) {
+	flags := strings.Split(hours, ",")
+	if len(flags) == 24 && isFlagList(flags) {
+		return strings.Join(flags, ","), nil
+	}
+	if isFlagList(flags) {
+		return "", fmt.Errorf("%q has %d hour flags, want 24 (one per hour of the day)", hours, len(flags))
+	}
+
+	var allowed [24]bool
+	for _, interval := range flags {
+		interval = strings.TrimSpace(interval)
+		from, to, ok := strings.Cut(interval, "-")
+		if !ok {
+			return "", fmt.Errorf("invalid interval %q: want hh:mm-hh:mm, e.g. 08:00-18:00", interval)
+		}
+		start, err := parseIntervalTime(from, false)
+		if err != nil {
+			return "", fmt.Errorf("invalid interval %q: %w", interval, err)
+		}
+		end, err := parseIntervalTime(to, true)
+		if err != nil {
+			return "", fmt.Errorf("invalid interval %q: %w", interval, err)
+		}
+		if start == end {
+			return "", fmt.Errorf("invalid interval %q: it is empty", interval)
+		}
+		n := (end - start + 24) % 24
+		if n == 0 {
+			n = 24 // 00:00-24:00
+		}
+		for i := 0; i < n; i++ {
+			allowed[(start+i)%24] = true
+		}
+	}
+
+	out := make([]string, len(allowed))
+	for h, ok := range allowed {
+		out[h] = "0"
+		if ok {
+			out[h] = "1"
+		}
+	}
+	return strings.Join(out, ","), nil
+}
+
+func isFlagList(flags []string) bool {
+	for i, f := range flags {
+		f = strings.TrimSpace(f)
+		if f != "0" && f != "1" {
+			return false
+		}
+		flags[i] = f
+	}
+	return true
+}
+
+// parseIntervalTime returns the hour an interval starts or ends at. Backup windows have a
+// granularity of one hour, so times must be on the full hour; an end time of hh:59 stands for the
+// end of that hour.
+func parseIntervalTime(s string, isEnd bool) (int, error) {
+	s = strings.TrimSpace(s)
+	hh, mm, ok := strings.Cut(s, ":")
+	h, herr := strconv.Atoi(hh)
+	m, merr := strconv.Atoi(mm)
+	if !ok || herr != nil || merr != nil || h < 0 || h > 24 || m < 0 || m > 59 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("%q is not a time of day in hh:mm format", s)
+	}
+	switch {
+	case m == 0:
+		return h, nil
+	case isEnd && m == 59:
+		return h + 1, nil
+	default:
+		return 0, fmt.Errorf("%q is not on the full hour; backup windows are set per hour", s)
+	}
+}
+
+// WindowFromSpecs builds a backup window for every day of the week from specs such as
+// "Mon-Fri 20:00-06:00" or "Sat,Sun 00:00-24:00": days, ranges of days or one of daily, weekdays
+// and weekends, followed by comma-separated time intervals. Intervals that end before they start
+// run overnight into the next day, so "Fri 20:00-06:00" includes the early hours of Saturday. Days
+// no spec names allow no hours.
+func WindowFromSpecs(specs []string) ([]BackupWindowDay, error) {
+	var allowed [7][24]bool
+	for _, spec := range specs {
+		dayList, intervals, ok := strings.Cut(strings.TrimSpace(spec), " ")
+		if !ok || strings.TrimSpace(intervals) == "" {
+			return nil, fmt.Errorf("invalid backup window %q: want days followed by time intervals, e.g. Mon-Fri 20:00-06:00", spec)
+		}
+		days, err := parseWindowDays(dayList)
+		if err != nil {
+			return nil, fmt.Errorf("invalid backup window %q: %w", spec, err)
+		}
+
+		for _, interval := range strings.Split(intervals, ",") {
+			interval = strings.TrimSpace(interval)
+			from, to, ok := strings.Cut(interval, "-")
+			if !ok {
+				return nil, fmt.Errorf("invalid backup window %q: invalid interval %q: want hh:mm-hh:mm, e.g. 20:00-06:00", spec, interval)
+			}
+			start, err := parseIntervalTime(from, false)
+			if err != nil {
+				return nil, fmt.Errorf("invalid backup window %q: invalid interval %q: %w", spec, interval, err)
+			}
+			end, err := parseIntervalTime(to, true)
+			if err != nil {
+				return nil, fmt.Errorf("invalid backup window %q: invalid interval %q: %w", spec, interval, err)
+			}
+			if start == end || start == 24 {
+				return nil, fmt.Errorf("invalid backup window %q: interval %q is empty", spec, interval)
+			}
+			if end < start {
+				end += 24 // overnight
+			}
+			for _, day := range days {
+				for h := start; h < end; h++ {
+					allowed[(day+h/24)%7][h%24] = true
+				}
+			}
+		}
+	}
+
+	window := make([]BackupWindowDay, len(WeekDays))
+	for i, day := range WeekDays {
+		flags := make([]string, 24)
+		for h, ok := range allowed[i] {
+			flags[h] = "0"
+			if ok {
+				flags[h] = "1"
+			}
+		}
+		window[i] = BackupWindowDay{Day: day, Hours: strings.Join(flags, ",")}
+	}
+	return window, nil
+}
+
+// parseWindowDays returns the indexes in WeekDays of the days of a backup window spec, e.g.
+// "Mon-Fri", "Sat,Sun", "Fri-Mon" or "weekdays"
+func parseWindowDays(s string) ([]int, error) {
+	var days []int
+	for _, part := range strings.Split(s, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		switch part {
+		case "daily":
+			days = append(days, 0, 1, 2, 3, 4, 5, 6)
+			continue
+		case "weekdays":
+			days = append(days, 1, 2, 3, 4, 5)
+			continue
+		case "weekends":
+			days = append(days, 6, 0)
+			continue
+		}
+
+		from, to, isRange := strings.Cut(part, "-")
+		start, err := parseWeekDay(from)
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if isRange {
+			if end, err = parseWeekDay(to); err != nil {
+				return nil, err
+			}
+		}
+		// Ranges such as Fri-Mon wrap around the end of the week
+		for d := start; ; d = (d + 1) % 7 {
+			days = append(days, d)
+			if d == end {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+// parseWeekDay returns the index in WeekDays of a day given by its name or an abbreviation of at
+// least three letters, e.g. "Mon"
+func parseWeekDay(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if len(s) >= 3 {
+		for i, day := range WeekDays {
+			if strings.HasPrefix(day, s) {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%q is not a day of the week, e.g. Mon or monday", s)
+}
+
+// ValidateWindowHours is the plan-time validation of the hours of a backup window day
+func ValidateWindowHours(v interface{}, k string) ([]string, []error) {
+	if _, err := NormalizeWindowHours(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// suppressEquivalentWindowHours hides differences between hours that select the same hours of the
+// day, e.g. configured intervals and the flags returned by the API
+func suppressEquivalentWindowHours(_, old, new string, _ *schema.ResourceData) bool {
+	o, oerr := NormalizeWindowHours(old)
+	n, nerr := NormalizeWindowHours(new)
+	return oerr == nil && nerr == nil && o == n
+}
+
+// hashWindowDay hashes a backup window day by its normalized hours, so that a day configured with
+// intervals and the same day read back from the API are the same set element
+func hashWindowDay(v interface{}) int {
+	m := v.(map[string]interface{})
+	hours, _ := m["hours"].(string)
+	if normalized, err := NormalizeWindowHours(hours); err == nil {
+		hours = normalized
+	}
+	day, _ := m["day"].(string)
+	return schema.HashString(day + "/" + hours)
+}
+
+// EquivalentWindows reports whether two backup windows allow the same hours on every day, however
+// their days are ordered and their hours written
+func EquivalentWindows(a, b []BackupWindowDay) bool {
+	normalize := func(days []BackupWindowDay) (map[string]string, bool) {
+		m := make(map[string]string, len(days))
+		for _, d := range days {
+			hours, err := NormalizeWindowHours(d.Hours)
+			if err != nil {
+				return nil, false
+			}
+			m[d.Day] = hours
+		}
+		return m, true
+	}
+	am, aok := normalize(a)
+	bm, bok := normalize(b)
+	if !aok || !bok || len(am) != len(bm) {
+		return false
+	}
+	for day, hours := range am {
+		if bm[day] != hours {
+			return false
+		}
+	}
+	return true
+}
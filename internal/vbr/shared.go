@@ -1,5 +1,9 @@
 package vbr
 
+import (
+	"terraform-provider-veeambackup/internal/vbr/schedule"
+)
+
 // ============================================================================
 // VBR Unstructured Data Server Types
 // ============================================================================
@@ -53,89 +57,38 @@ type VBRCloudCredentialsResponseData struct {
 // VBR Backup Job Types
 // ============================================================================
 
-type VbrBackupJobRetentionPolicy struct {
-	Type     string `json:"type"`
-	Quantity int    `json:"quantity"`
-}
-
 type VbrBackupJobArchiveRepository struct {
-	ArchiveRepositoryID         string                           `json:"archiveRepositoryId"`
-	ArchiveRecentFileVersions   *bool                            `json:"archiveRecentFileVersions,omitempty"`
-	ArchivePreviousFileVersions *bool                            `json:"archivePreviousFileVersions,omitempty"`
-	ArchiveRetentionPolicy      *VbrBackupJobRetentionPolicy     `json:"archiveRetentionPolicy,omitempty"`
-	FileArchiveSettings         *VbrBackupJobFileArchiveSettings `json:"fileArchiveSettings,omitempty"`
-}
-
-type VbrBackupJobFileArchiveSettings struct {
-	ArchivalType  *string   `json:"archivalType,omitempty"`
-	InclusionMask *[]string `json:"inclusionMask,omitempty"`
-	ExclusionMask *[]string `json:"exclusionMask,omitempty"`
-}
-
-type VbrBackupJobSchedule struct {
-	RunAutomatically bool                               `json:"runAutomatically"`
-	Daily            *VbrBackupJobScheduleDaily         `json:"daily,omitempty"`
-	Monthly          *VbrBackupJobScheduleMonthly       `json:"monthly,omitempty"`
-	Periodically     *VbrBackupJobSchedulePeriodically  `json:"periodically,omitempty"`
-	Continuously     *VbrBackupJobScheduleContinuously  `json:"continuously,omitempty"`
-	AfterThisJob     *VbrBackupJobScheduleAfterThisJob  `json:"afterThisJob,omitempty"`
-	Retry            *VbrBackupJobScheduleRetry         `json:"retry,omitempty"`
-	BackupWindow     *VbrBackupJobScheduleBackupWindows `json:"backupWindow,omitempty"`
-}
-
-type VbrBackupJobScheduleDaily struct {
-	IsEnabled bool      `json:"isEnabled"`
-	LocalTime *string   `json:"localTime,omitempty"`
-	DailyKind *string   `json:"dailyKind,omitempty"`
-	Days      *[]string `json:"days,omitempty"`
-}
-
-type VbrBackupJobScheduleMonthly struct {
-	IsEnabled        bool      `json:"isEnabled"`
-	DayOfWeek        *string   `json:"dayOfWeek,omitempty"`
-	DayNumberInMonth *string   `json:"dayNumberInMonth,omitempty"`
-	DayOfMonth       *int      `json:"dayOfMonth,omitempty"`
-	Months           *[]string `json:"months,omitempty"`
-	LocalTime        *string   `json:"localTime,omitempty"`
-	IsLastDayOfMonth *bool     `json:"isLastDayOfMonth,omitempty"`
+	ArchiveRepositoryID         string                                 `json:"archiveRepositoryId"`
+	ArchiveRecentFileVersions   *bool                                  `json:"archiveRecentFileVersions,omitempty"`
+	ArchivePreviousFileVersions *bool                                  `json:"archivePreviousFileVersions,omitempty"`
+	ArchiveRetentionPolicy      *schedule.RetentionPolicy              `json:"archiveRetentionPolicy,omitempty"`
+	GFSRetentionPolicy          *VbrBackupJobArchiveGFSRetentionPolicy `json:"gfsRetentionPolicy,omitempty"`
+	CostOptimization            *VbrBackupJobArchiveCostOptimization   `json:"costOptimization,omitempty"`
+	FileArchiveSettings         *VbrBackupJobFileArchiveSettings       `json:"fileArchiveSettings,omitempty"`
 }
 
-type VbrBackupJobSchedulePeriodically struct {
-	IsEnabled           bool                              `json:"isEnabled"`
-	PeriodicallyKind    *string                           `json:"periodicallyKind,omitempty"`
-	Frequency           *int                              `json:"frequency,omitempty"`
-	BackupWindow        *VbrBackupJobScheduleBackupWindow `json:"backupWindow,omitempty"`
-	StartTimeWithinHour *int                              `json:"startTimeWithinHour,omitempty"`
+// VbrBackupJobArchiveGFSRetentionPolicy overrides the archive retention for GFS backups
+type VbrBackupJobArchiveGFSRetentionPolicy struct {
+	Weekly  *VbrBackupJobArchiveGFSPeriod `json:"weekly,omitempty"`
+	Monthly *VbrBackupJobArchiveGFSPeriod `json:"monthly,omitempty"`
+	Yearly  *VbrBackupJobArchiveGFSPeriod `json:"yearly,omitempty"`
 }
 
-type VbrBackupJobScheduleBackupWindow struct {
-	Days []VbrBackupJobScheduleBackupWindowDays `json:"days"`
+type VbrBackupJobArchiveGFSPeriod struct {
+	IsEnabled     bool `json:"isEnabled"`
+	KeepForNumber *int `json:"keepForNumber,omitempty"`
 }
 
-type VbrBackupJobScheduleBackupWindowDays struct {
-	Day   string `json:"day"`
-	Hours string `json:"hours"`
+// VbrBackupJobArchiveCostOptimization avoids early deletion fees of archive tiers
+type VbrBackupJobArchiveCostOptimization struct {
+	IsEnabled            bool `json:"isEnabled"`
+	ArchiveOlderThanDays *int `json:"archiveOlderThanDays,omitempty"`
 }
 
-type VbrBackupJobScheduleContinuously struct {
-	IsEnabled    bool                              `json:"isEnabled"`
-	BackupWindow *VbrBackupJobScheduleBackupWindow `json:"backupWindow,omitempty"`
-}
-
-type VbrBackupJobScheduleAfterThisJob struct {
-	IsEnabled bool    `json:"isEnabled"`
-	JobName   *string `json:"jobName,omitempty"`
-}
-
-type VbrBackupJobScheduleRetry struct {
-	IsEnabled    bool `json:"isEnabled"`
-	RetryCount   *int `json:"retryCount,omitempty"`
-	AwaitMinutes *int `json:"awaitMinutes,omitempty"`
-}
-
-type VbrBackupJobScheduleBackupWindows struct {
-	IsEnabled    bool                              `json:"isEnabled"`
-	BackupWindow *VbrBackupJobScheduleBackupWindow `json:"backupWindow,omitempty"`
+type VbrBackupJobFileArchiveSettings struct {
+	ArchivalType  *string   `json:"archivalType,omitempty"`
+	InclusionMask *[]string `json:"inclusionMask,omitempty"`
+	ExclusionMask *[]string `json:"exclusionMask,omitempty"`
 }
 
 // ============================================================================
@@ -182,6 +135,7 @@ type VBRRepositoryMountServerSettings struct {
 	MountServerID         string                                         `json:"mountServerId"`
 	VPowerNFSEnabled      *bool                                          `json:"vPowerNfsEnabled,omitempty"`
 	WriteCacheEnabled     *bool                                          `json:"writeCacheEnabled,omitempty"`
+	WriteCacheFolder      *string                                        `json:"writeCacheFolder,omitempty"`
 	VPowerNFSPortSettings *VBRRepositoryMountServerVPowerNFSPortSettings `json:"vPowerNfsPortSettings,omitempty"`
 }
 
@@ -190,6 +144,17 @@ type VBRRepositoryMountServerVPowerNFSPortSettings struct {
 	VPowerNFSPort *int `json:"vPowerNfsPort,omitempty"`
 }
 
+type VBRRepositoryShare struct {
+	SharePath     string                      `json:"sharePath"`
+	CredentialsID *string                     `json:"credentialsId,omitempty"` //Used for type Smb
+	GatewayServer *VBRRepositoryGatewayServer `json:"gatewayServer,omitempty"`
+}
+
+type VBRRepositoryGatewayServer struct {
+	AutoSelectEnabled bool     `json:"autoSelectEnabled"`
+	GatewayServerIDs  []string `json:"gatewayServerIds,omitempty"`
+}
+
 type VBRRepositoryAmazonS3Bucket struct {
 	RegionID                string                                `json:"regionId"`
 	BucketName              string                                `json:"bucketName"`
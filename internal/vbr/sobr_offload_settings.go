@@ -0,0 +1,200 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+	"terraform-provider-veeambackup/internal/vbr/schedule"
+)
+
+// SOBROffloadSettings holds the capacity and archive tier settings of a scale-out backup
+// repository. When updating, nil fields are left unchanged.
+type SOBROffloadSettings struct {
+	RepositoryID        string
+	Name                string
+	CapacityTierEnabled bool
+	ArchiveTierEnabled  bool
+
+	CopyPolicyEnabled             *bool
+	MovePolicyEnabled             *bool
+	OperationalRestorePeriodDays  *int
+	OverrideEnabled               *bool
+	OverrideSpaceThresholdPercent *int
+	// OffloadWindow lists the hours during which backups may be offloaded; an empty window allows
+	// offloading at any time
+	OffloadWindow []schedule.BackupWindowDay
+	// EncryptionPasswordID is the password capacity tier backups are encrypted with; an empty ID
+	// disables encryption
+	EncryptionPasswordID *string
+
+	ArchivePeriodDays           *int
+	CostOptimizedArchiveEnabled *bool
+	ArchiveDeduplicationEnabled *bool
+}
+
+// scaleOutRepository is the part of a scale-out backup repository returned by the VBR API that
+// holds its capacity and archive tier settings
+type scaleOutRepository struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	CapacityTier struct {
+		IsEnabled                    bool `json:"isEnabled"`
+		CopyPolicyEnabled            bool `json:"copyPolicyEnabled"`
+		MovePolicyEnabled            bool `json:"movePolicyEnabled"`
+		OperationalRestorePeriodDays int  `json:"operationalRestorePeriodDays"`
+		OverridePolicy               struct {
+			IsEnabled                      bool `json:"isEnabled"`
+			OverrideSpaceThresholdPercents int  `json:"overrideSpaceThresholdPercents"`
+		} `json:"overridePolicy"`
+		OffloadWindow *schedule.WindowOption `json:"offloadWindow"`
+		Encryption    struct {
+			IsEnabled            bool   `json:"isEnabled"`
+			EncryptionPasswordID string `json:"encryptionPasswordId"`
+		} `json:"encryption"`
+	} `json:"capacityTier"`
+	ArchiveTier struct {
+		IsEnabled         bool `json:"isEnabled"`
+		ArchivePeriodDays int  `json:"archivePeriodDays"`
+		AdvancedSettings  struct {
+			CostOptimizedArchiveEnabled bool `json:"costOptimizedArchiveEnabled"`
+			ArchiveDeduplicationEnabled bool `json:"archiveDeduplicationEnabled"`
+		} `json:"advancedSettings"`
+	} `json:"archiveTier"`
+}
+
+// GetSOBROffloadSettings returns the capacity and archive tier settings of the scale-out backup
+// repository with the given ID
+func GetSOBROffloadSettings(ctx context.Context, client *vc.VBRClient, id string) (*SOBROffloadSettings, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, scaleOutRepositoryURL(client, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var repository scaleOutRepository
+	if err := json.Unmarshal(respBody, &repository); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR scale-out repository response: %w", err)
+	}
+
+	capacity := repository.CapacityTier
+	archive := repository.ArchiveTier
+	encryptionPasswordID := ""
+	if capacity.Encryption.IsEnabled {
+		encryptionPasswordID = capacity.Encryption.EncryptionPasswordID
+	}
+	offloadWindow := []schedule.BackupWindowDay{}
+	if w := capacity.OffloadWindow; w != nil && w.IsEnabled && w.BackupWindow != nil {
+		offloadWindow = w.BackupWindow.Days
+	}
+	return &SOBROffloadSettings{
+		RepositoryID:                  repository.ID,
+		Name:                          repository.Name,
+		CapacityTierEnabled:           capacity.IsEnabled,
+		ArchiveTierEnabled:            archive.IsEnabled,
+		CopyPolicyEnabled:             &capacity.CopyPolicyEnabled,
+		MovePolicyEnabled:             &capacity.MovePolicyEnabled,
+		OperationalRestorePeriodDays:  &capacity.OperationalRestorePeriodDays,
+		OverrideEnabled:               &capacity.OverridePolicy.IsEnabled,
+		OverrideSpaceThresholdPercent: &capacity.OverridePolicy.OverrideSpaceThresholdPercents,
+		OffloadWindow:                 offloadWindow,
+		EncryptionPasswordID:          &encryptionPasswordID,
+		ArchivePeriodDays:             &archive.ArchivePeriodDays,
+		CostOptimizedArchiveEnabled:   &archive.AdvancedSettings.CostOptimizedArchiveEnabled,
+		ArchiveDeduplicationEnabled:   &archive.AdvancedSettings.ArchiveDeduplicationEnabled,
+	}, nil
+}
+
+// UpdateSOBROffloadSettings changes the capacity and archive tier settings of a scale-out backup
+// repository. The API only updates complete repositories, so the repository is read and written
+// back with every other setting unchanged. Settings of a tier that is not enabled on the
+// repository are rejected rather than silently ignored by VBR.
+func UpdateSOBROffloadSettings(ctx context.Context, client *vc.VBRClient, settings SOBROffloadSettings) error {
+	repoURL := scaleOutRepositoryURL(client, settings.RepositoryID)
+	respBody, err := client.DoRequest(ctx, http.MethodGet, repoURL, nil)
+	if err != nil {
+		return err
+	}
+
+	var repository map[string]interface{}
+	if err := json.Unmarshal(respBody, &repository); err != nil {
+		return fmt.Errorf("failed to decode VBR scale-out repository response: %w", err)
+	}
+
+	capacity := childMap(repository, "capacityTier")
+	if settings.hasCapacityTierSettings() && capacity["isEnabled"] != true {
+		return fmt.Errorf("scale-out repository %s has no capacity tier", settings.RepositoryID)
+	}
+	setIfNotNil(capacity, "copyPolicyEnabled", settings.CopyPolicyEnabled)
+	setIfNotNil(capacity, "movePolicyEnabled", settings.MovePolicyEnabled)
+	setIfNotNil(capacity, "operationalRestorePeriodDays", settings.OperationalRestorePeriodDays)
+	setIfNotNil(childMap(capacity, "overridePolicy"), "isEnabled", settings.OverrideEnabled)
+	setIfNotNil(childMap(capacity, "overridePolicy"), "overrideSpaceThresholdPercents", settings.OverrideSpaceThresholdPercent)
+	if settings.OffloadWindow != nil {
+		window := schedule.WindowOption{IsEnabled: len(settings.OffloadWindow) > 0}
+		if window.IsEnabled {
+			window.BackupWindow = &schedule.BackupWindow{}
+			for _, day := range settings.OffloadWindow {
+				hours, err := schedule.NormalizeWindowHours(day.Hours)
+				if err != nil {
+					return fmt.Errorf("invalid offload window hours for %s: %w", day.Day, err)
+				}
+				window.BackupWindow.Days = append(window.BackupWindow.Days, schedule.BackupWindowDay{Day: day.Day, Hours: hours})
+			}
+		}
+		capacity["offloadWindow"] = window
+	}
+	if settings.EncryptionPasswordID != nil {
+		encryption := childMap(capacity, "encryption")
+		encryption["isEnabled"] = *settings.EncryptionPasswordID != ""
+		if *settings.EncryptionPasswordID != "" {
+			encryption["encryptionPasswordId"] = *settings.EncryptionPasswordID
+		} else {
+			delete(encryption, "encryptionPasswordId")
+		}
+	}
+
+	archive := childMap(repository, "archiveTier")
+	if settings.hasArchiveTierSettings() && archive["isEnabled"] != true {
+		return fmt.Errorf("scale-out repository %s has no archive tier", settings.RepositoryID)
+	}
+	setIfNotNil(archive, "archivePeriodDays", settings.ArchivePeriodDays)
+	setIfNotNil(childMap(archive, "advancedSettings"), "costOptimizedArchiveEnabled", settings.CostOptimizedArchiveEnabled)
+	setIfNotNil(childMap(archive, "advancedSettings"), "archiveDeduplicationEnabled", settings.ArchiveDeduplicationEnabled)
+
+	body, err := json.Marshal(repository)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR scale-out repository request: %w", err)
+	}
+	_, err = client.DoRequest(ctx, http.MethodPut, repoURL, body)
+	return err
+}
+
+func (s SOBROffloadSettings) hasCapacityTierSettings() bool {
+	return s.CopyPolicyEnabled != nil || s.MovePolicyEnabled != nil || s.OperationalRestorePeriodDays != nil ||
+		s.OverrideEnabled != nil || s.OverrideSpaceThresholdPercent != nil || s.OffloadWindow != nil || s.EncryptionPasswordID != nil
+}
+
+func (s SOBROffloadSettings) hasArchiveTierSettings() bool {
+	return s.ArchivePeriodDays != nil || s.CostOptimizedArchiveEnabled != nil || s.ArchiveDeduplicationEnabled != nil
+}
+
+// childMap returns the object stored at key, adding an empty one when there is none
+func childMap(m map[string]interface{}, key string) map[string]interface{} {
+	child, _ := m[key].(map[string]interface{})
+	if child == nil {
+		child = map[string]interface{}{}
+		m[key] = child
+	}
+	return child
+}
+
+func setIfNotNil[T any](m map[string]interface{}, key string, value *T) {
+	if value != nil {
+		m[key] = *value
+	}
+}
+
+func scaleOutRepositoryURL(client *vc.VBRClient, id string) string {
+	return client.BuildAPIURL(vc.Endpoint("/api/v1/backupInfrastructure/scaleOutRepositories/%s", id))
+}
@@ -46,6 +46,9 @@ func StartBackupJob(ctx context.Context, client *vc.VBRClient, input StartBackup
 		return nil, fmt.Errorf("failed to marshal VBR start job request: %w", err)
 	}
 
-	endpoint := client.BuildAPIURL("/api/v1/jobs/" + jobID + "/start")
+	client.LockJob(jobID)
+	defer client.UnlockJob(jobID)
+
+	endpoint := client.BuildAPIURL(vc.Endpoint("/api/v1/jobs/%s/start", jobID))
 	return client.DoRequest(ctx, http.MethodPost, endpoint, requestBody)
 }
\ No newline at end of file
@@ -0,0 +1,102 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// Latency limits VBR uses until storage latency control is configured
+const (
+	DefaultStorageLatencyLimitMs      = 20
+	DefaultStorageThrottlingIOLimitMs = 30
+)
+
+// StorageLatencySettings holds the storage latency control (backup I/O control) settings of the
+// backup server. When updating, nil fields are left unchanged.
+type StorageLatencySettings struct {
+	Enabled *bool
+	// LatencyLimitMs is the datastore latency above which no new tasks are assigned to it
+	LatencyLimitMs *int
+	// ThrottlingIOLimitMs is the datastore latency above which the I/O of running tasks is throttled
+	ThrottlingIOLimitMs *int
+	// Datastores overrides the limits per datastore; nil leaves the overrides unchanged
+	Datastores []DatastoreLatencySettings
+}
+
+// DatastoreLatencySettings are the latency limits of a single datastore, which require the
+// Enterprise Plus edition
+type DatastoreLatencySettings struct {
+	DatastoreID         string `json:"datastoreId"`
+	DatastoreName       string `json:"datastoreName,omitempty"`
+	LatencyLimitMs      int    `json:"latencyLimitMs"`
+	ThrottlingIOLimitMs int    `json:"throttlingIOLimitMs"`
+}
+
+type storageLatencyOptions struct {
+	IsEnabled           bool                       `json:"isEnabled"`
+	LatencyLimitMs      int                        `json:"latencyLimitMs"`
+	ThrottlingIOLimitMs int                        `json:"throttlingIOLimitMs"`
+	DatastoreSettings   []DatastoreLatencySettings `json:"datastoreSettings"`
+}
+
+// GetStorageLatencySettings returns the storage latency control settings of the backup server
+func GetStorageLatencySettings(ctx context.Context, client *vc.VBRClient) (*StorageLatencySettings, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, storageLatencyURL(client), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var options storageLatencyOptions
+	if err := json.Unmarshal(respBody, &options); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR storage latency response: %w", err)
+	}
+	datastores := options.DatastoreSettings
+	if datastores == nil {
+		datastores = []DatastoreLatencySettings{}
+	}
+	return &StorageLatencySettings{
+		Enabled:             &options.IsEnabled,
+		LatencyLimitMs:      &options.LatencyLimitMs,
+		ThrottlingIOLimitMs: &options.ThrottlingIOLimitMs,
+		Datastores:          datastores,
+	}, nil
+}
+
+// UpdateStorageLatencySettings changes the storage latency control settings of the backup server.
+// The options are read and written back, so that settings left nil and fields this provider does
+// not know keep their values.
+func UpdateStorageLatencySettings(ctx context.Context, client *vc.VBRClient, settings StorageLatencySettings) error {
+	optionsURL := storageLatencyURL(client)
+	respBody, err := client.DoRequest(ctx, http.MethodGet, optionsURL, nil)
+	if err != nil {
+		return err
+	}
+
+	var options map[string]interface{}
+	if err := json.Unmarshal(respBody, &options); err != nil {
+		return fmt.Errorf("failed to decode VBR storage latency response: %w", err)
+	}
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	setIfNotNil(options, "isEnabled", settings.Enabled)
+	setIfNotNil(options, "latencyLimitMs", settings.LatencyLimitMs)
+	setIfNotNil(options, "throttlingIOLimitMs", settings.ThrottlingIOLimitMs)
+	if settings.Datastores != nil {
+		options["datastoreSettings"] = settings.Datastores
+	}
+
+	body, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR storage latency request: %w", err)
+	}
+	_, err = client.DoRequest(ctx, http.MethodPut, optionsURL, body)
+	return err
+}
+
+func storageLatencyURL(client *vc.VBRClient) string {
+	return client.BuildAPIURL("/api/v1/generalOptions/storageLatency")
+}
@@ -0,0 +1,266 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+const (
+	applicationGroupsPath = "/api/v1/backupInfrastructure/applicationGroups"
+	virtualLabsPath       = "/api/v1/backupInfrastructure/virtualLabs"
+)
+
+// ApplicationGroupVMRoles are the roles of the VMs of an application group, which select the
+// tests SureBackup runs on them
+var ApplicationGroupVMRoles = []string{
+	"DNSServer",
+	"DomainControllerAuthoritative",
+	"DomainControllerNonAuthoritative",
+	"GlobalCatalog",
+	"MailServer",
+	"SQLServer",
+	"WebServer",
+}
+
+// VirtualLabNetworkingTypes are the networking modes of a virtual lab: basic labs isolate a single
+// production network, advanced labs several
+var VirtualLabNetworkingTypes = []string{"Basic", "Advanced"}
+
+// SureBackupObject is a vSphere object referenced by an application group or virtual lab
+type SureBackupObject struct {
+	Platform string `json:"platform"`
+	Type     string `json:"type"`
+	HostName string `json:"hostName"`
+	Name     string `json:"name,omitempty"`
+	ObjectID string `json:"objectId"`
+}
+
+// NewSureBackupObject returns the vSphere object of the given type with the given managed object
+// reference ID on a server added to the backup infrastructure
+func NewSureBackupObject(hostName, objectType, objectID, name string) SureBackupObject {
+	return SureBackupObject{
+		Platform: "VMware",
+		Type:     objectType,
+		HostName: hostName,
+		Name:     name,
+		ObjectID: objectID,
+	}
+}
+
+// ApplicationGroup is a group of VMs SureBackup starts, in order, in a virtual lab so that the
+// VMs of a backup job can be verified together with the services they depend on
+type ApplicationGroup struct {
+	ID              string               `json:"id,omitempty"`
+	Name            string               `json:"name"`
+	Description     string               `json:"description"`
+	Type            string               `json:"type"`
+	VirtualMachines []ApplicationGroupVM `json:"virtualMachines"`
+}
+
+// ApplicationGroupVM is a VM of an application group. VMs start in the order they are listed.
+type ApplicationGroupVM struct {
+	VMObject       SureBackupObject          `json:"vmObject"`
+	Roles          []string                  `json:"roles"`
+	StartupOptions ApplicationGroupVMStartup `json:"startupOptions"`
+}
+
+// ApplicationGroupVMStartup are the resources and checks of a VM started in a virtual lab
+type ApplicationGroupVMStartup struct {
+	AllocatedMemoryPercent              int  `json:"allocatedMemory"`
+	MaximumBootTimeSec                  int  `json:"maximumBootTime"`
+	ApplicationInitializationTimeoutSec int  `json:"applicationInitializationTimeout"`
+	VMHeartbeatCheckEnabled             bool `json:"vmHeartbeatCheckEnabled"`
+	VMPingCheckEnabled                  bool `json:"vmPingCheckEnabled"`
+}
+
+// VirtualLab is an isolated environment on an ESXi host in which SureBackup starts VMs from their
+// backups. The proxy appliance routes between the production network and the isolated networks.
+type VirtualLab struct {
+	ID              string                     `json:"id,omitempty"`
+	Name            string                     `json:"name"`
+	Description     string                     `json:"description"`
+	Type            string                     `json:"type"`
+	Host            SureBackupObject           `json:"host"`
+	Datastore       SureBackupObject           `json:"datastore"`
+	ProxyAppliance  *VirtualLabProxyAppliance  `json:"proxyAppliance,omitempty"`
+	NetworkingType  string                     `json:"networkingType"`
+	NetworkMappings []VirtualLabNetworkMapping `json:"networkMappings"`
+}
+
+// VirtualLabProxyAppliance connects a virtual lab to the production network. It obtains its IP
+// address by DHCP when IPAddress is empty.
+type VirtualLabProxyAppliance struct {
+	ProductionNetwork SureBackupObject `json:"productionNetwork"`
+	IPAddress         string           `json:"ipAddress,omitempty"`
+	SubnetMask        string           `json:"subnetMask,omitempty"`
+	DefaultGateway    string           `json:"defaultGateway,omitempty"`
+}
+
+// VirtualLabNetworkMapping maps a production network to the isolated network VMs connected to it
+// are attached to in the virtual lab
+type VirtualLabNetworkMapping struct {
+	ProductionNetwork   SureBackupObject `json:"productionNetwork"`
+	IsolatedNetworkName string           `json:"isolatedNetworkName"`
+	VLANID              int              `json:"vlanId"`
+	ApplianceIPAddress  string           `json:"applianceIpAddress"`
+	SubnetMask          string           `json:"subnetMask"`
+	// MasqueradeIPAddress is the network through which production machines reach the isolated
+	// VMs; VBR derives it from ApplianceIPAddress when empty
+	MasqueradeIPAddress string `json:"masqueradeIpAddress,omitempty"`
+	DHCPEnabled         bool   `json:"dhcpEnabled"`
+}
+
+func CreateApplicationGroup(ctx context.Context, client *vc.VBRClient, group ApplicationGroup) (*ApplicationGroup, error) {
+	respBody, err := createSureBackupObject(ctx, client, applicationGroupsPath, group, group.Name)
+	if err != nil {
+		return nil, err
+	}
+	var created ApplicationGroup
+	return &created, decodeSureBackupObject(respBody, &created, "application group")
+}
+
+func GetApplicationGroup(ctx context.Context, client *vc.VBRClient, id string) (*ApplicationGroup, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, sureBackupObjectURL(client, applicationGroupsPath, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	var group ApplicationGroup
+	return &group, decodeSureBackupObject(respBody, &group, "application group")
+}
+
+func UpdateApplicationGroup(ctx context.Context, client *vc.VBRClient, group ApplicationGroup) (*ApplicationGroup, error) {
+	if err := updateSureBackupObject(ctx, client, applicationGroupsPath, group.ID, group); err != nil {
+		return nil, err
+	}
+	return GetApplicationGroup(ctx, client, group.ID)
+}
+
+// DeleteApplicationGroup deletes an application group. VBR rejects the deletion while a SureBackup
+// job uses the group.
+func DeleteApplicationGroup(ctx context.Context, client *vc.VBRClient, id string) error {
+	return deleteSureBackupObject(ctx, client, applicationGroupsPath, id)
+}
+
+func CreateVirtualLab(ctx context.Context, client *vc.VBRClient, lab VirtualLab) (*VirtualLab, error) {
+	respBody, err := createSureBackupObject(ctx, client, virtualLabsPath, lab, lab.Name)
+	if err != nil {
+		return nil, err
+	}
+	var created VirtualLab
+	return &created, decodeSureBackupObject(respBody, &created, "virtual lab")
+}
+
+func GetVirtualLab(ctx context.Context, client *vc.VBRClient, id string) (*VirtualLab, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, sureBackupObjectURL(client, virtualLabsPath, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	var lab VirtualLab
+	return &lab, decodeSureBackupObject(respBody, &lab, "virtual lab")
+}
+
+func UpdateVirtualLab(ctx context.Context, client *vc.VBRClient, lab VirtualLab) (*VirtualLab, error) {
+	if err := updateSureBackupObject(ctx, client, virtualLabsPath, lab.ID, lab); err != nil {
+		return nil, err
+	}
+	return GetVirtualLab(ctx, client, lab.ID)
+}
+
+// DeleteVirtualLab deletes a virtual lab and removes its proxy appliance and isolated networks
+// from the ESXi host. VBR rejects the deletion while a SureBackup job uses the lab.
+func DeleteVirtualLab(ctx context.Context, client *vc.VBRClient, id string) error {
+	return deleteSureBackupObject(ctx, client, virtualLabsPath, id)
+}
+
+// createSureBackupObject creates an application group or virtual lab and returns it as the API
+// returns it. When VBR creates the object in a session, the object is looked up by name once the
+// session has finished.
+func createSureBackupObject(ctx context.Context, client *vc.VBRClient, collectionPath string, object interface{}, name string) ([]byte, error) {
+	body, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VBR request: %w", err)
+	}
+	respBody, err := client.DoRequest(ctx, http.MethodPost, client.BuildAPIURL(collectionPath), body)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := waitForResponseSession(ctx, client, respBody)
+	if err != nil || !session {
+		return respBody, err
+	}
+
+	id, err := findSureBackupObjectByName(ctx, client, collectionPath, name)
+	if err != nil {
+		return nil, err
+	}
+	return client.DoRequest(ctx, http.MethodGet, sureBackupObjectURL(client, collectionPath, id), nil)
+}
+
+func updateSureBackupObject(ctx context.Context, client *vc.VBRClient, collectionPath, id string, object interface{}) error {
+	body, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR request: %w", err)
+	}
+	respBody, err := client.DoRequest(ctx, http.MethodPut, sureBackupObjectURL(client, collectionPath, id), body)
+	if err != nil {
+		return err
+	}
+	_, err = waitForResponseSession(ctx, client, respBody)
+	return err
+}
+
+func deleteSureBackupObject(ctx context.Context, client *vc.VBRClient, collectionPath, id string) error {
+	respBody, err := client.DoRequest(ctx, http.MethodDelete, sureBackupObjectURL(client, collectionPath, id), nil)
+	if err != nil {
+		return err
+	}
+	_, err = waitForResponseSession(ctx, client, respBody)
+	return err
+}
+
+// findSureBackupObjectByName returns the ID of the only object of the collection named name
+func findSureBackupObjectByName(ctx context.Context, client *vc.VBRClient, collectionPath, name string) (string, error) {
+	queryParams := url.Values{}
+	queryParams.Set("nameFilter", name)
+	objects, err := vc.FetchAllPages(ctx, 0, vc.DefaultPageSize, func(ctx context.Context, skip, limit int) (vc.Page[vbrJobListItem], error) {
+		queryParams.Set("skip", fmt.Sprintf("%d", skip))
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+		respBody, err := client.DoRequest(ctx, http.MethodGet, client.BuildAPIURL(vc.WithQuery(collectionPath, queryParams)), nil)
+		if err != nil {
+			return vc.Page[vbrJobListItem]{}, err
+		}
+
+		var response vbrJobListResponse
+		if err := json.Unmarshal(respBody, &response); err != nil {
+			return vc.Page[vbrJobListItem]{}, fmt.Errorf("error parsing response: %w", err)
+		}
+		return vc.Page[vbrJobListItem]{Items: response.Data, Total: response.Pagination.Total}, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// The name filter matches substrings, so objects with longer names are skipped
+	for _, object := range objects.Items {
+		if object.Name == name {
+			return object.ID, nil
+		}
+	}
+	return "", fmt.Errorf("%s was created, but no object named %q was found", collectionPath, name)
+}
+
+func decodeSureBackupObject(body []byte, target interface{}, kind string) error {
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("failed to decode VBR %s response: %w", kind, err)
+	}
+	return nil
+}
+
+func sureBackupObjectURL(client *vc.VBRClient, collectionPath, id string) string {
+	return client.BuildAPIURL(collectionPath + vc.Endpoint("/%s", id))
+}
@@ -0,0 +1,85 @@
+package vbr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	vc "terraform-provider-veeambackup/internal/client"
+)
+
+// Transport protocols of a syslog server
+const (
+	SyslogProtocolUDP = "Udp"
+	SyslogProtocolTCP = "Tcp"
+	SyslogProtocolTLS = "Tls"
+)
+
+// SyslogProtocols are the transport protocols VBR forwards events to syslog servers with
+var SyslogProtocols = []string{SyslogProtocolUDP, SyslogProtocolTCP, SyslogProtocolTLS}
+
+// SyslogServer is a syslog server VBR forwards its events to. VBR 12.1 or later is required.
+type SyslogServer struct {
+	ServerName            string  `json:"serverName"`
+	Port                  int     `json:"port"`
+	TransportProtocol     string  `json:"transportProtocol"`
+	CertificateThumbprint *string `json:"certificateThumbprint,omitempty"` // Thumbprint of the server certificate trusted for TLS
+}
+
+// DefaultSyslogPort returns the well-known port of a syslog transport protocol
+func DefaultSyslogPort(protocol string) int {
+	if protocol == SyslogProtocolTLS {
+		return 6514
+	}
+	return 514
+}
+
+// GetSyslogServers returns the syslog servers VBR forwards its events to
+func GetSyslogServers(ctx context.Context, client *vc.VBRClient) ([]SyslogServer, error) {
+	respBody, err := client.DoRequest(ctx, http.MethodGet, eventForwardingURL(client), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var options struct {
+		SyslogServers []SyslogServer `json:"syslogServers"`
+	}
+	if err := json.Unmarshal(respBody, &options); err != nil {
+		return nil, fmt.Errorf("failed to decode VBR event forwarding response: %w", err)
+	}
+	return options.SyslogServers, nil
+}
+
+// UpdateSyslogServers replaces the syslog servers VBR forwards its events to. The API only updates
+// the event forwarding options as a whole, so they are read and written back with the other
+// settings, such as SNMP receivers, unchanged.
+func UpdateSyslogServers(ctx context.Context, client *vc.VBRClient, servers []SyslogServer) error {
+	optionsURL := eventForwardingURL(client)
+	respBody, err := client.DoRequest(ctx, http.MethodGet, optionsURL, nil)
+	if err != nil {
+		return err
+	}
+
+	var options map[string]interface{}
+	if err := json.Unmarshal(respBody, &options); err != nil {
+		return fmt.Errorf("failed to decode VBR event forwarding response: %w", err)
+	}
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	if servers == nil {
+		servers = []SyslogServer{}
+	}
+	options["syslogServers"] = servers
+
+	body, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VBR event forwarding request: %w", err)
+	}
+	_, err = client.DoRequest(ctx, http.MethodPut, optionsURL, body)
+	return err
+}
+
+func eventForwardingURL(client *vc.VBRClient) string {
+	return client.BuildAPIURL("/api/v1/generalOptions/eventForwarding")
+}
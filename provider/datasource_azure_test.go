@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-veeambackup/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceAzureProtectedItems(t *testing.T) {
+	p, server := testAzureProvider(t)
+	recent := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	old := time.Now().Add(-72 * time.Hour).UTC().Format(time.RFC3339)
+	server.Put("/protectedItem/virtualMachines", acctest.Object{
+		"results": []interface{}{
+			acctest.Object{"id": "vm1", "azureId": "/subscriptions/s/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/web", "name": "web", "restorePointsCount": 3, "latestRestorePointDate": recent},
+			acctest.Object{"id": "vm2", "azureId": "/subscriptions/s/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/db", "name": "db", "restorePointsCount": 5, "latestRestorePointDate": old},
+		},
+		"totalCount": 2,
+	})
+
+	d := readDataSource(t, p, "veeambackup_azure_protected_items", map[string]interface{}{
+		"item_types":            []interface{}{"VirtualMachine"},
+		"max_restore_point_age": "24h",
+		"required_azure_ids": []interface{}{
+			"/subscriptions/s/resourceGroups/RG/providers/Microsoft.Compute/virtualMachines/web",
+			"/subscriptions/s/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/db",
+			"/subscriptions/s/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/app",
+		},
+	})
+	if got := d.Get("items.#").(int); got != 2 {
+		t.Fatalf("items has %d items, want 2", got)
+	}
+	if got := d.Get("items.1.protection_status").(string); got != "Outdated" {
+		t.Errorf("items.1.protection_status = %q, want Outdated", got)
+	}
+	want := []interface{}{
+		"/subscriptions/s/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/app",
+		"/subscriptions/s/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/db",
+	}
+	if got := d.Get("unprotected_azure_ids").([]interface{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("unprotected_azure_ids = %v, want %v", got, want)
+	}
+	if d.Get("all_protected").(bool) {
+		t.Error("all_protected = true, want false")
+	}
+}
+
+func TestDataSourceAzureResourceGroup(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Put("/cloudInfrastructure/resourceGroups", acctest.Object{
+		"results": []interface{}{
+			acctest.Object{"id": "rg1", "resourceId": "/subscriptions/s1/resourceGroups/Production", "name": "Production", "subscriptionId": "s1", "regionId": "westeurope"},
+			acctest.Object{"id": "rg2", "resourceId": "/subscriptions/s1/resourceGroups/production-web", "name": "production-web", "subscriptionId": "s1", "regionId": "westeurope"},
+			acctest.Object{"id": "rg3", "resourceId": "/subscriptions/s2/resourceGroups/production", "name": "production", "subscriptionId": "s2", "regionId": "eastus"},
+		},
+		"totalCount": 3,
+	})
+
+	d := readDataSource(t, p, "veeambackup_azure_resource_group", map[string]interface{}{
+		"name":            "production",
+		"subscription_id": "s1",
+	})
+	if d.Id() != "rg1" {
+		t.Errorf("id = %q, want rg1", d.Id())
+	}
+	if got := d.Get("resource_id").(string); got != "/subscriptions/s1/resourceGroups/Production" {
+		t.Errorf("resource_id = %q, want /subscriptions/s1/resourceGroups/Production", got)
+	}
+
+	ds := p.DataSourcesMap["veeambackup_azure_resource_group"]
+	d = schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"name": "staging", "subscription_id": "s1"})
+	if diags := ds.ReadContext(context.Background(), d, p.Meta()); !diags.HasError() {
+		t.Error("reading a resource group that does not exist succeeded")
+	}
+}
+
+func TestDataSourceAzureTenants(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Put("/accounts/azure/service", acctest.Object{
+		"results": []interface{}{
+			acctest.Object{"accountId": "sa1", "tenantId": "aaaaaaaa-0000-0000-0000-000000000001", "tenantName": "Contoso"},
+			acctest.Object{"accountId": "sa2", "tenantId": "AAAAAAAA-0000-0000-0000-000000000001", "tenantName": "Contoso"},
+			acctest.Object{"accountId": "sa3", "tenantId": "bbbbbbbb-0000-0000-0000-000000000002", "tenantName": "Fabrikam"},
+			acctest.Object{"accountId": "sa4", "tenantId": "cccccccc-0000-0000-0000-000000000003", "tenantName": "Fabrikam"},
+		},
+		"totalCount": 4,
+	})
+
+	d := readDataSource(t, p, "veeambackup_azure_tenants", map[string]interface{}{})
+	if got := d.Get("tenants.#").(int); got != 3 {
+		t.Fatalf("tenants has %d tenants, want 3", got)
+	}
+	if got := d.Get("tenants.0.service_account_ids").([]interface{}); !reflect.DeepEqual(got, []interface{}{"sa1", "sa2"}) {
+		t.Errorf("tenants.0.service_account_ids = %v, want [sa1 sa2]", got)
+	}
+	want := map[string]interface{}{"Contoso": "aaaaaaaa-0000-0000-0000-000000000001"}
+	if got := d.Get("tenants_by_name").(map[string]interface{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("tenants_by_name = %v, want %v", got, want)
+	}
+}
+
+func TestDataSourceAzureUnprotectedItems(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Put("/virtualMachines", acctest.Object{
+		"results": []interface{}{
+			acctest.Object{"id": "vm2", "azureId": "/subscriptions/s1/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/web", "name": "web", "subscriptionId": "s1", "regionId": "eastus", "regionName": "East US"},
+			acctest.Object{"id": "vm1", "azureId": "/subscriptions/s1/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/app", "name": "app", "subscriptionId": "s1", "regionId": "eastus", "regionName": "East US"},
+			acctest.Object{"id": "vm3", "azureId": "/subscriptions/s2/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/db", "name": "db", "subscriptionId": "s2", "regionId": "westeurope", "regionName": "West Europe"},
+		},
+		"totalCount": 3,
+	})
+	server.Put("/databases", acctest.Object{
+		"results": []interface{}{
+			acctest.Object{"id": "sql1", "resourceId": "/subscriptions/s1/resourceGroups/rg/providers/Microsoft.Sql/servers/sql/databases/orders", "name": "orders", "subscriptionId": "s1", "regionId": "eastus", "regionName": "East US"},
+		},
+		"total": 1,
+	})
+
+	d := readDataSource(t, p, "veeambackup_azure_unprotected_items", map[string]interface{}{
+		"item_types": []interface{}{"VirtualMachine", "SqlDatabase"},
+	})
+	if got := d.Get("total_count").(int); got != 4 {
+		t.Fatalf("total_count = %d, want 4", got)
+	}
+	if got := d.Get("items.0.azure_id").(string); got != "/subscriptions/s1/resourceGroups/rg/providers/Microsoft.Sql/servers/sql/databases/orders" {
+		t.Errorf("items.0.azure_id = %q, want the resource ID of the database", got)
+	}
+	if got := d.Get("groups.#").(int); got != 3 {
+		t.Fatalf("groups has %d items, want 3", got)
+	}
+	if got := d.Get("groups.1.type").(string); got != "VirtualMachine" {
+		t.Errorf("groups.1.type = %q, want VirtualMachine", got)
+	}
+	if got := d.Get("groups.1.ids").([]interface{}); !reflect.DeepEqual(got, []interface{}{"vm1", "vm2"}) {
+		t.Errorf("groups.1.ids = %v, want [vm1 vm2]", got)
+	}
+	if got := d.Get("groups.2.region_name").(string); got != "West Europe" {
+		t.Errorf("groups.2.region_name = %q, want West Europe", got)
+	}
+}
+
+func TestDataSourceAzurePolicyCostEstimation(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Put("/policies/virtualMachines/00000000-0000-0000-0000-00000000aaaa/costEstimation", acctest.Object{
+		"currency": "USD", "totalCost": 120.5, "snapshotCost": 20.5, "backupCost": 100,
+	})
+
+	d := readDataSource(t, p, "veeambackup_azure_policy_cost_estimation", map[string]interface{}{
+		"policy_type":    "VirtualMachine",
+		"policy_id":      "00000000-0000-0000-0000-00000000aaaa",
+		"monthly_budget": 100.0,
+	})
+	if got := d.Get("total_monthly_cost").(float64); got != 120.5 {
+		t.Errorf("total_monthly_cost = %v, want 120.5", got)
+	}
+	if d.Get("within_budget").(bool) {
+		t.Error("within_budget = true, want false")
+	}
+	if got := d.Id(); got != "VirtualMachine/00000000-0000-0000-0000-00000000aaaa" {
+		t.Errorf("id = %q, want VirtualMachine/00000000-0000-0000-0000-00000000aaaa", got)
+	}
+}
+
+func TestDataSourceAzurePolicyImports(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Put("/policies/virtualMachines", acctest.Object{
+		"results": []interface{}{
+			acctest.Object{"id": "p2", "name": "Production VMs"},
+			acctest.Object{"id": "p1", "name": "production-vms"},
+		},
+		"totalCount": 2,
+	})
+	server.Put("/policies/sql", acctest.Object{
+		"results":    []interface{}{acctest.Object{"id": "p3", "name": "Databases"}},
+		"totalCount": 1,
+	})
+
+	d := readDataSource(t, p, "veeambackup_azure_policy_imports", map[string]interface{}{
+		"policy_types": []interface{}{"VirtualMachine", "SqlDatabase"},
+	})
+	if got := d.Get("imports.#").(int); got != 3 {
+		t.Fatalf("imports has %d items, want 3", got)
+	}
+	want := []string{
+		"veeambackup_azure_sql_backup_policy.databases",
+		"veeambackup_azure_vm_backup_policy.production_vms",
+		"veeambackup_azure_vm_backup_policy.production_vms_2",
+	}
+	for i, address := range want {
+		if got := d.Get(fmt.Sprintf("imports.%d.address", i)).(string); got != address {
+			t.Errorf("imports.%d.address = %q, want %q", i, got, address)
+		}
+	}
+	if d.Get("imports.0.importable").(bool) {
+		t.Error("imports.0.importable is true, but SQL backup policies cannot be imported")
+	}
+
+	blocks := d.Get("import_blocks").(string)
+	if !strings.Contains(blocks, "to = veeambackup_azure_vm_backup_policy.production_vms_2\n  id = \"p1\"") {
+		t.Errorf("import_blocks does not import p1:\n%s", blocks)
+	}
+	if strings.Contains(blocks, "p3") {
+		t.Errorf("import_blocks imports the SQL backup policy:\n%s", blocks)
+	}
+}
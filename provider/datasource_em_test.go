@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testEMProvider returns a provider configured against a new mock Enterprise Manager server that
+// federates two backup servers
+func testEMProvider(t *testing.T) *schema.Provider {
+	server := acctest.NewEMServer(t)
+	server.Put("/backupServers", acctest.Object{"BackupServers": []acctest.Object{
+		{"UID": "urn:veeam:BackupServer:aaaa", "Name": "vbr-east.example.com", "Port": 9392, "Version": "12.3.0.310"},
+		{"UID": "urn:veeam:BackupServer:bbbb", "Name": "vbr-west.example.com", "Port": 9392, "Version": "12.2.0.334"},
+	}})
+	server.Put("/jobs", acctest.Object{"Jobs": []acctest.Object{
+		emJob("1111", "Daily VMs", "aaaa", "vbr-east.example.com"),
+		emJob("2222", "Daily VMs", "bbbb", "vbr-west.example.com"),
+		emJob("3333", "SQL servers", "bbbb", "vbr-west.example.com"),
+	}})
+
+	p := Provider()
+	acctest.ConfigureProvider(t, p, acctest.EMProviderConfig(server))
+	return p
+}
+
+func emJob(id, name, serverID, serverName string) acctest.Object {
+	return acctest.Object{
+		"UID":                "urn:veeam:Job:" + id,
+		"Name":               name,
+		"JobType":            "Backup",
+		"Platform":           "VMware",
+		"ScheduleConfigured": true,
+		"ScheduleEnabled":    true,
+		"Links": []acctest.Object{{
+			"Rel":  "Up",
+			"Type": "BackupServerReference",
+			"Name": serverName,
+			"Href": "https://em.example.com:9398/api/backupServers/" + serverID,
+		}},
+	}
+}
+
+// readDataSource reads the data source with the given configuration and returns its state
+func readDataSource(t *testing.T, p *schema.Provider, name string, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	ds := p.DataSourcesMap[name]
+	d := schema.TestResourceDataRaw(t, ds.Schema, config)
+	if diags := ds.ReadContext(context.Background(), d, p.Meta()); diags.HasError() {
+		t.Fatalf("reading %s: %v", name, diags)
+	}
+	return d
+}
+
+func TestDataSourceEMBackupServers(t *testing.T) {
+	p := testEMProvider(t)
+
+	d := readDataSource(t, p, "veeambackup_em_backup_servers", map[string]interface{}{})
+	if got := d.Get("backup_servers.#").(int); got != 2 {
+		t.Fatalf("backup_servers has %d items, want 2", got)
+	}
+	if got := d.Get("backup_servers.1.id").(string); got != "bbbb" {
+		t.Errorf("backup_servers.1.id = %q, want bbbb", got)
+	}
+
+	d = readDataSource(t, p, "veeambackup_em_backup_servers", map[string]interface{}{"name_filter": "EAST"})
+	if got := d.Get("backup_servers.#").(int); got != 1 {
+		t.Fatalf("filtered backup_servers has %d items, want 1", got)
+	}
+	if got := d.Get("backup_servers.0.name").(string); got != "vbr-east.example.com" {
+		t.Errorf("backup_servers.0.name = %q, want vbr-east.example.com", got)
+	}
+}
+
+func TestDataSourceEMJobs(t *testing.T) {
+	p := testEMProvider(t)
+
+	d := readDataSource(t, p, "veeambackup_em_jobs", map[string]interface{}{})
+	if got := d.Get("jobs.#").(int); got != 3 {
+		t.Fatalf("jobs has %d items, want 3", got)
+	}
+
+	d = readDataSource(t, p, "veeambackup_em_jobs", map[string]interface{}{"backup_server_id": "bbbb", "name_filter": "daily"})
+	if got := d.Get("jobs.#").(int); got != 1 {
+		t.Fatalf("filtered jobs has %d items, want 1", got)
+	}
+	for key, want := range map[string]string{
+		"jobs.0.id":                 "2222",
+		"jobs.0.backup_server_id":   "bbbb",
+		"jobs.0.backup_server_name": "vbr-west.example.com",
+	} {
+		if got := d.Get(key).(string); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+}
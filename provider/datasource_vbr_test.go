@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"terraform-provider-veeambackup/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceVBRBackups(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{Path: "/api/v1/backups"})
+	for id, backup := range map[string]acctest.Object{
+		"b1": {"name": "Daily file shares", "jobId": "j1", "jobType": "FileBackup", "repositoryId": "r1"},
+		"b2": {"name": "Daily file shares", "jobId": "j2", "jobType": "FileBackup", "repositoryId": "r2"},
+		"b3": {"name": "Object storage", "jobId": "j3", "jobType": "ObjectStorageBackup", "repositoryId": "r1"},
+	} {
+		backup["id"] = id
+		server.Put("/api/v1/backups/"+id, backup)
+	}
+
+	d := readDataSource(t, p, "veeambackup_vbr_backups", map[string]interface{}{})
+	if got := d.Get("backups.#").(int); got != 3 {
+		t.Fatalf("backups has %d items, want 3", got)
+	}
+
+	d = readDataSource(t, p, "veeambackup_vbr_backups", map[string]interface{}{
+		"name_filter":          "Daily*",
+		"repository_id_filter": "r2",
+	})
+	if got := d.Get("backups.#").(int); got != 1 {
+		t.Fatalf("filtered backups has %d items, want 1", got)
+	}
+	if got := d.Get("backups.0.id").(string); got != "b2" {
+		t.Errorf("backups.0.id = %q, want b2", got)
+	}
+	if got := d.Get("pagination.0.count").(int); got != 1 {
+		t.Errorf("pagination.0.count = %d, want 1", got)
+	}
+}
+
+func TestDataSourceVBRJobStates(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{Path: "/api/v1/jobs/states"})
+	server.Collection(acctest.Collection{Path: "/api/v1/sessions/s1/taskSessions"})
+	recent := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	old := time.Now().Add(-48 * time.Hour).UTC().Format(time.RFC3339)
+	server.Put("/api/v1/jobs/states/j1", acctest.Object{"id": "j1", "name": "Finance shares", "type": "FileBackup", "lastRun": recent, "lastResult": "Success", "sessionId": "s1"})
+	server.Put("/api/v1/jobs/states/j2", acctest.Object{"id": "j2", "name": "Archive buckets", "type": "ObjectStorageBackup", "lastRun": old, "lastResult": "Success"})
+	server.Put("/api/v1/sessions/s1", acctest.Object{"id": "s1", "creationTime": "2024-05-01T22:00:00Z", "endTime": "2024-05-01T22:12:30Z"})
+	server.Put("/api/v1/sessions/s1/taskSessions/t1", acctest.Object{"id": "t1", "progress": acctest.Object{"processedSize": 1000}})
+	server.Put("/api/v1/sessions/s1/taskSessions/t2", acctest.Object{"id": "t2", "progress": acctest.Object{"processedSize": 500}})
+
+	d := readDataSource(t, p, "veeambackup_vbr_job_states", map[string]interface{}{})
+	if got := d.Get("jobs.#").(int); got != 2 {
+		t.Fatalf("jobs has %d items, want 2", got)
+	}
+	if got := d.Get("result_counts.Success").(int); got != 2 {
+		t.Errorf("result_counts.Success = %d, want 2", got)
+	}
+	if !d.Get("all_succeeded").(bool) {
+		t.Error("all_succeeded is false without last_run_within")
+	}
+
+	d = readDataSource(t, p, "veeambackup_vbr_job_states", map[string]interface{}{"last_run_within": "24h"})
+	if d.Get("all_succeeded").(bool) {
+		t.Error("all_succeeded is true although Archive buckets last ran 48h ago")
+	}
+
+	d = readDataSource(t, p, "veeambackup_vbr_job_states", map[string]interface{}{"name_filter": "Finance*", "last_run_within": "24h"})
+	if !d.Get("all_succeeded").(bool) {
+		t.Error("all_succeeded is false for Finance shares, which succeeded an hour ago")
+	}
+	if got := d.Get("jobs.0.last_run_duration").(int); got != 750 {
+		t.Errorf("jobs.0.last_run_duration = %d, want 750", got)
+	}
+	if got := d.Get("jobs.0.processed_size").(int); got != 1500 {
+		t.Errorf("jobs.0.processed_size = %d, want 1500", got)
+	}
+}
+
+func TestDataSourceVBRRepositorySpace(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{Path: "/api/v1/backupInfrastructure/repositories/states"})
+	server.Collection(acctest.Collection{Path: "/api/v1/backupInfrastructure/repositories"})
+	const hardenedID, s3ID = "00000000-0000-0000-0000-00000000aaaa", "00000000-0000-0000-0000-00000000bbbb"
+	server.Put("/api/v1/backupInfrastructure/repositories/states/"+hardenedID, acctest.Object{"id": hardenedID, "name": "hardened", "type": "LinuxHardened", "capacityGB": 1000, "freeGB": 150, "usedSpaceGB": 800})
+	server.Put("/api/v1/backupInfrastructure/repositories/states/"+s3ID, acctest.Object{"id": s3ID, "name": "archive", "type": "AmazonS3", "usedSpaceGB": 4000})
+	server.Put("/api/v1/backupInfrastructure/repositories/"+hardenedID, acctest.Object{"id": hardenedID, "type": "LinuxHardened", "repository": acctest.Object{"useImmutableBackups": true, "makeRecentBackupsImmutableDays": 14}})
+	server.Put("/api/v1/backupInfrastructure/repositories/"+s3ID, acctest.Object{"id": s3ID, "type": "AmazonS3", "bucket": acctest.Object{"immutability": acctest.Object{"isEnabled": true, "daysCount": 30}}})
+
+	d := readDataSource(t, p, "veeambackup_vbr_repository_space", map[string]interface{}{"max_used_percent": 80.0})
+	if got := d.Get("repositories.#").(int); got != 2 {
+		t.Fatalf("repositories has %d items, want 2", got)
+	}
+	if got := d.Get("repositories.1.used_percent").(float64); got != 85 {
+		t.Errorf("used_percent of hardened = %v, want 85", got)
+	}
+	if got := d.Get("repositories.1.immutability_days").(int); got != 14 {
+		t.Errorf("immutability_days of hardened = %d, want 14", got)
+	}
+	if got := d.Get("repositories.0.immutability_days").(int); got != 30 {
+		t.Errorf("immutability_days of archive = %d, want 30", got)
+	}
+	if got := d.Get("over_threshold_ids").([]interface{}); len(got) != 1 || got[0] != hardenedID {
+		t.Errorf("over_threshold_ids = %v, want only the hardened repository", got)
+	}
+	if d.Get("all_within_threshold").(bool) {
+		t.Error("all_within_threshold is true although hardened is 85% used")
+	}
+
+	d = readDataSource(t, p, "veeambackup_vbr_repository_space", map[string]interface{}{"max_used_percent": 80.0, "repository_ids": []interface{}{s3ID}})
+	if !d.Get("all_within_threshold").(bool) {
+		t.Error("all_within_threshold is false for the archive repository, whose capacity is unknown")
+	}
+}
+
+func TestDataSourceVBRProxiesLoad(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{Path: "/api/v1/backupInfrastructure/proxies/states"})
+	server.Collection(acctest.Collection{Path: "/api/v1/backupInfrastructure/proxies"})
+	for _, proxy := range []struct {
+		id, name     string
+		online       bool
+		running, max int
+	}{
+		{"p1", "proxy-a", true, 4, 4},
+		{"p2", "proxy-b", true, 3, 8},
+		{"p3", "proxy-c", false, 0, 8},
+		{"p4", "proxy-d", true, 1, 2},
+	} {
+		server.Put("/api/v1/backupInfrastructure/proxies/states/"+proxy.id, acctest.Object{"id": proxy.id, "name": proxy.name, "type": "ViProxy", "isOnline": proxy.online, "runningTasksCount": proxy.running})
+		server.Put("/api/v1/backupInfrastructure/proxies/"+proxy.id, acctest.Object{"id": proxy.id, "name": proxy.name, "type": "ViProxy", "server": acctest.Object{"hostId": "h-" + proxy.id, "maxTaskCount": proxy.max}})
+	}
+
+	d := readDataSource(t, p, "veeambackup_vbr_proxies_load", map[string]interface{}{})
+	if got := d.Get("proxies.#").(int); got != 4 {
+		t.Fatalf("proxies has %d items, want 4", got)
+	}
+	if got := d.Get("proxies.1.free_task_slots").(int); got != 5 {
+		t.Errorf("free_task_slots of proxy-b = %d, want 5", got)
+	}
+	if got := d.Get("proxies.0.load_percent").(float64); got != 100 {
+		t.Errorf("load_percent of proxy-a = %v, want 100", got)
+	}
+	if got := d.Get("total_running_tasks").(int); got != 8 {
+		t.Errorf("total_running_tasks = %d, want 8", got)
+	}
+	// proxy-c is offline, so its free slots do not count
+	if got := d.Get("total_free_task_slots").(int); got != 6 {
+		t.Errorf("total_free_task_slots = %d, want 6", got)
+	}
+	if got := d.Get("least_loaded_proxy_id").(string); got != "p2" {
+		t.Errorf("least_loaded_proxy_id = %q, want p2", got)
+	}
+}
+
+func TestDataSourceVBREncryptionKMSHealth(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{Path: "/api/v1/kmsServers"})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %s", err)
+	}
+	defer listener.Close()
+	openPort := listener.Addr().(*net.TCPAddr).Port
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %s", err)
+	}
+	closedPort := closed.Addr().(*net.TCPAddr).Port
+	closed.Close()
+
+	const upID, downID, missingID = "00000000-0000-0000-0000-00000000aaaa", "00000000-0000-0000-0000-00000000bbbb", "00000000-0000-0000-0000-00000000cccc"
+	server.Put("/api/v1/kmsServers/"+upID, acctest.Object{"id": upID, "name": "127.0.0.1", "description": "primary", "type": "KMIP", "port": openPort})
+	server.Put("/api/v1/kmsServers/"+downID, acctest.Object{"id": downID, "name": "127.0.0.1", "description": "secondary", "type": "KMIP", "port": closedPort})
+
+	d := readDataSource(t, p, "veeambackup_vbr_encryption_kms_health", map[string]interface{}{"timeout": "2s"})
+	if got := d.Get("kms_servers.#").(int); got != 2 {
+		t.Fatalf("kms_servers has %d items, want 2", got)
+	}
+	if got := d.Get("unreachable_ids").([]interface{}); len(got) != 1 || got[0] != downID {
+		t.Errorf("unreachable_ids = %v, want only %s", got, downID)
+	}
+	if d.Get("all_reachable").(bool) {
+		t.Error("all_reachable is true although a KMS server refuses connections")
+	}
+
+	d = readDataSource(t, p, "veeambackup_vbr_encryption_kms_health", map[string]interface{}{"kms_server_ids": []interface{}{upID}})
+	if !d.Get("all_reachable").(bool) {
+		t.Errorf("all_reachable is false for the listening KMS server: %v", d.Get("kms_servers"))
+	}
+
+	d = readDataSource(t, p, "veeambackup_vbr_encryption_kms_health", map[string]interface{}{"kms_server_ids": []interface{}{upID, missingID}})
+	if got := d.Get("unreachable_ids").([]interface{}); len(got) != 1 || got[0] != missingID {
+		t.Errorf("unreachable_ids = %v, want only the unregistered %s", got, missingID)
+	}
+}
+
+func TestDataSourceVBRVmwareInventory(t *testing.T) {
+	p, server := testVBRProvider(t)
+	var filter interface{}
+	server.Collection(acctest.Collection{
+		Path: "/api/v1/inventory/vmware/hosts/vc01.example.com",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			filter = obj["filter"]
+		},
+		Respond: func(_ *acctest.Server, _ acctest.Object) interface{} {
+			return acctest.Object{
+				"data": []acctest.Object{
+					{"platform": "VMware", "hostName": "vc01.example.com", "name": "backup-gold", "type": "Tag", "objectId": "urn:vmomi:InventoryServiceTag:1", "urn": "urn:vmomi:InventoryServiceTag:1:GLOBAL"},
+				},
+				"pagination": acctest.Object{"total": 1, "count": 1},
+			}
+		},
+	})
+
+	d := readDataSource(t, p, "veeambackup_vbr_vmware_inventory", map[string]interface{}{
+		"host_name":      "vc01.example.com",
+		"hierarchy_type": "VmsAndTags",
+		"type_filter":    "Tag",
+		"name_filter":    "backup",
+	})
+	if got := d.Get("objects.#").(int); got != 1 {
+		t.Fatalf("objects has %d items, want 1", got)
+	}
+	if got := d.Get("objects.0.urn").(string); got != "urn:vmomi:InventoryServiceTag:1:GLOBAL" {
+		t.Errorf("objects.0.urn = %q", got)
+	}
+	group, _ := filter.(acctest.Object)
+	if items, _ := group["items"].([]interface{}); group["type"] != "GroupExpression" || len(items) != 2 {
+		t.Errorf("filter = %v, want a group of the type and name predicates", filter)
+	}
+}
+
+func TestDataSourceVBRInstanceLicensingUsage(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Put("/api/v1/license", acctest.Object{
+		"status":  "Valid",
+		"edition": "EnterprisePlus",
+		"instanceLicenseSummary": acctest.Object{
+			"licensedInstancesNumber": 200,
+			"usedInstancesNumber":     150,
+			"objects": []interface{}{
+				acctest.Object{"type": "VirtualMachine", "count": 120, "multiplier": 1, "usedInstancesNumber": 120},
+				acctest.Object{"type": "Server", "count": 10, "multiplier": 3, "usedInstancesNumber": 30},
+			},
+		},
+	})
+	server.Collection(acctest.Collection{Path: "/api/v1/license/instances"})
+	server.Put("/api/v1/license/instances/i1", acctest.Object{"instanceId": "i1", "name": "sql01", "type": "Server", "usedInstancesNumber": 3})
+
+	d := readDataSource(t, p, "veeambackup_vbr_instance_licensing_usage", map[string]interface{}{})
+	if got := d.Get("used_instances_percent").(float64); got != 75 {
+		t.Errorf("used_instances_percent = %v, want 75", got)
+	}
+	if got := d.Get("workload_types.1.used_instances").(float64); got != 30 {
+		t.Errorf("workload_types.1.used_instances = %v, want 30", got)
+	}
+	if got := d.Get("workloads.#").(int); got != 0 {
+		t.Errorf("workloads has %d items without include_workloads", got)
+	}
+
+	d = readDataSource(t, p, "veeambackup_vbr_instance_licensing_usage", map[string]interface{}{"include_workloads": true})
+	if got := d.Get("workloads.0.name").(string); got != "sql01" {
+		t.Errorf("workloads.0.name = %q, want sql01", got)
+	}
+}
+
+func TestDataSourceVBRJobAdopt(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{Path: "/api/v1/jobs"})
+	server.Put("/api/v1/jobs/j1", acctest.Object{
+		"id": "j1", "name": "Tenant VMs", "type": "CloudDirectorBackup", "isDisabled": false,
+		"virtualMachines": acctest.Object{
+			"includes": []interface{}{acctest.Object{"platform": "CloudDirector", "hostName": "vcd.example.com", "name": "tenant", "type": "Organization"}},
+			"excludes": acctest.Object{"vms": []interface{}{}},
+		},
+		"storage": acctest.Object{
+			"backupRepositoryId": "r1",
+			"backupProxies":      acctest.Object{"autoSelection": true},
+			"advancedSettings":   acctest.Object{"backupModeType": "Incremental"},
+		},
+		"guestProcessing": nil,
+	})
+	server.Put("/api/v1/jobs/j2", acctest.Object{"id": "j2", "name": "Tenant VMs (old)", "type": "CloudDirectorBackup"})
+	server.Put("/api/v1/jobs/j3", acctest.Object{"id": "j3", "name": "Finance shares", "type": "FileBackup"})
+
+	d := readDataSource(t, p, "veeambackup_vbr_job_adopt", map[string]interface{}{"name": "Tenant VMs"})
+	if d.Id() != "j1" {
+		t.Errorf("id = %q, want j1, the job named exactly Tenant VMs", d.Id())
+	}
+	if got := d.Get("resource_type").(string); got != "veeambackup_vbr_vmware_cloud_director_job" {
+		t.Errorf("resource_type = %q, want veeambackup_vbr_vmware_cloud_director_job", got)
+	}
+	if !d.Get("importable").(bool) {
+		t.Errorf("importable is false: %v", d.Get("issues"))
+	}
+	if got := d.Get("unmanaged_settings").([]interface{}); len(got) != 1 || got[0] != "storage.advancedSettings" {
+		t.Errorf("unmanaged_settings = %v, want [storage.advancedSettings]", got)
+	}
+	if got, want := d.Get("unmanaged_settings_json").(string), `{"storage":{"advancedSettings":{"backupModeType":"Incremental"}}}`; got != want {
+		t.Errorf("unmanaged_settings_json = %s, want %s", got, want)
+	}
+
+	d = readDataSource(t, p, "veeambackup_vbr_job_adopt", map[string]interface{}{"name": "Finance shares"})
+	if d.Get("importable").(bool) {
+		t.Error("importable is true for a file share job, whose resource does not support import")
+	}
+
+	d = readDataSource(t, p, "veeambackup_vbr_job_adopt", map[string]interface{}{
+		"name":          "Tenant VMs",
+		"resource_type": "veeambackup_vbr_file_share_backup_job",
+	})
+	if d.Get("importable").(bool) || d.Get("issues.#").(int) != 1 {
+		t.Errorf("importing a Cloud Director job into a file share job is reported as %v", d.Get("issues"))
+	}
+
+	ds := p.DataSourcesMap["veeambackup_vbr_job_adopt"]
+	missing := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"name": "Payroll"})
+	if diags := ds.ReadContext(context.Background(), missing, p.Meta()); !diags.HasError() {
+		t.Error("looking up a job that does not exist succeeded")
+	}
+}
+
+func TestDataSourceVBRJobImports(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{Path: "/api/v1/jobs"})
+	server.Put("/api/v1/jobs/j1", acctest.Object{"id": "j1", "name": "Tenant A VMs", "type": "CloudDirectorBackup"})
+	server.Put("/api/v1/jobs/j2", acctest.Object{"id": "j2", "name": "Finance shares", "type": "FileBackup"})
+	server.Put("/api/v1/jobs/j3", acctest.Object{"id": "j3", "name": "Exchange", "type": "Backup"})
+
+	d := readDataSource(t, p, "veeambackup_vbr_job_imports", map[string]interface{}{})
+	if got := d.Get("imports.#").(int); got != 3 {
+		t.Fatalf("imports has %d items, want 3", got)
+	}
+	if got := d.Get("imports.0.address").(string); got != "" {
+		t.Errorf("imports.0.address = %q, want none for a job type no resource manages", got)
+	}
+	if got := d.Get("imports.1.address").(string); got != "veeambackup_vbr_file_share_backup_job.finance_shares" {
+		t.Errorf("imports.1.address = %q, want veeambackup_vbr_file_share_backup_job.finance_shares", got)
+	}
+	want := "import {\n  to = veeambackup_vbr_vmware_cloud_director_job.tenant_a_vms\n  id = \"j1\"\n}\n"
+	if got := d.Get("import_blocks").(string); got != want {
+		t.Errorf("import_blocks = %q, want %q", got, want)
+	}
+}
@@ -1,18 +1,86 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"terraform-provider-veeambackup/internal/azure"
 	"terraform-provider-veeambackup/internal/client"
 	"terraform-provider-veeambackup/internal/vbr"
 	"terraform-provider-veeambackup/internal/aws"
+	"terraform-provider-veeambackup/internal/gcp"
+	"terraform-provider-veeambackup/internal/vb365"
+	"terraform-provider-veeambackup/internal/em"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
+			// Retry behaviour shared by all service clients
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of retries for API requests that fail with a transient error (429, 502, 503, 504) (default: 3)",
+				DefaultFunc: schema.EnvDefaultFunc("VEEAM_MAX_RETRIES", 3),
+			},
+			"retry_wait_min": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Minimum time in seconds to wait before retrying a failed API request; doubled on every retry (default: 1)",
+				DefaultFunc: schema.EnvDefaultFunc("VEEAM_RETRY_WAIT_MIN", 1),
+			},
+			"retry_wait_max": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum time in seconds to wait between retries of a failed API request; a request whose Retry-After header asks for a longer wait fails instead (default: 30)",
+				DefaultFunc: schema.EnvDefaultFunc("VEEAM_RETRY_WAIT_MAX", 30),
+			},
+			"requests_per_second": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum number of API requests per second sent to each Veeam service; 0 disables client-side rate limiting (default: 0)",
+				DefaultFunc: schema.EnvDefaultFunc("VEEAM_REQUESTS_PER_SECOND", 0.0),
+			},
+			"max_concurrent_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of API requests sent at the same time by resources that read or modify many objects, such as veeambackup_vbr_job_set (default: 8)",
+				DefaultFunc: schema.EnvDefaultFunc("VEEAM_MAX_CONCURRENT_REQUESTS", client.DefaultMaxConcurrentRequests),
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of an HTTP, HTTPS or SOCKS5 proxy used for all API requests; when unset, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are honored",
+				DefaultFunc: schema.EnvDefaultFunc("VEEAM_PROXY_URL", ""),
+			},
+			"default_description_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Text appended to the description of every job and policy the provider creates or updates, e.g. \" (managed by Terraform)\", to identify Terraform-managed objects in the Veeam consoles. The suffix is removed again when reading, so it never shows up as a change. The suffix may contain the variables {terraform_workspace}, taken from TF_WORKSPACE or TFC_WORKSPACE_NAME, and {timestamp}, the UTC time the description is written.",
+				DefaultFunc: schema.EnvDefaultFunc("VEEAM_DEFAULT_DESCRIPTION_SUFFIX", ""),
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only read from the Veeam services: resources and actions refuse to create, update or delete anything, so that terraform plan can safely report drift on production appliances (default: false)",
+				DefaultFunc: schema.EnvDefaultFunc("VEEAM_READ_ONLY", false),
+			},
+			"validate_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Look up the repositories, proxies, servers, encryption passwords and KMS servers that VBR job resources reference while planning, so that IDs that do not exist on the backup server fail the plan instead of the apply. Each object is looked up once per run, and only for jobs that are created or changed (default: false)",
+				DefaultFunc: schema.EnvDefaultFunc("VEEAM_VALIDATE_REFERENCES", false),
+			},
+			"endpoint_healthcheck": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Sign in to every configured appliance when the provider is configured, so that an unreachable appliance, rejected credentials or an unsupported API version fail before any resource is planned; when false, each appliance is first contacted by the first resource or data source that uses it (default: true)",
+				DefaultFunc: schema.EnvDefaultFunc("VEEAM_ENDPOINT_HEALTHCHECK", true),
+			},
 			// Azure Backup for Azure configuration
 			"azure": {
 				Type:        schema.TypeList,
@@ -54,6 +122,25 @@ func Provider() *schema.Provider {
 							Description: "Skip SSL certificate verification (default: false)",
 							DefaultFunc: schema.EnvDefaultFunc("VEEAM_AZURE_INSECURE_SKIP_VERIFY", false),
 						},
+						"ca_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the Veeam Backup for Azure server certificate, e.g. for self-signed appliances",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_AZURE_CA_CERT_PEM", ""),
+						},
+						"client_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the Veeam Backup for Azure server for mutual TLS",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_AZURE_CLIENT_CERT_PEM", ""),
+						},
+						"client_key_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_AZURE_CLIENT_KEY_PEM", ""),
+						},
 					},
 				},
 			},
@@ -105,6 +192,228 @@ func Provider() *schema.Provider {
 							Description: "Skip SSL certificate verification (default: false)",
 							DefaultFunc: schema.EnvDefaultFunc("VEEAM_AWS_INSECURE_SKIP_VERIFY", false),
 						},
+						"ca_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the Veeam Backup for AWS server certificate, e.g. for self-signed appliances",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_AWS_CA_CERT_PEM", ""),
+						},
+						"client_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the Veeam Backup for AWS server for mutual TLS",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_AWS_CLIENT_CERT_PEM", ""),
+						},
+						"client_key_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_AWS_CLIENT_KEY_PEM", ""),
+						},
+					},
+				},
+			},
+			// Veeam Backup for Google Cloud configuration
+			"gcp": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration for Veeam Backup for Google Cloud",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Hostname or IP address of the Veeam Backup for Google Cloud server",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_GCP_HOSTNAME", nil),
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "13140",
+							Description: "Port for Google Cloud REST API (default: 13140)",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_GCP_PORT", "13140"),
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Username for Veeam Backup for Google Cloud authentication",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_GCP_USERNAME", nil),
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password for Veeam Backup for Google Cloud authentication",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_GCP_PASSWORD", nil),
+						},
+						"api_version": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "1.4-rev0",
+							Description: "Google Cloud Backup REST API version (default: 1.4-rev0)",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_GCP_API_VERSION", "1.4-rev0"),
+						},
+						"insecure_skip_verify": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Skip SSL certificate verification (default: false)",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_GCP_INSECURE_SKIP_VERIFY", false),
+						},
+						"ca_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the Veeam Backup for Google Cloud server certificate, e.g. for self-signed appliances",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_GCP_CA_CERT_PEM", ""),
+						},
+						"client_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the Veeam Backup for Google Cloud server for mutual TLS",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_GCP_CLIENT_CERT_PEM", ""),
+						},
+						"client_key_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_GCP_CLIENT_KEY_PEM", ""),
+						},
+					},
+				},
+			},
+			// Veeam Backup for Microsoft 365 configuration
+			"vb365": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration for Veeam Backup for Microsoft 365",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Hostname or IP address of the Veeam Backup for Microsoft 365 server",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VB365_HOSTNAME", nil),
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "4443",
+							Description: "Port for VB365 REST API (default: 4443)",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VB365_PORT", "4443"),
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Username for Veeam Backup for Microsoft 365 authentication",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VB365_USERNAME", nil),
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password for Veeam Backup for Microsoft 365 authentication",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VB365_PASSWORD", nil),
+						},
+						"api_version": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "v8",
+							Description: "VB365 REST API version used in the request path (default: v8)",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VB365_API_VERSION", "v8"),
+						},
+						"insecure_skip_verify": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Skip SSL certificate verification (default: false)",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VB365_INSECURE_SKIP_VERIFY", false),
+						},
+						"ca_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the Veeam Backup for Microsoft 365 server certificate, e.g. for self-signed appliances",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VB365_CA_CERT_PEM", ""),
+						},
+						"client_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the Veeam Backup for Microsoft 365 server for mutual TLS",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VB365_CLIENT_CERT_PEM", ""),
+						},
+						"client_key_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VB365_CLIENT_KEY_PEM", ""),
+						},
+					},
+				},
+			},
+			// Veeam Backup Enterprise Manager configuration
+			"enterprise_manager": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration for Veeam Backup Enterprise Manager, which federates multiple VBR servers",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Hostname or IP address of the Enterprise Manager server",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_EM_HOSTNAME", nil),
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "9398",
+							Description: "Port for Enterprise Manager REST API (default: 9398)",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_EM_PORT", "9398"),
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Username for Enterprise Manager authentication",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_EM_USERNAME", nil),
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Password for Enterprise Manager authentication",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_EM_PASSWORD", nil),
+						},
+						"insecure_skip_verify": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Skip SSL certificate verification (default: false)",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_EM_INSECURE_SKIP_VERIFY", false),
+						},
+						"ca_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the Enterprise Manager server certificate, e.g. for self-signed appliances",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_EM_CA_CERT_PEM", ""),
+						},
+						"client_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the Enterprise Manager server for mutual TLS",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_EM_CLIENT_CERT_PEM", ""),
+						},
+						"client_key_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_EM_CLIENT_KEY_PEM", ""),
+						},
 					},
 				},
 			},
@@ -131,23 +440,43 @@ func Provider() *schema.Provider {
 						},
 						"username": {
 							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Username for VBR authentication",
+							Optional:    true,
+							Description: "Username for VBR authentication; required unless access_token or authorization_code is set",
 							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_USERNAME", nil),
 						},
 						"password": {
 							Type:        schema.TypeString,
-							Required:    true,
+							Optional:    true,
 							Sensitive:   true,
-							Description: "Password for VBR authentication",
+							Description: "Password for VBR authentication; required unless access_token or authorization_code is set",
 							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_PASSWORD", nil),
 						},
+						"access_token": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Pre-issued VBR access token used instead of signing in with username and password",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_ACCESS_TOKEN", ""),
+						},
+						"authorization_code": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "One-time authorization code issued by POST /api/oauth2/authorization_code, used to sign in without a password",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_AUTHORIZATION_CODE", ""),
+						},
+						"mfa_code": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "One-time MFA code for VBR accounts with multi-factor authentication enforced",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_MFA_CODE", ""),
+						},
 						"api_version": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Default:     "1.3-rev1",
-							Description: "VBR REST API version (default: 1.3-rev1)",
-							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_API_VERSION", "1.3-rev1"),
+							Description: "VBR REST API version sent in the x-api-version header, e.g. 1.2-rev0; detected from the appliance build when unset",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_API_VERSION", ""),
 						},
 						"insecure_skip_verify": {
 							Type:        schema.TypeBool,
@@ -156,6 +485,25 @@ func Provider() *schema.Provider {
 							Description: "Skip SSL certificate verification (default: false)",
 							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_INSECURE_SKIP_VERIFY", false),
 						},
+						"ca_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded CA certificate bundle used to verify the VBR server certificate, e.g. for self-signed appliances",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_CA_CERT_PEM", ""),
+						},
+						"client_cert_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "PEM-encoded client certificate presented to the VBR server for mutual TLS",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_CLIENT_CERT_PEM", ""),
+						},
+						"client_key_pem": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "PEM-encoded private key of the client certificate; required with client_cert_pem",
+							DefaultFunc: schema.EnvDefaultFunc("VEEAM_VBR_CLIENT_KEY_PEM", ""),
+						},
 					},
 				},
 			},
@@ -167,15 +515,26 @@ func Provider() *schema.Provider {
 			"veeambackup_azure_file_shares_backup_policy": azure.ResourceAzureFileSharesBackupPolicy(),
 			"veeambackup_azure_sql_backup_policy":         azure.ResourceAzureSQLBackupPolicy(),
 			"veeambackup_azure_cosmos_backup_policy":      azure.ResourceAzureCosmosDbBackupPolicy(),
+			"veeambackup_azure_data_retrieval":            azure.ResourceAzureDataRetrieval(),
+			"veeambackup_azure_rbac_role_assignment":      azure.ResourceAzureRBACRoleAssignment(),
 			"veeambackup_vbr_unstructured_data_server":    vbr.ResourceVbrUnstructuredDataServer(),
 			"veeambackup_vbr_azure_cloud_credential":      vbr.ResourceVbrAzureCloudCredential(),
 			"veeambackup_vbr_amazon_cloud_credential":     vbr.ResourceVbrAmazonCloudCredential(),
 			"veeambackup_vbr_object_storage_backup_job":   vbr.ResourceVbrObjectStorageBackupJob(),
 			"veeambackup_vbr_file_share_backup_job":       vbr.ResourceVbrFileShareBackupJob(),
+			"veeambackup_vbr_vmware_cloud_director_job":   vbr.ResourceVbrVmwareCloudDirectorJob(),
+			"veeambackup_vbr_job_set":                     vbr.ResourceVbrJobSet(),
 			"veeambackup_vbr_repository":                  vbr.ResourceVbrRepository(),
 			"veeambackup_aws_iam_role":                    aws.ResourceAwsIAMRole(),
+			"veeambackup_aws_repository":                  aws.ResourceAwsRepository(),
 			"veeambackup_aws_ec2_backup_policy":           aws.ResourceAwsEC2InstanceBackupPolicy(),
 			"veeambackup_aws_rds_backup_policy":           aws.ResourceAwsRDSBackupPolicy(),
+			"veeambackup_gcp_repository":                  gcp.ResourceGCPRepository(),
+			"veeambackup_gcp_vm_backup_policy":            gcp.ResourceGCPVMBackupPolicy(),
+			"veeambackup_vb365_organization":              vb365.ResourceVB365Organization(),
+			"veeambackup_vb365_proxy":                     vb365.ResourceVB365Proxy(),
+			"veeambackup_vb365_backup_repository":         vb365.ResourceVB365BackupRepository(),
+			"veeambackup_vb365_backup_job":                vb365.ResourceVB365BackupJob(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"veeambackup_azure_backup_repositories":     azure.DataSourceAzureBackupRepositories(),
@@ -183,7 +542,9 @@ func Provider() *schema.Provider {
 			"veeambackup_azure_service_account":         azure.DataSourceAzureServiceAccount(),
 			"veeambackup_azure_vms":                     azure.DataSourceAzureVMs(),
 			"veeambackup_azure_subscriptions":           azure.DataSourceAzureSubscriptions(),
+			"veeambackup_azure_tenants":                 azure.DataSourceAzureTenants(),
 			"veeambackup_azure_resource_groups":         azure.DataSourceAzureResourceGroups(),
+			"veeambackup_azure_resource_group":          azure.DataSourceAzureResourceGroup(),
 			"veeambackup_azure_sql_servers":             azure.DataSourceAzureSqlServers(),
 			"veeambackup_azure_sql_databases":           azure.DataSourceAzureSqlDatabases(),
 			"veeambackup_azure_cosmos_accounts":         azure.DataSourceAzureCosmosDbAccounts(),
@@ -191,29 +552,62 @@ func Provider() *schema.Provider {
 			"veeambackup_azure_file_shares":             azure.DataSourceAzureFileShares(),
 			"veeambackup_azure_vm_restore_points":       azure.DataSourceAzureVMRestorePoints(),
 			"veeambackup_azure_vm_restore_point":        azure.DataSourceAzureVMRestorePoint(),
+			"veeambackup_azure_protected_items":         azure.DataSourceAzureProtectedItems(),
+			"veeambackup_azure_unprotected_items":       azure.DataSourceAzureUnprotectedItems(),
+			"veeambackup_azure_policy_cost_estimation":  azure.DataSourceAzurePolicyCostEstimation(),
+			"veeambackup_azure_policy_imports":          azure.DataSourceAzurePolicyImports(),
 			"veeambackup_vbr_unstructured_data_servers": vbr.DataSourceVbrUnstructuredDataServers(),
 			"veeambackup_vbr_cloud_credentials":         vbr.DataSourceVbrCloudCredentials(),
 			"veeambackup_vbr_cloud_credential":          vbr.DataSourceVbrCloudCredential(),
 			"veeambackup_vbr_repositories":              vbr.DataSourceVBRRepositories(),
 			"veeambackup_vbr_proxies":                   vbr.DataSourceVbrProxies(),
+			"veeambackup_vbr_backups":                   vbr.DataSourceVbrBackups(),
+			"veeambackup_vbr_job_states":                vbr.DataSourceVbrJobStates(),
+			"veeambackup_vbr_job_adopt":                 vbr.DataSourceVbrJobAdopt(),
+			"veeambackup_vbr_job_imports":               vbr.DataSourceVbrJobImports(),
+			"veeambackup_vbr_vmware_inventory":          vbr.DataSourceVbrVmwareInventory(),
+			"veeambackup_vbr_instance_licensing_usage":  vbr.DataSourceVbrInstanceLicensingUsage(),
+			"veeambackup_vbr_repository_space":          vbr.DataSourceVbrRepositorySpace(),
+			"veeambackup_vbr_proxies_load":              vbr.DataSourceVbrProxiesLoad(),
+			"veeambackup_vbr_encryption_kms_health":     vbr.DataSourceVbrEncryptionKMSHealth(),
 			"veeambackup_aws_repositories":              aws.DataSourceAwsRepositories(),
 			"veeambackup_aws_iam_roles":                 aws.DataSourceAwsIAMRoles(),
 			"veeambackup_aws_ec2_instances":             aws.DataSourceAwsEC2Instances(),
 			"veeambackup_aws_regions":                   aws.DataSourceAwsRegions(),
 			"veeambackup_aws_rds_instances":             aws.DataSourceAwsRDSInstances(),
+			"veeambackup_em_backup_servers":             em.DataSourceEMBackupServers(),
+			"veeambackup_em_jobs":                       em.DataSourceEMJobs(),
 		},
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
+	refuseWritesWhenReadOnly(p.ResourcesMap)
+	return p
 }
 
 // providerConfigure configures the provider and returns a client
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	// Check for service-specific configurations
 	azureConfig := d.Get("azure").([]interface{})
 	awsConfig := d.Get("aws").([]interface{})
+	gcpConfig := d.Get("gcp").([]interface{})
+	vb365Config := d.Get("vb365").([]interface{})
+	emConfig := d.Get("enterprise_manager").([]interface{})
 	vbrConfig := d.Get("vbr").([]interface{})
 
-	config := client.ClientConfig{}
+	config := client.ClientConfig{
+		Retry: client.RetryConfig{
+			MaxRetries:   d.Get("max_retries").(int),
+			RetryWaitMin: time.Duration(d.Get("retry_wait_min").(int)) * time.Second,
+			RetryWaitMax: time.Duration(d.Get("retry_wait_max").(int)) * time.Second,
+		},
+		RequestsPerSecond:     d.Get("requests_per_second").(float64),
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		ProxyURL:              d.Get("proxy_url").(string),
+		DescriptionSuffix:     d.Get("default_description_suffix").(string),
+		ReadOnly:              d.Get("read_only").(bool),
+		ValidateReferences:    d.Get("validate_references").(bool),
+		SkipSignIn:            !d.Get("endpoint_healthcheck").(bool),
+	}
 
 	// Handle Azure configuration
 	if len(azureConfig) > 0 {
@@ -223,7 +617,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			Username:           azureMap["username"].(string),
 			Password:           azureMap["password"].(string),
 			APIVersion:         azureMap["api_version"].(string),
-			InsecureSkipVerify: azureMap["insecure_skip_verify"].(bool),
+			TLS: client.TLSConfig{
+				InsecureSkipVerify: azureMap["insecure_skip_verify"].(bool),
+				CACertPEM:          azureMap["ca_cert_pem"].(string),
+				ClientCertPEM:      azureMap["client_cert_pem"].(string),
+				ClientKeyPEM:       azureMap["client_key_pem"].(string),
+			},
 		}
 	}
 
@@ -236,7 +635,65 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			Username:           awsMap["username"].(string),
 			Password:           awsMap["password"].(string),
 			APIVersion:         awsMap["api_version"].(string),
-			InsecureSkipVerify: awsMap["insecure_skip_verify"].(bool),
+			TLS: client.TLSConfig{
+				InsecureSkipVerify: awsMap["insecure_skip_verify"].(bool),
+				CACertPEM:          awsMap["ca_cert_pem"].(string),
+				ClientCertPEM:      awsMap["client_cert_pem"].(string),
+				ClientKeyPEM:       awsMap["client_key_pem"].(string),
+			},
+		}
+	}
+
+	// Handle Google Cloud configuration
+	if len(gcpConfig) > 0 {
+		gcpMap := gcpConfig[0].(map[string]interface{})
+		config.GCP = &client.GCPConfig{
+			Hostname:   gcpMap["hostname"].(string),
+			Port:       gcpMap["port"].(string),
+			Username:   gcpMap["username"].(string),
+			Password:   gcpMap["password"].(string),
+			APIVersion: gcpMap["api_version"].(string),
+			TLS: client.TLSConfig{
+				InsecureSkipVerify: gcpMap["insecure_skip_verify"].(bool),
+				CACertPEM:          gcpMap["ca_cert_pem"].(string),
+				ClientCertPEM:      gcpMap["client_cert_pem"].(string),
+				ClientKeyPEM:       gcpMap["client_key_pem"].(string),
+			},
+		}
+	}
+
+	// Handle VB365 configuration
+	if len(vb365Config) > 0 {
+		vb365Map := vb365Config[0].(map[string]interface{})
+		config.VB365 = &client.VB365Config{
+			Hostname:   vb365Map["hostname"].(string),
+			Port:       vb365Map["port"].(string),
+			Username:   vb365Map["username"].(string),
+			Password:   vb365Map["password"].(string),
+			APIVersion: vb365Map["api_version"].(string),
+			TLS: client.TLSConfig{
+				InsecureSkipVerify: vb365Map["insecure_skip_verify"].(bool),
+				CACertPEM:          vb365Map["ca_cert_pem"].(string),
+				ClientCertPEM:      vb365Map["client_cert_pem"].(string),
+				ClientKeyPEM:       vb365Map["client_key_pem"].(string),
+			},
+		}
+	}
+
+	// Handle Enterprise Manager configuration
+	if len(emConfig) > 0 {
+		emMap := emConfig[0].(map[string]interface{})
+		config.EM = &client.EMConfig{
+			Hostname: emMap["hostname"].(string),
+			Port:     emMap["port"].(string),
+			Username: emMap["username"].(string),
+			Password: emMap["password"].(string),
+			TLS: client.TLSConfig{
+				InsecureSkipVerify: emMap["insecure_skip_verify"].(bool),
+				CACertPEM:          emMap["ca_cert_pem"].(string),
+				ClientCertPEM:      emMap["client_cert_pem"].(string),
+				ClientKeyPEM:       emMap["client_key_pem"].(string),
+			},
 		}
 	}
 
@@ -244,26 +701,53 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if len(vbrConfig) > 0 {
 		vbrMap := vbrConfig[0].(map[string]interface{})
 		config.VBR = &client.VBRConfig{
-			Hostname:           vbrMap["hostname"].(string),
-			Port:               vbrMap["port"].(string),
-			Username:           vbrMap["username"].(string),
-			Password:           vbrMap["password"].(string),
-			APIVersion:         vbrMap["api_version"].(string),
-			InsecureSkipVerify: vbrMap["insecure_skip_verify"].(bool),
+			Hostname:          vbrMap["hostname"].(string),
+			Port:              vbrMap["port"].(string),
+			Username:          vbrMap["username"].(string),
+			Password:          vbrMap["password"].(string),
+			AccessToken:       vbrMap["access_token"].(string),
+			AuthorizationCode: vbrMap["authorization_code"].(string),
+			MFACode:           vbrMap["mfa_code"].(string),
+			APIVersion:        vbrMap["api_version"].(string),
+			TLS: client.TLSConfig{
+				InsecureSkipVerify: vbrMap["insecure_skip_verify"].(bool),
+				CACertPEM:          vbrMap["ca_cert_pem"].(string),
+				ClientCertPEM:      vbrMap["client_cert_pem"].(string),
+				ClientKeyPEM:       vbrMap["client_key_pem"].(string),
+			},
 		}
 	}
 
 	// Validate that at least one service is configured
-	if config.Azure == nil && config.AWS == nil && config.VBR == nil {
-		return nil, fmt.Errorf("at least one service configuration (azure, aws, vbr) must be provided")
+	if config.Azure == nil && config.AWS == nil && config.GCP == nil && config.VB365 == nil && config.EM == nil && config.VBR == nil {
+		return nil, diag.Errorf("at least one service configuration (azure, aws, gcp, vb365, enterprise_manager, vbr) must be provided")
 	}
 
 	// Create the unified client
 	veeamClient, err := client.NewVeeamClient(config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Veeam client: %w", err)
+		return nil, configureDiagnostics(err)
 	}
 
 	// Return unified client for all scenarios
 	return veeamClient, nil
 }
+
+// configureDiagnostics reports a failure to create the client. A failed sign-in names the
+// appliance and, when the cause is recognised, the settings to check.
+func configureDiagnostics(err error) diag.Diagnostics {
+	var endpointErr *client.EndpointError
+	if !errors.As(err, &endpointErr) {
+		return diag.FromErr(fmt.Errorf("failed to create Veeam client: %w", err))
+	}
+
+	detail := endpointErr.Err.Error()
+	if hint := endpointErr.Hint(); hint != "" {
+		detail += "\n\n" + hint
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Cannot sign in to %s at %s", endpointErr.Service, endpointErr.URL),
+		Detail:   detail,
+	}}
+}
@@ -1,10 +1,21 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
+	"terraform-provider-veeambackup/internal/acctest"
+	"terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // providerFactories are used to instantiate a provider during acceptance testing.
@@ -38,3 +49,313 @@ func testAccPreCheck(t *testing.T) {
 func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
+
+// TestProviderIDValidation checks that the configurable attributes that reference a repository, a
+// policy or a restore point by system ID reject values that are not UUIDs at plan time
+func TestProviderIDValidation(t *testing.T) {
+	var check func(path string, attributes map[string]*schema.Schema)
+	check = func(path string, attributes map[string]*schema.Schema) {
+		for name, s := range attributes {
+			if elem, ok := s.Elem.(*schema.Resource); ok {
+				check(path+"."+name, elem.Schema)
+			}
+			if s.Type != schema.TypeString || !(s.Required || s.Optional) {
+				continue
+			}
+			if !strings.HasSuffix(name, "repository_id") && name != "policy_id" && name != "restore_point_id" {
+				continue
+			}
+			if s.ValidateFunc == nil {
+				t.Errorf("%s.%s is not validated", path, name)
+				continue
+			}
+			if _, errs := s.ValidateFunc("not-a-uuid", name); len(errs) == 0 {
+				t.Errorf("%s.%s accepts a value that is not a UUID", path, name)
+			}
+		}
+	}
+
+	p := Provider()
+	for name, r := range p.ResourcesMap {
+		check(name, r.Schema)
+	}
+	for name, ds := range p.DataSourcesMap {
+		check("data."+name, ds.Schema)
+	}
+}
+
+// TestProviderAliases checks that two provider configurations, as created by provider aliases,
+// talk to their own appliance with their own token
+func TestProviderAliases(t *testing.T) {
+	var providers []*schema.Provider
+	var servers []*acctest.Server
+	for i := 0; i < 2; i++ {
+		p, server := testVBRProvider(t)
+		server.Collection(acctest.Collection{Path: "/api/v1/cloudCredentials"})
+		providers = append(providers, p)
+		servers = append(servers, server)
+	}
+
+	for i, p := range providers {
+		r := p.ResourcesMap["veeambackup_vbr_amazon_cloud_credential"]
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{"access_key": "AKIAEXAMPLE", "secret_key": "secret"})
+		plan, err := r.Diff(context.Background(), nil, config, p.Meta())
+		if err != nil {
+			t.Fatalf("provider %d: plan: %s", i, err)
+		}
+		if _, diags := r.Apply(context.Background(), nil, plan, p.Meta()); diags.HasError() {
+			t.Fatalf("provider %d: apply: %s", i, diags[0].Summary)
+		}
+	}
+
+	for i, server := range servers {
+		counts := map[string]int{}
+		for _, r := range server.Requests() {
+			counts[r]++
+		}
+		if n := counts["POST /api/v1/cloudCredentials"]; n != 1 {
+			t.Errorf("appliance %d received %d creates, want 1", i, n)
+		}
+		if n := counts["POST /api/oauth2/token"]; n != 1 {
+			t.Errorf("appliance %d received %d token requests, want 1", i, n)
+		}
+	}
+}
+
+func TestProviderPartialConfiguration(t *testing.T) {
+	p, _ := testVBRProvider(t)
+
+	_, diags := p.ResourcesMap["veeambackup_azure_repository"].RefreshWithoutUpgrade(context.Background(), &terraform.InstanceState{ID: "repository"}, p.Meta())
+	if !diags.HasError() {
+		t.Fatal("reading an Azure resource without an azure block succeeded")
+	}
+	if msg := diags[0].Summary; !strings.Contains(msg, `"azure" block`) {
+		t.Errorf("error %q does not name the missing azure block", msg)
+	}
+
+	_, err := client.GetAzureClient(p.Meta())
+	var notConfigured *client.ClientNotConfiguredError
+	if !errors.As(err, &notConfigured) || notConfigured.Block != "azure" {
+		t.Errorf("GetAzureClient error = %v, want a ClientNotConfiguredError for the azure block", err)
+	}
+}
+
+func TestProviderReadOnly(t *testing.T) {
+	server := acctest.NewVBRServer(t)
+	server.Collection(acctest.Collection{Path: "/api/v1/cloudCredentials"})
+	server.Put("/api/v1/cloudCredentials/existing", acctest.Object{"id": "existing", "type": "Amazon", "accessKey": "AKIAEXAMPLE"})
+	config := acctest.VBRProviderConfig(server)
+	config["read_only"] = true
+	p := Provider()
+	acctest.ConfigureProvider(t, p, config)
+	r := p.ResourcesMap["veeambackup_vbr_amazon_cloud_credential"]
+	ctx := context.Background()
+
+	state, diags := r.RefreshWithoutUpgrade(ctx, &terraform.InstanceState{ID: "existing"}, p.Meta())
+	if diags.HasError() {
+		t.Fatalf("refresh: %s", diags[0].Summary)
+	}
+	if state == nil {
+		t.Fatal("refresh removed the existing credential from state")
+	}
+
+	plan, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{"access_key": "AKIAOTHER", "secret_key": "secret"}), p.Meta())
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	if _, diags := r.Apply(ctx, nil, plan, p.Meta()); !diags.HasError() || diags[0].Summary != "Provider is read-only" {
+		t.Errorf("create in read-only mode returned %v, want a read-only error", diags)
+	}
+	if _, diags := r.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, p.Meta()); !diags.HasError() {
+		t.Error("delete in read-only mode succeeded")
+	}
+
+	vbrClient, _ := client.GetVBRClient(p.Meta())
+	_, err = vbrClient.DoRequest(ctx, "DELETE", vbrClient.BuildAPIURL("/api/v1/cloudCredentials/existing"), nil)
+	var readOnly *client.ReadOnlyError
+	if !errors.As(err, &readOnly) {
+		t.Errorf("DELETE through the VBR client returned %v, want a ReadOnlyError", err)
+	}
+
+	for _, req := range server.Requests() {
+		if !strings.HasPrefix(req, "GET ") && req != "POST /api/oauth2/token" {
+			t.Errorf("read-only provider sent %s", req)
+		}
+	}
+}
+
+func TestProviderValidateReferences(t *testing.T) {
+	const (
+		repositoryID = "0f3c1a5e-2b4d-4c6e-8f0a-1b2c3d4e5f60"
+		sobrID       = "7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d"
+		proxyID      = "11111111-2222-4333-8444-555555555555"
+	)
+	server := acctest.NewVBRServer(t)
+	server.Put("/api/v1/backupInfrastructure/repositories/"+repositoryID, acctest.Object{"id": repositoryID})
+	server.Put("/api/v1/backupInfrastructure/scaleOutRepositories/"+sobrID, acctest.Object{"id": sobrID})
+	jobConfig := func(repositoryID string, proxyIDs ...interface{}) *terraform.ResourceConfig {
+		storage := map[string]interface{}{"backup_repository_id": repositoryID}
+		if len(proxyIDs) > 0 {
+			storage["backup_proxies"] = []interface{}{map[string]interface{}{"auto_selection": false, "proxy_ids": proxyIDs}}
+		}
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":     "Tenants",
+			"includes": []interface{}{map[string]interface{}{"host_name": "vcd.example.com", "name": "Tenant A", "type": "Organization"}},
+			"storage":  []interface{}{storage},
+		})
+	}
+	ctx := context.Background()
+
+	for _, validate := range []bool{false, true} {
+		config := acctest.VBRProviderConfig(server)
+		config["validate_references"] = validate
+		p := Provider()
+		acctest.ConfigureProvider(t, p, config)
+		r := p.ResourcesMap["veeambackup_vbr_vmware_cloud_director_job"]
+
+		for _, id := range []string{repositoryID, sobrID} {
+			if _, err := r.Diff(ctx, nil, jobConfig(id), p.Meta()); err != nil {
+				t.Errorf("validate_references = %t: plan with repository %s: %s", validate, id, err)
+			}
+		}
+		_, err := r.Diff(ctx, nil, jobConfig(repositoryID, proxyID), p.Meta())
+		if validate && (err == nil || !strings.Contains(err.Error(), "no backup proxy with ID "+proxyID)) {
+			t.Errorf("plan with a missing proxy returned %v, want a missing proxy error", err)
+		}
+		if !validate && err != nil {
+			t.Errorf("plan with a missing proxy returned %v without validate_references", err)
+		}
+	}
+
+	lookups := 0
+	for _, req := range server.Requests() {
+		if req == "GET /api/v1/backupInfrastructure/repositories/"+repositoryID {
+			lookups++
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("repository %s was looked up %d times, want once", repositoryID, lookups)
+	}
+}
+
+func TestProviderEndpointHealthcheck(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unreachable := "http://" + listener.Addr().String()
+	listener.Close()
+
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"title": "Unauthorized", "detail": "Invalid user name or password"}`))
+	}))
+	defer rejecting.Close()
+
+	azureConfig := func(hostname string, healthcheck bool) map[string]interface{} {
+		return map[string]interface{}{
+			"max_retries":          0,
+			"endpoint_healthcheck": healthcheck,
+			"azure": []interface{}{map[string]interface{}{
+				"hostname": hostname,
+				"username": "acctest",
+				"password": "acctest",
+			}},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		config  map[string]interface{}
+		summary string
+		hint    string
+	}{
+		"unreachable": {
+			config:  azureConfig(unreachable, true),
+			summary: "Cannot sign in to Veeam Backup for Microsoft Azure at " + unreachable,
+			hint:    "not reachable",
+		},
+		"rejected credentials": {
+			config:  azureConfig(rejecting.URL, true),
+			summary: "Cannot sign in to Veeam Backup for Microsoft Azure at " + rejecting.URL,
+			hint:    "rejected the credentials",
+		},
+		"skipped": {
+			config: azureConfig(unreachable, false),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(tc.config))
+			if tc.summary == "" {
+				if diags.HasError() {
+					t.Fatalf("configure failed without the health check: %s", diags[0].Summary)
+				}
+				return
+			}
+			if !diags.HasError() {
+				t.Fatal("configure succeeded")
+			}
+			if diags[0].Summary != tc.summary {
+				t.Errorf("summary = %q, want %q", diags[0].Summary, tc.summary)
+			}
+			if !strings.Contains(diags[0].Detail, tc.hint) {
+				t.Errorf("detail %q does not contain %q", diags[0].Detail, tc.hint)
+			}
+		})
+	}
+}
+
+func TestProviderEndpointHealthcheckVBRVersion(t *testing.T) {
+	ctx := context.Background()
+	configure := func(server *acctest.Server, healthcheck bool) (*schema.Provider, diag.Diagnostics) {
+		config := acctest.VBRProviderConfig(server)
+		config["endpoint_healthcheck"] = healthcheck
+		p := Provider()
+		return p, p.Configure(ctx, terraform.NewResourceConfigRaw(config))
+	}
+
+	// Without the health check, the version is detected by the first request
+	server := acctest.NewVBRServer(t)
+	p, diags := configure(server, false)
+	if diags.HasError() {
+		t.Fatalf("configure failed without the health check: %s", diags[0].Summary)
+	}
+	if requests := server.Requests(); len(requests) > 0 {
+		t.Errorf("configure without the health check sent %v", requests)
+	}
+	vbr, err := client.GetVBRClient(p.Meta())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vbr.DoRequest(ctx, http.MethodGet, vbr.BuildAPIURL("/api/v1/serverInfo"), nil); err != nil {
+		t.Fatalf("first request: %s", err)
+	}
+	detections := 0
+	for _, req := range server.Requests() {
+		if req == "GET /api/v1/serverInfo" {
+			detections++
+		}
+	}
+	if detections != 2 {
+		t.Errorf("server info was read %d times, want once for the version and once by the request", detections)
+	}
+
+	// A failed detection names the appliance and api_version, with or without the health check
+	server = acctest.NewVBRServer(t)
+	server.Delete("/api/v1/serverInfo")
+	_, diags = configure(server, true)
+	if !diags.HasError() || !strings.HasPrefix(diags[0].Summary, "Cannot sign in to Veeam Backup & Replication") ||
+		!strings.Contains(diags[0].Detail, "api_version") {
+		t.Errorf("configure with a failed version detection = %v, want an endpoint error naming api_version", diags)
+	}
+
+	p, diags = configure(server, false)
+	if diags.HasError() {
+		t.Fatalf("configure failed without the health check: %s", diags[0].Summary)
+	}
+	vbr, _ = client.GetVBRClient(p.Meta())
+	_, err = vbr.DoRequest(ctx, http.MethodGet, vbr.BuildAPIURL("/api/v1/jobs"), nil)
+	var endpointErr *client.EndpointError
+	if !errors.As(err, &endpointErr) || !strings.Contains(endpointErr.Hint(), "api_version") {
+		t.Errorf("first request with a failed version detection = %v, want an endpoint error naming api_version", err)
+	}
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-veeambackup/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// refuseWritesWhenReadOnly makes the create, update and delete functions of every resource fail
+// before they send any request while the provider is configured with read_only = true. Plans and
+// refreshes still read the remote objects, which is what drift detection needs.
+func refuseWritesWhenReadOnly(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		r.CreateContext = refuseWhenReadOnly(name, "create", r.CreateContext)
+		r.UpdateContext = refuseWhenReadOnly(name, "update", r.UpdateContext)
+		r.DeleteContext = refuseWhenReadOnly(name, "delete", r.DeleteContext)
+	}
+}
+
+func refuseWhenReadOnly[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](resourceType, operation string, f F) F {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if c, ok := m.(*client.VeeamClient); ok && c.ReadOnly {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Provider is read-only",
+				Detail: fmt.Sprintf("The provider is configured with read_only = true, so it cannot %s %s. "+
+					"Read-only mode only supports plan and refresh, e.g. to report drift; unset read_only to apply changes.", operation, resourceType),
+			}}
+		}
+		return f(ctx, d, m)
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testAWSProvider returns a provider configured against a new mock VB for AWS server
+func testAWSProvider(t *testing.T) (*schema.Provider, *acctest.Server) {
+	server := acctest.NewAWSServer(t)
+	p := Provider()
+	acctest.ConfigureProvider(t, p, acctest.AWSProviderConfig(server))
+	return p, server
+}
+
+func TestResourceAWSRepository(t *testing.T) {
+	p, server := testAWSProvider(t)
+	server.Collection(acctest.Collection{
+		Path: "/repositories",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			// The IAM role is returned as the repository identity, and the password never
+			obj["identity"] = acctest.Object{"id": obj["amazonAccountId"], "type": "IamRole"}
+			obj["_embedded"] = acctest.Object{"amazonAccount": "backup-role", "region": "eu-west-1", "bucket": "veeam-backups"}
+			delete(obj, "amazonAccountId")
+			delete(obj, "password")
+		},
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                  "s3-backups",
+			"description":           description,
+			"amazon_account_id":     "00000000-0000-0000-0000-00000000aaaa",
+			"region_id":             "00000000-0000-0000-0000-00000000bbbb",
+			"amazon_bucket_id":      "00000000-0000-0000-0000-00000000cccc",
+			"amazon_storage_folder": "ec2",
+			"enable_encryption":     true,
+			"password":              "secret",
+			"hint":                  "vault",
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_aws_repository",
+		Steps: []acctest.Step{
+			{Config: config("EC2 backups")},
+			{Config: config("EC2 and RDS backups")},
+		},
+	}.Run(t)
+}
+
+func TestResourceAWSIAMRole(t *testing.T) {
+	p, server := testAWSProvider(t)
+	server.Collection(acctest.Collection{
+		Path:       "/accounts/amazon",
+		CreatePath: "/accounts/amazon/create",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			// The access keys are never returned, and the granted permissions replace the requested ones
+			obj["awsAccountId"] = "123456789012"
+			obj["regionType"] = "Global"
+			obj["iamRole"] = acctest.Object{"roleName": obj["roleName"], "isDefault": false}
+			obj["accountPermissions"] = obj["requestedPermissions"]
+			delete(obj, "roleName")
+			delete(obj, "accessKeys")
+			delete(obj, "requestedPermissions")
+		},
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        "production-backup-role",
+			"role_name":   "VeeamBackupRole",
+			"description": description,
+			"access_keys": []interface{}{map[string]interface{}{
+				"access_key": "AKIAEXAMPLE",
+				"secret_key": "secret",
+			}},
+			"requested_permissions": []interface{}{"EC2BackupSnapshot", "EC2Restore"},
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_aws_iam_role",
+		Steps: []acctest.Step{
+			{Config: config("EC2 backups")},
+			{Config: config("EC2 and RDS backups")},
+		},
+		ImportStateVerifyIgnore: []string{"role_name", "access_keys", "requested_permissions"},
+	}.Run(t)
+}
@@ -0,0 +1,906 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-veeambackup/internal/acctest"
+	"terraform-provider-veeambackup/internal/azure"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testAzureProvider returns a provider configured against a new mock VB for Azure server
+func testAzureProvider(t *testing.T) (*schema.Provider, *acctest.Server) {
+	server := acctest.NewAzureServer(t)
+	p := Provider()
+	acctest.ConfigureProvider(t, p, acctest.AzureProviderConfig(server))
+	return p, server
+}
+
+func TestResourceAzureRepository(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Collection(acctest.Collection{
+		Path:         "/repositories",
+		CreateStatus: 202,
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			obj["azureStorageFolder"] = acctest.Object{"name": obj["azureStorageFolder"]}
+			obj["azureStorageContainer"] = acctest.Object{"name": obj["azureStorageContainer"]}
+			obj["enabledEncryption"] = obj["enableEncryption"]
+			obj["status"] = "Ready"
+			delete(obj, "enableEncryption")
+			delete(obj, "password")
+		},
+		Respond: func(_ *acctest.Server, obj acctest.Object) interface{} {
+			return acctest.Object{
+				"id":     obj["id"],
+				"status": "Succeeded",
+				"type":   "Repository",
+				"repositoryJobInfo": acctest.Object{
+					"repositoryId":   obj["id"],
+					"repositoryName": obj["name"],
+				},
+			}
+		},
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                     "azure-repository",
+			"description":              description,
+			"azure_storage_account_id": "/subscriptions/acctest/resourceGroups/backup/providers/Microsoft.Storage/storageAccounts/backups",
+			"azure_storage_folder":     "vms",
+			"azure_storage_container":  "backups",
+			"azure_account_id":         "00000000-0000-0000-0000-00000000aaaa",
+			"enable_encryption":        true,
+			"password":                 "secret",
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_repository",
+		Steps: []acctest.Step{
+			{Config: config("Backups of production VMs")},
+			{Config: config("Backups of all VMs")},
+		},
+	}.Run(t)
+}
+
+// testAzureServiceAccounts registers the service account collection on server and returns the
+// configuration of a service account with the given description
+func testAzureServiceAccounts(server *acctest.Server) func(description string) map[string]interface{} {
+	server.Collection(acctest.Collection{
+		Path:         "/accounts/azure/service",
+		CreatePath:   "/accounts/azure/service/saveByApp",
+		UpdatePath:   "/accounts/azure/service/updateByApp",
+		IDField:      "accountId",
+		CreateStatus: 202,
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			info, _ := obj["accountInfo"].(acctest.Object)
+			login, _ := obj["clientLoginParameters"].(acctest.Object)
+			obj["name"] = info["name"]
+			obj["description"] = info["description"]
+			obj["applicationId"] = login["applicationId"]
+			obj["tenantId"] = login["tenantId"]
+			delete(obj, "accountInfo")
+			delete(obj, "clientLoginParameters")
+		},
+		Respond: func(s *acctest.Server, obj acctest.Object) interface{} {
+			return s.Operation(obj["accountId"])
+		},
+	})
+
+	return func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"account_info": []interface{}{map[string]interface{}{
+				"name":        "backup-operator",
+				"description": description,
+			}},
+			"client_login_parameters": []interface{}{map[string]interface{}{
+				"application_id":         "00000000-0000-0000-0000-00000000bbbb",
+				"tenant_id":              "00000000-0000-0000-0000-00000000cccc",
+				"client_secret":          "secret",
+				"azure_account_purposes": []interface{}{"VirtualMachineBackup", "AzureFiles"},
+			}},
+		}
+	}
+}
+
+func TestResourceAzureServiceAccount(t *testing.T) {
+	p, server := testAzureProvider(t)
+	config := testAzureServiceAccounts(server)
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_service_account",
+		Steps: []acctest.Step{
+			{Config: config("Backs up production")},
+			{Config: config("Backs up all subscriptions")},
+		},
+	}.Run(t)
+}
+
+// An update of a service account deleted outside of Terraform fails without removing it from
+// state; only a refresh does that
+func TestResourceAzureServiceAccount_updateDeleted(t *testing.T) {
+	p, server := testAzureProvider(t)
+	config := testAzureServiceAccounts(server)
+	ctx := context.Background()
+	r := p.ResourcesMap["veeambackup_azure_service_account"]
+
+	plan, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(config("Backs up production")), p.Meta())
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	state, diags := r.Apply(ctx, nil, plan, p.Meta())
+	if diags.HasError() {
+		t.Fatalf("apply: %v", diags)
+	}
+	server.Delete("/accounts/azure/service/" + state.ID)
+
+	plan, err = r.Diff(ctx, state, terraform.NewResourceConfigRaw(config("Backs up all subscriptions")), p.Meta())
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	updated, diags := r.Apply(ctx, state, plan, p.Meta())
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "404") {
+		t.Errorf("update of a deleted service account = %v, want a 404 error", diags)
+	}
+	if updated == nil || updated.ID != state.ID {
+		t.Errorf("a failed update removed the service account from state: %v", updated)
+	}
+
+	if refreshed, diags := r.RefreshWithoutUpgrade(ctx, state, p.Meta()); diags.HasError() || (refreshed != nil && refreshed.ID != "") {
+		t.Errorf("refresh of a deleted service account = %v, %v, want it removed", diags, refreshed)
+	}
+}
+
+// storePolicy mimics how VB for Azure stores a backup policy: region names are returned in
+// lower case and the configuration flags are computed
+func storePolicy(_ *acctest.Server, obj acctest.Object) {
+	regions, _ := obj["regions"].([]interface{})
+	for _, r := range regions {
+		if region, ok := r.(acctest.Object); ok {
+			if id, ok := region["regionId"].(string); ok {
+				region["regionId"] = strings.ToLower(id)
+			}
+		}
+	}
+	obj["isBackupConfigured"] = true
+	obj["isScheduleConfigured"] = obj["dailySchedule"] != nil
+}
+
+func TestResourceAzureVMBackupPolicy(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/policies/virtualMachines",
+		Store: storePolicy,
+	})
+
+	config := func(enabled bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name":               "production-vms",
+			"is_enabled":         enabled,
+			"backup_type":        "AllSubscriptions",
+			"tenant_id":          "00000000-0000-0000-0000-00000000cccc",
+			"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+			"regions": []interface{}{
+				map[string]interface{}{"name": "EastUS"},
+				map[string]interface{}{"name": "WestEurope"},
+			},
+			"snapshot_settings": []interface{}{map[string]interface{}{
+				"copy_original_tags": true,
+			}},
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_vm_backup_policy",
+		Steps: []acctest.Step{
+			{Config: config(true)},
+			{Config: config(false)},
+		},
+		ImportStateVerifyIgnore: []string{"snapshot_settings"},
+	}.Run(t)
+}
+
+func TestResourceAzureVMBackupPolicy_restorePoints(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/policies/virtualMachines",
+		Store: storePolicy,
+	})
+	server.Put("/protectedItem/virtualMachines", acctest.Object{
+		"results": []interface{}{
+			acctest.Object{"id": "vm1", "name": "web", "policyName": "production-vms", "restorePointsCount": 3},
+			acctest.Object{"id": "vm2", "name": "db", "policyName": "other-vms", "restorePointsCount": 5},
+		},
+		"totalCount": 2,
+	})
+	server.Put("/protectedItem/virtualMachines/vm1", acctest.Object{"id": "vm1", "name": "web"})
+	server.Put("/protectedItem/virtualMachines/vm2", acctest.Object{"id": "vm2", "name": "db"})
+
+	config := func(countRestorePoints bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                 "production-vms",
+			"is_enabled":           true,
+			"backup_type":          "AllSubscriptions",
+			"tenant_id":            "00000000-0000-0000-0000-00000000cccc",
+			"service_account_id":   "00000000-0000-0000-0000-00000000dddd",
+			"regions":              []interface{}{map[string]interface{}{"name": "EastUS"}},
+			"snapshot_settings":    []interface{}{map[string]interface{}{"copy_original_tags": true}},
+			"count_restore_points": countRestorePoints,
+		}
+	}
+	listedProtectedItems := func() int {
+		count := 0
+		for _, request := range server.Requests() {
+			if request == "GET "+acctest.AzureAPIPrefix+"/protectedItem/virtualMachines" {
+				count++
+			}
+		}
+		return count
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_vm_backup_policy",
+		Steps: []acctest.Step{
+			{
+				Config: config(false),
+				Check: func(t *testing.T, state *terraform.InstanceState) {
+					if got := state.Attributes["restore_point_count"]; got != "" && got != "0" {
+						t.Errorf("restore_point_count = %s, want it unread", got)
+					}
+					if got := listedProtectedItems(); got != 0 {
+						t.Errorf("refreshing listed the protected items %d times, want 0", got)
+					}
+				},
+			},
+			{
+				Config: config(true),
+				Check: func(t *testing.T, state *terraform.InstanceState) {
+					if got := state.Attributes["protected_item_count"]; got != "1" {
+						t.Errorf("protected_item_count = %s, want 1", got)
+					}
+					if got := state.Attributes["restore_point_count"]; got != "3" {
+						t.Errorf("restore_point_count = %s, want 3", got)
+					}
+				},
+			},
+		},
+		ImportStateVerifyIgnore: []string{"snapshot_settings", "count_restore_points", "protected_item_count", "restore_point_count"},
+	}.Run(t)
+
+	// Restore points are kept, since the API cannot tell which of them the policy created
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, "DELETE "+acctest.AzureAPIPrefix+"/protectedItem/") {
+			t.Errorf("destroying the policy sent %s", request)
+		}
+	}
+}
+
+// wait_for_ready only affects creation, so changing it must neither replace the data retrieval nor
+// start another one
+func TestResourceAzureDataRetrieval_waitForReady(t *testing.T) {
+	p, server := testAzureProvider(t)
+	const restorePointID = "00000000-0000-0000-0000-0000000000ee"
+	server.Put("/restorePoints/virtualMachines/"+restorePointID, acctest.Object{"id": restorePointID, "dataRetrievalStatus": "Retrieved"})
+	server.Collection(acctest.Collection{
+		Path:         "/restorePoints/virtualMachines/" + restorePointID + "/dataRetrieval",
+		CreateStatus: 202,
+	})
+
+	ctx := context.Background()
+	r := p.ResourcesMap["veeambackup_azure_data_retrieval"]
+	var state *terraform.InstanceState
+	for i, waitForReady := range []bool{false, true} {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"restore_point_id": restorePointID,
+			"days_to_keep":     7,
+			"wait_for_ready":   waitForReady,
+		})
+		plan, err := r.Diff(ctx, state, config, p.Meta())
+		if err != nil {
+			t.Fatalf("step %d: plan: %s", i+1, err)
+		}
+		if state != nil && plan.RequiresNew() {
+			t.Fatalf("step %d: changing wait_for_ready replaces the data retrieval", i+1)
+		}
+		var diags diag.Diagnostics
+		if state, diags = r.Apply(ctx, state, plan, p.Meta()); diags.HasError() {
+			t.Fatalf("step %d: apply: %v", i+1, diags)
+		}
+	}
+
+	retrievals := 0
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, "POST ") && strings.HasSuffix(request, "/dataRetrieval") {
+			retrievals++
+		}
+	}
+	if retrievals != 1 {
+		t.Errorf("started %d data retrievals, want 1", retrievals)
+	}
+	if got := state.Attributes["wait_for_ready"]; got != "true" {
+		t.Errorf("wait_for_ready = %s, want true", got)
+	}
+}
+
+// A retrieval that is not ready by the create timeout is kept with a warning, since tainting it
+// would start another billed retrieval. It is removed once its data expires.
+func TestResourceAzureDataRetrieval_timeoutAndExpiry(t *testing.T) {
+	p, server := testAzureProvider(t)
+	const restorePointID = "00000000-0000-0000-0000-0000000000ef"
+	restorePoint := func(status string) {
+		server.Put("/restorePoints/virtualMachines/"+restorePointID, acctest.Object{"id": restorePointID, "dataRetrievalStatus": status})
+	}
+	restorePoint("Retrieving")
+	server.Collection(acctest.Collection{
+		Path:         "/restorePoints/virtualMachines/" + restorePointID + "/dataRetrieval",
+		CreateStatus: 202,
+	})
+
+	ctx := context.Background()
+	r := p.ResourcesMap["veeambackup_azure_data_retrieval"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"restore_point_id": restorePointID,
+		"days_to_keep":     7,
+		"wait_for_ready":   true,
+	})
+	plan, err := r.Diff(ctx, nil, config, p.Meta())
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	state, diags := r.Apply(waitCtx, nil, plan, p.Meta())
+	if diags.HasError() || len(diags) == 0 {
+		t.Fatalf("apply after the create timeout = %v, want a warning", diags)
+	}
+	if state == nil || state.ID != restorePointID || state.Tainted {
+		t.Fatalf("a retrieval that timed out is stored as %v", state)
+	}
+	if got := state.Attributes["is_ready"]; got != "false" {
+		t.Errorf("is_ready = %s, want false", got)
+	}
+
+	restorePoint("Retrieved")
+	if state, diags = r.RefreshWithoutUpgrade(ctx, state, p.Meta()); diags.HasError() || state.Attributes["is_ready"] != "true" {
+		t.Fatalf("refresh after retrieval = %v, %v, want is_ready", diags, state)
+	}
+
+	restorePoint("None")
+	if state, diags = r.RefreshWithoutUpgrade(ctx, state, p.Meta()); diags.HasError() || (state != nil && state.ID != "") {
+		t.Errorf("refresh after the data expired = %v, %v, want the retrieval removed", diags, state)
+	}
+}
+
+func TestResourceAzureFileSharesBackupPolicy(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/policies/fileShares",
+		Store: storePolicy,
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":               "production-file-shares",
+			"description":        description,
+			"is_enabled":         true,
+			"backup_type":        "AllSubscriptions",
+			"tenant_id":          "00000000-0000-0000-0000-00000000cccc",
+			"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+			"regions": []interface{}{
+				map[string]interface{}{"region_id": "NorthEurope"},
+			},
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_file_shares_backup_policy",
+		Steps: []acctest.Step{
+			{Config: config("File shares of production")},
+			{Config: config("File shares of all subscriptions")},
+		},
+	}.Run(t)
+}
+
+func TestResourceAzureSQLBackupPolicy(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/policies/sql",
+		Store: storePolicy,
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":               "production-sql",
+			"description":        description,
+			"is_enabled":         true,
+			"backup_type":        "AllSubscriptions",
+			"tenant_id":          "00000000-0000-0000-0000-00000000cccc",
+			"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+			"regions": []interface{}{
+				map[string]interface{}{"name": "EastUS"},
+			},
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_sql_backup_policy",
+		Steps: []acctest.Step{
+			{Config: config("Production databases")},
+			{Config: config("All databases")},
+		},
+	}.Run(t)
+	checkCreatedAt(t, server, "/policies/sql")
+}
+
+// TestResourceAzureSQLBackupPolicyAsyncOperations checks that policies are created and updated
+// by appliances that answer with an operation to wait for rather than the policy
+func TestResourceAzureSQLBackupPolicyAsyncOperations(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Collection(acctest.Collection{
+		Path:         "/policies/sql",
+		CreateStatus: 202,
+		UpdateStatus: 202,
+		Store:        storePolicy,
+		Respond: func(s *acctest.Server, obj acctest.Object) interface{} {
+			return s.Operation(acctest.Object{"id": obj["id"], "name": obj["name"]})
+		},
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":               "production-sql",
+			"description":        description,
+			"is_enabled":         true,
+			"backup_type":        "AllSubscriptions",
+			"tenant_id":          "00000000-0000-0000-0000-00000000cccc",
+			"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+			"regions": []interface{}{
+				map[string]interface{}{"name": "EastUS"},
+			},
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_sql_backup_policy",
+		Steps: []acctest.Step{
+			{Config: config("Production databases")},
+			{Config: config("All databases")},
+		},
+	}.Run(t)
+
+	polled := 0
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, "GET "+acctest.AzureAPIPrefix+"/operations/") {
+			polled++
+		}
+	}
+	if polled != 2 {
+		t.Errorf("polled %d operations, want one for the create and one for the update", polled)
+	}
+}
+
+func TestResourceAzureSQLBackupPolicySelectedTagGroups(t *testing.T) {
+	p, server := testAzureProvider(t)
+	var selectedItems acctest.Object
+	server.Collection(acctest.Collection{
+		Path: "/policies/sql",
+		Store: func(s *acctest.Server, obj acctest.Object) {
+			selectedItems, _ = obj["selectedItems"].(acctest.Object)
+			storePolicy(s, obj)
+		},
+	})
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_sql_backup_policy",
+		Steps: []acctest.Step{
+			{Config: map[string]interface{}{
+				"name":               "tagged-sql",
+				"is_enabled":         true,
+				"backup_type":        "SelectedItems",
+				"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+				"regions": []interface{}{
+					map[string]interface{}{"name": "EastUS"},
+				},
+				"selected_items": []interface{}{
+					map[string]interface{}{
+						"subscriptions": []interface{}{
+							map[string]interface{}{"subscription_id": "00000000-0000-0000-0000-00000000eeee"},
+						},
+						"tag_groups": []interface{}{
+							map[string]interface{}{
+								"name": "production",
+								"subscription": []interface{}{
+									map[string]interface{}{"subscription_id": "00000000-0000-0000-0000-00000000eeee"},
+								},
+								"tags": []interface{}{
+									map[string]interface{}{"name": "backup", "value": "true"},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
+	}.Run(t)
+
+	wantSubscriptions := []interface{}{
+		acctest.Object{"subscriptionId": "00000000-0000-0000-0000-00000000eeee"},
+	}
+	if got := selectedItems["subscriptions"]; !reflect.DeepEqual(got, wantSubscriptions) {
+		t.Errorf("selectedItems.subscriptions = %v, want %v", got, wantSubscriptions)
+	}
+	wantTagGroups := []interface{}{
+		acctest.Object{
+			"name":         "production",
+			"subscription": acctest.Object{"subscriptionId": "00000000-0000-0000-0000-00000000eeee"},
+			"tags": []interface{}{
+				acctest.Object{"name": "backup", "value": "true"},
+			},
+		},
+	}
+	if got := selectedItems["tagGroups"]; !reflect.DeepEqual(got, wantTagGroups) {
+		t.Errorf("selectedItems.tagGroups = %v, want %v", got, wantTagGroups)
+	}
+}
+
+func TestResourceAzureCosmosDbBackupPolicy(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/policies/cosmosDb",
+		Store: storePolicy,
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":               "production-cosmos",
+			"description":        description,
+			"is_enabled":         true,
+			"backup_type":        "AllSubscriptions",
+			"tenant_id":          "00000000-0000-0000-0000-00000000cccc",
+			"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+			"regions": []interface{}{
+				map[string]interface{}{"name": "EastUS"},
+			},
+		}
+	}
+
+	continuous := config("Continuous backup")
+	continuous["continuous_backup_type"] = "Continuous7Days"
+	repository := config("Backup to repository")
+	repository["continuous_backup_type"] = "Continuous30Days"
+	repository["backup_workloads"] = []interface{}{"PostgreSQL", "MongoDB"}
+	repository["daily_schedule"] = []interface{}{
+		map[string]interface{}{"daily_type": "EveryDay"},
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_cosmos_backup_policy",
+		Steps: []acctest.Step{
+			{Config: config("Production accounts")},
+			{Config: config("All accounts")},
+			{Config: continuous},
+			{Config: repository},
+		},
+	}.Run(t)
+	checkCreatedAt(t, server, "/policies/cosmosDb")
+}
+
+// checkCreatedAt checks that a policy was created by posting it to its collection path rather
+// than to an item path
+func checkCreatedAt(t *testing.T, server *acctest.Server, path string) {
+	t.Helper()
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, "POST "+acctest.AzureAPIPrefix+path) && request != "POST "+acctest.AzureAPIPrefix+path {
+			t.Errorf("policy created with %s, want POST %s", request, acctest.AzureAPIPrefix+path)
+		}
+	}
+}
+
+func TestResourceAzureCosmosDbBackupPolicyHealthCheck(t *testing.T) {
+	p, server := testAzureProvider(t)
+	var healthCheck acctest.Object
+	server.Collection(acctest.Collection{
+		Path: "/policies/cosmosDb",
+		Store: func(s *acctest.Server, obj acctest.Object) {
+			healthCheck, _ = obj["healthCheckSchedule"].(acctest.Object)
+			storePolicy(s, obj)
+		},
+	})
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_cosmos_backup_policy",
+		Steps: []acctest.Step{
+			{Config: map[string]interface{}{
+				"name":               "checked-cosmos",
+				"is_enabled":         true,
+				"backup_type":        "AllSubscriptions",
+				"tenant_id":          "00000000-0000-0000-0000-00000000cccc",
+				"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+				"regions": []interface{}{
+					map[string]interface{}{"name": "EastUS"},
+				},
+				"backup_workloads": []interface{}{"MongoDB"},
+				"daily_schedule": []interface{}{
+					map[string]interface{}{"daily_type": "EveryDay"},
+				},
+				"health_check_schedule": []interface{}{
+					map[string]interface{}{
+						"health_check_enabled": true,
+						"day_number_in_month":  "OnDay",
+						"day_of_month":         15,
+					},
+				},
+			}},
+		},
+	}.Run(t)
+
+	want := acctest.Object{"healthCheckEnabled": true, "dayNumberInMonth": "OnDay", "dayOfMonth": float64(15)}
+	if !reflect.DeepEqual(healthCheck, want) {
+		t.Errorf("healthCheckSchedule = %v, want %v", healthCheck, want)
+	}
+}
+
+func TestResourceAzureCosmosDbBackupPolicyRepositoryValidation(t *testing.T) {
+	r := Provider().ResourcesMap["veeambackup_azure_cosmos_backup_policy"]
+	config := func() map[string]interface{} {
+		return map[string]interface{}{
+			"name":               "cosmos",
+			"is_enabled":         true,
+			"backup_type":        "AllSubscriptions",
+			"tenant_id":          "00000000-0000-0000-0000-00000000cccc",
+			"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+			"regions": []interface{}{
+				map[string]interface{}{"name": "EastUS"},
+			},
+		}
+	}
+
+	scheduleWithoutWorkloads := config()
+	scheduleWithoutWorkloads["continuous_backup_type"] = "Continuous7Days"
+	scheduleWithoutWorkloads["daily_schedule"] = []interface{}{
+		map[string]interface{}{"daily_type": "EveryDay"},
+	}
+	workloadsWithoutSchedule := config()
+	workloadsWithoutSchedule["backup_workloads"] = []interface{}{"MongoDB"}
+	workloadsWithoutSchedule["default_backup_account_id"] = "00000000-0000-0000-0000-00000000ffff"
+
+	for name, config := range map[string]map[string]interface{}{
+		"schedule without backup_workloads": scheduleWithoutWorkloads,
+		"backup_workloads without schedule": workloadsWithoutSchedule,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestResourceAzureVMRestoreModeValidation(t *testing.T) {
+	r := azure.ResourceAzureVMRestore()
+	config := func(mode string, alternative bool) map[string]interface{} {
+		config := map[string]interface{}{
+			"restore_point_id":   "00000000-0000-0000-0000-00000000aaaa",
+			"reason":             "Restore after a failed update",
+			"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+		}
+		if mode != "" {
+			config["restore_mode"] = mode
+		}
+		if alternative {
+			config["to_alternative"] = []interface{}{
+				map[string]interface{}{
+					"name":      "restored-vm",
+					"disk_type": "Premium_LRS",
+					"subscription": []interface{}{
+						map[string]interface{}{"id": "00000000-0000-0000-0000-00000000bbbb", "environment": "AzurePublic"},
+					},
+				},
+			}
+		}
+		return config
+	}
+
+	for name, tc := range map[string]struct {
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		"original":                     {config("Original", false), false},
+		"alternative":                  {config("Alternative", true), false},
+		"inferred":                     {config("", true), false},
+		"original with to_alternative": {config("Original", true), true},
+		"alternative without settings": {config("Alternative", false), true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestResourceAzureRBACRoleAssignment(t *testing.T) {
+	p, server := testAzureProvider(t)
+	server.Collection(acctest.Collection{
+		Path: "/users",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			delete(obj, "password")
+		},
+	})
+
+	config := func(role string) map[string]interface{} {
+		return map[string]interface{}{
+			"user_name":   "restore-operator",
+			"role":        role,
+			"description": "Operators restoring VMs",
+			"password":    "secret",
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_rbac_role_assignment",
+		Steps: []acctest.Step{
+			{Config: config("RestoreOperator")},
+			{Config: config("PortalOperator")},
+		},
+		ImportStateVerifyIgnore: []string{"password"},
+	}.Run(t)
+}
+
+func TestResourceAzureRBACRoleAssignmentValidation(t *testing.T) {
+	p := Provider()
+	r := p.ResourcesMap["veeambackup_azure_rbac_role_assignment"]
+
+	for name, config := range map[string]map[string]interface{}{
+		"local user without password": {
+			"user_name": "operator",
+			"role":      "PortalOperator",
+		},
+		"Entra ID group with password": {
+			"user_name":       "backup-admins",
+			"user_type":       "EntraGroup",
+			"entra_object_id": "00000000-0000-0000-0000-00000000aaaa",
+			"role":            "PortalAdministrator",
+			"password":        "secret",
+		},
+		"Entra ID user without object ID": {
+			"user_name": "jane@example.com",
+			"user_type": "EntraUser",
+			"role":      "RestoreOperator",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestResourceAzureCosmosDbBackupPolicyTagGroupSubscriptions(t *testing.T) {
+	p, server := testAzureProvider(t)
+	var selectedItems acctest.Object
+	server.Collection(acctest.Collection{
+		Path: "/policies/cosmosDb",
+		Store: func(s *acctest.Server, obj acctest.Object) {
+			selectedItems, _ = obj["selectedItems"].(acctest.Object)
+			storePolicy(s, obj)
+		},
+	})
+
+	config := func(tagGroup map[string]interface{}) map[string]interface{} {
+		tagGroup["name"] = "production"
+		tagGroup["tags"] = []interface{}{
+			map[string]interface{}{"name": "backup", "value": "true"},
+		}
+		return map[string]interface{}{
+			"name":               "tagged-cosmos",
+			"is_enabled":         true,
+			"backup_type":        "SelectedItems",
+			"tenant_id":          "00000000-0000-0000-0000-00000000cccc",
+			"service_account_id": "00000000-0000-0000-0000-00000000dddd",
+			"regions": []interface{}{
+				map[string]interface{}{"name": "EastUS"},
+			},
+			"selected_items": []interface{}{
+				map[string]interface{}{"tag_groups": []interface{}{tagGroup}},
+			},
+		}
+	}
+	checkTagGroups := func(want ...acctest.Object) func(*testing.T, *terraform.InstanceState) {
+		return func(t *testing.T, _ *terraform.InstanceState) {
+			wantTagGroups := make([]interface{}, len(want))
+			for i, tagGroup := range want {
+				tagGroup["name"] = "production"
+				tagGroup["tags"] = []interface{}{acctest.Object{"name": "backup", "value": "true"}}
+				wantTagGroups[i] = tagGroup
+			}
+			if got := selectedItems["tagGroups"]; !reflect.DeepEqual(got, wantTagGroups) {
+				t.Errorf("selectedItems.tagGroups = %v, want %v", got, wantTagGroups)
+			}
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_azure_cosmos_backup_policy",
+		Steps: []acctest.Step{
+			{
+				Config: config(map[string]interface{}{
+					"subscription": []interface{}{
+						map[string]interface{}{"subscription_id": "00000000-0000-0000-0000-00000000eeee"},
+						map[string]interface{}{"subscription_id": "00000000-0000-0000-0000-00000000ffff"},
+					},
+				}),
+				Check: checkTagGroups(
+					acctest.Object{"subscription": acctest.Object{"subscriptionId": "00000000-0000-0000-0000-00000000eeee"}},
+					acctest.Object{"subscription": acctest.Object{"subscriptionId": "00000000-0000-0000-0000-00000000ffff"}},
+				),
+			},
+			{
+				Config: config(map[string]interface{}{
+					"subsciption": []interface{}{
+						map[string]interface{}{"subscription_id": "00000000-0000-0000-0000-00000000eeee"},
+					},
+					"resource_groups": []interface{}{
+						map[string]interface{}{"id": "00000000-0000-0000-0000-0000000000a1"},
+						map[string]interface{}{"id": "00000000-0000-0000-0000-0000000000a2"},
+					},
+				}),
+				Check: checkTagGroups(
+					acctest.Object{
+						"subscription":   acctest.Object{"subscriptionId": "00000000-0000-0000-0000-00000000eeee"},
+						"resourceGroups": acctest.Object{"id": "00000000-0000-0000-0000-0000000000a1"},
+					},
+					acctest.Object{
+						"subscription":   acctest.Object{"subscriptionId": "00000000-0000-0000-0000-00000000eeee"},
+						"resourceGroups": acctest.Object{"id": "00000000-0000-0000-0000-0000000000a2"},
+					},
+				),
+			},
+		},
+	}.Run(t)
+}
+
+// TestResourceAzurePolicyTagGroupSubscriptionAlias checks that the deprecated subsciption alias of
+// tag groups accepts the same values as subscription, and as the alias of the other policies
+func TestResourceAzurePolicyTagGroupSubscriptionAlias(t *testing.T) {
+	var first *schema.Schema
+	for _, name := range []string{"veeambackup_azure_vm_backup_policy", "veeambackup_azure_cosmos_backup_policy"} {
+		selectedItems := Provider().ResourcesMap[name].Schema["selected_items"].Elem.(*schema.Resource)
+		tagGroup := selectedItems.Schema["tag_groups"].Elem.(*schema.Resource)
+		subscription, alias := tagGroup.Schema["subscription"], tagGroup.Schema["subsciption"]
+		if alias == nil || alias.Deprecated == "" {
+			t.Errorf("%s: subsciption is not a deprecated alias", name)
+			continue
+		}
+		if alias.MaxItems != subscription.MaxItems || !reflect.DeepEqual(alias.Elem, subscription.Elem) {
+			t.Errorf("%s: subsciption does not accept the same values as subscription", name)
+		}
+		if first == nil {
+			first = alias
+		} else if alias.MaxItems != first.MaxItems || !reflect.DeepEqual(alias.Elem, first.Elem) {
+			t.Errorf("%s: subsciption differs from the alias of veeambackup_azure_vm_backup_policy", name)
+		}
+	}
+}
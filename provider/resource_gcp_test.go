@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testGCPProvider returns a provider configured against a new mock VB for Google Cloud server
+func testGCPProvider(t *testing.T) (*schema.Provider, *acctest.Server) {
+	server := acctest.NewGCPServer(t)
+	p := Provider()
+	acctest.ConfigureProvider(t, p, acctest.GCPProviderConfig(server))
+	return p, server
+}
+
+func TestResourceGCPRepository(t *testing.T) {
+	p, server := testGCPProvider(t)
+	server.Collection(acctest.Collection{
+		Path: "/repositories",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			obj["region"] = "europe-west1"
+			delete(obj, "password")
+		},
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":               "gcs-backups",
+			"description":        description,
+			"service_account_id": "00000000-0000-0000-0000-00000000aaaa",
+			"project_id":         "backup-project",
+			"bucket_name":        "veeam-backups",
+			"folder_name":        "vm",
+			"storage_class":      "Nearline",
+			"enable_encryption":  true,
+			"password":           "secret",
+			"hint":               "vault",
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_gcp_repository",
+		Steps: []acctest.Step{
+			{Config: config("VM backups")},
+			{Config: config("VM and SQL backups")},
+		},
+		ImportStateVerifyIgnore: []string{"password"},
+	}.Run(t)
+}
+
+func TestResourceGCPVMBackupPolicy(t *testing.T) {
+	p, server := testGCPProvider(t)
+	server.Collection(acctest.Collection{Path: "/policies/vm"})
+
+	config := func(snapshotsToKeep int) map[string]interface{} {
+		return map[string]interface{}{
+			"name":               "gce-daily",
+			"description":        "Production instances",
+			"service_account_id": "00000000-0000-0000-0000-00000000aaaa",
+			"project_ids":        []interface{}{"prod-project"},
+			"regions":            []interface{}{"europe-west1", "europe-west4"},
+			"selected_items": []interface{}{map[string]interface{}{
+				"labels": map[string]interface{}{"env": "prod", "backup": "daily"},
+			}},
+			"daily_schedule": []interface{}{map[string]interface{}{
+				"daily_type": "EveryDay",
+				"snapshot_schedule": []interface{}{map[string]interface{}{
+					"hours":             []interface{}{6, 18},
+					"snapshots_to_keep": snapshotsToKeep,
+				}},
+				"backup_schedule": []interface{}{map[string]interface{}{
+					"hours":                []interface{}{22},
+					"target_repository_id": "00000000-0000-0000-0000-00000000bbbb",
+					"retention": []interface{}{map[string]interface{}{
+						"time_retention_duration": 14,
+						"retention_duration_type": "Days",
+					}},
+				}},
+			}},
+			"yearly_schedule": []interface{}{map[string]interface{}{
+				"type":                  "Last",
+				"day_of_week":           "Friday",
+				"month":                 "December",
+				"retention_years_count": 7,
+				"target_repository_id":  "00000000-0000-0000-0000-00000000bbbb",
+			}},
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_gcp_vm_backup_policy",
+		Steps: []acctest.Step{
+			{Config: config(3)},
+			{Config: config(5)},
+		},
+	}.Run(t)
+}
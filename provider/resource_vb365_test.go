@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testVB365Provider returns a provider configured against a new mock VB365 server
+func testVB365Provider(t *testing.T) (*schema.Provider, *acctest.Server) {
+	server := acctest.NewVB365Server(t)
+	p := Provider()
+	acctest.ConfigureProvider(t, p, acctest.VB365ProviderConfig(server))
+	return p, server
+}
+
+func TestResourceVB365Organization(t *testing.T) {
+	p, server := testVB365Provider(t)
+	server.Collection(acctest.Collection{
+		Path: "/Organizations",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			// The application certificate is never returned
+			for _, key := range []string{"exchangeOnlineSettings", "sharePointOnlineSettings"} {
+				if settings, ok := obj[key].(acctest.Object); ok {
+					obj["name"] = settings["officeOrganizationName"]
+					delete(settings, "applicationCertificate")
+					delete(settings, "applicationCertificatePassword")
+				}
+			}
+		},
+	})
+
+	config := func(teamsChats bool) map[string]interface{} {
+		return map[string]interface{}{
+			"office_organization_name":         "contoso.onmicrosoft.com",
+			"application_id":                   "00000000-0000-0000-0000-00000000aaaa",
+			"application_certificate":          "MIIKcQIBAzCCCi0GCSqGSIb3DQEHAaCC",
+			"application_certificate_password": "secret",
+			"teams_chats":                      teamsChats,
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vb365_organization",
+		Steps: []acctest.Step{
+			{Config: config(false)},
+			{Config: config(true)},
+		},
+		ImportStateVerifyIgnore: []string{"application_certificate", "application_certificate_password"},
+	}.Run(t)
+}
+
+func TestResourceVB365BackupRepository(t *testing.T) {
+	p, server := testVB365Provider(t)
+	server.Collection(acctest.Collection{Path: "/BackupRepositories"})
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vb365_backup_repository",
+		Steps: []acctest.Step{
+			{Config: map[string]interface{}{
+				"name":     "m365-repository",
+				"proxy_id": "00000000-0000-0000-0000-00000000aaaa",
+				"path":     `D:\Backups\M365`,
+			}},
+			{Config: map[string]interface{}{
+				"name":                   "m365-repository",
+				"description":            "Daily retention",
+				"proxy_id":               "00000000-0000-0000-0000-00000000aaaa",
+				"path":                   `D:\Backups\M365`,
+				"retention_period_type":  "Daily",
+				"daily_retention_period": 90,
+			}},
+		},
+	}.Run(t)
+}
+
+func TestResourceVB365Proxy(t *testing.T) {
+	p, server := testVB365Provider(t)
+	server.Collection(acctest.Collection{
+		Path: "/Proxies",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			obj["version"] = "8.0.0.2135"
+			delete(obj, "username")
+			delete(obj, "password")
+		},
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"hostname":    "proxy01.example.com",
+			"description": description,
+			"username":    `EXAMPLE\veeam`,
+			"password":    "secret",
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vb365_proxy",
+		Steps: []acctest.Step{
+			{Config: config("First proxy")},
+			{Config: config("Exchange proxy")},
+		},
+		ImportStateVerifyIgnore: []string{"username", "password"},
+	}.Run(t)
+}
+
+func TestResourceVB365BackupJob(t *testing.T) {
+	p, server := testVB365Provider(t)
+	organizationID := "00000000-0000-0000-0000-00000000aaaa"
+	server.Collection(acctest.Collection{
+		Path:       "/Jobs",
+		CreatePath: "/Organizations/" + organizationID + "/Jobs",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			obj["organizationId"] = organizationID
+			obj["lastStatus"] = "Stopped"
+		},
+	})
+
+	schedule := []interface{}{map[string]interface{}{
+		"type":       "Daily",
+		"daily_type": "Everyday",
+		"daily_time": "22:00:00",
+	}}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vb365_backup_job",
+		Steps: []acctest.Step{
+			{Config: map[string]interface{}{
+				"organization_id": organizationID,
+				"name":            "m365-entire-organization",
+				"repository_id":   "00000000-0000-0000-0000-00000000bbbb",
+				"scope": []interface{}{map[string]interface{}{
+					"mailbox":  true,
+					"onedrive": true,
+					"sites":    true,
+					"teams":    true,
+				}},
+				"schedule_policy": schedule,
+			}},
+			{Config: map[string]interface{}{
+				"organization_id": organizationID,
+				"name":            "m365-executives",
+				"description":     "Executive mailboxes and sites",
+				"repository_id":   "00000000-0000-0000-0000-00000000bbbb",
+				"backup_type":     "SelectedItems",
+				"scope": []interface{}{map[string]interface{}{
+					"mailbox":         true,
+					"archive_mailbox": true,
+				}},
+				"users":           []interface{}{"00000000-0000-0000-0000-0000000000c1", "00000000-0000-0000-0000-0000000000c2"},
+				"sites":           []interface{}{"00000000-0000-0000-0000-0000000000d1"},
+				"schedule_policy": schedule,
+			}},
+		},
+	}.Run(t)
+}
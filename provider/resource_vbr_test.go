@@ -0,0 +1,976 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"terraform-provider-veeambackup/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testVBRProvider returns a provider configured against a new mock VBR server
+func testVBRProvider(t *testing.T) (*schema.Provider, *acctest.Server) {
+	server := acctest.NewVBRServer(t)
+	p := Provider()
+	acctest.ConfigureProvider(t, p, acctest.VBRProviderConfig(server))
+	return p, server
+}
+
+func TestResourceVBRAmazonCloudCredential(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path: "/api/v1/cloudCredentials",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			delete(obj, "secretKey")
+		},
+	})
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_amazon_cloud_credential",
+		Steps: []acctest.Step{
+			{Config: map[string]interface{}{
+				"access_key": "AKIAEXAMPLE",
+				"secret_key": "secret",
+			}},
+			{Config: map[string]interface{}{
+				"access_key":  "AKIAEXAMPLE",
+				"secret_key":  "secret",
+				"description": "Backup account",
+			}},
+		},
+		ImportStateVerifyIgnore: []string{"secret_key"},
+	}.Run(t)
+}
+
+func TestResourceVBRAzureCloudCredential_storage(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path: "/api/v1/cloudCredentials",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			delete(obj, "sharedKey")
+		},
+	})
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_azure_cloud_credential",
+		Steps: []acctest.Step{
+			{Config: map[string]interface{}{
+				"type":       "AzureStorage",
+				"account":    "backupstorage",
+				"shared_key": "key",
+			}},
+			{Config: map[string]interface{}{
+				"type":        "AzureStorage",
+				"account":     "backupstorage",
+				"shared_key":  "key",
+				"description": "Archive storage",
+			}},
+		},
+		ImportStateVerifyIgnore: []string{"shared_key"},
+	}.Run(t)
+}
+
+func TestResourceVBRAzureCloudCredential_existingAccount(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path: "/api/v1/cloudCredentials",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			// The account details are returned at the root, without the secret
+			if account, ok := obj["existingAccount"].(acctest.Object); ok {
+				obj["deployment"] = account["deployment"]
+				obj["subscription"] = account["subscription"]
+			}
+			delete(obj, "existingAccount")
+			delete(obj, "creationMode")
+			if subscription, ok := obj["subscription"].(acctest.Object); ok {
+				delete(subscription, "secret")
+			}
+		},
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":            "AzureCompute",
+			"connection_name": "production",
+			"creation_mode":   "ExistingAccount",
+			"description":     description,
+			"existing_account": []interface{}{map[string]interface{}{
+				"deployment": []interface{}{map[string]interface{}{
+					"deployment_type": "MicrosoftAzure",
+					"region":          "Global",
+				}},
+				"subscription": []interface{}{map[string]interface{}{
+					"tenant_id":      "00000000-0000-0000-0000-00000000aaaa",
+					"application_id": "00000000-0000-0000-0000-00000000bbbb",
+					"secret":         "secret",
+				}},
+			}},
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_azure_cloud_credential",
+		Steps: []acctest.Step{
+			{Config: config("Production subscription")},
+			{Config: config("Production subscription (backup)")},
+		},
+		ImportStateVerifyIgnore: []string{"creation_mode", "existing_account.0.subscription.0.secret"},
+	}.Run(t)
+}
+
+func TestResourceVBRRepository(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path: "/api/v1/backupInfrastructure/repositories",
+		Respond: func(s *acctest.Server, obj acctest.Object) interface{} {
+			return s.Session("RepositoryManagement", obj["id"].(string))
+		},
+	})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        "azure-blob",
+			"description": description,
+			"type":        "AzureBlob",
+			"account": []interface{}{map[string]interface{}{
+				"credential_id": "00000000-0000-0000-0000-00000000cccc",
+				"region_type":   "Global",
+				"connection_settings": []interface{}{map[string]interface{}{
+					"connection_type": "Direct",
+				}},
+			}},
+			"container": []interface{}{map[string]interface{}{
+				"container_name": "backups",
+				"folder_name":    "vbr",
+			}},
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_repository",
+		Steps: []acctest.Step{
+			{Config: config("Object storage repository")},
+			{Config: config("Object storage repository for offloads")},
+		},
+	}.Run(t)
+}
+
+func TestResourceVBRRepositorySMBGateway(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path: "/api/v1/backupInfrastructure/repositories",
+		Respond: func(s *acctest.Server, obj acctest.Object) interface{} {
+			return s.Session("RepositoryManagement", obj["id"].(string))
+		},
+	})
+
+	config := func(gatewayServer map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        "smb",
+			"description": "SMB repository",
+			"type":        "Smb",
+			"share": []interface{}{map[string]interface{}{
+				"share_path":     `\\nas01\backups`,
+				"credentials_id": "00000000-0000-0000-0000-00000000cccc",
+				"gateway_server": []interface{}{gatewayServer},
+			}},
+			"mount_server": []interface{}{map[string]interface{}{
+				"mount_server_settings_type": "Windows",
+				"windows": []interface{}{map[string]interface{}{
+					"mount_server_id":     "00000000-0000-0000-0000-00000000eeee",
+					"v_power_nfs_enabled": true,
+					"write_cache_folder":  `C:\ProgramData\Veeam\Backup\IRCache`,
+				}},
+			}},
+		}
+	}
+	gateway := func(want acctest.Object) func(t *testing.T, state *terraform.InstanceState) {
+		return func(t *testing.T, state *terraform.InstanceState) {
+			repository, _ := server.Get("/api/v1/backupInfrastructure/repositories/" + state.ID)
+			share, _ := repository["share"].(acctest.Object)
+			if got := share["gatewayServer"]; fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("gatewayServer = %v, want %v", got, want)
+			}
+			mountServer, _ := repository["mountServer"].(acctest.Object)
+			windows, _ := mountServer["windows"].(acctest.Object)
+			if windows["writeCacheFolder"] != `C:\ProgramData\Veeam\Backup\IRCache` {
+				t.Errorf("writeCacheFolder = %v", windows["writeCacheFolder"])
+			}
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_repository",
+		Steps: []acctest.Step{
+			{
+				Config: config(map[string]interface{}{"auto_select_enabled": true}),
+				Check:  gateway(acctest.Object{"autoSelectEnabled": true}),
+			},
+			{
+				Config: config(map[string]interface{}{
+					"auto_select_enabled": false,
+					"gateway_server_ids":  []interface{}{"00000000-0000-0000-0000-00000000ffff"},
+				}),
+				Check: gateway(acctest.Object{"autoSelectEnabled": false, "gatewayServerIds": []interface{}{"00000000-0000-0000-0000-00000000ffff"}}),
+			},
+		},
+	}.Run(t)
+}
+
+func TestResourceVBRUnstructuredDataServer(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path: "/api/v1/inventory/unstructuredDataServers",
+		Store: func(_ *acctest.Server, obj acctest.Object) {
+			// SMB shares are listed under their path
+			obj["name"] = obj["path"]
+		},
+		Respond: func(s *acctest.Server, obj acctest.Object) interface{} {
+			return s.Session("InventoryManagement", obj["id"].(string))
+		},
+	})
+
+	config := func(processingMode string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":                        "SMBShare",
+			"path":                        `\\fs01\share`,
+			"access_credentials_required": true,
+			"access_credentials_id":       "00000000-0000-0000-0000-00000000dddd",
+			"processing": []interface{}{map[string]interface{}{
+				"backup_proxies": []interface{}{map[string]interface{}{
+					"auto_selection_enabled": true,
+				}},
+			}},
+			"advanced_settings": []interface{}{map[string]interface{}{
+				"processing_mode": processingMode,
+			}},
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_unstructured_data_server",
+		Steps: []acctest.Step{
+			{Config: config("Direct")},
+			{Config: config("VSSSnapshot")},
+		},
+	}.Run(t)
+}
+
+// storeJob mimics how VBR stores a job schedule: times of day gain seconds and the retry settings
+// are filled in with defaults when not sent
+func storeJob(_ *acctest.Server, obj acctest.Object) {
+	sched, ok := obj["schedule"].(acctest.Object)
+	if !ok {
+		return
+	}
+	if daily, ok := sched["daily"].(acctest.Object); ok {
+		if localTime, ok := daily["localTime"].(string); ok && len(localTime) == len("15:04") {
+			daily["localTime"] = localTime + ":00"
+		}
+	}
+	if _, ok := sched["retry"]; !ok {
+		sched["retry"] = acctest.Object{"isEnabled": true, "retryCount": 3, "awaitMinutes": 10}
+	}
+}
+
+// testJobSchedule returns a daily schedule configuration running at localTime
+func testJobSchedule(localTime string) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"run_automatically": true,
+		"daily": []interface{}{map[string]interface{}{
+			"is_enabled": true,
+			"local_time": localTime,
+			"daily_kind": "Everyday",
+		}},
+	}}
+}
+
+func TestResourceVBRFileShareBackupJob(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+
+	config := func(description, localTime string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        "file-share-backup",
+			"description": description,
+			"objects": []interface{}{map[string]interface{}{
+				"file_server_id": "00000000-0000-0000-0000-00000000eeee",
+				"path":           `\\fs01\share`,
+			}},
+			"backup_repository": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+			}},
+			"schedule": testJobSchedule(localTime),
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_file_share_backup_job",
+		Steps: []acctest.Step{
+			{Config: config("Nightly file share backup", "22:00")},
+			{Config: config("Late file share backup", "23:30")},
+		},
+	}.Run(t)
+}
+
+func TestResourceVBRFileShareBackupJob_archiveRepository(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+
+	config := func(weeks int) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "file-share-backup",
+			"objects": []interface{}{map[string]interface{}{
+				"file_server_id": "00000000-0000-0000-0000-00000000eeee",
+				"path":           `\\fs01\share`,
+			}},
+			"backup_repository": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+			}},
+			"archive_repository": []interface{}{map[string]interface{}{
+				"archive_repository_id":        "00000000-0000-0000-0000-00000000abcd",
+				"archive_recent_file_versions": true,
+				"archive_retention_policy": []interface{}{map[string]interface{}{
+					"type":     "Years",
+					"quantity": 3,
+				}},
+				"gfs_retention_policy": []interface{}{map[string]interface{}{
+					"weekly": []interface{}{map[string]interface{}{
+						"is_enabled":      true,
+						"keep_for_number": weeks,
+					}},
+					"yearly": []interface{}{map[string]interface{}{
+						"is_enabled":      true,
+						"keep_for_number": 7,
+					}},
+				}},
+				"cost_optimization": []interface{}{map[string]interface{}{
+					"is_enabled":              true,
+					"archive_older_than_days": 180,
+				}},
+				"file_archive_settings": []interface{}{map[string]interface{}{
+					"archival_type":  "ExclusionMask",
+					"exclusion_mask": []interface{}{"*.tmp"},
+				}},
+			}},
+		}
+	}
+	checkWeeks := func(weeks float64) func(t *testing.T, state *terraform.InstanceState) {
+		return func(t *testing.T, state *terraform.InstanceState) {
+			job, _ := server.Get("/api/v1/jobs/" + state.ID)
+			archive, _ := job["archiveRepository"].(acctest.Object)
+			gfs, _ := archive["gfsRetentionPolicy"].(acctest.Object)
+			weekly, _ := gfs["weekly"].(acctest.Object)
+			if weekly["keepForNumber"] != weeks {
+				t.Errorf("stored gfsRetentionPolicy = %v, want %v weeks", gfs, weeks)
+			}
+			if cost, _ := archive["costOptimization"].(acctest.Object); cost["archiveOlderThanDays"] != float64(180) {
+				t.Errorf("stored costOptimization = %v, want archiving after 180 days", cost)
+			}
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_file_share_backup_job",
+		Steps: []acctest.Step{
+			{Config: config(4), Check: checkWeeks(4)},
+			{Config: config(8), Check: checkWeeks(8)},
+		},
+	}.Run(t)
+}
+
+func TestResourceVBRFileShareBackupJob_descriptionSuffix(t *testing.T) {
+	server := acctest.NewVBRServer(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+	config := acctest.VBRProviderConfig(server)
+	config["default_description_suffix"] = " [terraform]"
+	p := Provider()
+	acctest.ConfigureProvider(t, p, config)
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_file_share_backup_job",
+		Steps: []acctest.Step{{
+			Config: map[string]interface{}{
+				"name":        "file-share-backup",
+				"description": "Nightly file share backup",
+				"objects": []interface{}{map[string]interface{}{
+					"file_server_id": "00000000-0000-0000-0000-00000000eeee",
+					"path":           `\\fs01\share`,
+				}},
+				"backup_repository": []interface{}{map[string]interface{}{
+					"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+				}},
+			},
+			Check: func(t *testing.T, state *terraform.InstanceState) {
+				job, _ := server.Get("/api/v1/jobs/" + state.ID)
+				if got := job["description"]; got != "Nightly file share backup [terraform]" {
+					t.Errorf("stored description = %q, want the configured one with the suffix", got)
+				}
+			},
+		}},
+	}.Run(t)
+}
+
+func TestResourceVBRFileShareBackupJob_afterThisJobID(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+	const upstreamID = "00000000-0000-0000-0000-00000000dddd"
+	server.Put("/api/v1/jobs/"+upstreamID, acctest.Object{"id": upstreamID, "name": "vm-backup", "type": "Backup"})
+
+	config := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        "file-share-backup",
+			"description": description,
+			"objects": []interface{}{map[string]interface{}{
+				"file_server_id": "00000000-0000-0000-0000-00000000eeee",
+				"path":           `\\fs01\share`,
+			}},
+			"backup_repository": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+			}},
+			"schedule": []interface{}{map[string]interface{}{
+				"run_automatically": true,
+				"after_this_job": []interface{}{map[string]interface{}{
+					"is_enabled": true,
+					"job_id":     upstreamID,
+				}},
+			}},
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_file_share_backup_job",
+		Steps: []acctest.Step{
+			{
+				Config: config("Runs after the VM backup"),
+				Check: func(t *testing.T, state *terraform.InstanceState) {
+					job, _ := server.Get("/api/v1/jobs/" + state.ID)
+					after := job["schedule"].(acctest.Object)["afterThisJob"].(acctest.Object)
+					if got := after["jobName"]; got != "vm-backup" {
+						t.Errorf("stored afterThisJob.jobName = %q, want the name of job %s", got, upstreamID)
+					}
+
+					// Rename the upstream job the way VBR does, updating the jobs chained to it
+					server.Put("/api/v1/jobs/"+upstreamID, acctest.Object{"id": upstreamID, "name": "vm-backup-renamed", "type": "Backup"})
+					after["jobName"] = "vm-backup-renamed"
+				},
+			},
+			{
+				Config: config("Still runs after the VM backup"),
+				Check: func(t *testing.T, state *terraform.InstanceState) {
+					if got := state.Attributes["schedule.0.after_this_job.0.job_id"]; got != upstreamID {
+						t.Errorf("job_id = %q after the upstream job was renamed, want %s", got, upstreamID)
+					}
+					if got := state.Attributes["schedule.0.after_this_job.0.job_name"]; got != "vm-backup-renamed" {
+						t.Errorf("job_name = %q, want the new name of the upstream job", got)
+					}
+				},
+			},
+		},
+	}.Run(t)
+}
+
+func TestResourceVBRFileShareBackupJob_additionalSettings(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+
+	config := func(additionalSettings string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "file-share-backup",
+			"objects": []interface{}{map[string]interface{}{
+				"file_server_id": "00000000-0000-0000-0000-00000000eeee",
+				"path":           `\\fs01\share`,
+			}},
+			"backup_repository": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+			}},
+			"additional_settings_json": additionalSettings,
+		}
+	}
+	checkQuota := func(sizeGB float64) func(t *testing.T, state *terraform.InstanceState) {
+		return func(t *testing.T, state *terraform.InstanceState) {
+			job, _ := server.Get("/api/v1/jobs/" + state.ID)
+			quota, _ := job["storageQuota"].(acctest.Object)
+			if quota["isEnabled"] != true || quota["sizeGB"] != sizeGB {
+				t.Errorf("stored storageQuota = %v, want it enabled with %v GB", job["storageQuota"], sizeGB)
+			}
+			repository, _ := job["backupRepository"].(acctest.Object)
+			if repository["backupRepositoryId"] != "00000000-0000-0000-0000-00000000ffff" || repository["sourceBackupId"] != "backup-1" {
+				t.Errorf("stored backupRepository = %v, want the modeled and the additional settings merged", repository)
+			}
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_file_share_backup_job",
+		Steps: []acctest.Step{
+			{
+				Config: config(`{"storageQuota": {"isEnabled": true, "sizeGB": 100}, "backupRepository": {"sourceBackupId": "backup-1"}}`),
+				Check:  checkQuota(100),
+			},
+			{
+				Config: config(`{"storageQuota": {"isEnabled": true, "sizeGB": 250}, "backupRepository": {"sourceBackupId": "backup-1"}}`),
+				Check:  checkQuota(250),
+			},
+		},
+		ImportStateVerifyIgnore: []string{"additional_settings_json"},
+	}.Run(t)
+}
+
+func TestResourceVBRObjectStorageBackupJob(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+
+	config := func(highPriority bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name":             "object-storage-backup",
+			"is_high_priority": highPriority,
+			"objects": []interface{}{map[string]interface{}{
+				"object_storage_server_id": "00000000-0000-0000-0000-00000000eeee",
+				"container":                "documents",
+				"inclusion_tag_mask": []interface{}{map[string]interface{}{
+					"name":          "backup",
+					"value":         "true",
+					"is_object_tag": true,
+				}},
+			}},
+			"backup_repository": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+			}},
+			"schedule": testJobSchedule("01:00"),
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_object_storage_backup_job",
+		Steps: []acctest.Step{
+			{Config: config(false)},
+			{Config: config(true)},
+		},
+	}.Run(t)
+}
+
+func TestResourceVBRObjectStorageBackupJob_deleteBackups(t *testing.T) {
+	ctx := context.Background()
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+	server.Collection(acctest.Collection{Path: "/api/v1/backups"})
+	server.Put("/api/v1/backups/other", acctest.Object{"id": "other", "name": "other-backup", "jobId": "00000000-0000-0000-0000-000000000001"})
+
+	config := func(preventDestroyData bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "object-storage-backup",
+			"objects": []interface{}{map[string]interface{}{
+				"object_storage_server_id": "00000000-0000-0000-0000-00000000eeee",
+				"container":                "documents",
+			}},
+			"backup_repository": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+			}},
+			"schedule":             testJobSchedule("01:00"),
+			"delete_backups":       true,
+			"prevent_destroy_data": preventDestroyData,
+		}
+	}
+	r := p.ResourcesMap["veeambackup_vbr_object_storage_backup_job"]
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_object_storage_backup_job",
+		Steps: []acctest.Step{
+			{Config: config(true), Check: func(t *testing.T, state *terraform.InstanceState) {
+				server.Put("/api/v1/backups/job", acctest.Object{"id": "job", "name": "object-storage-backup", "jobId": state.ID})
+
+				if _, diags := r.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, p.Meta()); !diags.HasError() {
+					t.Fatal("destroy with delete_backups and prevent_destroy_data succeeded")
+				}
+				if _, ok := server.Get("/api/v1/jobs/" + state.ID); !ok {
+					t.Fatal("refused destroy deleted the job")
+				}
+			}},
+			{Config: config(false)},
+		},
+		ImportStateVerifyIgnore: []string{"delete_backups", "prevent_destroy_data"},
+	}.Run(t)
+
+	if _, ok := server.Get("/api/v1/backups/job"); ok {
+		t.Error("backup of the job still exists after destroy")
+	}
+	if _, ok := server.Get("/api/v1/backups/other"); !ok {
+		t.Error("backup of another job was deleted")
+	}
+}
+
+func TestResourceVBRObjectStorageBackupJob_deprecatedActionVersionRention(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+
+	config := func(objectVersions map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "object-storage-backup",
+			"objects": []interface{}{map[string]interface{}{
+				"object_storage_server_id": "00000000-0000-0000-0000-00000000eeee",
+				"container":                "documents",
+			}},
+			"backup_repository": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+				"advanced_settings": []interface{}{map[string]interface{}{
+					"object_versions": []interface{}{objectVersions},
+				}},
+			}},
+			"schedule": testJobSchedule("01:00"),
+		}
+	}
+
+	r := p.ResourcesMap["veeambackup_vbr_object_storage_backup_job"]
+	both := config(map[string]interface{}{"action_version_retention": 5, "action_version_rention": 5})
+	if diags := r.Validate(terraform.NewResourceConfigRaw(both)); !diags.HasError() {
+		t.Error("expected action_version_rention to conflict with action_version_retention")
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_object_storage_backup_job",
+		Steps: []acctest.Step{
+			{
+				Config: config(map[string]interface{}{"version_retention_type": "KeepLastVersions", "action_version_rention": 5}),
+				Check: func(t *testing.T, state *terraform.InstanceState) {
+					job, _ := server.Get("/api/v1/jobs/" + state.ID)
+					settings := job["backupRepository"].(acctest.Object)["advancedSettings"].(acctest.Object)
+					if got := settings["objectVersions"].(acctest.Object)["actionVersionRetention"]; got != float64(5) {
+						t.Errorf("stored actionVersionRetention = %v, want 5 from action_version_rention", got)
+					}
+				},
+			},
+		},
+	}.Run(t)
+}
+
+func TestResourceVBRVmwareCloudDirectorJob(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+
+	config := func(proxyIDs []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "vcd-tenant-backup",
+			"includes": []interface{}{map[string]interface{}{
+				"host_name": "vcd01.example.com",
+				"name":      "tenant-a",
+				"type":      "Organization",
+			}},
+			"excluded_vms": []interface{}{map[string]interface{}{
+				"host_name": "vcd01.example.com",
+				"name":      "tenant-a-scratch",
+				"type":      "VirtualMachine",
+			}},
+			"storage": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+				"backup_proxies": []interface{}{map[string]interface{}{
+					"auto_selection": len(proxyIDs) == 0,
+					"proxy_ids":      proxyIDs,
+				}},
+			}},
+			"schedule": testJobSchedule("22:00"),
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_vmware_cloud_director_job",
+		Steps: []acctest.Step{
+			{
+				Config: config(nil),
+				Check: func(t *testing.T, state *terraform.InstanceState) {
+					job, _ := server.Get("/api/v1/jobs/" + state.ID)
+					if job["type"] != "CloudDirectorBackup" {
+						t.Errorf("stored type = %v, want CloudDirectorBackup", job["type"])
+					}
+					includes, _ := job["virtualMachines"].(acctest.Object)["includes"].([]interface{})
+					if len(includes) != 1 || includes[0].(acctest.Object)["platform"] != "CloudDirector" {
+						t.Errorf("stored includes = %v, want one Cloud Director object", includes)
+					}
+				},
+			},
+			{Config: config([]interface{}{"00000000-0000-0000-0000-00000000aaaa"})},
+		},
+		ImportStateVerifyIgnore: []string{"schedule", "storage.0.backup_proxies"},
+	}.Run(t)
+}
+
+func TestResourceVBRJobSet(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+
+	job := func(name, path string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": name,
+			"file_share_objects": []interface{}{map[string]interface{}{
+				"file_server_id": "00000000-0000-0000-0000-00000000eeee",
+				"path":           path,
+			}},
+		}
+	}
+	config := func(localTime string, jobs ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"job_type":    "FileBackup",
+			"description": "Department shares",
+			"backup_repository": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+			}},
+			"schedule": testJobSchedule(localTime),
+			"job":      jobs,
+		}
+	}
+	checkJobs := func(want map[string]string) func(*testing.T, *terraform.InstanceState) {
+		return func(t *testing.T, state *terraform.InstanceState) {
+			if got := state.Attributes["job_ids.%"]; got != fmt.Sprint(len(want)) {
+				t.Fatalf("job_ids.%% = %s, want %d", got, len(want))
+			}
+			for name, localTime := range want {
+				stored, ok := server.Get("/api/v1/jobs/" + state.Attributes["job_ids."+name])
+				if !ok {
+					t.Fatalf("job %s was not created", name)
+				}
+				if stored["name"] != name {
+					t.Errorf("job %s is stored as %v", name, stored["name"])
+				}
+				daily := stored["schedule"].(acctest.Object)["daily"].(acctest.Object)
+				if daily["localTime"] != localTime+":00" {
+					t.Errorf("job %s runs at %v, want %s", name, daily["localTime"], localTime)
+				}
+			}
+		}
+	}
+
+	acctest.Lifecycle{
+		Provider: p,
+		Resource: "veeambackup_vbr_job_set",
+		Steps: []acctest.Step{
+			{
+				Config: config("22:00", job("finance", `\\fs01\finance`), job("sales", `\\fs01\sales`)),
+				Check:  checkJobs(map[string]string{"finance": "22:00", "sales": "22:00"}),
+			},
+			{
+				Config: config("23:30", job("finance", `\\fs01\finance`), job("legal", `\\fs01\legal`)),
+				Check:  checkJobs(map[string]string{"finance": "23:30", "legal": "23:30"}),
+			},
+		},
+	}.Run(t)
+}
+
+func TestResourceVBRJobSetPartialCreate(t *testing.T) {
+	p, server := testVBRProvider(t)
+	rejected := map[string]bool{"sales": true}
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+		Validate: func(obj acctest.Object) error {
+			if rejected[obj["name"].(string)] {
+				return errors.New("the share is not available")
+			}
+			return nil
+		},
+	})
+
+	job := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": name,
+			"file_share_objects": []interface{}{map[string]interface{}{
+				"file_server_id": "00000000-0000-0000-0000-00000000eeee",
+				"path":           `\\fs01\` + name,
+			}},
+		}
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"job_type": "FileBackup",
+		"backup_repository": []interface{}{map[string]interface{}{
+			"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+		}},
+		"schedule": testJobSchedule("22:00"),
+		"job":      []interface{}{job("finance"), job("sales")},
+	})
+	ctx := context.Background()
+	r := p.ResourcesMap["veeambackup_vbr_job_set"]
+
+	plan, err := r.Diff(ctx, nil, config, p.Meta())
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	state, diags := r.Apply(ctx, nil, plan, p.Meta())
+	if diags.HasError() {
+		t.Fatalf("a partially created job set failed: %v", diags)
+	}
+	if len(diags) == 0 {
+		t.Error("the failed job is not reported")
+	}
+	if state == nil || state.ID == "" {
+		t.Fatal("a partially created job set is not stored")
+	}
+	if state.Attributes["job_ids.%"] != "1" || state.Attributes["job_ids.finance"] == "" {
+		t.Errorf("job_ids = %v, want only finance", state.Attributes)
+	}
+	financeID := state.Attributes["job_ids.finance"]
+
+	// The next apply creates the failed job
+	delete(rejected, "sales")
+	plan, err = r.Diff(ctx, state, config, p.Meta())
+	if err != nil {
+		t.Fatalf("plan after partial create: %s", err)
+	}
+	if plan.Empty() || plan.RequiresNew() {
+		t.Fatalf("plan after partial create = %v, want an in-place update", plan)
+	}
+	state, diags = r.Apply(ctx, state, plan, p.Meta())
+	if len(diags) > 0 {
+		t.Fatalf("creating the failed job: %v", diags)
+	}
+	if state.Attributes["job_ids.%"] != "2" {
+		t.Errorf("job_ids.%% = %s, want 2", state.Attributes["job_ids.%"])
+	}
+	if got := state.Attributes["job_ids.finance"]; got != financeID {
+		t.Errorf("finance was created again as %s, want %s", got, financeID)
+	}
+
+	// A job set of which no job could be created is not stored
+	rejected["legal"] = true
+	legal := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"job_type": "FileBackup",
+		"backup_repository": []interface{}{map[string]interface{}{
+			"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+		}},
+		"schedule": testJobSchedule("22:00"),
+		"job":      []interface{}{job("legal")},
+	})
+	plan, err = r.Diff(ctx, nil, legal, p.Meta())
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	state, diags = r.Apply(ctx, nil, plan, p.Meta())
+	if !diags.HasError() {
+		t.Error("a job set of which no job was created did not fail")
+	}
+	if state != nil && state.ID != "" {
+		t.Errorf("a job set of which no job was created is stored as %s", state.ID)
+	}
+}
+
+func TestResourceVBRJobSetUnknownAfterThisJob(t *testing.T) {
+	p, server := testVBRProvider(t)
+	server.Collection(acctest.Collection{
+		Path:  "/api/v1/jobs",
+		Store: storeJob,
+	})
+
+	job := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": name,
+			"file_share_objects": []interface{}{map[string]interface{}{
+				"file_server_id": "00000000-0000-0000-0000-00000000eeee",
+				"path":           `\\fs01\` + name,
+			}},
+		}
+	}
+	config := func(schedule []interface{}, jobs ...interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"job_type": "FileBackup",
+			"backup_repository": []interface{}{map[string]interface{}{
+				"backup_repository_id": "00000000-0000-0000-0000-00000000ffff",
+			}},
+			"schedule": schedule,
+			"job":      jobs,
+		})
+	}
+	unknown := []interface{}{map[string]interface{}{
+		"run_automatically": true,
+		"after_this_job": []interface{}{map[string]interface{}{
+			"is_enabled": true,
+			"job_id":     "00000000-0000-0000-0000-00000000dddd",
+		}},
+	}}
+	ctx := context.Background()
+	r := p.ResourcesMap["veeambackup_vbr_job_set"]
+
+	// No job is created when the job to run after cannot be resolved
+	plan, err := r.Diff(ctx, nil, config(unknown, job("finance"), job("sales")), p.Meta())
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	state, diags := r.Apply(ctx, nil, plan, p.Meta())
+	if !diags.HasError() {
+		t.Error("a job set chained to an unknown job did not fail")
+	}
+	if state != nil && (state.ID != "" || state.Attributes["job_ids.%"] != "") {
+		t.Errorf("a job set of which no job was created is stored as %s with %v", state.ID, state.Attributes)
+	}
+
+	// Nor is a job added, or the existing one changed, by an update
+	plan, err = r.Diff(ctx, nil, config(testJobSchedule("22:00"), job("finance")), p.Meta())
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	state, diags = r.Apply(ctx, nil, plan, p.Meta())
+	if diags.HasError() {
+		t.Fatalf("creating the job set: %v", diags)
+	}
+	financeID := state.Attributes["job_ids.finance"]
+	plan, err = r.Diff(ctx, state, config(unknown, job("finance"), job("legal")), p.Meta())
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	state, diags = r.Apply(ctx, state, plan, p.Meta())
+	if !diags.HasError() {
+		t.Error("chaining a job set to an unknown job did not fail")
+	}
+	if state.Attributes["job_ids.%"] != "1" || state.Attributes["job_ids.finance"] != financeID {
+		t.Errorf("job_ids = %v, want only finance as %s", state.Attributes, financeID)
+	}
+	if stored, _ := server.Get("/api/v1/jobs/" + financeID); stored["schedule"].(acctest.Object)["afterThisJob"] != nil {
+		t.Errorf("finance was chained to an unknown job: %v", stored["schedule"])
+	}
+}